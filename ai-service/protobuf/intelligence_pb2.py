@@ -25,7 +25,7 @@ _sym_db = _symbol_database.Default()
 from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1bprotobuf/intelligence.proto\x12\x0cintelligence\x1a\x1fgoogle/protobuf/timestamp.proto\"}\n\x19\x45nhanceTestimonialRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x13\n\x0btarget_tone\x18\x02 \x01(\t\x12\x1c\n\x14preserve_key_metrics\x18\x03 \x01(\x08\x12\x1f\n\x17key_points_to_emphasize\x18\x04 \x03(\t\"i\n\x1a\x45nhanceTestimonialResponse\x12\x15\n\renhanced_text\x18\x01 \x01(\t\x12\x1e\n\x16\x65nhancement_confidence\x18\x02 \x01(\x02\x12\x14\n\x0c\x63hanges_made\x18\x03 \x03(\t\"\\\n\x17\x41nalyzeSentimentRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x18\n\x10industry_context\x18\x02 \x01(\t\x12\x19\n\x11\x61spect_categories\x18\x03 \x03(\t\"\xa8\x01\n\x18\x41nalyzeSentimentResponse\x12\x17\n\x0fsentiment_score\x18\x01 \x01(\x02\x12/\n\x08\x65motions\x18\x02 \x03(\x0b\x32\x1d.intelligence.EmotionAnalysis\x12-\n\x07\x61spects\x18\x03 \x03(\x0b\x32\x1c.intelligence.AspectAnalysis\x12\x13\n\x0bkey_phrases\x18\x04 \x03(\t\"z\n\x1c\x44\x65tectFakeTestimonialRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12-\n\tuser_data\x18\x02 \x01(\x0b\x32\x1a.intelligence.UserMetadata\x12\x1d\n\x15previous_testimonials\x18\x03 \x03(\t\"\xdf\x01\n\x1d\x44\x65tectFakeTestimonialResponse\x12\x1a\n\x12\x61uthenticity_score\x18\x01 \x01(\x02\x12\x14\n\x0crisk_factors\x18\x02 \x03(\t\x12V\n\x0e\x66\x65\x61ture_scores\x18\x03 \x03(\x0b\x32>.intelligence.DetectFakeTestimonialResponse.FeatureScoresEntry\x1a\x34\n\x12\x46\x65\x61tureScoresEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x02:\x02\x38\x01\"\x8e\x01\n\x1bTranslateTestimonialRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x17\n\x0fsource_language\x18\x02 \x01(\t\x12\x17\n\x0ftarget_language\x18\x03 \x01(\t\x12\x15\n\rpreserve_tone\x18\x04 \x01(\x08\x12\x18\n\x10industry_context\x18\x05 \x01(\t\"u\n\x1cTranslateTestimonialResponse\x12\x17\n\x0ftranslated_text\x18\x01 \x01(\t\x12\x1e\n\x16translation_confidence\x18\x02 \x01(\x02\x12\x1c\n\x14\x63ultural_adaptations\x18\x03 \x03(\t\"\xb4\x01\n\x1fGenerateVideoTestimonialRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x35\n\x0c\x61vatar_prefs\x18\x02 \x01(\x0b\x32\x1f.intelligence.AvatarPreferences\x12\x13\n\x0bvoice_style\x18\x03 \x01(\t\x12\x37\n\x0f\x65motion_markers\x18\x04 \x03(\x0b\x32\x1e.intelligence.EmotionTimestamp\"h\n GenerateVideoTestimonialResponse\x12\x11\n\tvideo_url\x18\x01 \x01(\t\x12\x15\n\rthumbnail_url\x18\x02 \x01(\t\x12\x1a\n\x12generation_quality\x18\x03 \x01(\x02\"\xab\x01\n\x1fGenerateVoiceTestimonialRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x10\n\x08voice_id\x18\x02 \x01(\t\x12\x33\n\x0e\x65motion_points\x18\x03 \x03(\x0b\x32\x1b.intelligence.EmotionMarker\x12\x33\n\x0bpreferences\x18\x04 \x01(\x0b\x32\x1e.intelligence.AudioPreferences\"\xf2\x01\n GenerateVoiceTestimonialResponse\x12\x11\n\taudio_url\x18\x01 \x01(\t\x12\x61\n\x12\x65motion_confidence\x18\x02 \x03(\x0b\x32\x45.intelligence.GenerateVoiceTestimonialResponse.EmotionConfidenceEntry\x12\x1e\n\x16voice_naturality_score\x18\x03 \x01(\x02\x1a\x38\n\x16\x45motionConfidenceEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x02:\x02\x38\x01\"\x91\x01\n\x14GenerateStoryRequest\x12\x17\n\x0ftestimonial_ids\x18\x01 \x03(\t\x12\x17\n\x0fnarrative_style\x18\x02 \x01(\t\x12\x12\n\nkey_themes\x18\x03 \x03(\t\x12\x33\n\x0bpreferences\x18\x04 \x01(\x0b\x32\x1e.intelligence.StoryPreferences\"u\n\x15GenerateStoryResponse\x12\x12\n\nstory_text\x18\x01 \x01(\t\x12\x1b\n\x13source_testimonials\x18\x02 \x03(\t\x12+\n\x06themes\x18\x03 \x03(\x0b\x32\x1b.intelligence.ThemeAnalysis\"\x81\x01\n\x18GenerateAutoReplyRequest\x12\x18\n\x10testimonial_text\x18\x01 \x01(\t\x12\x13\n\x0b\x62rand_voice\x18\x02 \x01(\t\x12\x17\n\x0fsentiment_score\x18\x03 \x01(\x02\x12\x1d\n\x15key_points_to_address\x18\x04 \x03(\t\"`\n\x19GenerateAutoReplyResponse\x12\x12\n\nreply_text\x18\x01 \x01(\t\x12\x15\n\rempathy_score\x18\x02 \x01(\x02\x12\x18\n\x10\x61\x64\x64ressed_points\x18\x03 \x03(\t\"j\n\x19\x43ompetitorAnalysisRequest\x12\x1f\n\x17\x63ompetitor_testimonials\x18\x01 \x03(\t\x12\x10\n\x08industry\x18\x02 \x01(\t\x12\x1a\n\x12\x66\x65\x61ture_categories\x18\x03 \x03(\t\"\xa7\x02\n\x1a\x43ompetitorAnalysisResponse\x12\x31\n\x08insights\x18\x01 \x03(\x0b\x32\x1f.intelligence.CompetitorInsight\x12_\n\x14sentiment_comparison\x18\x02 \x03(\x0b\x32\x41.intelligence.CompetitorAnalysisResponse.SentimentComparisonEntry\x12\x39\n\x10\x66\x65\x61ture_analysis\x18\x03 \x03(\x0b\x32\x1f.intelligence.FeatureComparison\x1a:\n\x18SentimentComparisonEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x02:\x02\x38\x01\"\x90\x01\n\x1aSentimentPredictionRequest\x12:\n\x0fhistorical_data\x18\x01 \x03(\x0b\x32!.intelligence.HistoricalSentiment\x12\x1c\n\x14prediction_timeframe\x18\x02 \x01(\x05\x12\x18\n\x10\x65xternal_factors\x18\x03 \x03(\t\"\xaf\x01\n\x1bSentimentPredictionResponse\x12\x38\n\x0bpredictions\x18\x01 \x03(\x0b\x32#.intelligence.TimestampedPrediction\x12\x37\n\x14\x63ontributing_factors\x18\x02 \x03(\x0b\x32\x19.intelligence.TrendFactor\x12\x1d\n\x15prediction_confidence\x18\x03 \x01(\x02\"\x8e\x01\n\x10PlacementRequest\x12\x16\n\x0etestimonial_id\x18\x01 \x01(\t\x12\x14\n\x0cpage_context\x18\x02 \x01(\t\x12\x32\n\x0ftarget_audience\x18\x03 \x01(\x0b\x32\x19.intelligence.UserSegment\x12\x18\n\x10\x63onversion_goals\x18\x04 \x03(\t\"\x89\x02\n\x11PlacementResponse\x12>\n\x0frecommendations\x18\x01 \x03(\x0b\x32%.intelligence.PlacementRecommendation\x12V\n\x14predicted_engagement\x18\x02 \x03(\x0b\x32\x38.intelligence.PlacementResponse.PredictedEngagementEntry\x12 \n\x18optimization_suggestions\x18\x03 \x03(\t\x1a:\n\x18PredictedEngagementEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x02:\x02\x38\x01\"G\n\x0f\x45motionAnalysis\x12\x0f\n\x07\x65motion\x18\x01 \x01(\t\x12\x11\n\tintensity\x18\x02 \x01(\x02\x12\x10\n\x08triggers\x18\x03 \x03(\t\"N\n\x0e\x41spectAnalysis\x12\x0e\n\x06\x61spect\x18\x01 \x01(\t\x12\x17\n\x0fsentiment_score\x18\x02 \x01(\x02\x12\x13\n\x0bkey_phrases\x18\x03 \x03(\t\"O\n\x0cUserMetadata\x12\x0f\n\x07user_id\x18\x01 \x01(\t\x12\x13\n\x0b\x61\x63\x63ount_age\x18\x02 \x01(\t\x12\x19\n\x11previous_activity\x18\x03 \x03(\t\"d\n\x11\x41vatarPreferences\x12\x0e\n\x06gender\x18\x01 \x01(\t\x12\x11\n\tage_range\x18\x02 \x01(\t\x12\x11\n\tethnicity\x18\x03 \x01(\t\x12\x19\n\x11style_preferences\x18\x04 \x03(\t\"I\n\x10\x45motionTimestamp\x12\x11\n\ttimestamp\x18\x01 \x01(\x02\x12\x0f\n\x07\x65motion\x18\x02 \x01(\t\x12\x11\n\tintensity\x18\x03 \x01(\x02\"F\n\rEmotionMarker\x12\x11\n\ttimestamp\x18\x01 \x01(\x02\x12\x0f\n\x07\x65motion\x18\x02 \x01(\t\x12\x11\n\tintensity\x18\x03 \x01(\x02\"M\n\x10\x41udioPreferences\x12\x13\n\x0bvoice_style\x18\x01 \x01(\t\x12\x15\n\rspeaking_rate\x18\x02 \x01(\x02\x12\r\n\x05pitch\x18\x03 \x01(\x02\"L\n\x10StoryPreferences\x12\x0c\n\x04tone\x18\x01 \x01(\t\x12\x15\n\rtarget_length\x18\x02 \x01(\x05\x12\x13\n\x0b\x66ocus_areas\x18\x03 \x03(\t\"M\n\rThemeAnalysis\x12\r\n\x05theme\x18\x01 \x01(\t\x12\x12\n\nprominence\x18\x02 \x01(\x02\x12\x19\n\x11supporting_quotes\x18\x03 \x03(\t\"N\n\x11\x43ompetitorInsight\x12\x12\n\ncompetitor\x18\x01 \x01(\t\x12\x11\n\tstrengths\x18\x02 \x03(\t\x12\x12\n\nweaknesses\x18\x03 \x03(\t\"\xc8\x01\n\x11\x46\x65\x61tureComparison\x12\x0f\n\x07\x66\x65\x61ture\x18\x01 \x01(\t\x12P\n\x11\x63ompetitor_scores\x18\x02 \x03(\x0b\x32\x35.intelligence.FeatureComparison.CompetitorScoresEntry\x12\x17\n\x0fkey_differences\x18\x03 \x03(\t\x1a\x37\n\x15\x43ompetitorScoresEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x02:\x02\x38\x01\"y\n\x13HistoricalSentiment\x12-\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x17\n\x0fsentiment_score\x18\x02 \x01(\x02\x12\x1a\n\x12\x63ontextual_factors\x18\x03 \x03(\t\"\x80\x01\n\x15TimestampedPrediction\x12-\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x1b\n\x13predicted_sentiment\x18\x02 \x01(\x02\x12\x1b\n\x13\x63onfidence_interval\x18\x03 \x01(\x02\"I\n\x0bTrendFactor\x12\x0e\n\x06\x66\x61\x63tor\x18\x01 \x01(\t\x12\x15\n\rimpact_weight\x18\x02 \x01(\x02\x12\x13\n\x0b\x65xplanation\x18\x03 \x01(\t\"O\n\x0bUserSegment\x12\x13\n\x0b\x64\x65mographic\x18\x01 \x01(\t\x12\x18\n\x10\x62\x65havior_pattern\x18\x02 \x01(\t\x12\x11\n\tinterests\x18\x03 \x03(\t\"X\n\x17PlacementRecommendation\x12\x10\n\x08location\x18\x01 \x01(\t\x12\x18\n\x10\x63onfidence_score\x18\x02 \x01(\x02\x12\x11\n\treasoning\x18\x03 \x03(\t\"V\n\x0cJourneyStage\x12\x12\n\nstage_name\x18\x01 \x01(\t\x12\x1c\n\x14testimonial_excerpts\x18\x02 \x03(\t\x12\x14\n\x0ckey_outcomes\x18\x03 \x03(\t\"J\n\x12\x45motionalMilestone\x12\x0f\n\x07\x65motion\x18\x01 \x01(\t\x12\x0f\n\x07trigger\x18\x02 \x01(\t\x12\x12\n\nresolution\x18\x03 \x01(\t\"H\n\x0fMetricMilestone\x12\x13\n\x0bmetric_name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x01\x12\x11\n\ttimeframe\x18\x03 \x01(\t\"U\n\rDemoHighlight\x12\x0f\n\x07\x66\x65\x61ture\x18\x01 \x01(\t\x12\x19\n\x11testimonial_proof\x18\x02 \x03(\t\x12\x18\n\x10interaction_type\x18\x03 \x01(\t\"I\n\rCustomerQuote\x12\r\n\x05quote\x18\x01 \x01(\t\x12\x0f\n\x07\x63ontext\x18\x02 \x01(\t\x12\x18\n\x10related_features\x18\x03 \x03(\t\"[\n\x13MetricVisualization\x12\x13\n\x0bmetric_name\x18\x01 \x01(\t\x12\x1a\n\x12visualization_type\x18\x02 \x01(\t\x12\x13\n\x0b\x64\x61ta_points\x18\x03 \x03(\x01\"L\n\x0e\x43ompanyProfile\x12\x0c\n\x04size\x18\x01 \x01(\t\x12\x10\n\x08industry\x18\x02 \x01(\t\x12\x1a\n\x12\x63urrent_challenges\x18\x03 \x03(\t\"U\n\tROIMetric\x12\x13\n\x0bmetric_name\x18\x01 \x01(\t\x12\x17\n\x0fprojected_value\x18\x02 \x01(\x01\x12\x1a\n\x12\x63\x61lculation_method\x18\x03 \x01(\t\"^\n\x13\x42\x65nchmarkComparison\x12\x0e\n\x06metric\x18\x01 \x01(\t\x12\x18\n\x10industry_average\x18\x02 \x01(\x01\x12\x1d\n\x15projected_performance\x18\x03 \x01(\x01\"\x7f\n\x11JourneyMapRequest\x12\x17\n\x0ftestimonial_ids\x18\x01 \x03(\t\x12\x19\n\x11industry_vertical\x18\x02 \x01(\t\x12!\n\x19include_emotional_journey\x18\x03 \x01(\x08\x12\x13\n\x0bkey_metrics\x18\x04 \x03(\t\"\x9b\x01\n\x12JourneyMapResponse\x12*\n\x06stages\x18\x01 \x03(\x0b\x32\x1a.intelligence.JourneyStage\x12>\n\x14\x65motional_milestones\x18\x02 \x03(\x0b\x32 .intelligence.EmotionalMilestone\x12\x19\n\x11visualization_url\x18\x03 \x01(\t\"\x92\x01\n\x12ProductDemoRequest\x12\x16\n\x0etestimonial_id\x18\x01 \x01(\t\x12\x18\n\x10product_category\x18\x02 \x01(\t\x12.\n\x0btarget_user\x18\x03 \x01(\x0b\x32\x19.intelligence.UserProfile\x12\x1a\n\x12highlight_features\x18\x04 \x03(\t\"\xec\x01\n\x13ProductDemoResponse\x12\x1c\n\x14interactive_demo_url\x18\x01 \x01(\t\x12\'\n\x06scenes\x18\x02 \x03(\x0b\x32\x17.intelligence.DemoScene\x12T\n\x12\x65ngagement_metrics\x18\x03 \x03(\x0b\x32\x38.intelligence.ProductDemoResponse.EngagementMetricsEntry\x1a\x38\n\x16\x45ngagementMetricsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x02:\x02\x38\x01\"\xad\x01\n\x13ROIPredictorRequest\x12\x10\n\x08industry\x18\x01 \x01(\t\x12\x35\n\x0f\x63ompany_profile\x18\x02 \x01(\x0b\x32\x1c.intelligence.CompanyProfile\x12\x16\n\x0etarget_metrics\x18\x03 \x03(\t\x12\x35\n\x0fhistorical_data\x18\x04 \x03(\x0b\x32\x1c.intelligence.HistoricalData\"\x84\x01\n\x14ROIPredictorResponse\x12\x30\n\x0bpredictions\x18\x01 \x03(\x0b\x32\x1b.intelligence.ROIPrediction\x12\x1c\n\x14visualization_widget\x18\x02 \x01(\t\x12\x1c\n\x14insight_explanations\x18\x03 \x03(\t\"u\n\x10MicrositeRequest\x12\x18\n\x10prospect_company\x18\x01 \x01(\t\x12\x13\n\x0bpain_points\x18\x02 \x03(\t\x12\x17\n\x0fsuccess_metrics\x18\x03 \x03(\t\x12\x19\n\x11industry_vertical\x18\x04 \x01(\t\"\x85\x01\n\x11MicrositeResponse\x12\x15\n\rmicrosite_url\x18\x01 \x01(\t\x12\x38\n\x0e\x63ontent_blocks\x18\x02 \x03(\x0b\x32 .intelligence.AIGeneratedContent\x12\x1f\n\x17personalization_factors\x18\x03 \x03(\t\"\x8e\x01\n\x18SalesConversationRequest\x12\x16\n\x0etestimonial_id\x18\x01 \x01(\t\x12\x19\n\x11prospect_industry\x18\x02 \x01(\t\x12\x12\n\nobjections\x18\x03 \x03(\t\x12+\n\x07\x63ontext\x18\x04 \x01(\x0b\x32\x1a.intelligence.SalesContext\"\x86\x02\n\x19SalesConversationResponse\x12\x37\n\x0etalking_points\x18\x01 \x03(\x0b\x32\x1f.intelligence.ConversationPoint\x12\x1a\n\x12testimonial_quotes\x18\x02 \x03(\t\x12Z\n\x12objection_handlers\x18\x03 \x03(\x0b\x32>.intelligence.SalesConversationResponse.ObjectionHandlersEntry\x1a\x38\n\x16ObjectionHandlersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"j\n\x19\x45motionalResonanceRequest\x12\x1b\n\x13testimonial_content\x18\x01 \x01(\t\x12\x17\n\x0ftarget_audience\x18\x02 \x01(\t\x12\x17\n\x0f\x65motional_goals\x18\x03 \x03(\t\"\x91\x01\n\x1a\x45motionalResonanceResponse\x12\x17\n\x0fresonance_score\x18\x01 \x01(\x02\x12\x38\n\x11\x65motional_impacts\x18\x02 \x03(\x0b\x32\x1d.intelligence.EmotionalImpact\x12 \n\x18optimization_suggestions\x18\x03 \x03(\t\"c\n\x16\x43ompetitiveDiffRequest\x12\x17\n\x0ftestimonial_ids\x18\x01 \x03(\t\x12\x18\n\x10\x63ompetitor_names\x18\x02 \x03(\t\x12\x16\n\x0emarket_segment\x18\x03 \x01(\t\"\x94\x02\n\x17\x43ompetitiveDiffResponse\x12<\n\x0f\x64ifferentiators\x18\x01 \x03(\x0b\x32#.intelligence.DifferentiatorInsight\x12`\n\x16\x63ompetitive_advantages\x18\x02 \x03(\x0b\x32@.intelligence.CompetitiveDiffResponse.CompetitiveAdvantagesEntry\x12\x1b\n\x13\x61\x63tionable_insights\x18\x03 \x03(\t\x1a<\n\x1a\x43ompetitiveAdvantagesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x02:\x02\x38\x01\"x\n\x11MultimodalRequest\x12\x16\n\x0etestimonial_id\x18\x01 \x01(\t\x12\x16\n\x0eoutput_formats\x18\x02 \x03(\t\x12\x33\n\x0bstyle_prefs\x18\x03 \x01(\x0b\x32\x1e.intelligence.StylePreferences\"\xe3\x01\n\x12MultimodalResponse\x12G\n\x0c\x63ontent_urls\x18\x01 \x03(\x0b\x32\x31.intelligence.MultimodalResponse.ContentUrlsEntry\x12\x36\n\x0fquality_metrics\x18\x02 \x03(\x0b\x32\x1d.intelligence.SynthesisMetric\x12\x18\n\x10integration_code\x18\x03 \x01(\t\x1a\x32\n\x10\x43ontentUrlsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"y\n\x0bUserProfile\x12\x0f\n\x07user_id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\r\n\x05\x65mail\x18\x03 \x01(\t\x12\x1d\n\x15\x61\x63\x63ount_creation_date\x18\x04 \x01(\t\x12\x1d\n\x15previous_interactions\x18\x05 \x03(\t\"T\n\tDemoScene\x12\x12\n\nscene_name\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\x1e\n\x16key_features_showcased\x18\x03 \x03(\t\"f\n\x0eHistoricalData\x12\x19\n\x11past_testimonials\x18\x01 \x03(\t\x12\x39\n\x14past_roi_predictions\x18\x02 \x03(\x0b\x32\x1b.intelligence.ROIPrediction\"Y\n\rROIPrediction\x12\x13\n\x0bmetric_name\x18\x01 \x01(\t\x12\x17\n\x0fprojected_value\x18\x02 \x01(\x01\x12\x1a\n\x12\x63\x61lculation_method\x18\x03 \x01(\t\"T\n\x12\x41IGeneratedContent\x12\x14\n\x0c\x63ontent_type\x18\x01 \x01(\t\x12\x16\n\x0egenerated_text\x18\x02 \x01(\t\x12\x10\n\x08metadata\x18\x03 \x01(\t\"Q\n\x0cSalesContext\x12\x10\n\x08industry\x18\x01 \x01(\t\x12\x17\n\x0ftarget_audience\x18\x02 \x01(\t\x12\x16\n\x0esales_strategy\x18\x03 \x01(\t\"Z\n\x11\x43onversationPoint\x12\r\n\x05topic\x18\x01 \x01(\t\x12\x1c\n\x14recommended_response\x18\x02 \x01(\t\x12\x18\n\x10\x65ngagement_score\x18\x03 \x01(\x02\"S\n\x0f\x45motionalImpact\x12\x0e\n\x06\x66\x61\x63tor\x18\x01 \x01(\t\x12\x11\n\tintensity\x18\x02 \x01(\x02\x12\x1d\n\x15\x63ontributing_elements\x18\x03 \x03(\t\"h\n\x15\x44ifferentiatorInsight\x12\x16\n\x0e\x64ifferentiator\x18\x01 \x01(\t\x12\x1d\n\x15\x63ompetitive_advantage\x18\x02 \x01(\t\x12\x18\n\x10uniqueness_score\x18\x03 \x01(\x02\"O\n\x10StylePreferences\x12\x0c\n\x04tone\x18\x01 \x01(\t\x12\x0e\n\x06\x66ormat\x18\x02 \x01(\t\x12\x1d\n\x15personalization_level\x18\x03 \x01(\t\"d\n\x0fSynthesisMetric\x12\x13\n\x0bmetric_name\x18\x01 \x01(\t\x12\x1b\n\x13\x65\x66\x66\x65\x63tiveness_score\x18\x02 \x01(\x02\x12\x1f\n\x17improvement_suggestions\x18\x03 \x03(\t\"`\n\x10\x42\x65nchmarkRequest\x12\x10\n\x08industry\x18\x01 \x01(\t\x12\x19\n\x11metric_to_compare\x18\x02 \x01(\t\x12\x1f\n\x17\x63ompetitor_testimonials\x18\x03 \x03(\t\"\x93\x01\n\x11\x42\x65nchmarkResponse\x12\x0e\n\x06metric\x18\x01 \x01(\t\x12\x18\n\x10industry_average\x18\x02 \x01(\x01\x12\x1d\n\x15projected_performance\x18\x03 \x01(\x01\x12\x35\n\x08insights\x18\x04 \x03(\x0b\x32#.intelligence.DifferentiatorInsight\"F\n\x0b\x43hatMessage\x12\x12\n\nsession_id\x18\x01 \x01(\t\x12\x0c\n\x04text\x18\x02 \x01(\t\x12\x15\n\rvideo_context\x18\x03 \x01(\t\"Q\n\x0c\x43hatResponse\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x16\n\x0e\x65motional_tone\x18\x02 \x01(\t\x12\x1b\n\x13suggested_questions\x18\x03 \x03(\t\"L\n\nVideoChunk\x12\x0f\n\x07\x63ontent\x18\x01 \x01(\x0c\x12-\n\x08metadata\x18\x02 \x01(\x0b\x32\x1b.intelligence.VideoMetadata\"4\n\rVideoMetadata\x12\x0f\n\x07user_id\x18\x01 \x01(\t\x12\x12\n\nsession_id\x18\x02 \x01(\t\"I\n\x0cVideoSummary\x12\x12\n\nhighlights\x18\x01 \x01(\t\x12\x12\n\ntranscript\x18\x02 \x01(\t\x12\x11\n\tsentiment\x18\x03 \x01(\t2\xbc\x0e\n\x0cIntelligence\x12g\n\x12\x45nhanceTestimonial\x12\'.intelligence.EnhanceTestimonialRequest\x1a(.intelligence.EnhanceTestimonialResponse\x12\x61\n\x10\x41nalyzeSentiment\x12%.intelligence.AnalyzeSentimentRequest\x1a&.intelligence.AnalyzeSentimentResponse\x12p\n\x15\x44\x65tectFakeTestimonial\x12*.intelligence.DetectFakeTestimonialRequest\x1a+.intelligence.DetectFakeTestimonialResponse\x12h\n\x1dGenerateStoryFromTestimonials\x12\".intelligence.GenerateStoryRequest\x1a#.intelligence.GenerateStoryResponse\x12m\n\x14TranslateTestimonial\x12).intelligence.TranslateTestimonialRequest\x1a*.intelligence.TranslateTestimonialResponse\x12y\n\x18GenerateVideoTestimonial\x12-.intelligence.GenerateVideoTestimonialRequest\x1a..intelligence.GenerateVideoTestimonialResponse\x12y\n\x18GenerateVoiceTestimonial\x12-.intelligence.GenerateVoiceTestimonialRequest\x1a..intelligence.GenerateVoiceTestimonialResponse\x12^\n\x1b\x42\x65nchmarkAgainstCompetitors\x12\x1e.intelligence.BenchmarkRequest\x1a\x1f.intelligence.BenchmarkResponse\x12Y\n\x14GenerateAIJourneyMap\x12\x1f.intelligence.JourneyMapRequest\x1a .intelligence.JourneyMapResponse\x12\\\n\x15GenerateAIProductDemo\x12 .intelligence.ProductDemoRequest\x1a!.intelligence.ProductDemoResponse\x12_\n\x16GenerateAIROIPredictor\x12!.intelligence.ROIPredictorRequest\x1a\".intelligence.ROIPredictorResponse\x12V\n\x13GenerateAIMicrosite\x12\x1e.intelligence.MicrositeRequest\x1a\x1f.intelligence.MicrositeResponse\x12l\n\x19GenerateSalesConversation\x12&.intelligence.SalesConversationRequest\x1a\'.intelligence.SalesConversationResponse\x12n\n\x19\x41nalyzeEmotionalResonance\x12\'.intelligence.EmotionalResonanceRequest\x1a(.intelligence.EmotionalResonanceResponse\x12p\n!AnalyzeCompetitiveDifferentiators\x12$.intelligence.CompetitiveDiffRequest\x1a%.intelligence.CompetitiveDiffResponse\x12\x64\n\x1fSynthesizeMultimodalTestimonial\x12\x1f.intelligence.MultimodalRequest\x1a .intelligence.MultimodalResponse\x12G\n\nChatStream\x12\x19.intelligence.ChatMessage\x1a\x1a.intelligence.ChatResponse(\x01\x30\x01\x12N\n\x14ProcessVideoByChunks\x12\x18.intelligence.VideoChunk\x1a\x1a.intelligence.VideoSummary(\x01\x42\x06Z\x04./pbb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1bprotobuf/intelligence.proto\x12\x0cintelligence\x1a\x1fgoogle/protobuf/timestamp.proto\"}\n\x19\x45nhanceTestimonialRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x13\n\x0btarget_tone\x18\x02 \x01(\t\x12\x1c\n\x14preserve_key_metrics\x18\x03 \x01(\x08\x12\x1f\n\x17key_points_to_emphasize\x18\x04 \x03(\t\"i\n\x1a\x45nhanceTestimonialResponse\x12\x15\n\renhanced_text\x18\x01 \x01(\t\x12\x1e\n\x16\x65nhancement_confidence\x18\x02 \x01(\x02\x12\x14\n\x0c\x63hanges_made\x18\x03 \x03(\t\"\\\n\x17\x41nalyzeSentimentRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x18\n\x10industry_context\x18\x02 \x01(\t\x12\x19\n\x11\x61spect_categories\x18\x03 \x03(\t\"\xa8\x01\n\x18\x41nalyzeSentimentResponse\x12\x17\n\x0fsentiment_score\x18\x01 \x01(\x02\x12/\n\x08\x65motions\x18\x02 \x03(\x0b\x32\x1d.intelligence.EmotionAnalysis\x12-\n\x07\x61spects\x18\x03 \x03(\x0b\x32\x1c.intelligence.AspectAnalysis\x12\x13\n\x0bkey_phrases\x18\x04 \x03(\t\"z\n\x1c\x44\x65tectFakeTestimonialRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12-\n\tuser_data\x18\x02 \x01(\x0b\x32\x1a.intelligence.UserMetadata\x12\x1d\n\x15previous_testimonials\x18\x03 \x03(\t\"\xdf\x01\n\x1d\x44\x65tectFakeTestimonialResponse\x12\x1a\n\x12\x61uthenticity_score\x18\x01 \x01(\x02\x12\x14\n\x0crisk_factors\x18\x02 \x03(\t\x12V\n\x0e\x66\x65\x61ture_scores\x18\x03 \x03(\x0b\x32>.intelligence.DetectFakeTestimonialResponse.FeatureScoresEntry\x1a\x34\n\x12\x46\x65\x61tureScoresEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x02:\x02\x38\x01\"\x8e\x01\n\x1bTranslateTestimonialRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x17\n\x0fsource_language\x18\x02 \x01(\t\x12\x17\n\x0ftarget_language\x18\x03 \x01(\t\x12\x15\n\rpreserve_tone\x18\x04 \x01(\x08\x12\x18\n\x10industry_context\x18\x05 \x01(\t\"u\n\x1cTranslateTestimonialResponse\x12\x17\n\x0ftranslated_text\x18\x01 \x01(\t\x12\x1e\n\x16translation_confidence\x18\x02 \x01(\x02\x12\x1c\n\x14\x63ultural_adaptations\x18\x03 \x03(\t\"\xb4\x01\n\x1fGenerateVideoTestimonialRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x35\n\x0c\x61vatar_prefs\x18\x02 \x01(\x0b\x32\x1f.intelligence.AvatarPreferences\x12\x13\n\x0bvoice_style\x18\x03 \x01(\t\x12\x37\n\x0f\x65motion_markers\x18\x04 \x03(\x0b\x32\x1e.intelligence.EmotionTimestamp\"h\n GenerateVideoTestimonialResponse\x12\x11\n\tvideo_url\x18\x01 \x01(\t\x12\x15\n\rthumbnail_url\x18\x02 \x01(\t\x12\x1a\n\x12generation_quality\x18\x03 \x01(\x02\"\xab\x01\n\x1fGenerateVoiceTestimonialRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x10\n\x08voice_id\x18\x02 \x01(\t\x12\x33\n\x0e\x65motion_points\x18\x03 \x03(\x0b\x32\x1b.intelligence.EmotionMarker\x12\x33\n\x0bpreferences\x18\x04 \x01(\x0b\x32\x1e.intelligence.AudioPreferences\"\xf2\x01\n GenerateVoiceTestimonialResponse\x12\x11\n\taudio_url\x18\x01 \x01(\t\x12\x61\n\x12\x65motion_confidence\x18\x02 \x03(\x0b\x32\x45.intelligence.GenerateVoiceTestimonialResponse.EmotionConfidenceEntry\x12\x1e\n\x16voice_naturality_score\x18\x03 \x01(\x02\x1a\x38\n\x16\x45motionConfidenceEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x02:\x02\x38\x01\"\x91\x01\n\x14GenerateStoryRequest\x12\x17\n\x0ftestimonial_ids\x18\x01 \x03(\t\x12\x17\n\x0fnarrative_style\x18\x02 \x01(\t\x12\x12\n\nkey_themes\x18\x03 \x03(\t\x12\x33\n\x0bpreferences\x18\x04 \x01(\x0b\x32\x1e.intelligence.StoryPreferences\"u\n\x15GenerateStoryResponse\x12\x12\n\nstory_text\x18\x01 \x01(\t\x12\x1b\n\x13source_testimonials\x18\x02 \x03(\t\x12+\n\x06themes\x18\x03 \x03(\x0b\x32\x1b.intelligence.ThemeAnalysis\"\x81\x01\n\x18GenerateAutoReplyRequest\x12\x18\n\x10testimonial_text\x18\x01 \x01(\t\x12\x13\n\x0b\x62rand_voice\x18\x02 \x01(\t\x12\x17\n\x0fsentiment_score\x18\x03 \x01(\x02\x12\x1d\n\x15key_points_to_address\x18\x04 \x03(\t\"`\n\x19GenerateAutoReplyResponse\x12\x12\n\nreply_text\x18\x01 \x01(\t\x12\x15\n\rempathy_score\x18\x02 \x01(\x02\x12\x18\n\x10\x61\x64\x64ressed_points\x18\x03 \x03(\t\"j\n\x19\x43ompetitorAnalysisRequest\x12\x1f\n\x17\x63ompetitor_testimonials\x18\x01 \x03(\t\x12\x10\n\x08industry\x18\x02 \x01(\t\x12\x1a\n\x12\x66\x65\x61ture_categories\x18\x03 \x03(\t\"\xa7\x02\n\x1a\x43ompetitorAnalysisResponse\x12\x31\n\x08insights\x18\x01 \x03(\x0b\x32\x1f.intelligence.CompetitorInsight\x12_\n\x14sentiment_comparison\x18\x02 \x03(\x0b\x32\x41.intelligence.CompetitorAnalysisResponse.SentimentComparisonEntry\x12\x39\n\x10\x66\x65\x61ture_analysis\x18\x03 \x03(\x0b\x32\x1f.intelligence.FeatureComparison\x1a:\n\x18SentimentComparisonEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x02:\x02\x38\x01\"\x90\x01\n\x1aSentimentPredictionRequest\x12:\n\x0fhistorical_data\x18\x01 \x03(\x0b\x32!.intelligence.HistoricalSentiment\x12\x1c\n\x14prediction_timeframe\x18\x02 \x01(\x05\x12\x18\n\x10\x65xternal_factors\x18\x03 \x03(\t\"\xaf\x01\n\x1bSentimentPredictionResponse\x12\x38\n\x0bpredictions\x18\x01 \x03(\x0b\x32#.intelligence.TimestampedPrediction\x12\x37\n\x14\x63ontributing_factors\x18\x02 \x03(\x0b\x32\x19.intelligence.TrendFactor\x12\x1d\n\x15prediction_confidence\x18\x03 \x01(\x02\"\x8e\x01\n\x10PlacementRequest\x12\x16\n\x0etestimonial_id\x18\x01 \x01(\t\x12\x14\n\x0cpage_context\x18\x02 \x01(\t\x12\x32\n\x0ftarget_audience\x18\x03 \x01(\x0b\x32\x19.intelligence.UserSegment\x12\x18\n\x10\x63onversion_goals\x18\x04 \x03(\t\"\x89\x02\n\x11PlacementResponse\x12>\n\x0frecommendations\x18\x01 \x03(\x0b\x32%.intelligence.PlacementRecommendation\x12V\n\x14predicted_engagement\x18\x02 \x03(\x0b\x32\x38.intelligence.PlacementResponse.PredictedEngagementEntry\x12 \n\x18optimization_suggestions\x18\x03 \x03(\t\x1a:\n\x18PredictedEngagementEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x02:\x02\x38\x01\"G\n\x0f\x45motionAnalysis\x12\x0f\n\x07\x65motion\x18\x01 \x01(\t\x12\x11\n\tintensity\x18\x02 \x01(\x02\x12\x10\n\x08triggers\x18\x03 \x03(\t\"N\n\x0e\x41spectAnalysis\x12\x0e\n\x06\x61spect\x18\x01 \x01(\t\x12\x17\n\x0fsentiment_score\x18\x02 \x01(\x02\x12\x13\n\x0bkey_phrases\x18\x03 \x03(\t\"O\n\x0cUserMetadata\x12\x0f\n\x07user_id\x18\x01 \x01(\t\x12\x13\n\x0b\x61\x63\x63ount_age\x18\x02 \x01(\t\x12\x19\n\x11previous_activity\x18\x03 \x03(\t\"d\n\x11\x41vatarPreferences\x12\x0e\n\x06gender\x18\x01 \x01(\t\x12\x11\n\tage_range\x18\x02 \x01(\t\x12\x11\n\tethnicity\x18\x03 \x01(\t\x12\x19\n\x11style_preferences\x18\x04 \x03(\t\"I\n\x10\x45motionTimestamp\x12\x11\n\ttimestamp\x18\x01 \x01(\x02\x12\x0f\n\x07\x65motion\x18\x02 \x01(\t\x12\x11\n\tintensity\x18\x03 \x01(\x02\"F\n\rEmotionMarker\x12\x11\n\ttimestamp\x18\x01 \x01(\x02\x12\x0f\n\x07\x65motion\x18\x02 \x01(\t\x12\x11\n\tintensity\x18\x03 \x01(\x02\"M\n\x10\x41udioPreferences\x12\x13\n\x0bvoice_style\x18\x01 \x01(\t\x12\x15\n\rspeaking_rate\x18\x02 \x01(\x02\x12\r\n\x05pitch\x18\x03 \x01(\x02\"L\n\x10StoryPreferences\x12\x0c\n\x04tone\x18\x01 \x01(\t\x12\x15\n\rtarget_length\x18\x02 \x01(\x05\x12\x13\n\x0b\x66ocus_areas\x18\x03 \x03(\t\"M\n\rThemeAnalysis\x12\r\n\x05theme\x18\x01 \x01(\t\x12\x12\n\nprominence\x18\x02 \x01(\x02\x12\x19\n\x11supporting_quotes\x18\x03 \x03(\t\"N\n\x11\x43ompetitorInsight\x12\x12\n\ncompetitor\x18\x01 \x01(\t\x12\x11\n\tstrengths\x18\x02 \x03(\t\x12\x12\n\nweaknesses\x18\x03 \x03(\t\"\xc8\x01\n\x11\x46\x65\x61tureComparison\x12\x0f\n\x07\x66\x65\x61ture\x18\x01 \x01(\t\x12P\n\x11\x63ompetitor_scores\x18\x02 \x03(\x0b\x32\x35.intelligence.FeatureComparison.CompetitorScoresEntry\x12\x17\n\x0fkey_differences\x18\x03 \x03(\t\x1a\x37\n\x15\x43ompetitorScoresEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x02:\x02\x38\x01\"y\n\x13HistoricalSentiment\x12-\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x17\n\x0fsentiment_score\x18\x02 \x01(\x02\x12\x1a\n\x12\x63ontextual_factors\x18\x03 \x03(\t\"\x80\x01\n\x15TimestampedPrediction\x12-\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x1b\n\x13predicted_sentiment\x18\x02 \x01(\x02\x12\x1b\n\x13\x63onfidence_interval\x18\x03 \x01(\x02\"I\n\x0bTrendFactor\x12\x0e\n\x06\x66\x61\x63tor\x18\x01 \x01(\t\x12\x15\n\rimpact_weight\x18\x02 \x01(\x02\x12\x13\n\x0b\x65xplanation\x18\x03 \x01(\t\"O\n\x0bUserSegment\x12\x13\n\x0b\x64\x65mographic\x18\x01 \x01(\t\x12\x18\n\x10\x62\x65havior_pattern\x18\x02 \x01(\t\x12\x11\n\tinterests\x18\x03 \x03(\t\"X\n\x17PlacementRecommendation\x12\x10\n\x08location\x18\x01 \x01(\t\x12\x18\n\x10\x63onfidence_score\x18\x02 \x01(\x02\x12\x11\n\treasoning\x18\x03 \x03(\t\"V\n\x0cJourneyStage\x12\x12\n\nstage_name\x18\x01 \x01(\t\x12\x1c\n\x14testimonial_excerpts\x18\x02 \x03(\t\x12\x14\n\x0ckey_outcomes\x18\x03 \x03(\t\"J\n\x12\x45motionalMilestone\x12\x0f\n\x07\x65motion\x18\x01 \x01(\t\x12\x0f\n\x07trigger\x18\x02 \x01(\t\x12\x12\n\nresolution\x18\x03 \x01(\t\"H\n\x0fMetricMilestone\x12\x13\n\x0bmetric_name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x01\x12\x11\n\ttimeframe\x18\x03 \x01(\t\"U\n\rDemoHighlight\x12\x0f\n\x07\x66\x65\x61ture\x18\x01 \x01(\t\x12\x19\n\x11testimonial_proof\x18\x02 \x03(\t\x12\x18\n\x10interaction_type\x18\x03 \x01(\t\"I\n\rCustomerQuote\x12\r\n\x05quote\x18\x01 \x01(\t\x12\x0f\n\x07\x63ontext\x18\x02 \x01(\t\x12\x18\n\x10related_features\x18\x03 \x03(\t\"[\n\x13MetricVisualization\x12\x13\n\x0bmetric_name\x18\x01 \x01(\t\x12\x1a\n\x12visualization_type\x18\x02 \x01(\t\x12\x13\n\x0b\x64\x61ta_points\x18\x03 \x03(\x01\"L\n\x0e\x43ompanyProfile\x12\x0c\n\x04size\x18\x01 \x01(\t\x12\x10\n\x08industry\x18\x02 \x01(\t\x12\x1a\n\x12\x63urrent_challenges\x18\x03 \x03(\t\"U\n\tROIMetric\x12\x13\n\x0bmetric_name\x18\x01 \x01(\t\x12\x17\n\x0fprojected_value\x18\x02 \x01(\x01\x12\x1a\n\x12\x63\x61lculation_method\x18\x03 \x01(\t\"^\n\x13\x42\x65nchmarkComparison\x12\x0e\n\x06metric\x18\x01 \x01(\t\x12\x18\n\x10industry_average\x18\x02 \x01(\x01\x12\x1d\n\x15projected_performance\x18\x03 \x01(\x01\"\x7f\n\x11JourneyMapRequest\x12\x17\n\x0ftestimonial_ids\x18\x01 \x03(\t\x12\x19\n\x11industry_vertical\x18\x02 \x01(\t\x12!\n\x19include_emotional_journey\x18\x03 \x01(\x08\x12\x13\n\x0bkey_metrics\x18\x04 \x03(\t\"\x9b\x01\n\x12JourneyMapResponse\x12*\n\x06stages\x18\x01 \x03(\x0b\x32\x1a.intelligence.JourneyStage\x12>\n\x14\x65motional_milestones\x18\x02 \x03(\x0b\x32 .intelligence.EmotionalMilestone\x12\x19\n\x11visualization_url\x18\x03 \x01(\t\"\x92\x01\n\x12ProductDemoRequest\x12\x16\n\x0etestimonial_id\x18\x01 \x01(\t\x12\x18\n\x10product_category\x18\x02 \x01(\t\x12.\n\x0btarget_user\x18\x03 \x01(\x0b\x32\x19.intelligence.UserProfile\x12\x1a\n\x12highlight_features\x18\x04 \x03(\t\"\xec\x01\n\x13ProductDemoResponse\x12\x1c\n\x14interactive_demo_url\x18\x01 \x01(\t\x12\'\n\x06scenes\x18\x02 \x03(\x0b\x32\x17.intelligence.DemoScene\x12T\n\x12\x65ngagement_metrics\x18\x03 \x03(\x0b\x32\x38.intelligence.ProductDemoResponse.EngagementMetricsEntry\x1a\x38\n\x16\x45ngagementMetricsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x02:\x02\x38\x01\"\xad\x01\n\x13ROIPredictorRequest\x12\x10\n\x08industry\x18\x01 \x01(\t\x12\x35\n\x0f\x63ompany_profile\x18\x02 \x01(\x0b\x32\x1c.intelligence.CompanyProfile\x12\x16\n\x0etarget_metrics\x18\x03 \x03(\t\x12\x35\n\x0fhistorical_data\x18\x04 \x03(\x0b\x32\x1c.intelligence.HistoricalData\"\x84\x01\n\x14ROIPredictorResponse\x12\x30\n\x0bpredictions\x18\x01 \x03(\x0b\x32\x1b.intelligence.ROIPrediction\x12\x1c\n\x14visualization_widget\x18\x02 \x01(\t\x12\x1c\n\x14insight_explanations\x18\x03 \x03(\t\"u\n\x10MicrositeRequest\x12\x18\n\x10prospect_company\x18\x01 \x01(\t\x12\x13\n\x0bpain_points\x18\x02 \x03(\t\x12\x17\n\x0fsuccess_metrics\x18\x03 \x03(\t\x12\x19\n\x11industry_vertical\x18\x04 \x01(\t\"\x85\x01\n\x11MicrositeResponse\x12\x15\n\rmicrosite_url\x18\x01 \x01(\t\x12\x38\n\x0e\x63ontent_blocks\x18\x02 \x03(\x0b\x32 .intelligence.AIGeneratedContent\x12\x1f\n\x17personalization_factors\x18\x03 \x03(\t\"\x8e\x01\n\x18SalesConversationRequest\x12\x16\n\x0etestimonial_id\x18\x01 \x01(\t\x12\x19\n\x11prospect_industry\x18\x02 \x01(\t\x12\x12\n\nobjections\x18\x03 \x03(\t\x12+\n\x07\x63ontext\x18\x04 \x01(\x0b\x32\x1a.intelligence.SalesContext\"\x86\x02\n\x19SalesConversationResponse\x12\x37\n\x0etalking_points\x18\x01 \x03(\x0b\x32\x1f.intelligence.ConversationPoint\x12\x1a\n\x12testimonial_quotes\x18\x02 \x03(\t\x12Z\n\x12objection_handlers\x18\x03 \x03(\x0b\x32>.intelligence.SalesConversationResponse.ObjectionHandlersEntry\x1a\x38\n\x16ObjectionHandlersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"j\n\x19\x45motionalResonanceRequest\x12\x1b\n\x13testimonial_content\x18\x01 \x01(\t\x12\x17\n\x0ftarget_audience\x18\x02 \x01(\t\x12\x17\n\x0f\x65motional_goals\x18\x03 \x03(\t\"\x91\x01\n\x1a\x45motionalResonanceResponse\x12\x17\n\x0fresonance_score\x18\x01 \x01(\x02\x12\x38\n\x11\x65motional_impacts\x18\x02 \x03(\x0b\x32\x1d.intelligence.EmotionalImpact\x12 \n\x18optimization_suggestions\x18\x03 \x03(\t\"c\n\x16\x43ompetitiveDiffRequest\x12\x17\n\x0ftestimonial_ids\x18\x01 \x03(\t\x12\x18\n\x10\x63ompetitor_names\x18\x02 \x03(\t\x12\x16\n\x0emarket_segment\x18\x03 \x01(\t\"\x94\x02\n\x17\x43ompetitiveDiffResponse\x12<\n\x0f\x64ifferentiators\x18\x01 \x03(\x0b\x32#.intelligence.DifferentiatorInsight\x12`\n\x16\x63ompetitive_advantages\x18\x02 \x03(\x0b\x32@.intelligence.CompetitiveDiffResponse.CompetitiveAdvantagesEntry\x12\x1b\n\x13\x61\x63tionable_insights\x18\x03 \x03(\t\x1a<\n\x1a\x43ompetitiveAdvantagesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x02:\x02\x38\x01\"x\n\x11MultimodalRequest\x12\x16\n\x0etestimonial_id\x18\x01 \x01(\t\x12\x16\n\x0eoutput_formats\x18\x02 \x03(\t\x12\x33\n\x0bstyle_prefs\x18\x03 \x01(\x0b\x32\x1e.intelligence.StylePreferences\"\xe3\x01\n\x12MultimodalResponse\x12G\n\x0c\x63ontent_urls\x18\x01 \x03(\x0b\x32\x31.intelligence.MultimodalResponse.ContentUrlsEntry\x12\x36\n\x0fquality_metrics\x18\x02 \x03(\x0b\x32\x1d.intelligence.SynthesisMetric\x12\x18\n\x10integration_code\x18\x03 \x01(\t\x1a\x32\n\x10\x43ontentUrlsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"y\n\x0bUserProfile\x12\x0f\n\x07user_id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\r\n\x05\x65mail\x18\x03 \x01(\t\x12\x1d\n\x15\x61\x63\x63ount_creation_date\x18\x04 \x01(\t\x12\x1d\n\x15previous_interactions\x18\x05 \x03(\t\"T\n\tDemoScene\x12\x12\n\nscene_name\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\x1e\n\x16key_features_showcased\x18\x03 \x03(\t\"f\n\x0eHistoricalData\x12\x19\n\x11past_testimonials\x18\x01 \x03(\t\x12\x39\n\x14past_roi_predictions\x18\x02 \x03(\x0b\x32\x1b.intelligence.ROIPrediction\"Y\n\rROIPrediction\x12\x13\n\x0bmetric_name\x18\x01 \x01(\t\x12\x17\n\x0fprojected_value\x18\x02 \x01(\x01\x12\x1a\n\x12\x63\x61lculation_method\x18\x03 \x01(\t\"T\n\x12\x41IGeneratedContent\x12\x14\n\x0c\x63ontent_type\x18\x01 \x01(\t\x12\x16\n\x0egenerated_text\x18\x02 \x01(\t\x12\x10\n\x08metadata\x18\x03 \x01(\t\"Q\n\x0cSalesContext\x12\x10\n\x08industry\x18\x01 \x01(\t\x12\x17\n\x0ftarget_audience\x18\x02 \x01(\t\x12\x16\n\x0esales_strategy\x18\x03 \x01(\t\"Z\n\x11\x43onversationPoint\x12\r\n\x05topic\x18\x01 \x01(\t\x12\x1c\n\x14recommended_response\x18\x02 \x01(\t\x12\x18\n\x10\x65ngagement_score\x18\x03 \x01(\x02\"S\n\x0f\x45motionalImpact\x12\x0e\n\x06\x66\x61\x63tor\x18\x01 \x01(\t\x12\x11\n\tintensity\x18\x02 \x01(\x02\x12\x1d\n\x15\x63ontributing_elements\x18\x03 \x03(\t\"h\n\x15\x44ifferentiatorInsight\x12\x16\n\x0e\x64ifferentiator\x18\x01 \x01(\t\x12\x1d\n\x15\x63ompetitive_advantage\x18\x02 \x01(\t\x12\x18\n\x10uniqueness_score\x18\x03 \x01(\x02\"O\n\x10StylePreferences\x12\x0c\n\x04tone\x18\x01 \x01(\t\x12\x0e\n\x06\x66ormat\x18\x02 \x01(\t\x12\x1d\n\x15personalization_level\x18\x03 \x01(\t\"d\n\x0fSynthesisMetric\x12\x13\n\x0bmetric_name\x18\x01 \x01(\t\x12\x1b\n\x13\x65\x66\x66\x65\x63tiveness_score\x18\x02 \x01(\x02\x12\x1f\n\x17improvement_suggestions\x18\x03 \x03(\t\"`\n\x10\x42\x65nchmarkRequest\x12\x10\n\x08industry\x18\x01 \x01(\t\x12\x19\n\x11metric_to_compare\x18\x02 \x01(\t\x12\x1f\n\x17\x63ompetitor_testimonials\x18\x03 \x03(\t\"\x93\x01\n\x11\x42\x65nchmarkResponse\x12\x0e\n\x06metric\x18\x01 \x01(\t\x12\x18\n\x10industry_average\x18\x02 \x01(\x01\x12\x1d\n\x15projected_performance\x18\x03 \x01(\x01\x12\x35\n\x08insights\x18\x04 \x03(\x0b\x32#.intelligence.DifferentiatorInsight\"F\n\x0b\x43hatMessage\x12\x12\n\nsession_id\x18\x01 \x01(\t\x12\x0c\n\x04text\x18\x02 \x01(\t\x12\x15\n\rvideo_context\x18\x03 \x01(\t\"Q\n\x0c\x43hatResponse\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x16\n\x0e\x65motional_tone\x18\x02 \x01(\t\x12\x1b\n\x13suggested_questions\x18\x03 \x03(\t\"L\n\nVideoChunk\x12\x0f\n\x07\x63ontent\x18\x01 \x01(\x0c\x12-\n\x08metadata\x18\x02 \x01(\x0b\x32\x1b.intelligence.VideoMetadata\"4\n\rVideoMetadata\x12\x0f\n\x07user_id\x18\x01 \x01(\t\x12\x12\n\nsession_id\x18\x02 \x01(\t\"I\n\x0cVideoSummary\x12\x12\n\nhighlights\x18\x01 \x01(\t\x12\x12\n\ntranscript\x18\x02 \x01(\t\x12\x11\n\tsentiment\x18\x03 \x01(\t\"\"\n\x11\x45mbedTextsRequest\x12\r\n\x05texts\x18\x01 \x03(\t\"\x1b\n\tEmbedding\x12\x0e\n\x06values\x18\x01 \x03(\x02\"P\n\x12\x45mbedTextsResponse\x12+\n\nembeddings\x18\x01 \x03(\x0b\x32\x17.intelligence.Embedding\x12\r\n\x05model\x18\x02 \x01(\t2\x8d\x0f\n\x0cIntelligence\x12g\n\x12\x45nhanceTestimonial\x12\'.intelligence.EnhanceTestimonialRequest\x1a(.intelligence.EnhanceTestimonialResponse\x12\x61\n\x10\x41nalyzeSentiment\x12%.intelligence.AnalyzeSentimentRequest\x1a&.intelligence.AnalyzeSentimentResponse\x12p\n\x15\x44\x65tectFakeTestimonial\x12*.intelligence.DetectFakeTestimonialRequest\x1a+.intelligence.DetectFakeTestimonialResponse\x12h\n\x1dGenerateStoryFromTestimonials\x12\".intelligence.GenerateStoryRequest\x1a#.intelligence.GenerateStoryResponse\x12m\n\x14TranslateTestimonial\x12).intelligence.TranslateTestimonialRequest\x1a*.intelligence.TranslateTestimonialResponse\x12y\n\x18GenerateVideoTestimonial\x12-.intelligence.GenerateVideoTestimonialRequest\x1a..intelligence.GenerateVideoTestimonialResponse\x12y\n\x18GenerateVoiceTestimonial\x12-.intelligence.GenerateVoiceTestimonialRequest\x1a..intelligence.GenerateVoiceTestimonialResponse\x12^\n\x1b\x42\x65nchmarkAgainstCompetitors\x12\x1e.intelligence.BenchmarkRequest\x1a\x1f.intelligence.BenchmarkResponse\x12Y\n\x14GenerateAIJourneyMap\x12\x1f.intelligence.JourneyMapRequest\x1a .intelligence.JourneyMapResponse\x12\\\n\x15GenerateAIProductDemo\x12 .intelligence.ProductDemoRequest\x1a!.intelligence.ProductDemoResponse\x12_\n\x16GenerateAIROIPredictor\x12!.intelligence.ROIPredictorRequest\x1a\".intelligence.ROIPredictorResponse\x12V\n\x13GenerateAIMicrosite\x12\x1e.intelligence.MicrositeRequest\x1a\x1f.intelligence.MicrositeResponse\x12l\n\x19GenerateSalesConversation\x12&.intelligence.SalesConversationRequest\x1a\'.intelligence.SalesConversationResponse\x12n\n\x19\x41nalyzeEmotionalResonance\x12\'.intelligence.EmotionalResonanceRequest\x1a(.intelligence.EmotionalResonanceResponse\x12p\n!AnalyzeCompetitiveDifferentiators\x12$.intelligence.CompetitiveDiffRequest\x1a%.intelligence.CompetitiveDiffResponse\x12\x64\n\x1fSynthesizeMultimodalTestimonial\x12\x1f.intelligence.MultimodalRequest\x1a .intelligence.MultimodalResponse\x12G\n\nChatStream\x12\x19.intelligence.ChatMessage\x1a\x1a.intelligence.ChatResponse(\x01\x30\x01\x12N\n\x14ProcessVideoByChunks\x12\x18.intelligence.VideoChunk\x1a\x1a.intelligence.VideoSummary(\x01\x12O\n\nEmbedTexts\x12\x1f.intelligence.EmbedTextsRequest\x1a .intelligence.EmbedTextsResponseB\x06Z\x04./pbb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -231,6 +231,12 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_VIDEOMETADATA']._serialized_end=10007
   _globals['_VIDEOSUMMARY']._serialized_start=10009
   _globals['_VIDEOSUMMARY']._serialized_end=10082
-  _globals['_INTELLIGENCE']._serialized_start=10085
-  _globals['_INTELLIGENCE']._serialized_end=11937
+  _globals['_EMBEDTEXTSREQUEST']._serialized_start=10084
+  _globals['_EMBEDTEXTSREQUEST']._serialized_end=10118
+  _globals['_EMBEDDING']._serialized_start=10120
+  _globals['_EMBEDDING']._serialized_end=10147
+  _globals['_EMBEDTEXTSRESPONSE']._serialized_start=10149
+  _globals['_EMBEDTEXTSRESPONSE']._serialized_end=10229
+  _globals['_INTELLIGENCE']._serialized_start=10232
+  _globals['_INTELLIGENCE']._serialized_end=12165
 # @@protoc_insertion_point(module_scope)
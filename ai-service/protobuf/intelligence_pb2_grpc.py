@@ -126,6 +126,11 @@ class IntelligenceStub(object):
                 request_serializer=protobuf_dot_intelligence__pb2.VideoChunk.SerializeToString,
                 response_deserializer=protobuf_dot_intelligence__pb2.VideoSummary.FromString,
                 _registered_method=True)
+        self.EmbedTexts = channel.unary_unary(
+                '/intelligence.Intelligence/EmbedTexts',
+                request_serializer=protobuf_dot_intelligence__pb2.EmbedTextsRequest.SerializeToString,
+                response_deserializer=protobuf_dot_intelligence__pb2.EmbedTextsResponse.FromString,
+                _registered_method=True)
 
 
 class IntelligenceServicer(object):
@@ -271,6 +276,13 @@ class IntelligenceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def EmbedTexts(self, request, context):
+        """Embeds texts as dense vectors for semantic similarity search
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_IntelligenceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -364,6 +376,11 @@ def add_IntelligenceServicer_to_server(servicer, server):
                     request_deserializer=protobuf_dot_intelligence__pb2.VideoChunk.FromString,
                     response_serializer=protobuf_dot_intelligence__pb2.VideoSummary.SerializeToString,
             ),
+            'EmbedTexts': grpc.unary_unary_rpc_method_handler(
+                    servicer.EmbedTexts,
+                    request_deserializer=protobuf_dot_intelligence__pb2.EmbedTextsRequest.FromString,
+                    response_serializer=protobuf_dot_intelligence__pb2.EmbedTextsResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'intelligence.Intelligence', rpc_method_handlers)
@@ -862,3 +879,30 @@ class Intelligence(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def EmbedTexts(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/intelligence.Intelligence/EmbedTexts',
+            protobuf_dot_intelligence__pb2.EmbedTextsRequest.SerializeToString,
+            protobuf_dot_intelligence__pb2.EmbedTextsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
@@ -16,53 +16,67 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/health": {
-            "get": {
-                "description": "Returns the health status of the application",
+        "/beacon": {
+            "post": {
+                "description": "Record impressions, clicks and conversions of a widget's testimonials. Meant for navigator.sendBeacon, so the body is read as JSON whatever its content type. Each visitor is counted once a day per testimonial, widget and event type.",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "text/plain"
-                ],
                 "tags": [
-                    "Health"
+                    "Analytics"
+                ],
+                "summary": "Record Beacon",
+                "parameters": [
+                    {
+                        "description": "Beacon",
+                        "name": "beacon",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.BeaconRequest"
+                        }
+                    }
                 ],
-                "summary": "Health Check",
                 "responses": {
-                    "200": {
-                        "description": "Healthy",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Unhealthy",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/users": {
+        "/c/{slug}": {
             "get": {
-                "description": "Fetch a user by their ID.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the form definition of an active portal. The returned form_token must be sent back with the submission.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Collection Portals"
                 ],
-                "summary": "Get User",
+                "summary": "Get Portal Form",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "id",
+                        "description": "Portal slug",
+                        "name": "slug",
                         "in": "path",
                         "required": true
                     }
@@ -71,17 +85,17 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.User"
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.PublicPortal"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
                         }
@@ -89,7 +103,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Register a new user.",
+                "description": "Submit a testimonial through an active portal. Custom fields are validated against the portal's form. Testimonials are always stored pending review.",
                 "consumes": [
                     "application/json"
                 ],
@@ -97,25 +111,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Collection Portals"
                 ],
-                "summary": "Register User",
+                "summary": "Submit Through Portal",
                 "parameters": [
                     {
-                        "description": "User data",
-                        "name": "user",
+                        "type": "string",
+                        "description": "Portal slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Submission",
+                        "name": "submission",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.User"
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.PortalSubmission"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "User registered successfully",
+                        "description": "Created",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "400": {
@@ -124,8 +148,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
                         }
@@ -133,35 +169,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspaces": {
+        "/embed/{widgetID}": {
             "get": {
-                "description": "Fetch a workspace by their ID.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Public. Returns the widget's published testimonials and brand styling as JSON, or as an HTML fragment with format=html or an Accept header preferring text/html. Cross-origin requests are only allowed from the workspace's website and custom domain. Responses carry an ETag; send it back in If-None-Match to get a 304 when nothing changed.",
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/html"
                 ],
                 "tags": [
-                    "Workspaces"
+                    "Widgets"
                 ],
-                "summary": "Get Workspace",
+                "summary": "Embed Widget",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Workspace ID",
-                        "name": "id",
+                        "description": "Widget ID",
+                        "name": "widgetID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "json or html",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.Workspace"
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WidgetEmbed"
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -175,9 +218,40 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Returns the health status of the application",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Health Check",
+                "responses": {
+                    "200": {
+                        "description": "Healthy",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Unhealthy",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/invitations/accept": {
             "post": {
-                "description": "Create a new workspace.",
+                "description": "Add the signed-in user to the workspace with the invited role. The user's email must match the invitation.",
                 "consumes": [
                     "application/json"
                 ],
@@ -185,25 +259,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Workspaces"
+                    "Team Invitations"
                 ],
-                "summary": "Create Workspace",
+                "summary": "Accept Invitation",
                 "parameters": [
                     {
-                        "description": "Workspace data",
-                        "name": "workspace",
+                        "description": "Token from the invitation email",
+                        "name": "token",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.Workspace"
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.AcceptInvitationRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Workspace created successfully",
+                        "description": "Created",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TeamMember"
                         }
                     },
                     "400": {
@@ -212,8 +286,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "410": {
+                        "description": "Gone",
                         "schema": {
                             "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
                         }
@@ -221,87 +307,5185 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspaces/{id}": {
-            "delete": {
-                "description": "Delete workspace using the provided path parameter ` + "`" + `id` + "`" + `.",
+        "/onboard": {
+            "post": {
+                "description": "Create a new workspace as an owner.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Workspaces"
+                    "Workspaces",
+                    "TeamMembers",
+                    "Users"
                 ],
-                "summary": "Delete workspace by ID",
+                "summary": "Create Workspace as an owner",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "The ID of the workspace to delete",
-                        "name": "id",
+                        "description": "User ID",
+                        "name": "userId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Onboarding Data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers.OnboardRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Onboarding successful",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_ifeanyidike_cenphi_internal_models.BrandingSettings": {
-            "type": "object",
-            "required": [
-                "logo_url",
-                "primary_color"
-            ],
-            "properties": {
-                "logo_url": {
-                    "type": "string"
-                },
-                "primary_color": {
-                    "type": "string"
+        },
+        "/providers": {
+            "get": {
+                "description": "Returns every available provider with its auth type, rate limits, default schedule and the credential fields the UI must collect",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Providers"
+                ],
+                "summary": "List Providers",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_providers.Definition"
+                            }
+                        }
+                    }
                 }
             }
         },
-        "github_com_ifeanyidike_cenphi_internal_models.IntegrationSettings": {
-            "type": "object",
-            "properties": {
-                "google_analytics_id": {
-                    "type": "string"
-                },
-                "slack_webhook_url": {
-                    "type": "string"
+        "/providers/{workspaceID}/runs": {
+            "get": {
+                "description": "Returns the most recent sync runs for a workspace, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Providers"
+                ],
+                "summary": "List Sync Runs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of runs to return",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.SyncRun"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "github_com_ifeanyidike_cenphi_internal_models.Plan": {
-            "type": "string",
-            "enum": [
-                "free",
-                "basic",
-                "pro",
-                "enterprise"
-            ],
-            "x-enum-varnames": [
-                "PlanFree",
-                "PlanBasic",
-                "PlanPro",
-                "PlanEnterprise"
-            ]
-        },
-        "github_com_ifeanyidike_cenphi_internal_models.User": {
+        "/public/workspaces/{workspaceID}/testimonials": {
+            "post": {
+                "description": "Submit a testimonial on behalf of a workspace. Authenticate with a workspace API key in the X-API-Key header. Send an Idempotency-Key header to make retries safe; a retried request returns the original response. Testimonials are always stored pending review.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public API"
+                ],
+                "summary": "Submit Testimonial",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Workspace API key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unique key for safely retrying the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Testimonial",
+                        "name": "testimonial",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TestimonialSubmission"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.Testimonial"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/slack/interactions": {
+            "post": {
+                "description": "Request URL for the Slack app's interactivity. Requests must carry a valid Slack signature.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "tags": [
+                    "Slack"
+                ],
+                "summary": "Slack Interactions",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team-member/firebase_uid/{id}": {
+            "get": {
+                "description": "Fetch the team member of the signed in user. Other users' memberships cannot be looked up.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "TeamMembers"
+                ],
+                "summary": "Get Team Member by by FirebaseUID.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Firebase UID of the signed in user",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TeamMemberGetParams"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials/{testimonialID}/jobs": {
+            "get": {
+                "description": "List the AI jobs queued or run for a testimonial, newest first, with their status, attempts and output. Available to any member of the testimonial's workspace.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "AI Jobs"
+                ],
+                "summary": "List Testimonial AI Jobs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Testimonial ID",
+                        "name": "testimonialID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.AIJob"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "description": "Fetch a user by their ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Update a new user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update User",
+                "parameters": [
+                    {
+                        "description": "User data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.User"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Firebase UID",
+                        "name": "firebase_uid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User updateed successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces": {
+            "get": {
+                "description": "Fetch a workspace by their ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Get Workspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.Workspace"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new workspace.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Create Workspace",
+                "parameters": [
+                    {
+                        "description": "Workspace data",
+                        "name": "workspace",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.Workspace"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Workspace created successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{id}": {
+            "delete": {
+                "description": "Delete workspace using the provided path parameter ` + "`" + `id` + "`" + `.",
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Delete workspace by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The ID of the workspace to delete",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/analytics": {
+            "get": {
+                "description": "Aggregate the testimonials collected over a period: a timeline of counts by status with average rating and sentiment, counts by source and collection method, the rating distribution, top tags and categories, competitor mentions and the conversion funnel. Defaults to the last 30 days; results are cached for a few minutes.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Get Workspace Analytics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Timeline buckets: day, week or month (chosen from the period when omitted)",
+                        "name": "granularity",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WorkspaceAnalytics"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/analytics/testimonials": {
+            "get": {
+                "description": "Report impressions, clicks, conversions and conversion rates per testimonial, most conversions first. Rates are fractions of impressions. Defaults to the last 30 days.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Get Testimonial Performance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only count this widget",
+                        "name": "widget_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of testimonials (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TestimonialPerformanceReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/api-keys": {
+            "get": {
+                "description": "List active and revoked API keys of a workspace. Secrets are never returned. Requires the owner or admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "summary": "List API Keys",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.APIKey"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Issue a key for the public API. The plaintext key is only returned in this response. Requires the owner or admin role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "summary": "Issue API Key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Key name and rate limit",
+                        "name": "key",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.APIKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.IssuedAPIKey"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/api-keys/{keyID}": {
+            "delete": {
+                "description": "Revoke an API key immediately. Requires the owner or admin role.",
+                "tags": [
+                    "API Keys"
+                ],
+                "summary": "Revoke API Key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API key ID",
+                        "name": "keyID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/api-keys/{keyID}/rotate": {
+            "post": {
+                "description": "Revoke an API key and issue a replacement with the same name and rate limit. Requires the owner or admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "summary": "Rotate API Key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API key ID",
+                        "name": "keyID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.IssuedAPIKey"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/invitations": {
+            "post": {
+                "description": "Email an invitation to join the workspace with a role. Pending invitations count towards the plan's seat limit. Requires the members:manage permission, and only owners may invite admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Team Invitations"
+                ],
+                "summary": "Invite Team Member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Email and role",
+                        "name": "invitation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.InvitationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TeamInvitation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "402": {
+                        "description": "Payment Required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/invitations/{invitationID}": {
+            "delete": {
+                "description": "Cancel a pending invitation and free its seat. Requires the members:manage permission.",
+                "tags": [
+                    "Team Invitations"
+                ],
+                "summary": "Revoke Invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Invitation ID",
+                        "name": "invitationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/invitations/{invitationID}/resend": {
+            "post": {
+                "description": "Email a new link for a pending invitation and restart its expiry. Links sent earlier stop working. Requires the members:manage permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Team Invitations"
+                ],
+                "summary": "Resend Invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Invitation ID",
+                        "name": "invitationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TeamInvitation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/members": {
+            "get": {
+                "description": "Retrieve a list of team members with pagination support.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "TeamMembers"
+                ],
+                "summary": "Get Team Members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page (default: 10)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TeamMember"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/members/{memberID}": {
+            "get": {
+                "description": "Fetch a member of the workspace by their ID. Members of other workspaces are not found.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "TeamMembers"
+                ],
+                "summary": "Get Team Member by its ID.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Team member ID",
+                        "name": "memberID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TeamMemberGetParams"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a member from the workspace. The owner cannot be removed and only owners may remove admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "TeamMembers"
+                ],
+                "summary": "Delete Team Member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Team member ID",
+                        "name": "memberID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "TeamMember deleted successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/portals": {
+            "get": {
+                "description": "List the hosted collection portals of a workspace. Requires the owner, admin or editor role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Collection Portals"
+                ],
+                "summary": "List Collection Portals",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.CollectionPortal"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a hosted form for collecting testimonials. The slug is generated from the name when omitted. Requires the owner, admin or editor role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Collection Portals"
+                ],
+                "summary": "Create Collection Portal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Portal",
+                        "name": "portal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.CollectionPortalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.CollectionPortal"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/portals/{portalID}": {
+            "get": {
+                "description": "Get a hosted collection portal of a workspace. Requires the owner, admin or editor role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Collection Portals"
+                ],
+                "summary": "Get Collection Portal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Portal ID",
+                        "name": "portalID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.CollectionPortal"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the settings of a hosted collection portal. Omitting the slug keeps the current link. Requires the owner, admin or editor role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Collection Portals"
+                ],
+                "summary": "Update Collection Portal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Portal ID",
+                        "name": "portalID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Portal",
+                        "name": "portal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.CollectionPortalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.CollectionPortal"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a hosted collection portal. Testimonials already collected are kept. Requires the owner, admin or editor role.",
+                "tags": [
+                    "Collection Portals"
+                ],
+                "summary": "Delete Collection Portal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Portal ID",
+                        "name": "portalID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/slack": {
+            "get": {
+                "description": "Get the Slack notification settings of a workspace. The channel webhook comes from the workspace's integration settings. Requires the owner or admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Slack"
+                ],
+                "summary": "Get Slack Settings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.SlackSettings"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Choose which testimonials are posted to Slack, when alerts fire, how they are routed to channels and when the daily digest is sent. Requires the owner or admin role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Slack"
+                ],
+                "summary": "Update Slack Settings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Slack settings",
+                        "name": "settings",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.SlackSettingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.SlackSettings"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/slack/link": {
+            "post": {
+                "description": "Redeem the link a Slack user was sent after clicking a moderation button, so their clicks act as the signed-in member.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Slack"
+                ],
+                "summary": "Link Slack Account",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Link token",
+                        "name": "link",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.SlackLinkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.SlackUserLink"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/slack/test": {
+            "post": {
+                "description": "Post a test message to the workspace's channel and to every channel its routing rules use. Requires the owner or admin role.",
+                "tags": [
+                    "Slack"
+                ],
+                "summary": "Send Slack Test Message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/team": {
+            "get": {
+                "description": "List the members of a workspace alongside its pending invitations and seat usage.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Team Invitations"
+                ],
+                "summary": "Get Team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TeamRoster"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/testimonials": {
+            "get": {
+                "description": "Returns one page of the workspace's testimonials with the total that match the filters. Pass next_cursor back as cursor, with the same sort and filters, to fetch the following page. Use fields to return only some fields, for example to leave out transcripts and source data.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "List Workspace Testimonials",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "created_at, rating, view_count or authenticity_score, prefixed with - for descending order (default -created_at)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 1 to 100 (default 20)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to return; id is always included",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TestimonialPage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/testimonials/search": {
+            "get": {
+                "description": "Searches the title, summary, content and transcript of testimonials, stemmed in each testimonial's language, and the customer's name and company. Quoted phrases match words in order, -word excludes testimonials containing the word and or combines alternatives. Misspelled words still match through trigram similarity. Results are ordered by relevance and include HTML snippets with the matched words wrapped in \u003cmark\u003e tags.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Search Workspace Testimonials",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of results, 1 to 50 (default 20)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of results to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TestimonialSearchHit"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/testimonials/similar": {
+            "get": {
+                "description": "Compares testimonial embeddings to find testimonials that say similar things, even in different words. Pass to with a testimonial ID to find testimonials like it, or q with a free text description. Testimonials are embedded shortly after they are created or changed. Similarity is the cosine similarity of the embeddings, up to 1.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Find Similar Testimonials",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Testimonial ID to compare against",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Free text to compare against",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of results, 1 to 50 (default 10)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.SimilarTestimonial"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/testimonials/{testimonialID}/transitions": {
+            "get": {
+                "description": "List the status changes of a testimonial, oldest first, with the team member who made each and their reason. Available to any member of the workspace.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moderation"
+                ],
+                "summary": "List Testimonial Transitions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Testimonial ID",
+                        "name": "testimonialID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TestimonialTransition"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Move a testimonial along the moderation workflow: pending_review to approved or rejected, approved to featured, scheduled or archived. Approving, rejecting, featuring and scheduling require the owner, admin or editor role; archiving and restoring archived testimonials require the owner or admin role. A reason is required to reject. Scheduling requires publish_at, either RFC 3339 or a local time such as 2024-05-01T09:00 read in the IANA timezone given; the testimonial is published and approved when that time arrives. Moving a scheduled testimonial back to approved cancels the schedule. Every change is written to the audit log.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moderation"
+                ],
+                "summary": "Transition Testimonial Status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Testimonial ID",
+                        "name": "testimonialID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target status, reason and publish time",
+                        "name": "transition",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TestimonialTransitionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TestimonialTransition"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/uploads": {
+            "post": {
+                "description": "Start a resumable upload of a video or audio testimonial. Send the file in parts of chunk_size bytes, then complete the upload. Requires the owner, admin or editor role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Media Uploads"
+                ],
+                "summary": "Start Media Upload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "File details",
+                        "name": "upload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.MediaUploadRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.MediaUpload"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "402": {
+                        "description": "Payment Required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/uploads/{uploadID}": {
+            "get": {
+                "description": "Get a media upload and the parts received so far. Use it to resume an interrupted upload.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Media Uploads"
+                ],
+                "summary": "Get Media Upload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Upload ID",
+                        "name": "uploadID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.MediaUpload"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Cancel an in-progress upload and discard the parts received so far.",
+                "tags": [
+                    "Media Uploads"
+                ],
+                "summary": "Abort Media Upload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Upload ID",
+                        "name": "uploadID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/uploads/{uploadID}/complete": {
+            "post": {
+                "description": "Assemble the uploaded parts and create a testimonial, pending review, that links to the stored media.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Media Uploads"
+                ],
+                "summary": "Complete Media Upload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Upload ID",
+                        "name": "uploadID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.Testimonial"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/uploads/{uploadID}/parts/{partNumber}": {
+            "put": {
+                "description": "Upload one part as the raw request body. Every part except the last must be exactly chunk_size bytes. Parts may be sent in any order and retried.",
+                "consumes": [
+                    "application/octet-stream"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Media Uploads"
+                ],
+                "summary": "Upload Media Part",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Upload ID",
+                        "name": "uploadID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Part number, starting at 1",
+                        "name": "partNumber",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.MediaUploadPart"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "411": {
+                        "description": "Length Required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/usage": {
+            "get": {
+                "description": "Report how much of each plan quota the workspace has used, with its limit and what remains. Monthly quotas count usage in the current calendar month (UTC). Limit and remaining are omitted for quotas the plan does not cap.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Get Workspace Usage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WorkspaceUsage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/webhooks": {
+            "get": {
+                "description": "List the webhook endpoints of a workspace. Secrets are never returned. Requires the owner or admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List Webhook Endpoints",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WebhookEndpoint"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Register an HTTPS URL to receive events. The signing secret is only returned in this response. Requires the owner or admin role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Create Webhook Endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "URL and subscribed events",
+                        "name": "endpoint",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WebhookEndpointRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WebhookEndpoint"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/webhooks/deliveries": {
+            "get": {
+                "description": "List webhook deliveries, newest first. Filter by status=dead for deliveries that ran out of attempts. Requires the owner or admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List Webhook Deliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only deliveries to this endpoint",
+                        "name": "endpoint_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "pending, succeeded or dead",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of deliveries (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WebhookDelivery"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/webhooks/deliveries/{deliveryID}/redeliver": {
+            "post": {
+                "description": "Queue a copy of a delivery, with the same event ID and payload, to be sent straight away. Requires the owner or admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Redeliver Webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook delivery ID",
+                        "name": "deliveryID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WebhookDelivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/webhooks/{endpointID}": {
+            "get": {
+                "description": "Get a webhook endpoint of a workspace. Requires the owner or admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get Webhook Endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook endpoint ID",
+                        "name": "endpointID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WebhookEndpoint"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the URL, description and subscribed events of a webhook endpoint, and optionally pause or resume it. Requires the owner or admin role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Update Webhook Endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook endpoint ID",
+                        "name": "endpointID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "URL and subscribed events",
+                        "name": "endpoint",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WebhookEndpointRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WebhookEndpoint"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a webhook endpoint. Queued deliveries to it are dropped. Requires the owner or admin role.",
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Delete Webhook Endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook endpoint ID",
+                        "name": "endpointID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/webhooks/{endpointID}/rotate-secret": {
+            "post": {
+                "description": "Replace the signing secret of a webhook endpoint. The new secret is only returned in this response. Requires the owner or admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Rotate Webhook Secret",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook endpoint ID",
+                        "name": "endpointID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WebhookEndpoint"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/widgets": {
+            "get": {
+                "description": "List the embeddable widgets of a workspace. Requires the owner, admin or editor role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Widgets"
+                ],
+                "summary": "List Widgets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.DisplayWidget"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create an embeddable widget showing the workspace's published testimonials. Requires the owner, admin or editor role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Widgets"
+                ],
+                "summary": "Create Widget",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Widget",
+                        "name": "widget",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.DisplayWidgetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.DisplayWidget"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspaceID}/widgets/{widgetID}": {
+            "get": {
+                "description": "Get an embeddable widget of a workspace. Requires the owner, admin or editor role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Widgets"
+                ],
+                "summary": "Get Widget",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Widget ID",
+                        "name": "widgetID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.DisplayWidget"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an embeddable widget. An empty layout keeps the current one. Requires the owner, admin or editor role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Widgets"
+                ],
+                "summary": "Update Widget",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Widget ID",
+                        "name": "widgetID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Widget",
+                        "name": "widget",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.DisplayWidgetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.DisplayWidget"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an embeddable widget. Sites embedding it get a 404. Requires the owner, admin or editor role.",
+                "tags": [
+                    "Widgets"
+                ],
+                "summary": "Delete Widget",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Widget ID",
+                        "name": "widgetID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_ifeanyidike_cenphi_internal_models.AIJob": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "description": "Queue state. A failed attempt is retried at RunAt until MaxAttempts is reached.",
+                    "type": "integer"
+                },
+                "completed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "error_details": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "input_parameters": {
+                    "description": "Input parameters",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                        }
+                    ]
+                },
+                "job_type": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.AIServiceCategory"
+                },
+                "max_attempts": {
+                    "type": "integer"
+                },
+                "output_data": {
+                    "description": "Result data",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                        }
+                    ]
+                },
+                "output_reference_id": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "run_at": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "description": "Processing metadata",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "testimonial_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.AIServiceCategory": {
+            "type": "string",
+            "enum": [
+                "analysis",
+                "enhancement",
+                "generation",
+                "optimization",
+                "verification",
+                "segmentation",
+                "recommendation"
+            ],
+            "x-enum-varnames": [
+                "AIServiceCategoryAnalysis",
+                "AIServiceCategoryEnhancement",
+                "AIServiceCategoryGeneration",
+                "AIServiceCategoryOptimization",
+                "AIServiceCategoryVerification",
+                "AIServiceCategorySegmentation",
+                "AIServiceCategoryRecommendation"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.APIKey": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "rate_limit_per_minute": {
+                    "type": "integer"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "workspace_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.APIKeyRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "rate_limit_per_minute": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.AcceptInvitationRequest": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.AnalysisType": {
+            "type": "string",
+            "enum": [
+                "sentiment",
+                "narrative",
+                "authenticity",
+                "audience",
+                "business_value",
+                "credibility",
+                "competitor",
+                "story"
+            ],
+            "x-enum-varnames": [
+                "AnalysisTypeSentiment",
+                "AnalysisTypeNarrative",
+                "AnalysisTypeAuthenticity",
+                "AnalysisTypeAudience",
+                "AnalysisTypeBusinessValue",
+                "AnalysisTypeCredibility",
+                "AnalysisTypeCompetitor",
+                "AnalysisTypeStory"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.AnalyticsBucket": {
+            "type": "object",
+            "properties": {
+                "average_rating": {
+                    "type": "number"
+                },
+                "average_sentiment": {
+                    "type": "number"
+                },
+                "by_status": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "start": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.AnalyticsCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.AnalyticsFunnel": {
+            "type": "object",
+            "properties": {
+                "approved": {
+                    "type": "integer"
+                },
+                "clicks": {
+                    "type": "integer"
+                },
+                "collected": {
+                    "type": "integer"
+                },
+                "conversions": {
+                    "type": "integer"
+                },
+                "impressions": {
+                    "type": "integer"
+                },
+                "published": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.AnalyticsGranularity": {
+            "type": "string",
+            "enum": [
+                "day",
+                "week",
+                "month"
+            ],
+            "x-enum-varnames": [
+                "GranularityDay",
+                "GranularityWeek",
+                "GranularityMonth"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.BeaconEvent": {
+            "type": "object",
+            "properties": {
+                "conversion_type": {
+                    "description": "ConversionType and Value describe a conversion, such as a signup or a purchase.",
+                    "type": "string"
+                },
+                "testimonial_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TrackingEventType"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.BeaconRequest": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.BeaconEvent"
+                    }
+                },
+                "visitor_id": {
+                    "description": "VisitorID is an opaque identifier the embed keeps for the browser. Without it the\nvisitor is told apart by IP address and user agent.",
+                    "type": "string"
+                },
+                "widget_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.BrandingSettings": {
+            "type": "object",
+            "required": [
+                "logo_url",
+                "primary_color"
+            ],
+            "properties": {
+                "logo_url": {
+                    "type": "string"
+                },
+                "primary_color": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.CollectionMethod": {
+            "type": "string",
+            "enum": [
+                "website",
+                "email",
+                "chat",
+                "social",
+                "custom",
+                "direct_link",
+                "embed_form",
+                "qr_code",
+                "email_request",
+                "sms_request",
+                "api",
+                "social_import",
+                "interview",
+                "survey",
+                "screen_recording",
+                "event_capture"
+            ],
+            "x-enum-varnames": [
+                "CollectionMethodWebsite",
+                "CollectionMethodEmail",
+                "CollectionMethodChat",
+                "CollectionMethodSocial",
+                "CollectionMethodCustom",
+                "CollectionMethodDirectLink",
+                "CollectionMethodEmbedForm",
+                "CollectionMethodQRCode",
+                "CollectionMethodEmailRequest",
+                "CollectionMethodSMSRequest",
+                "CollectionMethodAPI",
+                "CollectionMethodSocialImport",
+                "CollectionMethodInterview",
+                "CollectionMethodSurvey",
+                "CollectionMethodScreenRecording",
+                "CollectionMethodEventCapture"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.CollectionPortal": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "branding_settings": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "collection_methods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.CollectionMethod"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "form_settings": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.PortalFormSettings"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notification_settings": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "verification_methods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.VerificationType"
+                    }
+                },
+                "workspace_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.CollectionPortalRequest": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "branding_settings": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "collection_methods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.CollectionMethod"
+                    }
+                },
+                "custom_fields": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "form_settings": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.PortalFormSettings"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notification_settings": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "verification_methods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.VerificationType"
+                    }
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.CompetitorMention": {
+            "type": "object",
+            "properties": {
+                "ai_confidence_score": {
+                    "type": "number"
+                },
+                "comparison_type": {
+                    "type": "string"
+                },
+                "competitor_name": {
+                    "type": "string"
+                },
+                "context": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "sentiment": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.Sentiment"
+                },
+                "sentiment_score": {
+                    "type": "number"
+                },
+                "testimonial_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.CompetitorMentionCount": {
+            "type": "object",
+            "properties": {
+                "average_sentiment": {
+                    "type": "number"
+                },
+                "competitor": {
+                    "type": "string"
+                },
+                "mentions": {
+                    "type": "integer"
+                },
+                "negative": {
+                    "type": "integer"
+                },
+                "positive": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.ContentFormat": {
+            "type": "string",
+            "enum": [
+                "text",
+                "video",
+                "audio",
+                "image",
+                "social_post",
+                "survey",
+                "interview"
+            ],
+            "x-enum-varnames": [
+                "ContentFormatText",
+                "ContentFormatVideo",
+                "ContentFormatAudio",
+                "ContentFormatImage",
+                "ContentFormatSocialPost",
+                "ContentFormatSurvey",
+                "ContentFormatInterview"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.ContentStatus": {
+            "type": "string",
+            "enum": [
+                "pending_review",
+                "approved",
+                "rejected",
+                "archived",
+                "featured",
+                "scheduled"
+            ],
+            "x-enum-varnames": [
+                "StatusPendingReview",
+                "StatusApproved",
+                "StatusRejected",
+                "StatusArchived",
+                "StatusFeatured",
+                "StatusScheduled"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.CustomerProfile": {
+            "type": "object",
+            "properties": {
+                "avatar_url": {
+                    "type": "string"
+                },
+                "company": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "external_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "industry": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "social_profiles": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "testimonials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.Testimonial"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "workspace_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.DisplayWidget": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "layout": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WidgetLayout"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "settings": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WidgetSettings"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "workspace_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.DisplayWidgetRequest": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "layout": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WidgetLayout"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "settings": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WidgetSettings"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.EmbedTestimonial": {
+            "type": "object",
+            "properties": {
+                "author_company": {
+                    "type": "string"
+                },
+                "author_name": {
+                    "type": "string"
+                },
+                "author_title": {
+                    "type": "string"
+                },
+                "avatar_url": {
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "format": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.ContentFormat"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "media_url": {
+                    "type": "string"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.IntegrationSettings": {
+            "type": "object",
+            "properties": {
+                "google_analytics_id": {
+                    "type": "string"
+                },
+                "slack_webhook_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.InvitationRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.MemberRole"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.InvitationStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "accepted",
+                "revoked"
+            ],
+            "x-enum-varnames": [
+                "InvitationPending",
+                "InvitationAccepted",
+                "InvitationRevoked"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.IssuedAPIKey": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "rate_limit_per_minute": {
+                    "type": "integer"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "workspace_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.JSONMap": {
+            "type": "object",
+            "additionalProperties": true
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.MediaUpload": {
+            "type": "object",
+            "properties": {
+                "chunk_size": {
+                    "type": "integer"
+                },
+                "content_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "filename": {
+                    "type": "string"
+                },
+                "format": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.ContentFormat"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.MediaUploadMetadata"
+                },
+                "received_parts": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "size_bytes": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.MediaUploadStatus"
+                },
+                "testimonial_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "workspace_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.MediaUploadMetadata": {
+            "type": "object",
+            "properties": {
+                "customer": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.SubmissionCustomer"
+                },
+                "duration_seconds": {
+                    "description": "DurationSeconds is the length of the recording, which video uploads count against\nthe plan's video minutes.",
+                    "type": "integer"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.MediaUploadPart": {
+            "type": "object",
+            "properties": {
+                "etag": {
+                    "type": "string"
+                },
+                "part_number": {
+                    "type": "integer"
+                },
+                "size_bytes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.MediaUploadRequest": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string"
+                },
+                "customer": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.SubmissionCustomer"
+                },
+                "duration_seconds": {
+                    "description": "DurationSeconds is the length of the recording, which video uploads count against\nthe plan's video minutes.",
+                    "type": "integer"
+                },
+                "filename": {
+                    "type": "string"
+                },
+                "size_bytes": {
+                    "type": "integer"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.MediaUploadStatus": {
+            "type": "string",
+            "enum": [
+                "in_progress",
+                "completed",
+                "aborted"
+            ],
+            "x-enum-varnames": [
+                "MediaUploadInProgress",
+                "MediaUploadCompleted",
+                "MediaUploadAborted"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.MemberRole": {
+            "type": "string",
+            "enum": [
+                "owner",
+                "admin",
+                "viewer",
+                "editor"
+            ],
+            "x-enum-varnames": [
+                "Owner",
+                "Admin",
+                "Viewer",
+                "Editor"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.PerformanceCounts": {
+            "type": "object",
+            "properties": {
+                "click_through_rate": {
+                    "type": "number"
+                },
+                "clicks": {
+                    "type": "integer"
+                },
+                "conversion_rate": {
+                    "type": "number"
+                },
+                "conversion_value": {
+                    "type": "number"
+                },
+                "conversions": {
+                    "type": "integer"
+                },
+                "impressions": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.Plan": {
+            "type": "string",
+            "enum": [
+                "essentials",
+                "growth",
+                "accelerate",
+                "transform",
+                "enterprise"
+            ],
+            "x-enum-comments": {
+                "PlanEssential": "free"
+            },
+            "x-enum-varnames": [
+                "PlanEssential",
+                "PlanGrowth",
+                "PlanAccelerate",
+                "PlanTransform",
+                "PlanEnterprise"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.PortalField": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "max_length": {
+                    "type": "integer"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.PortalFieldType"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.PortalFieldType": {
+            "type": "string",
+            "enum": [
+                "text",
+                "textarea",
+                "email",
+                "number",
+                "select",
+                "checkbox"
+            ],
+            "x-enum-varnames": [
+                "PortalFieldText",
+                "PortalFieldTextarea",
+                "PortalFieldEmail",
+                "PortalFieldNumber",
+                "PortalFieldSelect",
+                "PortalFieldCheckbox"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.PortalFormSettings": {
+            "type": "object",
+            "properties": {
+                "allowed_formats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.ContentFormat"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.PortalField"
+                    }
+                },
+                "require_email": {
+                    "type": "boolean"
+                },
+                "success_message": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.PortalSubmission": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "content": {
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "customer": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.SubmissionCustomer"
+                },
+                "experience_context": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "form_token": {
+                    "type": "string"
+                },
+                "format": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.ContentFormat"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "media_duration": {
+                    "type": "integer"
+                },
+                "media_url": {
+                    "type": "string"
+                },
+                "media_urls": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "product_context": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "purchase_context": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "testimonial_type": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TestimonialType"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "website": {
+                    "description": "Website is a honeypot. It is hidden from people, so only bots fill it in.",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.PublicPortal": {
+            "type": "object",
+            "properties": {
+                "branding_settings": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "form": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.PortalFormSettings"
+                },
+                "form_token": {
+                    "description": "FormToken must be echoed back with the submission.",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "verification_methods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.VerificationType"
+                    }
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.Quota": {
+            "type": "string",
+            "enum": [
+                "testimonials_per_month",
+                "connected_providers",
+                "team_seats",
+                "ai_jobs_per_month",
+                "video_minutes_per_month"
+            ],
+            "x-enum-varnames": [
+                "QuotaTestimonials",
+                "QuotaProviders",
+                "QuotaTeamSeats",
+                "QuotaAIJobs",
+                "QuotaVideoMinutes"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.QuotaUsage": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "description": "Limit and Remaining are omitted for quotas the plan does not cap.",
+                    "type": "integer"
+                },
+                "quota": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.Quota"
+                },
+                "remaining": {
+                    "type": "integer"
+                },
+                "used": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.RatingCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "rating": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.Sentiment": {
+            "type": "string",
+            "enum": [
+                "very_negative",
+                "negative",
+                "neutral",
+                "positive",
+                "very_positive"
+            ],
+            "x-enum-varnames": [
+                "SentimentVeryNegative",
+                "SentimentNegative",
+                "SentimentNeutral",
+                "SentimentPositive",
+                "SentimentVeryPositive"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.SimilarTestimonial": {
+            "type": "object",
+            "properties": {
+                "similarity": {
+                    "type": "number"
+                },
+                "testimonial": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.Testimonial"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.SlackLinkRequest": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.SlackRoutingRule": {
+            "type": "object",
+            "properties": {
+                "alerts_only": {
+                    "description": "AlertsOnly limits the rule to alerts.",
+                    "type": "boolean"
+                },
+                "max_rating": {
+                    "type": "number"
+                },
+                "min_rating": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "providers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "webhook_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.SlackSettings": {
+            "type": "object",
+            "properties": {
+                "alert_rating": {
+                    "description": "AlertRating is the rating at or below which a testimonial is posted as an alert.",
+                    "type": "number"
+                },
+                "alert_sentiments": {
+                    "description": "AlertSentiments are the sentiments that are posted as an alert.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.Sentiment"
+                    }
+                },
+                "digest_enabled": {
+                    "type": "boolean"
+                },
+                "digest_hour": {
+                    "description": "DigestHour is the hour of the day, in Timezone, the daily digest is posted at.",
+                    "type": "integer"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "next_digest_at": {
+                    "type": "string"
+                },
+                "notify_new": {
+                    "description": "NotifyNew posts every new testimonial. Alerts are posted either way.",
+                    "type": "boolean"
+                },
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.SlackRoutingRule"
+                    }
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "webhook_url": {
+                    "description": "WebhookURL is read from the workspace's integration settings.",
+                    "type": "string"
+                },
+                "workspace_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.SlackSettingsRequest": {
+            "type": "object",
+            "properties": {
+                "alert_rating": {
+                    "type": "number"
+                },
+                "alert_sentiments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.Sentiment"
+                    }
+                },
+                "digest_enabled": {
+                    "type": "boolean"
+                },
+                "digest_hour": {
+                    "type": "integer"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "notify_new": {
+                    "type": "boolean"
+                },
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.SlackRoutingRule"
+                    }
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.SlackUserLink": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "slack_team_id": {
+                    "type": "string"
+                },
+                "slack_user_id": {
+                    "type": "string"
+                },
+                "team_member_id": {
+                    "type": "string"
+                },
+                "workspace_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.SubmissionCustomer": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "external_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.SyncRun": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "instance_id": {
+                    "type": "string"
+                },
+                "provider_name": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.SyncRunStatus"
+                },
+                "testimonials_fetched": {
+                    "type": "integer"
+                },
+                "trigger": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.SyncTrigger"
+                },
+                "workspace_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.SyncRunStatus": {
+            "type": "string",
+            "enum": [
+                "running",
+                "succeeded",
+                "failed",
+                "skipped"
+            ],
+            "x-enum-varnames": [
+                "SyncRunRunning",
+                "SyncRunSucceeded",
+                "SyncRunFailed",
+                "SyncRunSkipped"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.SyncTrigger": {
+            "type": "string",
+            "enum": [
+                "scheduled",
+                "manual"
+            ],
+            "x-enum-varnames": [
+                "SyncTriggerScheduled",
+                "SyncTriggerManual"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.TeamInvitation": {
+            "type": "object",
+            "properties": {
+                "accepted_at": {
+                    "type": "string"
+                },
+                "accepted_by": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "invited_by": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.MemberRole"
+                },
+                "sent_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.InvitationStatus"
+                },
+                "workspace_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.TeamMember": {
+            "type": "object",
+            "required": [
+                "role",
+                "user_id",
+                "workspace_id"
+            ],
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "permissions": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "role": {
+                    "enum": [
+                        "owner",
+                        "admin",
+                        "member"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.MemberRole"
+                        }
+                    ]
+                },
+                "settings": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "workspace_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.TeamMemberGetParams": {
+            "type": "object",
+            "required": [
+                "role",
+                "user_id",
+                "workspace_id"
+            ],
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "email_verified": {
+                    "type": "boolean"
+                },
+                "firebase_uid": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "industry": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "permissions": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "role": {
+                    "enum": [
+                        "owner",
+                        "admin",
+                        "member"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.MemberRole"
+                        }
+                    ]
+                },
+                "settings": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "website_url": {
+                    "type": "string"
+                },
+                "workspace_id": {
+                    "type": "string"
+                },
+                "workspace_name": {
+                    "type": "string"
+                },
+                "workspace_plan": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.Plan"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.TeamMemberSummary": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.MemberRole"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.TeamRoster": {
+            "type": "object",
+            "properties": {
+                "invitations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TeamInvitation"
+                    }
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TeamMemberSummary"
+                    }
+                },
+                "seat_limit": {
+                    "description": "SeatLimit is omitted for plans without a limit.",
+                    "type": "integer"
+                },
+                "seats_used": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.Testimonial": {
+            "type": "object",
+            "properties": {
+                "additional_media": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "ai_jobs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.AIJob"
+                    }
+                },
+                "analyses": {
+                    "description": "Associated Data (populated in FetchByID but not stored directly in the DB)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TestimonialAnalysis"
+                    }
+                },
+                "authenticity_score": {
+                    "type": "number"
+                },
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "collection_method": {
+                    "description": "Collection \u0026 Verification",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.CollectionMethod"
+                        }
+                    ]
+                },
+                "competitor_mentions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.CompetitorMention"
+                    }
+                },
+                "content": {
+                    "type": "string"
+                },
+                "conversion_count": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "description": "Timestamps",
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "custom_formatting": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "customer_profile": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.CustomerProfile"
+                },
+                "customer_profile_id": {
+                    "description": "Customer relationship",
+                    "type": "string"
+                },
+                "engagement_metrics": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "experience_context": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "format": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.ContentFormat"
+                },
+                "hold_reason": {
+                    "description": "HoldReason explains why the testimonial was held for review automatically.",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "media_duration": {
+                    "type": "integer"
+                },
+                "media_url": {
+                    "description": "Media Metadata",
+                    "type": "string"
+                },
+                "media_urls": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "product_context": {
+                    "description": "Context",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                        }
+                    ]
+                },
+                "published": {
+                    "description": "Publishing",
+                    "type": "boolean"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "purchase_context": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "scheduled_publish_at": {
+                    "type": "string"
+                },
+                "share_count": {
+                    "type": "integer"
+                },
+                "source_data": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.ContentStatus"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "tags": {
+                    "description": "Organization",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "testimonial_type": {
+                    "description": "Categorization \u0026 Status",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TestimonialType"
+                        }
+                    ]
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "description": "Content",
+                    "type": "string"
+                },
+                "transcript": {
+                    "type": "string"
+                },
+                "trigger_data": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "trigger_source": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "verification_data": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "verification_method": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.VerificationType"
+                },
+                "verification_status": {
+                    "type": "string"
+                },
+                "verified_at": {
+                    "type": "string"
+                },
+                "view_count": {
+                    "description": "Usage Metrics",
+                    "type": "integer"
+                },
+                "workspace_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.TestimonialAnalysis": {
+            "type": "object",
+            "properties": {
+                "analysis_data": {
+                    "description": "Detailed data",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                        }
+                    ]
+                },
+                "analysis_type": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.AnalysisType"
+                },
+                "analysis_version": {
+                    "description": "Metadata",
+                    "type": "string"
+                },
+                "authenticity_score": {
+                    "type": "number"
+                },
+                "business_value_score": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "credibility_score": {
+                    "type": "number"
+                },
+                "emotional_score": {
+                    "type": "number"
+                },
+                "extracted_insights": {
+                    "type": "array",
+                    "items": {}
+                },
+                "id": {
+                    "type": "string"
+                },
+                "narrative_score": {
+                    "type": "number"
+                },
+                "sentiment_score": {
+                    "description": "Core metrics",
+                    "type": "number"
+                },
+                "testimonial_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.TestimonialPage": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "next_cursor": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.TestimonialPerformance": {
+            "type": "object",
+            "properties": {
+                "click_through_rate": {
+                    "type": "number"
+                },
+                "clicks": {
+                    "type": "integer"
+                },
+                "conversion_rate": {
+                    "type": "number"
+                },
+                "conversion_value": {
+                    "type": "number"
+                },
+                "conversions": {
+                    "type": "integer"
+                },
+                "impressions": {
+                    "type": "integer"
+                },
+                "testimonial_id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.TestimonialPerformanceReport": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "testimonials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TestimonialPerformance"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                },
+                "totals": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.PerformanceCounts"
+                },
+                "widget_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.TestimonialSearchHit": {
+            "type": "object",
+            "properties": {
+                "customer_company": {
+                    "type": "string"
+                },
+                "customer_name": {
+                    "type": "string"
+                },
+                "highlights": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "rank": {
+                    "type": "number"
+                },
+                "testimonial": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.Testimonial"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.TestimonialSubmission": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "content": {
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "customer": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.SubmissionCustomer"
+                },
+                "experience_context": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "format": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.ContentFormat"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "media_duration": {
+                    "type": "integer"
+                },
+                "media_url": {
+                    "type": "string"
+                },
+                "media_urls": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "product_context": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "purchase_context": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "testimonial_type": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TestimonialType"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.TestimonialTransition": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "from": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.ContentStatus"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "publish_at": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "testimonial_id": {
+                    "type": "string"
+                },
+                "to": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.ContentStatus"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.TestimonialTransitionRequest": {
+            "type": "object",
+            "properties": {
+                "publish_at": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.ContentStatus"
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.TestimonialType": {
+            "type": "string",
+            "enum": [
+                "customer",
+                "employee",
+                "partner",
+                "influencer",
+                "expert",
+                "case_study"
+            ],
+            "x-enum-varnames": [
+                "TestimonialTypeCustomer",
+                "TestimonialTypeEmployee",
+                "TestimonialTypePartner",
+                "TestimonialTypeInfluencer",
+                "TestimonialTypeExpert",
+                "TestimonialTypeCaseStudy"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.TrackingEventType": {
+            "type": "string",
+            "enum": [
+                "impression",
+                "click",
+                "conversion"
+            ],
+            "x-enum-varnames": [
+                "TrackingImpression",
+                "TrackingClick",
+                "TrackingConversion"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.User": {
             "type": "object",
             "properties": {
                 "created_at": {
@@ -316,16 +5500,13 @@ const docTemplate = `{
                 "firebase_uid": {
                     "type": "string"
                 },
-                "first_name": {
-                    "type": "string"
-                },
                 "id": {
                     "type": "string"
                 },
-                "last_active": {
+                "last_active_at": {
                     "type": "string"
                 },
-                "last_name": {
+                "name": {
                     "type": "string"
                 },
                 "permissions": {},
@@ -335,16 +5516,272 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_ifeanyidike_cenphi_internal_models.VerificationType": {
+            "type": "string",
+            "enum": [
+                "email",
+                "phone",
+                "social_login",
+                "order_verification",
+                "employee_verification",
+                "domain_verification"
+            ],
+            "x-enum-varnames": [
+                "VerificationTypeEmail",
+                "VerificationTypePhone",
+                "VerificationTypeSocialLogin",
+                "VerificationTypeOrderVerification",
+                "VerificationTypeEmployeeVerification",
+                "VerificationTypeDomainVerification"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "endpoint_id": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_attempt_at": {
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "redelivery_of": {
+                    "description": "RedeliveryOf is the delivery this one was manually resent from.",
+                    "type": "string"
+                },
+                "response_body": {
+                    "type": "string"
+                },
+                "response_status": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WebhookDeliveryStatus"
+                },
+                "workspace_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.WebhookDeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "succeeded",
+                "dead"
+            ],
+            "x-enum-varnames": [
+                "WebhookDeliveryPending",
+                "WebhookDeliverySucceeded",
+                "WebhookDeliveryDead"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.WebhookEndpoint": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "secret": {
+                    "description": "Secret signs the requests sent to the endpoint. It is only returned when the\nendpoint is created and when the secret is rotated.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "workspace_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.WebhookEndpointRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.WidgetEmbed": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "layout": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WidgetLayout"
+                },
+                "style": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.WidgetStyle"
+                },
+                "testimonials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.EmbedTestimonial"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.WidgetLayout": {
+            "type": "string",
+            "enum": [
+                "carousel",
+                "grid",
+                "wall",
+                "single"
+            ],
+            "x-enum-varnames": [
+                "WidgetLayoutCarousel",
+                "WidgetLayoutGrid",
+                "WidgetLayoutWall",
+                "WidgetLayoutSingle"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.WidgetSettings": {
+            "type": "object",
+            "properties": {
+                "formats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.ContentFormat"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "min_rating": {
+                    "type": "number"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "testimonial_ids": {
+                    "description": "TestimonialIDs pins the widget to these testimonials, shown in this order.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.WidgetStyle": {
+            "type": "object",
+            "properties": {
+                "animation": {
+                    "type": "boolean"
+                },
+                "border": {
+                    "type": "boolean"
+                },
+                "colors": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                },
+                "rating_style": {
+                    "type": "string"
+                },
+                "shadow": {
+                    "type": "string"
+                },
+                "shape": {
+                    "type": "string"
+                },
+                "show_avatar": {
+                    "type": "boolean"
+                },
+                "show_company": {
+                    "type": "boolean"
+                },
+                "show_date": {
+                    "type": "boolean"
+                },
+                "show_rating": {
+                    "type": "boolean"
+                },
+                "style": {
+                    "type": "string"
+                },
+                "typography": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.JSONMap"
+                }
+            }
+        },
         "github_com_ifeanyidike_cenphi_internal_models.Workspace": {
             "type": "object",
             "required": [
-                "name",
                 "plan"
             ],
             "properties": {
                 "analytics_settings": {
                     "type": "object",
-                    "additionalProperties": true
+                    "additionalProperties": {}
                 },
                 "branding_settings": {
                     "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.BrandingSettings"
@@ -358,19 +5795,25 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "industry": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3
+                },
                 "integration_settings": {
                     "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.IntegrationSettings"
                 },
                 "name": {
                     "type": "string",
-                    "maxLength": 100,
+                    "maxLength": 255,
                     "minLength": 3
                 },
                 "plan": {
                     "enum": [
-                        "free",
-                        "basic",
-                        "pro",
+                        "essentials",
+                        "growth",
+                        "accelerate",
+                        "transform",
                         "enterprise"
                     ],
                     "allOf": [
@@ -381,7 +5824,7 @@ const docTemplate = `{
                 },
                 "settings": {
                     "type": "object",
-                    "additionalProperties": true
+                    "additionalProperties": {}
                 },
                 "updated_at": {
                     "type": "string"
@@ -391,6 +5834,158 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_ifeanyidike_cenphi_internal_models.WorkspaceAnalytics": {
+            "type": "object",
+            "properties": {
+                "average_rating": {
+                    "type": "number"
+                },
+                "average_sentiment": {
+                    "type": "number"
+                },
+                "by_collection_method": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.AnalyticsCount"
+                    }
+                },
+                "by_source": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.AnalyticsCount"
+                    }
+                },
+                "competitor_mentions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.CompetitorMentionCount"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "funnel": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.AnalyticsFunnel"
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "granularity": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.AnalyticsGranularity"
+                },
+                "rating_distribution": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.RatingCount"
+                    }
+                },
+                "timeline": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.AnalyticsBucket"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                },
+                "top_categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.AnalyticsCount"
+                    }
+                },
+                "top_tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.AnalyticsCount"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "workspace_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_models.WorkspaceUsage": {
+            "type": "object",
+            "properties": {
+                "period_end": {
+                    "type": "string"
+                },
+                "period_start": {
+                    "type": "string"
+                },
+                "plan": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.Plan"
+                },
+                "quotas": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.QuotaUsage"
+                    }
+                },
+                "workspace_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_providers.AuthType": {
+            "type": "string",
+            "enum": [
+                "oauth",
+                "api_key"
+            ],
+            "x-enum-varnames": [
+                "AuthTypeOAuth",
+                "AuthTypeAPIKey"
+            ]
+        },
+        "github_com_ifeanyidike_cenphi_internal_providers.CredentialField": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "secret": {
+                    "description": "Secret fields should be masked when entered and never echoed back.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_ifeanyidike_cenphi_internal_providers.Definition": {
+            "type": "object",
+            "properties": {
+                "auth_type": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_providers.AuthType"
+                },
+                "credentials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_providers.CredentialField"
+                    }
+                },
+                "default_schedule": {
+                    "type": "string"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "rate_limit": {
+                    "type": "integer"
+                }
+            }
+        },
         "github_com_ifeanyidike_cenphi_internal_utils.ErrorResponse": {
             "type": "object",
             "properties": {
@@ -401,6 +5996,20 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "internal_controllers.OnboardRequest": {
+            "type": "object",
+            "properties": {
+                "firebase_uid": {
+                    "type": "string"
+                },
+                "team_member": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.TeamMember"
+                },
+                "workspace": {
+                    "$ref": "#/definitions/github_com_ifeanyidike_cenphi_internal_models.Workspace"
+                }
+            }
         }
     }
 }`
@@ -1,9 +1,16 @@
 package app
 
 import (
+	"context"
+	"crypto/rand"
 	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/go-chi/chi/middleware"
@@ -11,23 +18,41 @@ import (
 	"github.com/go-chi/cors"
 	"github.com/ifeanyidike/cenphi/internal/config"
 	"github.com/ifeanyidike/cenphi/internal/controllers"
+	"github.com/ifeanyidike/cenphi/internal/models"
 	"github.com/ifeanyidike/cenphi/internal/providers"
 	"github.com/ifeanyidike/cenphi/internal/repositories"
 	"github.com/ifeanyidike/cenphi/internal/routes"
 	"github.com/ifeanyidike/cenphi/internal/services"
 	"github.com/ifeanyidike/cenphi/pb"
+	"github.com/ifeanyidike/cenphi/pkg/envelope"
+	"github.com/ifeanyidike/cenphi/pkg/events"
+	"github.com/ifeanyidike/cenphi/pkg/formtoken"
+	"github.com/ifeanyidike/cenphi/pkg/idempotency"
+	"github.com/ifeanyidike/cenphi/pkg/invitetoken"
+	"github.com/ifeanyidike/cenphi/pkg/lease"
+	"github.com/ifeanyidike/cenphi/pkg/mediastore"
 	"github.com/ifeanyidike/cenphi/pkg/ratelimit"
+	"github.com/ifeanyidike/cenphi/pkg/slack"
+	"github.com/ifeanyidike/cenphi/pkg/tracking"
 	"github.com/redis/go-redis/v9"
 
 	midware "github.com/ifeanyidike/cenphi/internal/middleware"
 	"go.uber.org/zap"
 )
 
+// idempotencyKeyTTL is how long a public API response is kept for replay.
+const idempotencyKeyTTL = 24 * time.Hour
+
+// trackingDedupWindow is how long a visitor's beacon events are counted only once.
+const trackingDedupWindow = 24 * time.Hour
+
 type Application struct {
 	Config                *config.Config
 	Logger                *zap.Logger
 	DB                    *sql.DB
 	AuthMiddleware        *midware.AuthMiddleware
+	RealIPMiddleware      *midware.RealIPMiddleware
+	WorkspaceAccess       *midware.WorkspaceAccessMiddleware
 	RedisClient           *redis.Client
 	GrpcClient            *pb.IntelligenceClient
 	HealthController      *controllers.HealthController
@@ -37,6 +62,35 @@ type Application struct {
 	TeamMemberController  *controllers.TeamMemberController
 	OnboardingController  *controllers.OnboardingController
 	TestimonialController *controllers.TestimonialController
+	ProviderController    *controllers.ProviderController
+	APIKeyController      *controllers.APIKeyController
+	APIKeyMiddleware      *midware.APIKeyMiddleware
+	IdempotencyMiddleware *midware.IdempotencyMiddleware
+	PortalController      *controllers.CollectionPortalController
+	RateLimitMiddleware   *midware.RateLimitMiddleware
+	MediaUploadController *controllers.MediaUploadController
+	AIJobController       *controllers.AIJobController
+	ModerationController  *controllers.ModerationController
+	InvitationController  *controllers.InvitationController
+	UsageController       *controllers.UsageController
+	WebhookController     *controllers.WebhookController
+	SlackController       *controllers.SlackController
+	WidgetController      *controllers.WidgetController
+	AnalyticsController   *controllers.AnalyticsController
+	// AIJobWorker runs queued AI jobs while the server is running.
+	AIJobWorker *services.AIJobWorker
+	// ScheduledPublisher publishes scheduled testimonials while the server is running.
+	ScheduledPublisher *services.ScheduledPublisher
+	// WebhookDispatcher sends queued webhook deliveries while the server is running.
+	WebhookDispatcher *services.WebhookDispatcher
+	// SlackDigester posts daily Slack digests while the server is running.
+	SlackDigester *services.SlackDigester
+	// TrackingFlusher writes buffered beacon hits to the database while the server is running.
+	TrackingFlusher *services.TrackingFlusher
+	// SemanticIndexer embeds new and changed testimonials while the server is running.
+	SemanticIndexer *services.SemanticIndexer
+	// MediaHandler serves locally stored media; nil when media lives in S3.
+	MediaHandler http.Handler
 }
 
 func NewApplication(cfg *config.Config, db *sql.DB, redisClient *redis.Client, grpcClient *pb.IntelligenceClient) *Application {
@@ -49,11 +103,23 @@ func NewApplication(cfg *config.Config, db *sql.DB, redisClient *redis.Client, g
 	if err != nil {
 		log.Fatalf("failed to create auth middleware: %v", err)
 	}
-	// token := &oauth2.Token{
-	// 	AccessToken:  cfg.Providers.Google.AccessToken,
-	// 	RefreshToken: cfg.Providers.Google.RefreshToken,
-	// 	Expiry:       time.Now().Add(1 * time.Hour),
-	// }
+	realIPMiddleware, err := midware.NewRealIPMiddleware(cfg.Server.TrustedProxies)
+	if err != nil {
+		log.Fatalf("failed to parse TRUSTED_PROXIES: %v", err)
+	}
+	credentialsCipher := newCredentialsCipher(cfg.Security.CredentialsKey, logger)
+	formSigner, err := newFormSigner(cfg.Security.FormTokenSecret, cfg.Server.IsProduction(), logger)
+	if err != nil {
+		log.Fatalf("failed to initialize form token signer: %v", err)
+	}
+	invitationSigner, err := newInvitationSigner(cfg.Security.InvitationSecret, cfg.Server.IsProduction(), logger)
+	if err != nil {
+		log.Fatalf("failed to initialize invitation token signer: %v", err)
+	}
+	mediaStore, mediaHandler, err := newMediaStore(cfg, logger)
+	if err != nil {
+		log.Fatalf("failed to initialize media store: %v", err)
+	}
 
 	// initialize repositories
 	repo := repositories.NewRepositoryManager(redisClient)
@@ -62,7 +128,23 @@ func NewApplication(cfg *config.Config, db *sql.DB, redisClient *redis.Client, g
 	testimonialRepo := repositories.NewTestimonialRepository(redisClient)
 	workspaceRepo := repositories.NewWorkspaceRepository(redisClient)
 	customerProfileRepo := repositories.NewCustomerProfileRepository(redisClient)
-	providerRepo := repositories.NewProviderConfigRepository(redisClient)
+	providerRepo := repositories.NewProviderConfigRepository(redisClient, credentialsCipher)
+	syncRunRepo := repositories.NewSyncRunRepository(redisClient)
+	syncCursorRepo := repositories.NewSyncCursorRepository(redisClient)
+	apiKeyRepo := repositories.NewAPIKeyRepository(redisClient)
+	portalRepo := repositories.NewCollectionPortalRepository(redisClient)
+	mediaUploadRepo := repositories.NewMediaUploadRepository(redisClient)
+	aiJobRepo := repositories.NewAIJobRepository(redisClient)
+	analysisRepo := repositories.NewAnalysisRepository(redisClient)
+	auditLogRepo := repositories.NewAuditLogRepository(redisClient)
+	semanticIndexRepo := repositories.NewSemanticIndexRepository(redisClient)
+	invitationRepo := repositories.NewTeamInvitationRepository(redisClient)
+	entitlementRepo := repositories.NewEntitlementRepository(redisClient)
+	webhookRepo := repositories.NewWebhookRepository(redisClient, credentialsCipher)
+	slackRepo := repositories.NewSlackRepository(redisClient)
+	widgetRepo := repositories.NewWidgetRepository(redisClient)
+	brandGuideRepo := repositories.NewBrandGuideRepository(redisClient)
+	analyticsRepo := repositories.NewAnalyticsRepository(redisClient)
 
 	// initialize OAuth service
 	oauthService := services.NewOAuthService(
@@ -74,25 +156,31 @@ func NewApplication(cfg *config.Config, db *sql.DB, redisClient *redis.Client, g
 	// initialize sentiment service
 	sentimentService := services.NewSentimentService(
 		grpcClient,
+		redisClient,
+		analysisRepo,
 		cfg.Services.OpenAI.APIKey,
 		true,
+		db,
 	)
 
-	// // initialize providers
-	// twitter := providers.NewTwitterProvider(
-	// 	cfg.Providers.Twitter.BearerToken,
-	// 	cfg.Providers.Twitter.Username,
-	// 	cfg.Providers.Twitter.APIKey,
-	// 	cfg.Providers.Twitter.APISecret,
-	// 	customerProfileRepo,
-	// 	db,
-	// )
-	// instagram := providers.NewInstagramProvider(
-	// 	cfg.Providers.Instagram.AccessToken,
-	// 	cfg.Providers.Instagram.UserID,
-	// 	customerProfileRepo,
-	// 	db,
-	// )
+	// initialize providers
+	twitter := providers.NewTwitterProvider(
+		cfg.Providers.Twitter.BearerToken,
+		cfg.Providers.Twitter.Username,
+		cfg.Providers.Twitter.APIKey,
+		cfg.Providers.Twitter.APISecret,
+		sentimentService,
+		customerProfileRepo,
+		db,
+	)
+	instagram := providers.NewInstagramProvider(
+		cfg.Providers.Instagram.AccessToken,
+		cfg.Providers.Instagram.UserID,
+		oauthService,
+		sentimentService,
+		customerProfileRepo,
+		db,
+	)
 	facebook := providers.NewFacebookProvider(
 		cfg.Providers.Facebook.ClientID,
 		cfg.Providers.Facebook.ClientSecret,
@@ -101,46 +189,129 @@ func NewApplication(cfg *config.Config, db *sql.DB, redisClient *redis.Client, g
 		customerProfileRepo,
 		db,
 	)
-	// trustpilot := providers.NewTrustpilotProvider(
-	// 	cfg.Providers.Trustpilot.APIKey,
-	// 	cfg.Providers.Trustpilot.BusinessID,
-	// 	customerProfileRepo,
-	// 	db,
-	// )
-	// yelp := providers.NewYelpProvider(
-	// 	cfg.Providers.Yelp.APIKey,
-	// 	cfg.Providers.Yelp.BusinessID,
-	// 	customerProfileRepo,
-	// 	db,
-	// )
-	// google := providers.NewGoogleProvider(
-	// 	cfg.Providers.Google.ClientID,
-	// 	cfg.Providers.Google.ClientSecret,
-	// 	cfg.Providers.Google.AccountName,
-	// 	token,
-	// 	customerProfileRepo,
-	// 	db,
-	// )
-
-	// providers := []providers.Provider{twitter, instagram, facebook, trustpilot, yelp, google}
-	providers := []providers.Provider{facebook}
+	trustpilot := providers.NewTrustpilotProvider(
+		cfg.Providers.Trustpilot.APIKey,
+		cfg.Providers.Trustpilot.BusinessID,
+		customerProfileRepo,
+		db,
+	)
+	yelp := providers.NewYelpProvider(
+		cfg.Providers.Yelp.APIKey,
+		cfg.Providers.Yelp.BusinessID,
+		customerProfileRepo,
+		db,
+	)
+	google := providers.NewGoogleProvider(
+		cfg.Providers.Google.ClientID,
+		cfg.Providers.Google.ClientSecret,
+		cfg.Providers.Google.AccountName,
+		oauthService,
+		customerProfileRepo,
+		db,
+	)
+
+	providerRegistry := providers.DefaultRegistry()
+	providers := []providers.Provider{twitter, instagram, facebook, trustpilot, yelp, google}
+
+	limiter := ratelimit.NewRedisLimiter(redisClient)
 
 	// initialize services
+	// Events go to Redis subscribers, are queued for the workspace's webhooks and
+	// posted to its Slack channels
+	slackClient := slack.NewClient(nil)
+	webhookService := services.NewWebhookService(webhookRepo, teamMemberRepo, db)
+	slackService := services.NewSlackService(slackRepo, testimonialRepo, teamMemberRepo, slackClient, cfg.Server.AppURL, db)
+	publisher := events.Fanout{events.NewRedisPublisher(redisClient), webhookService, slackService}
 	userService := services.NewUserService(userRepo, db)
 	teamMemberService := services.NewTeamMemberService(teamMemberRepo, db)
 	onboardingService := services.NewOnboardingService(repo, db)
-	testimonialService := services.NewTestimonialService(testimonialRepo, db)
+	entitlementService := services.NewEntitlementService(entitlementRepo, teamMemberRepo, db)
+	aiJobService := services.NewAIJobService(aiJobRepo, testimonialRepo, teamMemberRepo, entitlementService, db)
+	authenticityService := services.NewAuthenticityService(aiJobService, testimonialRepo, analysisRepo, grpcClient, db)
+	testimonialService := services.NewTestimonialService(testimonialRepo, customerProfileRepo, authenticityService, entitlementService, publisher, db)
 	workspaceService := services.NewWorkspaceService(workspaceRepo, db)
+	apiKeyService := services.NewAPIKeyService(apiKeyRepo, teamMemberRepo, db)
+	portalService := services.NewCollectionPortalService(portalRepo, teamMemberRepo, testimonialService, formSigner, formtoken.NewReplayGuard(redisClient), db)
+	widgetService := services.NewWidgetService(widgetRepo, brandGuideRepo, workspaceRepo, teamMemberRepo, db)
+	trackingBuffer := tracking.NewBuffer(redisClient, trackingDedupWindow)
+	trackingService := services.NewTrackingService(trackingBuffer, analyticsRepo, teamMemberRepo, db)
+	analyticsService := services.NewAnalyticsService(analyticsRepo, teamMemberRepo, redisClient, db)
+	moderationService := services.NewModerationService(testimonialRepo, auditLogRepo, teamMemberRepo, publisher, db)
+	if cfg.Slack.SigningSecret == "" {
+		logger.Warn("SLACK_SIGNING_SECRET is not set; Slack moderation buttons are disabled")
+	}
+	slackInteractionService := services.NewSlackInteractionService(
+		slackRepo,
+		testimonialRepo,
+		teamMemberRepo,
+		moderationService,
+		slackClient,
+		cfg.Slack.SigningSecret,
+		newSlackLinkSecret(cfg.Slack, logger),
+		cfg.Server.AppURL,
+		db,
+	)
+	semanticSearchService := services.NewSemanticSearchService(semanticIndexRepo, grpcClient, cfg.Services.OpenAI.APIKey, db)
+	invitationService := services.NewInvitationService(
+		invitationRepo,
+		teamMemberRepo,
+		userRepo,
+		entitlementService,
+		invitationSigner,
+		newMailer(cfg.Mail, logger),
+		cfg.Server.AppURL,
+		db,
+	)
+	videoProcessingService := services.NewVideoProcessingService(
+		aiJobService,
+		analysisRepo,
+		testimonialRepo,
+		mediaStore,
+		grpcClient,
+		db,
+	)
+	mediaUploadService := services.NewMediaUploadService(
+		mediaUploadRepo,
+		teamMemberRepo,
+		testimonialService,
+		videoProcessingService,
+		entitlementService,
+		mediaStore,
+		cfg.Storage.MaxMediaUploadSize,
+		db,
+	)
 	providerService := services.NewProviderService(
 		providers,
-		ratelimit.NewRedisLimiter(redisClient),
+		providerRegistry,
+		limiter,
+		lease.NewRedisLease(redisClient),
 		testimonialRepo,
 		customerProfileRepo,
 		providerRepo,
+		syncRunRepo,
+		syncCursorRepo,
 		oauthService,
 		sentimentService,
+		authenticityService,
+		entitlementService,
+		publisher,
 		db,
 	)
+	aiJobWorker := services.NewAIJobWorker(aiJobRepo, testimonialRepo, publisher, services.AIJobWorkerConfig{Workers: cfg.AIJobs.Workers}, db)
+	services.RegisterIntelligenceJobHandlers(aiJobWorker, grpcClient, testimonialRepo, db)
+	aiJobWorker.Handle(models.AIServiceCategoryVerification, authenticityService)
+	aiJobWorker.HandleTask(models.AIJobTaskVideoTranscription, videoProcessingService)
+	scheduledPublisher := services.NewScheduledPublisher(testimonialRepo, auditLogRepo, publisher, db)
+	webhookDispatcher := services.NewWebhookDispatcher(webhookRepo, services.WebhookDispatcherConfig{
+		MaxAttempts:          cfg.Webhooks.MaxAttempts,
+		AllowPrivateNetworks: cfg.Webhooks.AllowPrivateNetworks,
+	}, db)
+	slackDigester := services.NewSlackDigester(slackRepo, slackClient, cfg.Server.AppURL, db)
+	trackingFlusher := services.NewTrackingFlusher(trackingBuffer, analyticsRepo, lease.NewRedisLease(redisClient), db)
+	semanticIndexer := services.NewSemanticIndexer(semanticIndexRepo, grpcClient, cfg.Services.OpenAI.APIKey, db)
+	if err := providerService.RestoreSchedules(context.Background()); err != nil {
+		logger.Error("failed to restore provider schedules", zap.Error(err))
+	}
 
 	// initialize controllers
 	userController := controllers.NewUserController(userService, logger)
@@ -148,13 +319,36 @@ func NewApplication(cfg *config.Config, db *sql.DB, redisClient *redis.Client, g
 	onboardingController := controllers.NewOnboardingController(onboardingService, logger)
 	healthController := controllers.NewHealthController(logger)
 	swaggerController := controllers.NewSwaggerController()
-	testimonialController := controllers.NewTestimonialController(testimonialService, *providerService, logger)
-	workspaceController := controllers.NewWorkspaceController(workspaceService, testimonialService, logger)
+	testimonialController := controllers.NewTestimonialController(testimonialService, providerService, logger)
+	providerController := controllers.NewProviderController(providerService, logger)
+	workspaceController := controllers.NewWorkspaceController(workspaceService, testimonialService, semanticSearchService, logger)
+	apiKeyController := controllers.NewAPIKeyController(apiKeyService, logger)
+	portalController := controllers.NewCollectionPortalController(portalService, logger)
+	mediaUploadController := controllers.NewMediaUploadController(mediaUploadService, logger)
+	aiJobController := controllers.NewAIJobController(aiJobService, logger)
+	moderationController := controllers.NewModerationController(moderationService, logger)
+	invitationController := controllers.NewInvitationController(invitationService, logger)
+	usageController := controllers.NewUsageController(entitlementService, logger)
+	webhookController := controllers.NewWebhookController(webhookService, logger)
+	slackController := controllers.NewSlackController(slackService, slackInteractionService, logger)
+	widgetController := controllers.NewWidgetController(widgetService, logger)
+	analyticsController := controllers.NewAnalyticsController(trackingService, analyticsService, logger)
+
+	// initialize public API middleware
+	apiKeyMiddleware := midware.NewAPIKeyMiddleware(apiKeyService, limiter, logger)
+	idempotencyMiddleware := midware.NewIdempotencyMiddleware(
+		idempotency.NewStore(redisClient, idempotencyKeyTTL),
+		logger,
+	)
+	rateLimitMiddleware := midware.NewRateLimitMiddleware(limiter, logger)
+	workspaceAccess := midware.NewWorkspaceAccessMiddleware(teamMemberService, logger)
 
 	return &Application{
 		Config:                cfg,
 		Logger:                logger,
 		AuthMiddleware:        authMiddleware,
+		RealIPMiddleware:      realIPMiddleware,
+		WorkspaceAccess:       workspaceAccess,
 		HealthController:      healthController,
 		UserController:        &userController,
 		SwaggerController:     swaggerController,
@@ -162,10 +356,137 @@ func NewApplication(cfg *config.Config, db *sql.DB, redisClient *redis.Client, g
 		TeamMemberController:  &teamMemberController,
 		OnboardingController:  &onboardingController,
 		TestimonialController: &testimonialController,
+		ProviderController:    &providerController,
+		APIKeyController:      &apiKeyController,
+		APIKeyMiddleware:      apiKeyMiddleware,
+		IdempotencyMiddleware: idempotencyMiddleware,
+		PortalController:      &portalController,
+		RateLimitMiddleware:   rateLimitMiddleware,
+		MediaUploadController: &mediaUploadController,
+		AIJobController:       &aiJobController,
+		ModerationController:  &moderationController,
+		InvitationController:  &invitationController,
+		UsageController:       &usageController,
+		WebhookController:     &webhookController,
+		SlackController:       &slackController,
+		WidgetController:      &widgetController,
+		AnalyticsController:   &analyticsController,
+		AIJobWorker:           aiJobWorker,
+		ScheduledPublisher:    scheduledPublisher,
+		WebhookDispatcher:     webhookDispatcher,
+		SlackDigester:         slackDigester,
+		TrackingFlusher:       trackingFlusher,
+		SemanticIndexer:       semanticIndexer,
+		MediaHandler:          mediaHandler,
+	}
+}
+
+// newCredentialsCipher builds the cipher for provider credentials and webhook secrets.
+// Without a valid key nothing can be encrypted, so provider configuration and webhooks
+// are disabled and fail with apperrors.ErrServiceUnavailable rather than the server
+// refusing to start.
+func newCredentialsCipher(key string, logger *zap.Logger) *envelope.Cipher {
+	cipher, err := envelope.NewCipher(key)
+	if err != nil {
+		logger.Error("CREDENTIALS_ENCRYPTION_KEY is missing or invalid; provider configuration and webhooks are disabled", zap.Error(err))
+		return nil
+	}
+	return cipher
+}
+
+// newFormSigner builds the signer for portal form tokens. The secret is required in
+// production: forms served by one replica are submitted to another, and a per-process
+// secret would reject them after every restart. Elsewhere it falls back to one.
+func newFormSigner(secret string, production bool, logger *zap.Logger) (*formtoken.Signer, error) {
+	if secret != "" {
+		return formtoken.NewSigner([]byte(secret))
+	}
+	if production {
+		return nil, errors.New("PORTAL_FORM_SECRET is required in production")
+	}
+	logger.Error("PORTAL_FORM_SECRET is not set; portal forms only work with a single replica and break on restart")
+	return formtoken.NewRandomSigner()
+}
+
+// newInvitationSigner builds the signer for invitation tokens. The secret is required
+// in production, where invitations stay valid for days and may be accepted on any
+// replica. Elsewhere it falls back to a per-process one.
+func newInvitationSigner(secret string, production bool, logger *zap.Logger) (*invitetoken.Signer, error) {
+	if secret != "" {
+		return invitetoken.NewSigner([]byte(secret))
+	}
+	if production {
+		return nil, errors.New("INVITATION_TOKEN_SECRET is required in production")
+	}
+	logger.Error("INVITATION_TOKEN_SECRET is not set; invitation links only work with a single replica and break on restart")
+	return invitetoken.NewRandomSigner()
+}
+
+// newSlackLinkSecret returns the secret for Slack account links. Without a configured
+// secret it falls back to a per-process one, so links only work on the server that sent
+// them and until it restarts.
+func newSlackLinkSecret(cfg config.SlackConfig, logger *zap.Logger) string {
+	if cfg.LinkSecret != "" {
+		return cfg.LinkSecret
+	}
+	logger.Warn("SLACK_LINK_SECRET is not set; Slack account links will not survive restarts")
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(secret)
+}
+
+// newMailer sends email through the configured SMTP server, or logs it when there is none.
+func newMailer(cfg config.MailConfig, logger *zap.Logger) services.Mailer {
+	if cfg.SMTPHost == "" {
+		logger.Warn("SMTP_HOST is not set; emails will be logged instead of sent")
+		return services.NewLogMailer()
+	}
+	return services.NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.From)
+}
+
+// newMediaStore picks the storage backend for uploaded media. When nothing is
+// configured it falls back to the local filesystem so uploads work in development.
+func newMediaStore(cfg *config.Config, logger *zap.Logger) (mediastore.Store, http.Handler, error) {
+	backend := cfg.Storage.Backend
+	if backend == "" && cfg.AWS.BucketName == "" {
+		logger.Warn("no media storage configured; storing uploads on the local filesystem")
+		backend = "local"
+	}
+
+	switch backend {
+	case "local":
+		dir := cfg.Storage.LocalDir
+		if dir == "" {
+			dir = filepath.Join(os.TempDir(), "cenphi-media")
+		}
+		store, err := mediastore.NewLocalStore(dir, cfg.Server.BaseURL+"/api/v1/media")
+		if err != nil {
+			return nil, nil, err
+		}
+		return store, store.Handler(), nil
+	case "", "s3":
+		store := mediastore.NewS3Store(cfg.AWS.BucketName, cfg.AWS.Region, cfg.AWS.S3Endpoint, mediastore.S3Credentials{
+			AccessKeyID:     cfg.AWS.AccessKeyID,
+			SecretAccessKey: cfg.AWS.SecretAccessKey,
+			SessionToken:    cfg.AWS.SessionToken,
+		}, nil)
+		return store, nil, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown storage backend %q", backend)
 	}
 }
 
 func (app *Application) Run(mux http.Handler) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go app.AIJobWorker.Run(ctx)
+	go app.ScheduledPublisher.Run(ctx)
+	go app.WebhookDispatcher.Run(ctx)
+	go app.SlackDigester.Run(ctx)
+	go app.TrackingFlusher.Run(ctx)
+	go app.SemanticIndexer.Run(ctx)
 
 	server := &http.Server{
 		Addr:         app.Config.Server.Address,
@@ -221,7 +542,7 @@ func (app *Application) Mount() http.Handler {
 
 	r.Use(m.Logging)
 	r.Use(middleware.RequestID)
-	r.Use(middleware.RealIP)
+	r.Use(app.RealIPMiddleware.Resolve)
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
 
@@ -230,7 +551,7 @@ func (app *Application) Mount() http.Handler {
 		AllowedOrigins: []string{"https://*", "http://*", "http://localhost:8081"},
 		// AllowOriginFunc:  func(r *http.Request, origin string) bool { return true },
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-API-Key", "Idempotency-Key"},
 		ExposedHeaders:   []string{"Link"},
 		AllowCredentials: false,
 		MaxAge:           300, // Maximum value not ignored by any of major browsers
@@ -240,6 +561,7 @@ func (app *Application) Mount() http.Handler {
 
 	routes.RegisterRoutes(r,
 		app.AuthMiddleware,
+		app.WorkspaceAccess,
 		app.HealthController,
 		*app.UserController,
 		app.SwaggerController,
@@ -247,6 +569,22 @@ func (app *Application) Mount() http.Handler {
 		app.TeamMemberController,
 		app.OnboardingController,
 		app.TestimonialController,
+		app.ProviderController,
+		app.APIKeyController,
+		app.APIKeyMiddleware,
+		app.IdempotencyMiddleware,
+		app.PortalController,
+		app.RateLimitMiddleware,
+		app.MediaUploadController,
+		app.AIJobController,
+		app.ModerationController,
+		app.InvitationController,
+		app.UsageController,
+		app.WebhookController,
+		app.SlackController,
+		app.WidgetController,
+		app.AnalyticsController,
+		app.MediaHandler,
 	)
 
 	return r
@@ -1,6 +1,9 @@
 package apperrors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // Common errors
 var (
@@ -70,3 +73,48 @@ var (
 	ErrProviderNotConfigured = errors.New("provider not configured")
 	ErrAuthExpired           = errors.New("authentication expired")
 )
+
+// API key errors
+var (
+	ErrAPIKeyInvalid = errors.New("invalid API key")
+	ErrAPIKeyRevoked = errors.New("API key has been revoked")
+)
+
+// Collection portal errors
+var (
+	ErrPortalSlugTaken = errors.New("portal slug is already in use")
+	ErrSpamDetected    = errors.New("submission rejected as spam")
+)
+
+// Media upload errors
+var (
+	ErrUploadClosed     = errors.New("upload is no longer in progress")
+	ErrUploadIncomplete = errors.New("upload is missing parts")
+)
+
+// Team invitation errors
+var (
+	ErrInvitationInvalid       = errors.New("invitation is invalid or no longer active")
+	ErrInvitationExpired       = errors.New("invitation has expired")
+	ErrInvitationEmailMismatch = errors.New("invitation was sent to a different email address")
+)
+
+// Plan entitlement errors
+var ErrQuotaExceeded = errors.New("plan quota exceeded")
+
+// QuotaExceededError reports which quota a workspace has used up. It matches
+// ErrQuotaExceeded with errors.Is.
+type QuotaExceededError struct {
+	Quota string
+	Plan  string
+	Limit int
+	Used  int
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("%s: the %s plan allows %d %s and %d are used", ErrQuotaExceeded, e.Plan, e.Limit, e.Quota, e.Used)
+}
+
+func (e *QuotaExceededError) Unwrap() error {
+	return ErrQuotaExceeded
+}
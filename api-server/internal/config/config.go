@@ -3,6 +3,8 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 	"sync"
 )
 
@@ -13,6 +15,12 @@ type Config struct {
 	AWS       AWSConfig
 	Providers ProviderConfig
 	Services  ServicesConfig
+	Security  SecurityConfig
+	Storage   StorageConfig
+	AIJobs    AIJobsConfig
+	Mail      MailConfig
+	Webhooks  WebhooksConfig
+	Slack     SlackConfig
 }
 
 type ServerConfig struct {
@@ -23,6 +31,12 @@ type ServerConfig struct {
 	Environment       string
 	FirebaseProjectID string
 	BaseURL           string
+	// AppURL is where the dashboard is served; links in emails point there.
+	AppURL string
+	// TrustedProxies lists the addresses or CIDR ranges of the load balancers in front
+	// of the server, from the comma separated TRUSTED_PROXIES. X-Forwarded-For and
+	// X-Real-IP are ignored on requests from anywhere else.
+	TrustedProxies []string
 }
 
 type ServicesConfig struct {
@@ -33,6 +47,20 @@ type OpenAI struct {
 	APIKey string
 }
 
+type SecurityConfig struct {
+	// CredentialsKey is the base64 encoded 256-bit master key used to encrypt
+	// provider credentials and webhook secrets at rest. Generate one with
+	// `openssl rand -base64 32`. Without it provider configuration and webhooks
+	// are disabled.
+	CredentialsKey string
+	// FormTokenSecret signs the tokens that protect public collection portal forms. It
+	// must be the same on every replica and is required in production.
+	FormTokenSecret string
+	// InvitationSecret signs the tokens in team invitation emails. It must be the same
+	// on every replica and is required in production.
+	InvitationSecret string
+}
+
 type DatabaseConfig struct {
 	DSN string
 }
@@ -45,8 +73,51 @@ type RedisConfig struct {
 }
 
 type AWSConfig struct {
-	Region     string
-	BucketName string
+	Region          string
+	BucketName      string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	// S3Endpoint points uploads at an S3 compatible service instead of AWS.
+	S3Endpoint string
+}
+
+type StorageConfig struct {
+	// Backend is "s3" or "local". The local backend is meant for development.
+	Backend            string
+	LocalDir           string
+	MaxMediaUploadSize int64
+}
+
+// MailConfig is the SMTP server used for outgoing email. Without a host, emails are
+// logged instead of sent.
+type MailConfig struct {
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	From         string
+}
+
+type AIJobsConfig struct {
+	// Workers is the number of AI jobs this process runs at once.
+	Workers int
+}
+
+type WebhooksConfig struct {
+	// MaxAttempts is how many times a delivery is tried before it is marked dead.
+	MaxAttempts int
+	// AllowPrivateNetworks lets endpoints point at loopback and private addresses, for
+	// receiving webhooks on a development machine.
+	AllowPrivateNetworks bool
+}
+
+type SlackConfig struct {
+	// SigningSecret is the Slack app's signing secret, used to check that button clicks
+	// come from Slack. Without it Slack buttons are ignored.
+	SigningSecret string
+	// LinkSecret signs the links that connect Slack users to their accounts.
+	LinkSecret string
 }
 
 var (
@@ -54,6 +125,12 @@ var (
 	Cfg  *Config
 )
 
+// IsProduction reports whether GO_ENV marks a deployed environment, where secrets that
+// must be shared between replicas are required.
+func (c ServerConfig) IsProduction() bool {
+	return c.Environment == "production"
+}
+
 func NewConfig() *Config {
 	once.Do(func() {
 		Cfg = &Config{
@@ -63,6 +140,8 @@ func NewConfig() *Config {
 				Environment:       os.Getenv("GO_ENV"),
 				FirebaseProjectID: os.Getenv("FIREBASE_PROJECT_ID"),
 				BaseURL:           os.Getenv("BASE_URL"),
+				AppURL:            os.Getenv("APP_URL"),
+				TrustedProxies:    envList("TRUSTED_PROXIES"),
 			},
 			Database: DatabaseConfig{
 				DSN: fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s",
@@ -81,15 +160,65 @@ func NewConfig() *Config {
 				DB:       0,
 			},
 			AWS: AWSConfig{
-				Region:     os.Getenv("AWS_REGION"),
-				BucketName: os.Getenv("AWS_BUCKET_NAME"),
+				Region:          os.Getenv("AWS_REGION"),
+				BucketName:      os.Getenv("AWS_BUCKET_NAME"),
+				AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+				SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+				SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+				S3Endpoint:      os.Getenv("AWS_S3_ENDPOINT"),
+			},
+			Storage: StorageConfig{
+				Backend:            os.Getenv("STORAGE_BACKEND"),
+				LocalDir:           os.Getenv("STORAGE_LOCAL_DIR"),
+				MaxMediaUploadSize: 500 * 1024 * 1024, // 500MB
 			},
 			Services: ServicesConfig{
 				OpenAI: OpenAI{
 					APIKey: os.Getenv("OPENAI_APIKEY"),
 				},
 			},
+			Security: SecurityConfig{
+				CredentialsKey:   os.Getenv("CREDENTIALS_ENCRYPTION_KEY"),
+				FormTokenSecret:  os.Getenv("PORTAL_FORM_SECRET"),
+				InvitationSecret: os.Getenv("INVITATION_TOKEN_SECRET"),
+			},
+			AIJobs: AIJobsConfig{
+				Workers: envInt("AI_JOB_WORKERS", 4),
+			},
+			Mail: MailConfig{
+				SMTPHost:     os.Getenv("SMTP_HOST"),
+				SMTPPort:     os.Getenv("SMTP_PORT"),
+				SMTPUsername: os.Getenv("SMTP_USERNAME"),
+				SMTPPassword: os.Getenv("SMTP_PASSWORD"),
+				From:         os.Getenv("MAIL_FROM"),
+			},
+			Webhooks: WebhooksConfig{
+				MaxAttempts:          envInt("WEBHOOK_MAX_ATTEMPTS", 10),
+				AllowPrivateNetworks: os.Getenv("WEBHOOK_ALLOW_PRIVATE_NETWORKS") == "true",
+			},
+			Slack: SlackConfig{
+				SigningSecret: os.Getenv("SLACK_SIGNING_SECRET"),
+				LinkSecret:    os.Getenv("SLACK_LINK_SECRET"),
+			},
 		}
 	})
 	return Cfg
 }
+
+// envList reads a comma separated environment variable.
+func envList(key string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// envInt reads an integer environment variable, falling back when it is unset or invalid.
+func envInt(key string, fallback int) int {
+	n, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+	return n
+}
@@ -1,7 +1,12 @@
 // internal/contracts/services.go
 package contracts
 
-import "golang.org/x/oauth2"
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+)
 
 // OAuthService defines the contract for obtaining tokens.
 type OAuthService interface {
@@ -23,4 +28,6 @@ type SentimentService interface {
 	AnalyzeWithGRPC(text string) (float64, error)
 
 	AnalyzeText(text string) (float64, error)
+	// RecordAnalysis stores the sentiment breakdown of a saved testimonial's text.
+	RecordAnalysis(ctx context.Context, testimonialID uuid.UUID, text string) error
 }
@@ -0,0 +1,65 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/services"
+	"github.com/ifeanyidike/cenphi/internal/utils"
+	"go.uber.org/zap"
+)
+
+type AIJobController interface {
+	ListTestimonialJobs(w http.ResponseWriter, r *http.Request)
+}
+
+type aiJobController struct {
+	service services.AIJobService
+	logger  *zap.Logger
+}
+
+func NewAIJobController(service services.AIJobService, logger *zap.Logger) AIJobController {
+	return &aiJobController{service: service, logger: logger}
+}
+
+// ListTestimonialJobs returns the AI jobs of a testimonial.
+// @Summary List Testimonial AI Jobs
+// @Description List the AI jobs queued or run for a testimonial, newest first, with their status, attempts and output. Available to any member of the testimonial's workspace.
+// @Tags AI Jobs
+// @Produce json
+// @Param testimonialID path string true "Testimonial ID"
+// @Success 200 {array} models.AIJob
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /testimonials/{testimonialID}/jobs [get]
+func (c *aiJobController) ListTestimonialJobs(w http.ResponseWriter, r *http.Request) {
+	testimonialID, err := uuid.Parse(chi.URLParam(r, "testimonialID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid testimonial ID")
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	jobs, err := c.service.ListForTestimonial(r.Context(), testimonialID, uid)
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrNotFound):
+			utils.RespondWithError(w, http.StatusNotFound, "Testimonial not found")
+		default:
+			c.logger.Error("failed to list ai jobs", zap.Error(err))
+			utils.RespondWithError(w, http.StatusInternalServerError, apperrors.ErrInternalServerError.Error())
+		}
+		return
+	}
+	if jobs == nil {
+		// Render an empty list rather than null
+		jobs = []models.AIJob{}
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, jobs)
+}
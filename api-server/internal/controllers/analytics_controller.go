@@ -0,0 +1,197 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/services"
+	"github.com/ifeanyidike/cenphi/internal/utils"
+	"go.uber.org/zap"
+)
+
+// maxBeaconSize bounds beacon bodies; MaxBeaconEvents events fit well within it.
+const maxBeaconSize = 16 << 10
+
+type AnalyticsController interface {
+	RecordBeacon(w http.ResponseWriter, r *http.Request)
+	GetTestimonialPerformance(w http.ResponseWriter, r *http.Request)
+	GetWorkspaceAnalytics(w http.ResponseWriter, r *http.Request)
+}
+
+type analyticsController struct {
+	service          services.TrackingService
+	analyticsService services.AnalyticsService
+	logger           *zap.Logger
+}
+
+func NewAnalyticsController(service services.TrackingService, analyticsService services.AnalyticsService, logger *zap.Logger) AnalyticsController {
+	return &analyticsController{service: service, analyticsService: analyticsService, logger: logger}
+}
+
+// respondWithAnalyticsError maps tracking and analytics service errors to HTTP responses.
+func (c *analyticsController) respondWithAnalyticsError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, apperrors.ErrWorkspaceAccessDenied):
+		utils.RespondWithError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, apperrors.ErrValidationFailed):
+		utils.RespondWithError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		c.logger.Error("analytics operation failed", zap.Error(err))
+		utils.RespondWithError(w, http.StatusInternalServerError, apperrors.ErrInternalServerError.Error())
+	}
+}
+
+// RecordBeacon records what a visitor did with the testimonials of an embedded widget.
+// @Summary Record Beacon
+// @Description Record impressions, clicks and conversions of a widget's testimonials. Meant for navigator.sendBeacon, so the body is read as JSON whatever its content type. Each visitor is counted once a day per testimonial, widget and event type.
+// @Tags Analytics
+// @Accept json
+// @Param beacon body models.BeaconRequest true "Beacon"
+// @Success 204
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 422 {object} utils.ErrorResponse
+// @Failure 429 {object} utils.ErrorResponse
+// @Router /beacon [post]
+func (c *analyticsController) RecordBeacon(w http.ResponseWriter, r *http.Request) {
+	var req models.BeaconRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBeaconSize)).Decode(&req); err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	client := models.BeaconClient{IP: middleware.ClientIP(r), UserAgent: r.UserAgent()}
+	if err := c.service.RecordBeacon(r.Context(), req, client); err != nil {
+		c.respondWithAnalyticsError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetTestimonialPerformance reports how a workspace's testimonials did in its widgets.
+// @Summary Get Testimonial Performance
+// @Description Report impressions, clicks, conversions and conversion rates per testimonial, most conversions first. Rates are fractions of impressions. Defaults to the last 30 days.
+// @Tags Analytics
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param widget_id query string false "Only count this widget"
+// @Param limit query int false "Maximum number of testimonials (default 20, max 100)"
+// @Success 200 {object} models.TestimonialPerformanceReport
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 422 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/analytics/testimonials [get]
+func (c *analyticsController) GetTestimonialPerformance(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	query, err := parsePerformanceQuery(r)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	report, err := c.service.Performance(r.Context(), workspaceID, uid, query)
+	if err != nil {
+		c.respondWithAnalyticsError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, report)
+}
+
+func parsePerformanceQuery(r *http.Request) (models.TestimonialPerformanceQuery, error) {
+	var query models.TestimonialPerformanceQuery
+	params := r.URL.Query()
+
+	if err := parseDayParams(params, &query.From, &query.To); err != nil {
+		return query, err
+	}
+	if v := params.Get("widget_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return query, errors.New("Invalid widget ID")
+		}
+		query.WidgetID = &id
+	}
+	if v := params.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return query, errors.New("Invalid limit")
+		}
+		query.Limit = limit
+	}
+	return query, nil
+}
+
+// parseDayParams reads the optional from and to days of a report.
+func parseDayParams(params url.Values, from, to *time.Time) error {
+	for _, p := range []struct {
+		name string
+		day  *time.Time
+	}{{"from", from}, {"to", to}} {
+		v := params.Get(p.name)
+		if v == "" {
+			continue
+		}
+		day, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			return errors.New("Invalid " + p.name + " date")
+		}
+		*p.day = day
+	}
+	return nil
+}
+
+// GetWorkspaceAnalytics returns the analytics dashboard of a workspace.
+// @Summary Get Workspace Analytics
+// @Description Aggregate the testimonials collected over a period: a timeline of counts by status with average rating and sentiment, counts by source and collection method, the rating distribution, top tags and categories, competitor mentions and the conversion funnel. Defaults to the last 30 days; results are cached for a few minutes.
+// @Tags Analytics
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param granularity query string false "Timeline buckets: day, week or month (chosen from the period when omitted)"
+// @Success 200 {object} models.WorkspaceAnalytics
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 422 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/analytics [get]
+func (c *analyticsController) GetWorkspaceAnalytics(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	params := r.URL.Query()
+	query := models.WorkspaceAnalyticsQuery{Granularity: models.AnalyticsGranularity(params.Get("granularity"))}
+	if err := parseDayParams(params, &query.From, &query.To); err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	analytics, err := c.analyticsService.WorkspaceAnalytics(r.Context(), workspaceID, uid, query)
+	if err != nil {
+		c.respondWithAnalyticsError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, analytics)
+}
@@ -0,0 +1,175 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/services"
+	"github.com/ifeanyidike/cenphi/internal/utils"
+	"go.uber.org/zap"
+)
+
+type APIKeyController interface {
+	IssueKey(w http.ResponseWriter, r *http.Request)
+	ListKeys(w http.ResponseWriter, r *http.Request)
+	RevokeKey(w http.ResponseWriter, r *http.Request)
+	RotateKey(w http.ResponseWriter, r *http.Request)
+}
+
+type apiKeyController struct {
+	service services.APIKeyService
+	logger  *zap.Logger
+}
+
+func NewAPIKeyController(service services.APIKeyService, logger *zap.Logger) APIKeyController {
+	return &apiKeyController{service: service, logger: logger}
+}
+
+// respondWithAPIKeyError maps API key service errors to HTTP responses.
+func (c *apiKeyController) respondWithAPIKeyError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, apperrors.ErrWorkspaceAccessDenied):
+		utils.RespondWithError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, apperrors.ErrNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, "API key not found")
+	case errors.Is(err, apperrors.ErrValidationFailed):
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+	default:
+		c.logger.Error("api key operation failed", zap.Error(err))
+		utils.RespondWithError(w, http.StatusInternalServerError, apperrors.ErrInternalServerError.Error())
+	}
+}
+
+// IssueKey issues a new API key for a workspace.
+// @Summary Issue API Key
+// @Description Issue a key for the public API. The plaintext key is only returned in this response. Requires the owner or admin role.
+// @Tags API Keys
+// @Accept json
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param key body models.APIKeyRequest true "Key name and rate limit"
+// @Success 201 {object} models.IssuedAPIKey
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/api-keys [post]
+func (c *apiKeyController) IssueKey(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	var req models.APIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	issued, err := c.service.Issue(r.Context(), workspaceID, uid, req)
+	if err != nil {
+		c.respondWithAPIKeyError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusCreated, issued)
+}
+
+// ListKeys lists the API keys of a workspace.
+// @Summary List API Keys
+// @Description List active and revoked API keys of a workspace. Secrets are never returned. Requires the owner or admin role.
+// @Tags API Keys
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Success 200 {array} models.APIKey
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/api-keys [get]
+func (c *apiKeyController) ListKeys(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	keys, err := c.service.List(r.Context(), workspaceID, uid)
+	if err != nil {
+		c.respondWithAPIKeyError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, keys)
+}
+
+// RevokeKey revokes an API key.
+// @Summary Revoke API Key
+// @Description Revoke an API key immediately. Requires the owner or admin role.
+// @Tags API Keys
+// @Param workspaceID path string true "Workspace ID"
+// @Param keyID path string true "API key ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/api-keys/{keyID} [delete]
+func (c *apiKeyController) RevokeKey(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return
+	}
+	keyID, err := uuid.Parse(chi.URLParam(r, "keyID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidID.Error())
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	if err := c.service.Revoke(r.Context(), workspaceID, keyID, uid); err != nil {
+		c.respondWithAPIKeyError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RotateKey replaces an API key with a new secret.
+// @Summary Rotate API Key
+// @Description Revoke an API key and issue a replacement with the same name and rate limit. Requires the owner or admin role.
+// @Tags API Keys
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param keyID path string true "API key ID"
+// @Success 201 {object} models.IssuedAPIKey
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/api-keys/{keyID}/rotate [post]
+func (c *apiKeyController) RotateKey(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return
+	}
+	keyID, err := uuid.Parse(chi.URLParam(r, "keyID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidID.Error())
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	issued, err := c.service.Rotate(r.Context(), workspaceID, keyID, uid)
+	if err != nil {
+		c.respondWithAPIKeyError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusCreated, issued)
+}
@@ -0,0 +1,288 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/services"
+	"github.com/ifeanyidike/cenphi/internal/utils"
+	"go.uber.org/zap"
+)
+
+// maxPortalSubmissionSize bounds anonymous submission bodies.
+const maxPortalSubmissionSize = 64 << 10
+
+type CollectionPortalController interface {
+	CreatePortal(w http.ResponseWriter, r *http.Request)
+	ListPortals(w http.ResponseWriter, r *http.Request)
+	GetPortal(w http.ResponseWriter, r *http.Request)
+	UpdatePortal(w http.ResponseWriter, r *http.Request)
+	DeletePortal(w http.ResponseWriter, r *http.Request)
+	GetPublicPortal(w http.ResponseWriter, r *http.Request)
+	SubmitToPortal(w http.ResponseWriter, r *http.Request)
+}
+
+type collectionPortalController struct {
+	service services.CollectionPortalService
+	logger  *zap.Logger
+}
+
+func NewCollectionPortalController(service services.CollectionPortalService, logger *zap.Logger) CollectionPortalController {
+	return &collectionPortalController{service: service, logger: logger}
+}
+
+// respondWithPortalError maps collection portal service errors to HTTP responses.
+func (c *collectionPortalController) respondWithPortalError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, apperrors.ErrWorkspaceAccessDenied):
+		utils.RespondWithError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, apperrors.ErrNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, "Portal not found")
+	case errors.Is(err, apperrors.ErrPortalSlugTaken):
+		utils.RespondWithError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, apperrors.ErrValidationFailed):
+		utils.RespondWithError(w, http.StatusUnprocessableEntity, err.Error())
+	case errors.Is(err, apperrors.ErrSpamDetected):
+		utils.RespondWithError(w, http.StatusBadRequest, "Submission rejected. Reload the form and try again.")
+	case errors.Is(err, apperrors.ErrQuotaExceeded):
+		// Visitors cannot act on the workspace's plan, so they are not shown its details
+		utils.RespondWithError(w, http.StatusServiceUnavailable, "This form is not accepting testimonials right now.")
+	default:
+		c.logger.Error("collection portal operation failed", zap.Error(err))
+		utils.RespondWithError(w, http.StatusInternalServerError, apperrors.ErrInternalServerError.Error())
+	}
+}
+
+// CreatePortal creates a hosted collection portal.
+// @Summary Create Collection Portal
+// @Description Create a hosted form for collecting testimonials. The slug is generated from the name when omitted. Requires the owner, admin or editor role.
+// @Tags Collection Portals
+// @Accept json
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param portal body models.CollectionPortalRequest true "Portal"
+// @Success 201 {object} models.CollectionPortal
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 422 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/portals [post]
+func (c *collectionPortalController) CreatePortal(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	var req models.CollectionPortalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	portal, err := c.service.Create(r.Context(), workspaceID, uid, req)
+	if err != nil {
+		c.respondWithPortalError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusCreated, portal)
+}
+
+// ListPortals lists the collection portals of a workspace.
+// @Summary List Collection Portals
+// @Description List the hosted collection portals of a workspace. Requires the owner, admin or editor role.
+// @Tags Collection Portals
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Success 200 {array} models.CollectionPortal
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/portals [get]
+func (c *collectionPortalController) ListPortals(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	portals, err := c.service.List(r.Context(), workspaceID, uid)
+	if err != nil {
+		c.respondWithPortalError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, portals)
+}
+
+// GetPortal returns a collection portal.
+// @Summary Get Collection Portal
+// @Description Get a hosted collection portal of a workspace. Requires the owner, admin or editor role.
+// @Tags Collection Portals
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param portalID path string true "Portal ID"
+// @Success 200 {object} models.CollectionPortal
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/portals/{portalID} [get]
+func (c *collectionPortalController) GetPortal(w http.ResponseWriter, r *http.Request) {
+	workspaceID, portalID, ok := parsePortalParams(w, r)
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	portal, err := c.service.Get(r.Context(), workspaceID, portalID, uid)
+	if err != nil {
+		c.respondWithPortalError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, portal)
+}
+
+// UpdatePortal replaces the settings of a collection portal.
+// @Summary Update Collection Portal
+// @Description Replace the settings of a hosted collection portal. Omitting the slug keeps the current link. Requires the owner, admin or editor role.
+// @Tags Collection Portals
+// @Accept json
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param portalID path string true "Portal ID"
+// @Param portal body models.CollectionPortalRequest true "Portal"
+// @Success 200 {object} models.CollectionPortal
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 422 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/portals/{portalID} [put]
+func (c *collectionPortalController) UpdatePortal(w http.ResponseWriter, r *http.Request) {
+	workspaceID, portalID, ok := parsePortalParams(w, r)
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	var req models.CollectionPortalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	portal, err := c.service.Update(r.Context(), workspaceID, portalID, uid, req)
+	if err != nil {
+		c.respondWithPortalError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, portal)
+}
+
+// DeletePortal deletes a collection portal.
+// @Summary Delete Collection Portal
+// @Description Delete a hosted collection portal. Testimonials already collected are kept. Requires the owner, admin or editor role.
+// @Tags Collection Portals
+// @Param workspaceID path string true "Workspace ID"
+// @Param portalID path string true "Portal ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/portals/{portalID} [delete]
+func (c *collectionPortalController) DeletePortal(w http.ResponseWriter, r *http.Request) {
+	workspaceID, portalID, ok := parsePortalParams(w, r)
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	if err := c.service.Delete(r.Context(), workspaceID, portalID, uid); err != nil {
+		c.respondWithPortalError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetPublicPortal serves the form definition of a portal.
+// @Summary Get Portal Form
+// @Description Get the form definition of an active portal. The returned form_token must be sent back with the submission.
+// @Tags Collection Portals
+// @Produce json
+// @Param slug path string true "Portal slug"
+// @Success 200 {object} models.PublicPortal
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 429 {object} utils.ErrorResponse
+// @Router /c/{slug} [get]
+func (c *collectionPortalController) GetPublicPortal(w http.ResponseWriter, r *http.Request) {
+	portal, err := c.service.GetPublic(r.Context(), chi.URLParam(r, "slug"))
+	if err != nil {
+		c.respondWithPortalError(w, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	utils.RespondWithJSON(w, http.StatusOK, portal)
+}
+
+// SubmitToPortal accepts a testimonial through a portal.
+// @Summary Submit Through Portal
+// @Description Submit a testimonial through an active portal. Custom fields are validated against the portal's form. Testimonials are always stored pending review.
+// @Tags Collection Portals
+// @Accept json
+// @Produce json
+// @Param slug path string true "Portal slug"
+// @Param submission body models.PortalSubmission true "Submission"
+// @Success 201 {object} map[string]string
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 422 {object} utils.ErrorResponse
+// @Failure 429 {object} utils.ErrorResponse
+// @Router /c/{slug} [post]
+func (c *collectionPortalController) SubmitToPortal(w http.ResponseWriter, r *http.Request) {
+	var submission models.PortalSubmission
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxPortalSubmissionSize))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&submission); err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	submission.SubmitterIP = middleware.ClientIP(r)
+
+	testimonial, err := c.service.SubmitPublic(r.Context(), chi.URLParam(r, "slug"), submission)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrSpamDetected) {
+			c.logger.Info("rejected portal submission", zap.String("slug", chi.URLParam(r, "slug")), zap.Error(err))
+		}
+		c.respondWithPortalError(w, err)
+		return
+	}
+
+	// Anonymous submitters only learn that their testimonial was received
+	utils.RespondWithJSON(w, http.StatusCreated, map[string]string{"id": testimonial.ID.String(), "status": string(testimonial.Status)})
+}
+
+func parsePortalParams(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+	portalID, err := uuid.Parse(chi.URLParam(r, "portalID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidID.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+	return workspaceID, portalID, true
+}
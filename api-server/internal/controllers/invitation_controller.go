@@ -0,0 +1,229 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/services"
+	"github.com/ifeanyidike/cenphi/internal/utils"
+	"go.uber.org/zap"
+)
+
+type InvitationController interface {
+	Invite(w http.ResponseWriter, r *http.Request)
+	ResendInvitation(w http.ResponseWriter, r *http.Request)
+	RevokeInvitation(w http.ResponseWriter, r *http.Request)
+	AcceptInvitation(w http.ResponseWriter, r *http.Request)
+	GetTeam(w http.ResponseWriter, r *http.Request)
+}
+
+type invitationController struct {
+	service services.InvitationService
+	logger  *zap.Logger
+}
+
+func NewInvitationController(service services.InvitationService, logger *zap.Logger) InvitationController {
+	return &invitationController{service: service, logger: logger}
+}
+
+// respondWithInvitationError maps invitation service errors to HTTP responses.
+func (c *invitationController) respondWithInvitationError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, apperrors.ErrWorkspaceAccessDenied),
+		errors.Is(err, apperrors.ErrInvitationEmailMismatch):
+		utils.RespondWithError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, apperrors.ErrNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, "Invitation not found")
+	case errors.Is(err, apperrors.ErrWorkspaceNotFound),
+		errors.Is(err, apperrors.ErrUserNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, apperrors.ErrInvitationInvalid),
+		errors.Is(err, apperrors.ErrInvitationExpired):
+		utils.RespondWithError(w, http.StatusGone, err.Error())
+	case errors.Is(err, apperrors.ErrConflict):
+		utils.RespondWithError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, apperrors.ErrQuotaExceeded):
+		utils.RespondWithError(w, http.StatusPaymentRequired, err.Error())
+	case errors.Is(err, apperrors.ErrValidationFailed),
+		errors.Is(err, apperrors.ErrInvalidEmailFormat):
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, apperrors.ErrServiceUnavailable):
+		c.logger.Warn("invitation email failed", zap.Error(err))
+		utils.RespondWithError(w, http.StatusServiceUnavailable, err.Error())
+	default:
+		c.logger.Error("invitation operation failed", zap.Error(err))
+		utils.RespondWithError(w, http.StatusInternalServerError, apperrors.ErrInternalServerError.Error())
+	}
+}
+
+// Invite invites someone to a workspace by email.
+// @Summary Invite Team Member
+// @Description Email an invitation to join the workspace with a role. Pending invitations count towards the plan's seat limit. Requires the members:manage permission, and only owners may invite admins.
+// @Tags Team Invitations
+// @Accept json
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param invitation body models.InvitationRequest true "Email and role"
+// @Success 201 {object} models.TeamInvitation
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 402 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 503 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/invitations [post]
+func (c *invitationController) Invite(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	var req models.InvitationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	invitation, err := c.service.Invite(r.Context(), workspaceID, uid, req)
+	if err != nil {
+		c.respondWithInvitationError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusCreated, invitation)
+}
+
+// ResendInvitation emails a pending invitation again.
+// @Summary Resend Invitation
+// @Description Email a new link for a pending invitation and restart its expiry. Links sent earlier stop working. Requires the members:manage permission.
+// @Tags Team Invitations
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param invitationID path string true "Invitation ID"
+// @Success 200 {object} models.TeamInvitation
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 503 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/invitations/{invitationID}/resend [post]
+func (c *invitationController) ResendInvitation(w http.ResponseWriter, r *http.Request) {
+	workspaceID, invitationID, ok := c.parseInvitationPath(w, r)
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	invitation, err := c.service.Resend(r.Context(), workspaceID, invitationID, uid)
+	if err != nil {
+		c.respondWithInvitationError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, invitation)
+}
+
+// RevokeInvitation cancels a pending invitation.
+// @Summary Revoke Invitation
+// @Description Cancel a pending invitation and free its seat. Requires the members:manage permission.
+// @Tags Team Invitations
+// @Param workspaceID path string true "Workspace ID"
+// @Param invitationID path string true "Invitation ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/invitations/{invitationID} [delete]
+func (c *invitationController) RevokeInvitation(w http.ResponseWriter, r *http.Request) {
+	workspaceID, invitationID, ok := c.parseInvitationPath(w, r)
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	if err := c.service.Revoke(r.Context(), workspaceID, invitationID, uid); err != nil {
+		c.respondWithInvitationError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AcceptInvitation joins the workspace an invitation is for.
+// @Summary Accept Invitation
+// @Description Add the signed-in user to the workspace with the invited role. The user's email must match the invitation.
+// @Tags Team Invitations
+// @Accept json
+// @Produce json
+// @Param token body models.AcceptInvitationRequest true "Token from the invitation email"
+// @Success 201 {object} models.TeamMember
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 410 {object} utils.ErrorResponse
+// @Router /invitations/accept [post]
+func (c *invitationController) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	var req models.AcceptInvitationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || strings.TrimSpace(req.Token) == "" {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	member, err := c.service.Accept(r.Context(), uid, strings.TrimSpace(req.Token))
+	if err != nil {
+		c.respondWithInvitationError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusCreated, member)
+}
+
+// GetTeam lists a workspace's members and pending invitations.
+// @Summary Get Team
+// @Description List the members of a workspace alongside its pending invitations and seat usage.
+// @Tags Team Invitations
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Success 200 {object} models.TeamRoster
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/team [get]
+func (c *invitationController) GetTeam(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	roster, err := c.service.Roster(r.Context(), workspaceID, uid)
+	if err != nil {
+		c.respondWithInvitationError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, roster)
+}
+
+func (c *invitationController) parseInvitationPath(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+	invitationID, err := uuid.Parse(chi.URLParam(r, "invitationID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidID.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+	return workspaceID, invitationID, true
+}
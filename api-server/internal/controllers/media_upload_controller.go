@@ -0,0 +1,232 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/services"
+	"github.com/ifeanyidike/cenphi/internal/utils"
+	"go.uber.org/zap"
+)
+
+type MediaUploadController interface {
+	CreateUpload(w http.ResponseWriter, r *http.Request)
+	GetUpload(w http.ResponseWriter, r *http.Request)
+	UploadPart(w http.ResponseWriter, r *http.Request)
+	CompleteUpload(w http.ResponseWriter, r *http.Request)
+	AbortUpload(w http.ResponseWriter, r *http.Request)
+}
+
+type mediaUploadController struct {
+	service services.MediaUploadService
+	logger  *zap.Logger
+}
+
+func NewMediaUploadController(service services.MediaUploadService, logger *zap.Logger) MediaUploadController {
+	return &mediaUploadController{service: service, logger: logger}
+}
+
+// respondWithUploadError maps media upload service errors to HTTP responses.
+func (c *mediaUploadController) respondWithUploadError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, apperrors.ErrWorkspaceAccessDenied):
+		utils.RespondWithError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, apperrors.ErrNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, "Upload not found")
+	case errors.Is(err, apperrors.ErrFileTooLarge):
+		utils.RespondWithError(w, http.StatusRequestEntityTooLarge, err.Error())
+	case errors.Is(err, apperrors.ErrFileTypeInvalid):
+		utils.RespondWithError(w, http.StatusUnsupportedMediaType, err.Error())
+	case errors.Is(err, apperrors.ErrUploadClosed), errors.Is(err, apperrors.ErrUploadIncomplete):
+		utils.RespondWithError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, apperrors.ErrValidationFailed):
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, apperrors.ErrQuotaExceeded):
+		utils.RespondWithError(w, http.StatusPaymentRequired, err.Error())
+	default:
+		c.logger.Error("media upload operation failed", zap.Error(err))
+		utils.RespondWithError(w, http.StatusInternalServerError, apperrors.ErrInternalServerError.Error())
+	}
+}
+
+// CreateUpload starts a chunked media upload.
+// @Summary Start Media Upload
+// @Description Start a resumable upload of a video or audio testimonial. Send the file in parts of chunk_size bytes, then complete the upload. Requires the owner, admin or editor role.
+// @Tags Media Uploads
+// @Accept json
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param upload body models.MediaUploadRequest true "File details"
+// @Success 201 {object} models.MediaUpload
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 402 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 413 {object} utils.ErrorResponse
+// @Failure 415 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/uploads [post]
+func (c *mediaUploadController) CreateUpload(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	var req models.MediaUploadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	upload, err := c.service.CreateUpload(r.Context(), workspaceID, uid, req)
+	if err != nil {
+		c.respondWithUploadError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusCreated, upload)
+}
+
+// GetUpload returns the progress of a media upload.
+// @Summary Get Media Upload
+// @Description Get a media upload and the parts received so far. Use it to resume an interrupted upload.
+// @Tags Media Uploads
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param uploadID path string true "Upload ID"
+// @Success 200 {object} models.MediaUpload
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/uploads/{uploadID} [get]
+func (c *mediaUploadController) GetUpload(w http.ResponseWriter, r *http.Request) {
+	workspaceID, uploadID, ok := parseUploadParams(w, r)
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	upload, err := c.service.GetUpload(r.Context(), workspaceID, uploadID, uid)
+	if err != nil {
+		c.respondWithUploadError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, upload)
+}
+
+// UploadPart stores one part of a media upload.
+// @Summary Upload Media Part
+// @Description Upload one part as the raw request body. Every part except the last must be exactly chunk_size bytes. Parts may be sent in any order and retried.
+// @Tags Media Uploads
+// @Accept application/octet-stream
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param uploadID path string true "Upload ID"
+// @Param partNumber path int true "Part number, starting at 1"
+// @Success 200 {object} models.MediaUploadPart
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 411 {object} utils.ErrorResponse
+// @Failure 415 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/uploads/{uploadID}/parts/{partNumber} [put]
+func (c *mediaUploadController) UploadPart(w http.ResponseWriter, r *http.Request) {
+	workspaceID, uploadID, ok := parseUploadParams(w, r)
+	if !ok {
+		return
+	}
+	partNumber, err := strconv.Atoi(chi.URLParam(r, "partNumber"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid part number")
+		return
+	}
+	if r.ContentLength < 0 {
+		utils.RespondWithError(w, http.StatusLengthRequired, "Content-Length is required")
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	part, err := c.service.UploadPart(r.Context(), workspaceID, uploadID, uid, partNumber, r.Body, r.ContentLength)
+	if err != nil {
+		c.respondWithUploadError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, part)
+}
+
+// CompleteUpload assembles a media upload and creates its testimonial.
+// @Summary Complete Media Upload
+// @Description Assemble the uploaded parts and create a testimonial, pending review, that links to the stored media.
+// @Tags Media Uploads
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param uploadID path string true "Upload ID"
+// @Success 201 {object} models.Testimonial
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/uploads/{uploadID}/complete [post]
+func (c *mediaUploadController) CompleteUpload(w http.ResponseWriter, r *http.Request) {
+	workspaceID, uploadID, ok := parseUploadParams(w, r)
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	testimonial, err := c.service.CompleteUpload(r.Context(), workspaceID, uploadID, uid)
+	if err != nil {
+		c.respondWithUploadError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusCreated, testimonial)
+}
+
+// AbortUpload cancels a media upload.
+// @Summary Abort Media Upload
+// @Description Cancel an in-progress upload and discard the parts received so far.
+// @Tags Media Uploads
+// @Param workspaceID path string true "Workspace ID"
+// @Param uploadID path string true "Upload ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/uploads/{uploadID} [delete]
+func (c *mediaUploadController) AbortUpload(w http.ResponseWriter, r *http.Request) {
+	workspaceID, uploadID, ok := parseUploadParams(w, r)
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	if err := c.service.AbortUpload(r.Context(), workspaceID, uploadID, uid); err != nil {
+		c.respondWithUploadError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func parseUploadParams(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+	uploadID, err := uuid.Parse(chi.URLParam(r, "uploadID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidID.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+	return workspaceID, uploadID, true
+}
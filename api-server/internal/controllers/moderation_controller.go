@@ -0,0 +1,127 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/services"
+	"github.com/ifeanyidike/cenphi/internal/utils"
+	"go.uber.org/zap"
+)
+
+type ModerationController interface {
+	TransitionTestimonial(w http.ResponseWriter, r *http.Request)
+	ListTransitions(w http.ResponseWriter, r *http.Request)
+}
+
+type moderationController struct {
+	service services.ModerationService
+	logger  *zap.Logger
+}
+
+func NewModerationController(service services.ModerationService, logger *zap.Logger) ModerationController {
+	return &moderationController{service: service, logger: logger}
+}
+
+// respondWithModerationError maps moderation service errors to HTTP responses.
+func (c *moderationController) respondWithModerationError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, apperrors.ErrWorkspaceAccessDenied):
+		utils.RespondWithError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, apperrors.ErrNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, "Testimonial not found")
+	case errors.Is(err, apperrors.ErrValidationFailed):
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, apperrors.ErrConflict):
+		utils.RespondWithError(w, http.StatusConflict, err.Error())
+	default:
+		c.logger.Error("testimonial moderation failed", zap.Error(err))
+		utils.RespondWithError(w, http.StatusInternalServerError, apperrors.ErrInternalServerError.Error())
+	}
+}
+
+// parseModerationPath reads the workspace and testimonial IDs from the URL.
+func parseModerationPath(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+	testimonialID, err := uuid.Parse(chi.URLParam(r, "testimonialID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid testimonial ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+	return workspaceID, testimonialID, true
+}
+
+// TransitionTestimonial moves a testimonial to another status.
+// @Summary Transition Testimonial Status
+// @Description Move a testimonial along the moderation workflow: pending_review to approved or rejected, approved to featured, scheduled or archived. Approving, rejecting, featuring and scheduling require the owner, admin or editor role; archiving and restoring archived testimonials require the owner or admin role. A reason is required to reject. Scheduling requires publish_at, either RFC 3339 or a local time such as 2024-05-01T09:00 read in the IANA timezone given; the testimonial is published and approved when that time arrives. Moving a scheduled testimonial back to approved cancels the schedule. Every change is written to the audit log.
+// @Tags Moderation
+// @Accept json
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param testimonialID path string true "Testimonial ID"
+// @Param transition body models.TestimonialTransitionRequest true "Target status, reason and publish time"
+// @Success 200 {object} models.TestimonialTransition
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/testimonials/{testimonialID}/transitions [post]
+func (c *moderationController) TransitionTestimonial(w http.ResponseWriter, r *http.Request) {
+	workspaceID, testimonialID, ok := parseModerationPath(w, r)
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	var req models.TestimonialTransitionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	transition, err := c.service.Transition(r.Context(), workspaceID, testimonialID, uid, req)
+	if err != nil {
+		c.respondWithModerationError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, transition)
+}
+
+// ListTransitions returns the status history of a testimonial.
+// @Summary List Testimonial Transitions
+// @Description List the status changes of a testimonial, oldest first, with the team member who made each and their reason. Available to any member of the workspace.
+// @Tags Moderation
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param testimonialID path string true "Testimonial ID"
+// @Success 200 {array} models.TestimonialTransition
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/testimonials/{testimonialID}/transitions [get]
+func (c *moderationController) ListTransitions(w http.ResponseWriter, r *http.Request) {
+	workspaceID, testimonialID, ok := parseModerationPath(w, r)
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	transitions, err := c.service.History(r.Context(), workspaceID, testimonialID, uid)
+	if err != nil {
+		c.respondWithModerationError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, transitions)
+}
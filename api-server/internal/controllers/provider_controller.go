@@ -3,10 +3,13 @@ package controllers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
 	"github.com/ifeanyidike/cenphi/internal/models"
 	"github.com/ifeanyidike/cenphi/internal/services"
 	"github.com/ifeanyidike/cenphi/internal/utils"
@@ -14,22 +17,35 @@ import (
 )
 
 type ProviderController interface {
+	ListProviders(w http.ResponseWriter, r *http.Request)
 	SetupProvider(w http.ResponseWriter, r *http.Request)
 	GetProviderStatus(w http.ResponseWriter, r *http.Request)
+	GetSyncRuns(w http.ResponseWriter, r *http.Request)
 }
 
 type providerController struct {
-	providerSvc services.ProviderService
+	providerSvc *services.ProviderService
 	logger      *zap.Logger
 }
 
-func NewProviderController(providerSvc services.ProviderService, logger *zap.Logger) ProviderController {
+func NewProviderController(providerSvc *services.ProviderService, logger *zap.Logger) ProviderController {
 	return &providerController{
 		providerSvc: providerSvc,
 		logger:      logger,
 	}
 }
 
+// ListProviders lists the providers a workspace can connect.
+// @Summary List Providers
+// @Description Returns every available provider with its auth type, rate limits, default schedule and the credential fields the UI must collect
+// @Tags Providers
+// @Produce json
+// @Success 200 {array} github_com_ifeanyidike_cenphi_internal_providers.Definition
+// @Router /providers [get]
+func (c *providerController) ListProviders(w http.ResponseWriter, r *http.Request) {
+	utils.RespondWithJSON(w, http.StatusOK, c.providerSvc.AvailableProviders())
+}
+
 func (c *providerController) SetupProvider(w http.ResponseWriter, r *http.Request) {
 	providerName := chi.URLParam(r, "provider")
 	workspaceIDStr := chi.URLParam(r, "workspaceID")
@@ -52,6 +68,14 @@ func (c *providerController) SetupProvider(w http.ResponseWriter, r *http.Reques
 	config.WorkspaceID = workspaceID
 
 	if err := c.providerSvc.ConfigureProvider(r.Context(), config); err != nil {
+		if errors.Is(err, apperrors.ErrQuotaExceeded) {
+			utils.RespondWithError(w, http.StatusPaymentRequired, err.Error())
+			return
+		}
+		if errors.Is(err, apperrors.ErrServiceUnavailable) {
+			utils.RespondWithError(w, http.StatusServiceUnavailable, "Provider configuration is not available")
+			return
+		}
 		c.logger.Error("failed to configure provider", zap.Error(err))
 		utils.RespondWithError(w, http.StatusInternalServerError, "Failed to configure provider")
 		return
@@ -71,6 +95,10 @@ func (c *providerController) GetProviderStatus(w http.ResponseWriter, r *http.Re
 	}
 
 	configs, err := c.providerSvc.GetWorkspaceProviders(r.Context(), workspaceID)
+	if errors.Is(err, apperrors.ErrServiceUnavailable) {
+		utils.RespondWithError(w, http.StatusServiceUnavailable, "Provider configuration is not available")
+		return
+	}
 	if err != nil {
 		c.logger.Error("failed to get provider status", zap.Error(err))
 		utils.RespondWithError(w, http.StatusInternalServerError, "Failed to get provider status")
@@ -79,3 +107,45 @@ func (c *providerController) GetProviderStatus(w http.ResponseWriter, r *http.Re
 
 	utils.RespondWithJSON(w, http.StatusOK, configs)
 }
+
+const maxSyncRunLimit = 200
+
+// GetSyncRuns lists the recent sync runs for a workspace.
+// @Summary List Sync Runs
+// @Description Returns the most recent sync runs for a workspace, newest first
+// @Tags Providers
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param limit query int false "Maximum number of runs to return"
+// @Success 200 {array} models.SyncRun
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /providers/{workspaceID}/runs [get]
+func (c *providerController) GetSyncRuns(w http.ResponseWriter, r *http.Request) {
+	workspaceIDStr := chi.URLParam(r, "workspaceID")
+
+	workspaceID, err := uuid.Parse(workspaceIDStr)
+	if err != nil || workspaceID == uuid.Nil {
+		c.logger.Error("invalid workspace ID", zap.String("workspace ID", workspaceIDStr), zap.Error(err))
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid workspace ID")
+		return
+	}
+
+	limit := 0
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > maxSyncRunLimit {
+			utils.RespondWithError(w, http.StatusBadRequest, "Invalid limit")
+			return
+		}
+	}
+
+	runs, err := c.providerSvc.GetSyncRuns(r.Context(), workspaceID, limit)
+	if err != nil {
+		c.logger.Error("failed to get sync runs", zap.String("workspace ID", workspaceIDStr), zap.Error(err))
+		utils.RespondWithError(w, http.StatusInternalServerError, "Failed to get sync runs")
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, runs)
+}
@@ -0,0 +1,209 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/services"
+	"github.com/ifeanyidike/cenphi/internal/utils"
+	"go.uber.org/zap"
+)
+
+// maxSlackInteractionSize bounds the body read from Slack before its signature is checked.
+const maxSlackInteractionSize = 1 << 20
+
+type SlackController interface {
+	GetSettings(w http.ResponseWriter, r *http.Request)
+	UpdateSettings(w http.ResponseWriter, r *http.Request)
+	SendTest(w http.ResponseWriter, r *http.Request)
+	LinkAccount(w http.ResponseWriter, r *http.Request)
+	HandleInteraction(w http.ResponseWriter, r *http.Request)
+}
+
+type slackController struct {
+	service      services.SlackService
+	interactions services.SlackInteractionService
+	logger       *zap.Logger
+}
+
+func NewSlackController(service services.SlackService, interactions services.SlackInteractionService, logger *zap.Logger) SlackController {
+	return &slackController{service: service, interactions: interactions, logger: logger}
+}
+
+// respondWithSlackError maps Slack service errors to HTTP responses.
+func (c *slackController) respondWithSlackError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, apperrors.ErrWorkspaceAccessDenied):
+		utils.RespondWithError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, apperrors.ErrWorkspaceNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, apperrors.ErrValidationFailed):
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, apperrors.ErrServiceUnavailable):
+		utils.RespondWithError(w, http.StatusBadGateway, err.Error())
+	default:
+		c.logger.Error("slack operation failed", zap.Error(err))
+		utils.RespondWithError(w, http.StatusInternalServerError, apperrors.ErrInternalServerError.Error())
+	}
+}
+
+func slackWorkspaceID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return uuid.Nil, false
+	}
+	return workspaceID, true
+}
+
+// GetSettings returns a workspace's Slack notification settings.
+// @Summary Get Slack Settings
+// @Description Get the Slack notification settings of a workspace. The channel webhook comes from the workspace's integration settings. Requires the owner or admin role.
+// @Tags Slack
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Success 200 {object} models.SlackSettings
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/slack [get]
+func (c *slackController) GetSettings(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := slackWorkspaceID(w, r)
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	settings, err := c.service.GetSettings(r.Context(), workspaceID, uid)
+	if err != nil {
+		c.respondWithSlackError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, settings)
+}
+
+// UpdateSettings replaces a workspace's Slack notification settings.
+// @Summary Update Slack Settings
+// @Description Choose which testimonials are posted to Slack, when alerts fire, how they are routed to channels and when the daily digest is sent. Requires the owner or admin role.
+// @Tags Slack
+// @Accept json
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param settings body models.SlackSettingsRequest true "Slack settings"
+// @Success 200 {object} models.SlackSettings
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/slack [put]
+func (c *slackController) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := slackWorkspaceID(w, r)
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	var req models.SlackSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	settings, err := c.service.UpdateSettings(r.Context(), workspaceID, uid, req)
+	if err != nil {
+		c.respondWithSlackError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, settings)
+}
+
+// SendTest posts a test message to a workspace's Slack channels.
+// @Summary Send Slack Test Message
+// @Description Post a test message to the workspace's channel and to every channel its routing rules use. Requires the owner or admin role.
+// @Tags Slack
+// @Param workspaceID path string true "Workspace ID"
+// @Success 204
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 502 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/slack/test [post]
+func (c *slackController) SendTest(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := slackWorkspaceID(w, r)
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	if err := c.service.SendTest(r.Context(), workspaceID, uid); err != nil {
+		c.respondWithSlackError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// LinkAccount links the caller's Slack user to their membership.
+// @Summary Link Slack Account
+// @Description Redeem the link a Slack user was sent after clicking a moderation button, so their clicks act as the signed-in member.
+// @Tags Slack
+// @Accept json
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param link body models.SlackLinkRequest true "Link token"
+// @Success 200 {object} models.SlackUserLink
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/slack/link [post]
+func (c *slackController) LinkAccount(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := slackWorkspaceID(w, r)
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	var req models.SlackLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	link, err := c.interactions.LinkUser(r.Context(), workspaceID, uid, req)
+	if err != nil {
+		c.respondWithSlackError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, link)
+}
+
+// HandleInteraction receives button clicks from Slack messages.
+// @Summary Slack Interactions
+// @Description Request URL for the Slack app's interactivity. Requests must carry a valid Slack signature.
+// @Tags Slack
+// @Accept x-www-form-urlencoded
+// @Success 200
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /slack/interactions [post]
+func (c *slackController) HandleInteraction(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxSlackInteractionSize))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	err = c.interactions.HandleInteraction(r.Context(), r.Header, body)
+	switch {
+	case err == nil:
+		w.WriteHeader(http.StatusOK)
+	case errors.Is(err, apperrors.ErrUnauthorized):
+		utils.RespondWithError(w, http.StatusUnauthorized, apperrors.ErrUnauthorized.Error())
+	default:
+		c.respondWithSlackError(w, err)
+	}
+}
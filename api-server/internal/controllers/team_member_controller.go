@@ -1,16 +1,14 @@
 package controllers
 
 import (
-	"context"
-	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
-	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/ifeanyidike/cenphi/internal/apperrors"
-	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
 	"github.com/ifeanyidike/cenphi/internal/services"
 	"github.com/ifeanyidike/cenphi/internal/utils"
 	"go.uber.org/zap"
@@ -18,9 +16,7 @@ import (
 
 type TeamMemberController interface {
 	GetTeamMember(w http.ResponseWriter, r *http.Request)
-	GetTeamMemberByUserID(w http.ResponseWriter, r *http.Request)
 	GetTeamMemberByFirebaseUID(w http.ResponseWriter, r *http.Request)
-	CreateTeamMember(w http.ResponseWriter, r *http.Request)
 	DeleteTeamMember(w http.ResponseWriter, r *http.Request)
 	GetTeamMembers(w http.ResponseWriter, r *http.Request)
 }
@@ -35,49 +31,41 @@ func NewTeamMemberController(service services.TeamMemberService, userSvc service
 	return &teamMemberController{logger: logger, service: service, userSvc: userSvc}
 }
 
-// GetTeamMember Gets team member data by its ID.
+// respondWithTeamMemberError maps team member service errors to HTTP responses.
+func (c *teamMemberController) respondWithTeamMemberError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, apperrors.ErrWorkspaceAccessDenied):
+		utils.RespondWithError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, apperrors.ErrNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, "Team member not found")
+	case errors.Is(err, apperrors.ErrValidationFailed):
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+	default:
+		c.logger.Error("team member operation failed", zap.Error(err))
+		utils.RespondWithError(w, http.StatusInternalServerError, apperrors.ErrInternalServerError.Error())
+	}
+}
+
+// GetTeamMember Gets a workspace's team member by its ID.
 // @Summary Get Team Member by its ID.
-// @Description Fetch a team member by their ID.
+// @Description Fetch a member of the workspace by their ID. Members of other workspaces are not found.
 // @Tags TeamMembers
 // @Accept json
 // @Produce json
-// @Param id path string true "ID"
-// @Success 200 {object} models.TeamMember
+// @Param workspaceID path string true "Workspace ID"
+// @Param memberID path string true "Team member ID"
+// @Success 200 {object} github_com_ifeanyidike_cenphi_internal_models.TeamMemberGetParams
 // @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
 // @Failure 404 {object} utils.ErrorResponse
-// @Router /team-member [get]
+// @Router /workspaces/{workspaceID}/members/{memberID} [get]
 func (c *teamMemberController) GetTeamMember(w http.ResponseWriter, r *http.Request) {
-	idStr := chi.URLParam(r, "id")
-	id, err := uuid.Parse(idStr)
-	if err != nil || id == uuid.Nil {
-		c.logger.Error("invalid team member ID", zap.String("team member ID", idStr), zap.Error(err))
-		utils.RespondWithError(w, http.StatusBadRequest, "Invalid or missing ID")
-		return
-	}
-
-	user, err := c.service.GetTeamMemberData(r.Context(), id)
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
 	if err != nil {
-		c.logger.Error("failed to get team member", zap.String("team member ID", id.String()), zap.Error(err))
-		utils.RespondWithError(w, http.StatusNotFound, err.Error())
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
 		return
 	}
-
-	utils.RespondWithJSON(w, http.StatusOK, user)
-}
-
-// GetTeamMemberByUserID Gets team member data by userID.
-// @Summary Get Team Member by by userID.
-// @Description Fetch a team member by userID.
-// @Tags TeamMembers
-// @Accept json
-// @Produce json
-// @Param id path string true "ID"
-// @Success 200 {object} models.TeamMember
-// @Failure 400 {object} utils.ErrorResponse
-// @Failure 404 {object} utils.ErrorResponse
-// @Router /team-member [get]
-func (c *teamMemberController) GetTeamMemberByUserID(w http.ResponseWriter, r *http.Request) {
-	idStr := chi.URLParam(r, "id")
+	idStr := chi.URLParam(r, "memberID")
 	id, err := uuid.Parse(idStr)
 	if err != nil || id == uuid.Nil {
 		c.logger.Error("invalid team member ID", zap.String("team member ID", idStr), zap.Error(err))
@@ -85,27 +73,27 @@ func (c *teamMemberController) GetTeamMemberByUserID(w http.ResponseWriter, r *h
 		return
 	}
 
-	teammember, err := c.service.GetTeamMemberDataByUserID(r.Context(), id)
+	member, err := c.service.GetTeamMemberData(r.Context(), workspaceID, id)
 	if err != nil {
-		c.logger.Error("failed to get team member", zap.String("team member ID", id.String()), zap.Error(err))
-		utils.RespondWithError(w, http.StatusNotFound, err.Error())
+		c.respondWithTeamMemberError(w, err)
 		return
 	}
 
-	utils.RespondWithJSON(w, http.StatusOK, teammember)
+	utils.RespondWithJSON(w, http.StatusOK, member)
 }
 
-// GetTeamMemberByFirebaseUID Gets team member data by FirebaseUID.
+// GetTeamMemberByFirebaseUID Gets the signed in user's team member data.
 // @Summary Get Team Member by by FirebaseUID.
-// @Description Fetch a team member by FirebaseUID.
+// @Description Fetch the team member of the signed in user. Other users' memberships cannot be looked up.
 // @Tags TeamMembers
 // @Accept json
 // @Produce json
-// @Param id path string true "ID"
-// @Success 200 {object} models.TeamMember
+// @Param id path string true "Firebase UID of the signed in user"
+// @Success 200 {object} github_com_ifeanyidike_cenphi_internal_models.TeamMemberGetParams
 // @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
 // @Failure 404 {object} utils.ErrorResponse
-// @Router /team-member [get]
+// @Router /team-member/firebase_uid/{id} [get]
 func (c *teamMemberController) GetTeamMemberByFirebaseUID(w http.ResponseWriter, r *http.Request) {
 	uid := chi.URLParam(r, "id")
 	if uid == "" {
@@ -113,6 +101,10 @@ func (c *teamMemberController) GetTeamMemberByFirebaseUID(w http.ResponseWriter,
 		utils.RespondWithError(w, http.StatusBadRequest, "Invalid or missing ID")
 		return
 	}
+	if callerUID, _ := middleware.UserIDFromContext(r.Context()); callerUID != uid {
+		utils.RespondWithError(w, http.StatusForbidden, apperrors.ErrUserAccessDenied.Error())
+		return
+	}
 
 	user, err := c.userSvc.FindByUID(r.Context(), uid)
 	if err != nil {
@@ -131,70 +123,37 @@ func (c *teamMemberController) GetTeamMemberByFirebaseUID(w http.ResponseWriter,
 	utils.RespondWithJSON(w, http.StatusOK, member)
 }
 
-// CreateTeamMember creates a new team member.
-// @Summary Create Team Member
-// @Description Create a new team member.
-// @Tags TeamMembers
-// @Accept json
-// @Produce json
-// @Param team member body models.TeamMember true "TeamMember data"
-// @Success 201 {string} string "TeamMember created successfully"
-// @Failure 400 {object} utils.ErrorResponse
-// @Failure 500 {object} utils.ErrorResponse
-// @Router /team-member [post]
-func (c *teamMemberController) CreateTeamMember(w http.ResponseWriter, r *http.Request) {
-	var member models.TeamMember
-
-	// Parse and validate the input
-	if err := json.NewDecoder(r.Body).Decode(&member); err != nil {
-		c.logger.Error("invalid request payload", zap.Error(err))
-		utils.RespondWithError(w, http.StatusBadRequest, "Invalid request payload")
-		return
-	}
-	if member.WorkspaceID == uuid.Nil || member.UserID == uuid.Nil {
-		utils.RespondWithError(w, http.StatusBadRequest, "The member data is malformed: Invalid member")
-		return
-	}
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
-
-	if err := c.service.AddTeamMember(ctx, &member); err != nil {
-		c.logger.Error("failed to register member", zap.Error(err))
-		utils.RespondWithError(w, http.StatusInternalServerError, "Failed to register member")
-		return
-	}
-
-	utils.RespondWithJSON(w, http.StatusCreated, "User registered successfully")
-}
-
-// DeleteTeamMember deletes a team member by their ID.
+// DeleteTeamMember removes a member from the workspace.
 // @Summary Delete Team Member
-// @Description Delete a team member by their ID.
+// @Description Remove a member from the workspace. The owner cannot be removed and only owners may remove admins.
 // @Tags TeamMembers
 // @Accept json
 // @Produce json
-// @Param id path string true "ID"
+// @Param workspaceID path string true "Workspace ID"
+// @Param memberID path string true "Team member ID"
 // @Success 200 {string} string "TeamMember deleted successfully"
 // @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
 // @Failure 404 {object} utils.ErrorResponse
 // @Failure 500 {object} utils.ErrorResponse
-// @Router /team-member/{id} [delete]
+// @Router /workspaces/{workspaceID}/members/{memberID} [delete]
 func (c *teamMemberController) DeleteTeamMember(w http.ResponseWriter, r *http.Request) {
-	idStr := chi.URLParam(r, "id")
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return
+	}
+	idStr := chi.URLParam(r, "memberID")
 	id, err := uuid.Parse(idStr)
 	if err != nil || id == uuid.Nil {
 		c.logger.Error("invalid team member ID", zap.String("team member ID", idStr), zap.Error(err))
 		utils.RespondWithError(w, http.StatusBadRequest, "Invalid or missing ID")
 		return
 	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
 
-	if err := c.service.RemoveTeamMember(r.Context(), id); err != nil {
-		c.logger.Error("failed to delete team member", zap.String("team member ID", id.String()), zap.Error(err))
-		if err == apperrors.ErrNotFound {
-			utils.RespondWithError(w, http.StatusNotFound, "Team member not found")
-		} else {
-			utils.RespondWithError(w, http.StatusInternalServerError, "Failed to delete team member")
-		}
+	if err := c.service.RemoveTeamMember(r.Context(), workspaceID, uid, id); err != nil {
+		c.respondWithTeamMemberError(w, err)
 		return
 	}
 
@@ -209,16 +168,17 @@ func (c *teamMemberController) DeleteTeamMember(w http.ResponseWriter, r *http.R
 // @Tags TeamMembers
 // @Accept json
 // @Produce json
-// @Param workspaceID path string true "workspaceID"
+// @Param workspaceID path string true "Workspace ID"
 // @Param page query int false "Page number (default: 1)"
 // @Param page_size query int false "Number of items per page (default: 10)"
-// @Success 200 {array} models.TeamMember
+// @Success 200 {array} github_com_ifeanyidike_cenphi_internal_models.TeamMember
 // @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
 // @Failure 500 {object} utils.ErrorResponse
-// @Router /team-members [get]
+// @Router /workspaces/{workspaceID}/members [get]
 func (c *teamMemberController) GetTeamMembers(w http.ResponseWriter, r *http.Request) {
 
-	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspace_id"))
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
 	if err != nil || workspaceID == uuid.Nil {
 		c.logger.Error("invalid team member ID", zap.String("workspace  ID", workspaceID.String()), zap.Error(err))
 		utils.RespondWithError(w, http.StatusBadRequest, "Invalid or missing ID")
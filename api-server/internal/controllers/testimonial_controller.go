@@ -2,10 +2,12 @@ package controllers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
 	"github.com/ifeanyidike/cenphi/internal/models"
 	"github.com/ifeanyidike/cenphi/internal/services"
 	"github.com/ifeanyidike/cenphi/internal/utils"
@@ -21,11 +23,11 @@ type TestimonialController interface {
 
 type testimonialController struct {
 	svc         services.TestimonialService
-	providerSvc services.ProviderService
+	providerSvc *services.ProviderService
 	logger      *zap.Logger
 }
 
-func NewTestimonialController(svc services.TestimonialService, providerSvc services.ProviderService, logger *zap.Logger) TestimonialController {
+func NewTestimonialController(svc services.TestimonialService, providerSvc *services.ProviderService, logger *zap.Logger) TestimonialController {
 	return &testimonialController{
 		svc:         svc,
 		providerSvc: providerSvc,
@@ -33,21 +35,56 @@ func NewTestimonialController(svc services.TestimonialService, providerSvc servi
 	}
 }
 
+// HandleAPISubmission accepts a testimonial through the public API.
+// @Summary Submit Testimonial
+// @Description Submit a testimonial on behalf of a workspace. Authenticate with a workspace API key in the X-API-Key header. Send an Idempotency-Key header to make retries safe; a retried request returns the original response. Testimonials are always stored pending review.
+// @Tags Public API
+// @Accept json
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param X-API-Key header string true "Workspace API key"
+// @Param Idempotency-Key header string false "Unique key for safely retrying the request"
+// @Param testimonial body models.TestimonialSubmission true "Testimonial"
+// @Success 201 {object} models.Testimonial
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 422 {object} utils.ErrorResponse
+// @Failure 429 {object} utils.ErrorResponse
+// @Router /public/workspaces/{workspaceID}/testimonials [post]
 func (c *testimonialController) HandleAPISubmission(w http.ResponseWriter, r *http.Request) {
-	var testimonial models.Testimonial
-
-	if err := json.NewDecoder(r.Body).Decode(&testimonial); err != nil {
-		c.logger.Error("invalid request payload", zap.Error(err))
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil || workspaceID == uuid.Nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return
 	}
 
-	if err := c.svc.ProcessTestimonials(r.Context(), []models.Testimonial{testimonial}); err != nil {
-		c.logger.Error("failed to process testimonials", zap.Error(err))
-		utils.RespondWithError(w, http.StatusInternalServerError, "Failed to process testimonials")
+	var submission models.TestimonialSubmission
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&submission); err != nil {
+		c.logger.Warn("invalid request payload", zap.Error(err))
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid request payload: "+err.Error())
 		return
 	}
 
-	utils.RespondWithJSON(w, http.StatusOK, "Testimonial is processed successfully")
+	testimonial, err := c.svc.Submit(r.Context(), workspaceID, models.CollectionMethodAPI, submission)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrValidationFailed) {
+			utils.RespondWithError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		if errors.Is(err, apperrors.ErrQuotaExceeded) {
+			utils.RespondWithError(w, http.StatusPaymentRequired, err.Error())
+			return
+		}
+		c.logger.Error("failed to store submitted testimonial", zap.String("workspace ID", workspaceID.String()), zap.Error(err))
+		utils.RespondWithError(w, http.StatusInternalServerError, "Failed to store testimonial")
+		return
+	}
 
+	utils.RespondWithJSON(w, http.StatusCreated, testimonial)
 }
 
 func (c *testimonialController) TriggerSync(w http.ResponseWriter, r *http.Request) {
@@ -105,6 +142,10 @@ func (c *testimonialController) FetchFromProvider(w http.ResponseWriter, r *http
 
 	// Call a new service method to fetch with custom credentials
 	testimonials, err := c.providerSvc.FetchWithCredentials(r.Context(), providerName, userId, workspaceID, credentials)
+	if errors.Is(err, apperrors.ErrQuotaExceeded) {
+		utils.RespondWithError(w, http.StatusPaymentRequired, err.Error())
+		return
+	}
 	if err != nil {
 		c.logger.Error("failed to fetch testimonials", zap.Error(err))
 		utils.RespondWithError(w, http.StatusInternalServerError, "Failed to fetch testimonials")
@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/services"
+	"github.com/ifeanyidike/cenphi/internal/utils"
+	"go.uber.org/zap"
+)
+
+type UsageController interface {
+	GetUsage(w http.ResponseWriter, r *http.Request)
+}
+
+type usageController struct {
+	service services.EntitlementService
+	logger  *zap.Logger
+}
+
+func NewUsageController(service services.EntitlementService, logger *zap.Logger) UsageController {
+	return &usageController{service: service, logger: logger}
+}
+
+// GetUsage reports a workspace's usage against its plan.
+// @Summary Get Workspace Usage
+// @Description Report how much of each plan quota the workspace has used, with its limit and what remains. Monthly quotas count usage in the current calendar month (UTC). Limit and remaining are omitted for quotas the plan does not cap.
+// @Tags Workspaces
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Success 200 {object} github_com_ifeanyidike_cenphi_internal_models.WorkspaceUsage
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/usage [get]
+func (c *usageController) GetUsage(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	usage, err := c.service.Usage(r.Context(), workspaceID, uid)
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrWorkspaceAccessDenied):
+			utils.RespondWithError(w, http.StatusForbidden, err.Error())
+		case errors.Is(err, apperrors.ErrWorkspaceNotFound):
+			utils.RespondWithError(w, http.StatusNotFound, err.Error())
+		default:
+			c.logger.Error("failed to get workspace usage", zap.Error(err))
+			utils.RespondWithError(w, http.StatusInternalServerError, apperrors.ErrInternalServerError.Error())
+		}
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, usage)
+}
@@ -0,0 +1,327 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/services"
+	"github.com/ifeanyidike/cenphi/internal/utils"
+	"go.uber.org/zap"
+)
+
+type WebhookController interface {
+	CreateEndpoint(w http.ResponseWriter, r *http.Request)
+	ListEndpoints(w http.ResponseWriter, r *http.Request)
+	GetEndpoint(w http.ResponseWriter, r *http.Request)
+	UpdateEndpoint(w http.ResponseWriter, r *http.Request)
+	DeleteEndpoint(w http.ResponseWriter, r *http.Request)
+	RotateSecret(w http.ResponseWriter, r *http.Request)
+	ListDeliveries(w http.ResponseWriter, r *http.Request)
+	Redeliver(w http.ResponseWriter, r *http.Request)
+}
+
+type webhookController struct {
+	service services.WebhookService
+	logger  *zap.Logger
+}
+
+func NewWebhookController(service services.WebhookService, logger *zap.Logger) WebhookController {
+	return &webhookController{service: service, logger: logger}
+}
+
+// respondWithWebhookError maps webhook service errors to HTTP responses.
+func (c *webhookController) respondWithWebhookError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, apperrors.ErrWorkspaceAccessDenied):
+		utils.RespondWithError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, apperrors.ErrNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, "Webhook not found")
+	case errors.Is(err, apperrors.ErrValidationFailed):
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, apperrors.ErrServiceUnavailable):
+		utils.RespondWithError(w, http.StatusServiceUnavailable, "Webhooks are not available")
+	default:
+		c.logger.Error("webhook operation failed", zap.Error(err))
+		utils.RespondWithError(w, http.StatusInternalServerError, apperrors.ErrInternalServerError.Error())
+	}
+}
+
+// webhookIDs parses the workspace ID and, when param is set, the ID named by it from
+// the URL. It responds with an error and returns false when either is malformed.
+func webhookIDs(w http.ResponseWriter, r *http.Request, param string) (uuid.UUID, uuid.UUID, bool) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+	if param == "" {
+		return workspaceID, uuid.Nil, true
+	}
+	id, err := uuid.Parse(chi.URLParam(r, param))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidID.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+	return workspaceID, id, true
+}
+
+// CreateEndpoint registers a webhook endpoint for a workspace.
+// @Summary Create Webhook Endpoint
+// @Description Register an HTTPS URL to receive events. The signing secret is only returned in this response. Requires the owner or admin role.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param endpoint body models.WebhookEndpointRequest true "URL and subscribed events"
+// @Success 201 {object} models.WebhookEndpoint
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/webhooks [post]
+func (c *webhookController) CreateEndpoint(w http.ResponseWriter, r *http.Request) {
+	workspaceID, _, ok := webhookIDs(w, r, "")
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	var req models.WebhookEndpointRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	endpoint, err := c.service.CreateEndpoint(r.Context(), workspaceID, uid, req)
+	if err != nil {
+		c.respondWithWebhookError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusCreated, endpoint)
+}
+
+// ListEndpoints lists the webhook endpoints of a workspace.
+// @Summary List Webhook Endpoints
+// @Description List the webhook endpoints of a workspace. Secrets are never returned. Requires the owner or admin role.
+// @Tags Webhooks
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Success 200 {array} models.WebhookEndpoint
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/webhooks [get]
+func (c *webhookController) ListEndpoints(w http.ResponseWriter, r *http.Request) {
+	workspaceID, _, ok := webhookIDs(w, r, "")
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	endpoints, err := c.service.ListEndpoints(r.Context(), workspaceID, uid)
+	if err != nil {
+		c.respondWithWebhookError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, endpoints)
+}
+
+// GetEndpoint returns a webhook endpoint.
+// @Summary Get Webhook Endpoint
+// @Description Get a webhook endpoint of a workspace. Requires the owner or admin role.
+// @Tags Webhooks
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param endpointID path string true "Webhook endpoint ID"
+// @Success 200 {object} models.WebhookEndpoint
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/webhooks/{endpointID} [get]
+func (c *webhookController) GetEndpoint(w http.ResponseWriter, r *http.Request) {
+	workspaceID, endpointID, ok := webhookIDs(w, r, "endpointID")
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	endpoint, err := c.service.GetEndpoint(r.Context(), workspaceID, endpointID, uid)
+	if err != nil {
+		c.respondWithWebhookError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, endpoint)
+}
+
+// UpdateEndpoint changes a webhook endpoint's URL, events or active flag.
+// @Summary Update Webhook Endpoint
+// @Description Replace the URL, description and subscribed events of a webhook endpoint, and optionally pause or resume it. Requires the owner or admin role.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param endpointID path string true "Webhook endpoint ID"
+// @Param endpoint body models.WebhookEndpointRequest true "URL and subscribed events"
+// @Success 200 {object} models.WebhookEndpoint
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/webhooks/{endpointID} [put]
+func (c *webhookController) UpdateEndpoint(w http.ResponseWriter, r *http.Request) {
+	workspaceID, endpointID, ok := webhookIDs(w, r, "endpointID")
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	var req models.WebhookEndpointRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	endpoint, err := c.service.UpdateEndpoint(r.Context(), workspaceID, endpointID, uid, req)
+	if err != nil {
+		c.respondWithWebhookError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, endpoint)
+}
+
+// DeleteEndpoint removes a webhook endpoint and its delivery log.
+// @Summary Delete Webhook Endpoint
+// @Description Delete a webhook endpoint. Queued deliveries to it are dropped. Requires the owner or admin role.
+// @Tags Webhooks
+// @Param workspaceID path string true "Workspace ID"
+// @Param endpointID path string true "Webhook endpoint ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/webhooks/{endpointID} [delete]
+func (c *webhookController) DeleteEndpoint(w http.ResponseWriter, r *http.Request) {
+	workspaceID, endpointID, ok := webhookIDs(w, r, "endpointID")
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	if err := c.service.DeleteEndpoint(r.Context(), workspaceID, endpointID, uid); err != nil {
+		c.respondWithWebhookError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RotateSecret replaces a webhook endpoint's signing secret.
+// @Summary Rotate Webhook Secret
+// @Description Replace the signing secret of a webhook endpoint. The new secret is only returned in this response. Requires the owner or admin role.
+// @Tags Webhooks
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param endpointID path string true "Webhook endpoint ID"
+// @Success 200 {object} models.WebhookEndpoint
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/webhooks/{endpointID}/rotate-secret [post]
+func (c *webhookController) RotateSecret(w http.ResponseWriter, r *http.Request) {
+	workspaceID, endpointID, ok := webhookIDs(w, r, "endpointID")
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	endpoint, err := c.service.RotateSecret(r.Context(), workspaceID, endpointID, uid)
+	if err != nil {
+		c.respondWithWebhookError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, endpoint)
+}
+
+// ListDeliveries returns the webhook delivery log of a workspace.
+// @Summary List Webhook Deliveries
+// @Description List webhook deliveries, newest first. Filter by status=dead for deliveries that ran out of attempts. Requires the owner or admin role.
+// @Tags Webhooks
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param endpoint_id query string false "Only deliveries to this endpoint"
+// @Param status query string false "pending, succeeded or dead"
+// @Param limit query int false "Maximum number of deliveries (default 50, max 200)"
+// @Success 200 {array} models.WebhookDelivery
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/webhooks/deliveries [get]
+func (c *webhookController) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	workspaceID, _, ok := webhookIDs(w, r, "")
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	query := r.URL.Query()
+	filter := models.WebhookDeliveryFilter{Status: models.WebhookDeliveryStatus(query.Get("status"))}
+	if value := query.Get("endpoint_id"); value != "" {
+		endpointID, err := uuid.Parse(value)
+		if err != nil {
+			utils.RespondWithError(w, http.StatusBadRequest, "Invalid endpoint_id")
+			return
+		}
+		filter.EndpointID = &endpointID
+	}
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 {
+			utils.RespondWithError(w, http.StatusBadRequest, "Invalid limit")
+			return
+		}
+		filter.Limit = limit
+	}
+
+	deliveries, err := c.service.ListDeliveries(r.Context(), workspaceID, uid, filter)
+	if err != nil {
+		c.respondWithWebhookError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, deliveries)
+}
+
+// Redeliver sends a webhook delivery again.
+// @Summary Redeliver Webhook
+// @Description Queue a copy of a delivery, with the same event ID and payload, to be sent straight away. Requires the owner or admin role.
+// @Tags Webhooks
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param deliveryID path string true "Webhook delivery ID"
+// @Success 202 {object} models.WebhookDelivery
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/webhooks/deliveries/{deliveryID}/redeliver [post]
+func (c *webhookController) Redeliver(w http.ResponseWriter, r *http.Request) {
+	workspaceID, deliveryID, ok := webhookIDs(w, r, "deliveryID")
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	delivery, err := c.service.Redeliver(r.Context(), workspaceID, deliveryID, uid)
+	if err != nil {
+		c.respondWithWebhookError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusAccepted, delivery)
+}
@@ -0,0 +1,325 @@
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/services"
+	"github.com/ifeanyidike/cenphi/internal/utils"
+	"go.uber.org/zap"
+)
+
+// embedCacheControl lets browsers and CDNs reuse an embed briefly, then revalidate it
+// with its ETag.
+const embedCacheControl = "public, max-age=60"
+
+type WidgetController interface {
+	CreateWidget(w http.ResponseWriter, r *http.Request)
+	ListWidgets(w http.ResponseWriter, r *http.Request)
+	GetWidget(w http.ResponseWriter, r *http.Request)
+	UpdateWidget(w http.ResponseWriter, r *http.Request)
+	DeleteWidget(w http.ResponseWriter, r *http.Request)
+	GetEmbed(w http.ResponseWriter, r *http.Request)
+}
+
+type widgetController struct {
+	service services.WidgetService
+	logger  *zap.Logger
+}
+
+func NewWidgetController(service services.WidgetService, logger *zap.Logger) WidgetController {
+	return &widgetController{service: service, logger: logger}
+}
+
+// respondWithWidgetError maps widget service errors to HTTP responses.
+func (c *widgetController) respondWithWidgetError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, apperrors.ErrWorkspaceAccessDenied):
+		utils.RespondWithError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, apperrors.ErrNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, "Widget not found")
+	case errors.Is(err, apperrors.ErrValidationFailed):
+		utils.RespondWithError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		c.logger.Error("widget operation failed", zap.Error(err))
+		utils.RespondWithError(w, http.StatusInternalServerError, apperrors.ErrInternalServerError.Error())
+	}
+}
+
+// CreateWidget creates an embeddable widget.
+// @Summary Create Widget
+// @Description Create an embeddable widget showing the workspace's published testimonials. Requires the owner, admin or editor role.
+// @Tags Widgets
+// @Accept json
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param widget body models.DisplayWidgetRequest true "Widget"
+// @Success 201 {object} models.DisplayWidget
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 422 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/widgets [post]
+func (c *widgetController) CreateWidget(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	var req models.DisplayWidgetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	widget, err := c.service.Create(r.Context(), workspaceID, uid, req)
+	if err != nil {
+		c.respondWithWidgetError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusCreated, widget)
+}
+
+// ListWidgets lists the embeddable widgets of a workspace.
+// @Summary List Widgets
+// @Description List the embeddable widgets of a workspace. Requires the owner, admin or editor role.
+// @Tags Widgets
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Success 200 {array} models.DisplayWidget
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/widgets [get]
+func (c *widgetController) ListWidgets(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	widgets, err := c.service.List(r.Context(), workspaceID, uid)
+	if err != nil {
+		c.respondWithWidgetError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, widgets)
+}
+
+// GetWidget returns an embeddable widget.
+// @Summary Get Widget
+// @Description Get an embeddable widget of a workspace. Requires the owner, admin or editor role.
+// @Tags Widgets
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param widgetID path string true "Widget ID"
+// @Success 200 {object} models.DisplayWidget
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/widgets/{widgetID} [get]
+func (c *widgetController) GetWidget(w http.ResponseWriter, r *http.Request) {
+	workspaceID, widgetID, ok := parseWidgetParams(w, r)
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	widget, err := c.service.Get(r.Context(), workspaceID, widgetID, uid)
+	if err != nil {
+		c.respondWithWidgetError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, widget)
+}
+
+// UpdateWidget replaces an embeddable widget's settings.
+// @Summary Update Widget
+// @Description Update an embeddable widget. An empty layout keeps the current one. Requires the owner, admin or editor role.
+// @Tags Widgets
+// @Accept json
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param widgetID path string true "Widget ID"
+// @Param widget body models.DisplayWidgetRequest true "Widget"
+// @Success 200 {object} models.DisplayWidget
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 422 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/widgets/{widgetID} [put]
+func (c *widgetController) UpdateWidget(w http.ResponseWriter, r *http.Request) {
+	workspaceID, widgetID, ok := parseWidgetParams(w, r)
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	var req models.DisplayWidgetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	widget, err := c.service.Update(r.Context(), workspaceID, widgetID, uid, req)
+	if err != nil {
+		c.respondWithWidgetError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, widget)
+}
+
+// DeleteWidget deletes an embeddable widget.
+// @Summary Delete Widget
+// @Description Delete an embeddable widget. Sites embedding it get a 404. Requires the owner, admin or editor role.
+// @Tags Widgets
+// @Param workspaceID path string true "Workspace ID"
+// @Param widgetID path string true "Widget ID"
+// @Success 204
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/widgets/{widgetID} [delete]
+func (c *widgetController) DeleteWidget(w http.ResponseWriter, r *http.Request) {
+	workspaceID, widgetID, ok := parseWidgetParams(w, r)
+	if !ok {
+		return
+	}
+	uid, _ := middleware.UserIDFromContext(r.Context())
+
+	if err := c.service.Delete(r.Context(), workspaceID, widgetID, uid); err != nil {
+		c.respondWithWidgetError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetEmbed serves a widget to the sites it is embedded on.
+// @Summary Embed Widget
+// @Description Public. Returns the widget's published testimonials and brand styling as JSON, or as an HTML fragment with format=html or an Accept header preferring text/html. Cross-origin requests are only allowed from the workspace's website and custom domain. Responses carry an ETag; send it back in If-None-Match to get a 304 when nothing changed.
+// @Tags Widgets
+// @Produce json
+// @Produce html
+// @Param widgetID path string true "Widget ID"
+// @Param format query string false "json or html"
+// @Success 200 {object} models.WidgetEmbed
+// @Success 304
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /embed/{widgetID} [get]
+func (c *widgetController) GetEmbed(w http.ResponseWriter, r *http.Request) {
+	widgetID, err := uuid.Parse(chi.URLParam(r, "widgetID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidID.Error())
+		return
+	}
+
+	embed, err := c.service.Embed(r.Context(), widgetID)
+	if err != nil {
+		c.respondWithWidgetError(w, err)
+		return
+	}
+
+	var body []byte
+	contentType := "application/json"
+	if embedWantsHTML(r) {
+		contentType = "text/html; charset=utf-8"
+		body, err = services.RenderWidgetHTML(embed)
+	} else {
+		body, err = json.Marshal(embed)
+	}
+	if err != nil {
+		c.respondWithWidgetError(w, err)
+		return
+	}
+
+	// The router's CORS handler allows every origin, so it is narrowed here to the
+	// workspace's own sites. The response depends on who asks and for what, so caches
+	// must keep them apart.
+	header := w.Header()
+	header.Set("Vary", "Origin, Accept")
+	if origin := r.Header.Get("Origin"); origin != "" && embed.AllowsOrigin(origin) {
+		header.Set("Access-Control-Allow-Origin", origin)
+		header.Set("Access-Control-Expose-Headers", "ETag")
+	} else {
+		header.Del("Access-Control-Allow-Origin")
+		header.Del("Access-Control-Expose-Headers")
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	header.Set("ETag", etag)
+	header.Set("Cache-Control", embedCacheControl)
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	header.Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body); err != nil {
+		c.logger.Debug("failed to write widget embed", zap.Error(err))
+	}
+}
+
+func parseWidgetParams(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+	widgetID, err := uuid.Parse(chi.URLParam(r, "widgetID"))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidID.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+	return workspaceID, widgetID, true
+}
+
+// embedWantsHTML reports whether the request asks for the HTML rendering. The format
+// query parameter wins over the Accept header, and JSON is the default.
+func embedWantsHTML(r *http.Request) bool {
+	switch strings.ToLower(r.URL.Query().Get("format")) {
+	case "html":
+		return true
+	case "json":
+		return false
+	}
+	accept := r.Header.Get("Accept")
+	htmlAt := strings.Index(accept, "text/html")
+	if htmlAt < 0 {
+		return false
+	}
+	jsonAt := strings.Index(accept, "application/json")
+	return jsonAt < 0 || htmlAt < jsonAt
+}
+
+// etagMatches reports whether an If-None-Match header names etag. Weak validators
+// match too, as the comparison for If-None-Match is weak.
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
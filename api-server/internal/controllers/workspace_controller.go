@@ -20,6 +20,8 @@ import (
 type WorkspaceController interface {
 	GetWorkspace(w http.ResponseWriter, r *http.Request)
 	GetTestimonialsByWorkspaceID(w http.ResponseWriter, r *http.Request)
+	SearchTestimonials(w http.ResponseWriter, r *http.Request)
+	SimilarTestimonials(w http.ResponseWriter, r *http.Request)
 	GetTestimonial(w http.ResponseWriter, r *http.Request)
 	CreateWorkspace(w http.ResponseWriter, r *http.Request)
 	UpdateWorkspace(w http.ResponseWriter, r *http.Request)
@@ -30,10 +32,16 @@ type workspaceController struct {
 	logger         *zap.Logger
 	service        services.WorkspaceService
 	testimonialSvc services.TestimonialService
+	semanticSvc    services.SemanticSearchService
 }
 
-func NewWorkspaceController(service services.WorkspaceService, testimonialSvc services.TestimonialService, logger *zap.Logger) WorkspaceController {
-	return &workspaceController{logger: logger, service: service, testimonialSvc: testimonialSvc}
+func NewWorkspaceController(
+	service services.WorkspaceService,
+	testimonialSvc services.TestimonialService,
+	semanticSvc services.SemanticSearchService,
+	logger *zap.Logger,
+) WorkspaceController {
+	return &workspaceController{logger: logger, service: service, testimonialSvc: testimonialSvc, semanticSvc: semanticSvc}
 }
 
 // GetWorkspace retrieves a workspace by ID.
@@ -49,7 +57,7 @@ func NewWorkspaceController(service services.WorkspaceService, testimonialSvc se
 // @Router /workspaces [get]
 func (c *workspaceController) GetWorkspace(w http.ResponseWriter, r *http.Request) {
 	// id, err := uuid.Parse(r.URL.Query().Get("id"))
-	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	id, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
 	if err != nil {
 		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
 		return
@@ -121,7 +129,7 @@ func (c *workspaceController) CreateWorkspace(w http.ResponseWriter, r *http.Req
 // @Router /workspaces/{id} [put]
 
 func (c *workspaceController) UpdateWorkspace(w http.ResponseWriter, r *http.Request) {
-	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	id, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
 	if err != nil {
 		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
 		return
@@ -176,7 +184,7 @@ func (c *workspaceController) UpdateWorkspace(w http.ResponseWriter, r *http.Req
 // @Failure 404 {object} map[string]interface{} "Not Found"
 // @Router /workspaces/{id} [delete]
 func (c *workspaceController) DeleteWorkspace(w http.ResponseWriter, r *http.Request) {
-	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	id, err := uuid.Parse(chi.URLParam(r, "workspaceID"))
 	if err != nil {
 		utils.RespondWithError(w, http.StatusBadRequest, apperrors.ErrInvalidWorkspaceID.Error())
 		return
@@ -197,6 +205,20 @@ func (c *workspaceController) DeleteWorkspace(w http.ResponseWriter, r *http.Req
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// GetTestimonialsByWorkspaceID lists a page of the workspace's testimonials.
+// @Summary List Workspace Testimonials
+// @Description Returns one page of the workspace's testimonials with the total that match the filters. Pass next_cursor back as cursor, with the same sort and filters, to fetch the following page. Use fields to return only some fields, for example to leave out transcripts and source data.
+// @Tags Workspaces
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param sort query string false "created_at, rating, view_count or authenticity_score, prefixed with - for descending order (default -created_at)"
+// @Param limit query int false "Page size, 1 to 100 (default 20)"
+// @Param cursor query string false "next_cursor from the previous page"
+// @Param fields query string false "Comma separated fields to return; id is always included"
+// @Success 200 {object} models.TestimonialPage
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/testimonials [get]
 func (c *workspaceController) GetTestimonialsByWorkspaceID(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "workspaceID")
 	id, err := uuid.Parse(idStr)
@@ -206,17 +228,105 @@ func (c *workspaceController) GetTestimonialsByWorkspaceID(w http.ResponseWriter
 		return
 	}
 
-	queryParams := r.URL.Query()
-	filters := models.GetFilterFromParam(queryParams)
+	page, err := models.GetPageFromParam(r.URL.Query())
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
-	testimonials, err := c.testimonialSvc.FetchByWorkspaceID(r.Context(), id, filters)
+	result, err := c.testimonialSvc.ListPage(r.Context(), id, page)
 	if err != nil {
-		c.logger.Error("failed to get testimonial", zap.String("testimonial ID", id.String()), zap.Error(err))
-		utils.RespondWithError(w, http.StatusNotFound, err.Error())
+		c.logger.Error("failed to list testimonials", zap.String("workspace ID", id.String()), zap.Error(err))
+		utils.RespondWithError(w, http.StatusInternalServerError, "Failed to list testimonials")
 		return
 	}
 
-	utils.RespondWithJSON(w, http.StatusOK, testimonials)
+	utils.RespondWithJSON(w, http.StatusOK, result)
+}
+
+// SearchTestimonials ranks the workspace's testimonials against a search query.
+// @Summary Search Workspace Testimonials
+// @Description Searches the title, summary, content and transcript of testimonials, stemmed in each testimonial's language, and the customer's name and company. Quoted phrases match words in order, -word excludes testimonials containing the word and or combines alternatives. Misspelled words still match through trigram similarity. Results are ordered by relevance and include HTML snippets with the matched words wrapped in <mark> tags.
+// @Tags Workspaces
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param q query string true "Search query"
+// @Param limit query int false "Number of results, 1 to 50 (default 20)"
+// @Param offset query int false "Number of results to skip"
+// @Success 200 {array} models.TestimonialSearchHit
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/testimonials/search [get]
+func (c *workspaceController) SearchTestimonials(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "workspaceID")
+	id, err := uuid.Parse(idStr)
+	if err != nil || id == uuid.Nil {
+		c.logger.Error("invalid workspace ID", zap.String("workspace ID", idStr), zap.Error(err))
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid or missing ID")
+		return
+	}
+
+	req, err := models.GetSearchFromParam(r.URL.Query())
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	hits, err := c.testimonialSvc.Search(r.Context(), id, req)
+	if err != nil {
+		c.logger.Error("failed to search testimonials", zap.String("workspace ID", id.String()), zap.Error(err))
+		utils.RespondWithError(w, http.StatusInternalServerError, "Failed to search testimonials")
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, hits)
+}
+
+// SimilarTestimonials finds the workspace's testimonials closest in meaning to another
+// testimonial or to a description.
+// @Summary Find Similar Testimonials
+// @Description Compares testimonial embeddings to find testimonials that say similar things, even in different words. Pass to with a testimonial ID to find testimonials like it, or q with a free text description. Testimonials are embedded shortly after they are created or changed. Similarity is the cosine similarity of the embeddings, up to 1.
+// @Tags Workspaces
+// @Produce json
+// @Param workspaceID path string true "Workspace ID"
+// @Param to query string false "Testimonial ID to compare against"
+// @Param q query string false "Free text to compare against"
+// @Param limit query int false "Number of results, 1 to 50 (default 10)"
+// @Success 200 {array} models.SimilarTestimonial
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 503 {object} utils.ErrorResponse
+// @Router /workspaces/{workspaceID}/testimonials/similar [get]
+func (c *workspaceController) SimilarTestimonials(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "workspaceID")
+	id, err := uuid.Parse(idStr)
+	if err != nil || id == uuid.Nil {
+		c.logger.Error("invalid workspace ID", zap.String("workspace ID", idStr), zap.Error(err))
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid or missing ID")
+		return
+	}
+
+	req, err := models.GetSimilarFromParam(r.URL.Query())
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	results, err := c.semanticSvc.Similar(r.Context(), id, req)
+	switch {
+	case err == nil:
+		utils.RespondWithJSON(w, http.StatusOK, results)
+	case errors.Is(err, apperrors.ErrNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, "Testimonial not found")
+	case errors.Is(err, apperrors.ErrValidationFailed):
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, apperrors.ErrServiceUnavailable):
+		c.logger.Warn("embedding unavailable", zap.String("workspace ID", id.String()), zap.Error(err))
+		utils.RespondWithError(w, http.StatusServiceUnavailable, "Similarity search is temporarily unavailable")
+	default:
+		c.logger.Error("failed to find similar testimonials", zap.String("workspace ID", id.String()), zap.Error(err))
+		utils.RespondWithError(w, http.StatusInternalServerError, "Failed to find similar testimonials")
+	}
 }
 
 func (c *workspaceController) GetTestimonial(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/utils"
+	"github.com/ifeanyidike/cenphi/pkg/ratelimit"
+	"go.uber.org/zap"
+)
+
+const apiKeyContextKey contextKey = "api_key"
+
+// APIKeyHeader carries the key on public API requests. A bearer Authorization header
+// is accepted as well.
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyAuthenticator resolves a plaintext key to its record.
+type APIKeyAuthenticator interface {
+	Authenticate(ctx context.Context, rawKey string) (*models.APIKey, error)
+}
+
+type APIKeyMiddleware struct {
+	authenticator APIKeyAuthenticator
+	limiter       *ratelimit.RedisLimiter
+	logger        *zap.Logger
+}
+
+func NewAPIKeyMiddleware(authenticator APIKeyAuthenticator, limiter *ratelimit.RedisLimiter, logger *zap.Logger) *APIKeyMiddleware {
+	return &APIKeyMiddleware{
+		authenticator: authenticator,
+		limiter:       limiter,
+		logger:        logger,
+	}
+}
+
+// APIKeyFromContext returns the key that authenticated the request.
+func APIKeyFromContext(ctx context.Context) (*models.APIKey, bool) {
+	key, ok := ctx.Value(apiKeyContextKey).(*models.APIKey)
+	return key, ok
+}
+
+// RequireAPIKey authenticates the request with a workspace API key, checks that the key
+// belongs to the workspaceID in the URL and applies the key's rate limit.
+func (m *APIKeyMiddleware) RequireAPIKey(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rawKey := r.Header.Get(APIKeyHeader)
+		if rawKey == "" {
+			rawKey = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		}
+		if rawKey == "" {
+			utils.RespondWithError(w, http.StatusUnauthorized, "API key is required")
+			return
+		}
+
+		key, err := m.authenticator.Authenticate(r.Context(), rawKey)
+		if err != nil {
+			if errors.Is(err, apperrors.ErrAPIKeyInvalid) || errors.Is(err, apperrors.ErrAPIKeyRevoked) {
+				utils.RespondWithError(w, http.StatusUnauthorized, err.Error())
+				return
+			}
+			m.logger.Error("failed to authenticate api key", zap.Error(err))
+			utils.RespondWithError(w, http.StatusInternalServerError, "Failed to authenticate API key")
+			return
+		}
+
+		if workspaceID := chi.URLParam(r, "workspaceID"); workspaceID != key.WorkspaceID.String() {
+			utils.RespondWithError(w, http.StatusForbidden, apperrors.ErrWorkspaceAccessDenied.Error())
+			return
+		}
+
+		allowed, err := m.limiter.Allow(r.Context(), "ratelimit:api_key:"+key.ID.String(), key.RateLimitPerMinute, time.Minute)
+		if err != nil {
+			m.logger.Error("failed to apply api key rate limit", zap.String("key", key.ID.String()), zap.Error(err))
+		}
+		if err != nil || !allowed {
+			w.Header().Set("Retry-After", strconv.Itoa(int(time.Minute.Seconds())))
+			utils.RespondWithError(w, http.StatusTooManyRequests, apperrors.ErrRateLimited.Error())
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), apiKeyContextKey, key)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -21,6 +21,12 @@ type contextKey string
 
 const userKey contextKey = "user"
 
+// UserIDFromContext returns the Firebase UID stored by VerifyToken.
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	uid, ok := ctx.Value(userKey).(string)
+	return uid, ok && uid != ""
+}
+
 type AuthMiddleware struct {
 	app     *firebase.App
 	client  *auth.Client
@@ -0,0 +1,123 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/ifeanyidike/cenphi/internal/utils"
+	"github.com/ifeanyidike/cenphi/pkg/idempotency"
+	"go.uber.org/zap"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	idempotencyReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLength   = 255
+	maxIdempotentBodySize     = 1 << 20 // 1MB
+)
+
+type IdempotencyMiddleware struct {
+	store  *idempotency.Store
+	logger *zap.Logger
+}
+
+func NewIdempotencyMiddleware(store *idempotency.Store, logger *zap.Logger) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{store: store, logger: logger}
+}
+
+// responseRecorder captures the status and body written by the wrapped handler.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// Idempotent replays the stored response when a request is retried with the same
+// Idempotency-Key. Keys are scoped to the API key that made the request. Requests
+// without the header pass through unchanged.
+func (m *IdempotencyMiddleware) Idempotent(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		idemKey := r.Header.Get(IdempotencyKeyHeader)
+		if idemKey == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if len(idemKey) > maxIdempotencyKeyLength {
+			utils.RespondWithError(w, http.StatusBadRequest, "Idempotency-Key is too long")
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxIdempotentBodySize+1))
+		if err != nil {
+			utils.RespondWithError(w, http.StatusBadRequest, "Failed to read request body")
+			return
+		}
+		if len(body) > maxIdempotentBodySize {
+			utils.RespondWithError(w, http.StatusRequestEntityTooLarge, "Request body is too large")
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		scope := r.URL.Path
+		if apiKey, ok := APIKeyFromContext(r.Context()); ok {
+			scope = apiKey.ID.String()
+		}
+		storeKey := scope + ":" + idemKey
+
+		sum := sha256.Sum256(append([]byte(r.Method+" "+r.URL.Path+"\n"), body...))
+		requestHash := hex.EncodeToString(sum[:])
+
+		stored, err := m.store.Begin(r.Context(), storeKey, requestHash)
+		switch {
+		case errors.Is(err, idempotency.ErrInProgress):
+			utils.RespondWithError(w, http.StatusConflict, err.Error())
+			return
+		case errors.Is(err, idempotency.ErrMismatch):
+			utils.RespondWithError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		case err != nil:
+			m.logger.Error("failed to check idempotency key", zap.Error(err))
+			utils.RespondWithError(w, http.StatusInternalServerError, "Failed to process request")
+			return
+		}
+
+		if stored != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set(idempotencyReplayedHeader, "true")
+			w.WriteHeader(stored.StatusCode)
+			w.Write(stored.Body)
+			return
+		}
+
+		rec := &responseRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+
+		// Server errors are not cached so that the client can retry with the same key
+		if rec.status == 0 || rec.status >= http.StatusInternalServerError {
+			if err := m.store.Release(r.Context(), storeKey); err != nil {
+				m.logger.Error("failed to release idempotency key", zap.Error(err))
+			}
+			return
+		}
+		if err := m.store.Complete(r.Context(), storeKey, requestHash, rec.status, rec.body.Bytes()); err != nil {
+			m.logger.Error("failed to store idempotent response", zap.Error(err))
+		}
+	})
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/utils"
+	"github.com/ifeanyidike/cenphi/pkg/ratelimit"
+	"go.uber.org/zap"
+)
+
+type RateLimitMiddleware struct {
+	limiter *ratelimit.RedisLimiter
+	logger  *zap.Logger
+}
+
+func NewRateLimitMiddleware(limiter *ratelimit.RedisLimiter, logger *zap.Logger) *RateLimitMiddleware {
+	return &RateLimitMiddleware{limiter: limiter, logger: logger}
+}
+
+// PerIP limits each client address to limit requests per window on every path the
+// middleware is mounted on. It relies on RealIPMiddleware having resolved the client address.
+// Unlike the API key limiter it fails open, so a Redis outage does not take down
+// public forms.
+func (m *RateLimitMiddleware) PerIP(name string, limit int, window time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := "ratelimit:" + name + ":" + ClientIP(r) + ":" + r.URL.Path
+			allowed, err := m.limiter.Allow(r.Context(), key, limit, window)
+			if err != nil {
+				m.logger.Error("failed to apply ip rate limit", zap.String("key", key), zap.Error(err))
+				next.ServeHTTP(w, r)
+				return
+			}
+			if !allowed {
+				w.Header().Set("Retry-After", strconv.Itoa(int(window.Seconds())))
+				utils.RespondWithError(w, http.StatusTooManyRequests, apperrors.ErrRateLimited.Error())
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ClientIP returns the address of the client that sent the request, without the port.
+// Behind trusted proxies it relies on RealIPMiddleware having resolved the client address.
+func ClientIP(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// RealIPMiddleware resolves the address of the client behind the load balancers in
+// front of the server. Forwarding headers are only believed when the request comes
+// from a trusted proxy; anyone else could set them to dodge per-IP rate limits.
+type RealIPMiddleware struct {
+	trusted []*net.IPNet
+}
+
+// NewRealIPMiddleware accepts proxies as single addresses or CIDR ranges.
+func NewRealIPMiddleware(proxies []string) (*RealIPMiddleware, error) {
+	m := &RealIPMiddleware{}
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", proxy)
+			}
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			}
+			m.trusted = append(m.trusted, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+		}
+		m.trusted = append(m.trusted, network)
+	}
+	return m, nil
+}
+
+// Resolve replaces RemoteAddr with the client address reported by trusted proxies.
+// X-Forwarded-For is read from the right, skipping trusted hops, because only the
+// entries appended by our own proxies can be relied on.
+func (m *RealIPMiddleware) Resolve(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if m.isTrusted(ClientIP(r)) {
+			if ip := m.forwardedFor(r); ip != "" {
+				r.RemoteAddr = ip
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (m *RealIPMiddleware) forwardedFor(r *http.Request) string {
+	if header := r.Header.Get("X-Forwarded-For"); header != "" {
+		hops := strings.Split(header, ",")
+		client := ""
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if net.ParseIP(hop) == nil {
+				break
+			}
+			client = hop
+			if !m.isTrusted(hop) {
+				break
+			}
+		}
+		return client
+	}
+	if ip := strings.TrimSpace(r.Header.Get("X-Real-IP")); net.ParseIP(ip) != nil {
+		return ip
+	}
+	return ""
+}
+
+func (m *RealIPMiddleware) isTrusted(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, network := range m.trusted {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRealIPMiddleware(t *testing.T) {
+	m, err := NewRealIPMiddleware([]string{"10.0.0.0/8", " 192.0.2.1"})
+	require.NoError(t, err)
+
+	resolve := func(remoteAddr string, headers map[string]string) string {
+		var got string
+		handler := m.Resolve(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = ClientIP(r)
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = remoteAddr
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		return got
+	}
+
+	t.Run("IgnoresHeadersFromUntrustedPeers", func(t *testing.T) {
+		assert.Equal(t, "203.0.113.9", resolve("203.0.113.9:4000", map[string]string{"X-Forwarded-For": "198.51.100.1"}))
+		assert.Equal(t, "203.0.113.9", resolve("203.0.113.9:4000", map[string]string{"X-Real-IP": "198.51.100.1"}))
+	})
+
+	t.Run("UsesRightmostUntrustedForwardedHop", func(t *testing.T) {
+		// The client prepended a spoofed address; our proxies appended the real one
+		assert.Equal(t, "203.0.113.9", resolve("10.1.2.3:4000", map[string]string{"X-Forwarded-For": "198.51.100.1, 203.0.113.9, 10.4.5.6"}))
+		assert.Equal(t, "203.0.113.9", resolve("192.0.2.1:4000", map[string]string{"X-Forwarded-For": "203.0.113.9"}))
+	})
+
+	t.Run("FallsBackToRealIPHeader", func(t *testing.T) {
+		assert.Equal(t, "203.0.113.9", resolve("10.1.2.3:4000", map[string]string{"X-Real-IP": "203.0.113.9"}))
+		assert.Equal(t, "10.1.2.3", resolve("10.1.2.3:4000", nil))
+	})
+
+	t.Run("RejectsInvalidProxies", func(t *testing.T) {
+		_, err := NewRealIPMiddleware([]string{"not-an-ip"})
+		assert.Error(t, err)
+		_, err = NewRealIPMiddleware([]string{"10.0.0.0/99"})
+		assert.Error(t, err)
+	})
+}
@@ -8,6 +8,18 @@ import (
 	"github.com/google/uuid"
 )
 
+// AI job statuses
+const (
+	AIJobStatusPending   = "pending"
+	AIJobStatusRunning   = "running"
+	AIJobStatusCompleted = "completed"
+	AIJobStatusFailed    = "failed"
+)
+
+// AIJobTaskVideoTranscription streams an uploaded video to the intelligence service
+// for its transcript and summary. The task is stored in the job's input parameters.
+const AIJobTaskVideoTranscription = "video_transcription"
+
 // AIJob represents the AI processing job with input and output data
 type AIJob struct {
 	ID            uuid.UUID         `json:"id" db:"id"`
@@ -19,6 +31,11 @@ type AIJob struct {
 	// Input parameters
 	InputParameters JSONMap `json:"input_parameters" db:"input_parameters"`
 
+	// Queue state. A failed attempt is retried at RunAt until MaxAttempts is reached.
+	Attempts    int       `json:"attempts" db:"attempts"`
+	MaxAttempts int       `json:"max_attempts" db:"max_attempts"`
+	RunAt       time.Time `json:"run_at" db:"run_at"`
+
 	// Processing metadata
 	StartedAt    *time.Time `json:"started_at,omitempty" db:"started_at"`
 	CompletedAt  *time.Time `json:"completed_at,omitempty" db:"completed_at"`
@@ -44,3 +44,29 @@ type TestimonialAnalysis struct {
 	CreatedAt       time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt       time.Time `json:"updated_at,omitempty" db:"updated_at"`
 }
+
+// Keys of Testimonial.SourceData read by the authenticity checks.
+const (
+	SourceDataSubmitterIP      = "submitter_ip"
+	SourceDataAccountCreatedAt = "account_created_at"
+)
+
+// AuthenticitySignals is what the authenticity checks know about a testimonial besides
+// the intelligence service's verdict.
+type AuthenticitySignals struct {
+	WorkspaceID       uuid.UUID
+	Status            ContentStatus
+	Text              string
+	CustomerProfileID *uuid.UUID
+	SourceData        JSONMap
+	// Threshold is the workspace's authenticity_threshold setting, if set.
+	Threshold *float64
+	// DuplicateCount is the number of testimonials with the same text from other
+	// customers of the workspace.
+	DuplicateCount int
+	// SubmissionsFromIP is the number of testimonials sent from the submitter's address
+	// around the same time, including this one.
+	SubmissionsFromIP int
+	// PreviousTestimonials are earlier texts by the same customer, newest first.
+	PreviousTestimonials []string
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultAPIKeyRateLimit is the number of requests per minute a key may make when no
+// explicit limit is set.
+const DefaultAPIKeyRateLimit = 60
+
+// APIKey authenticates calls to the public API on behalf of a single workspace. Only the
+// hash of the key is persisted.
+type APIKey struct {
+	ID                 uuid.UUID  `json:"id"`
+	WorkspaceID        uuid.UUID  `json:"workspace_id"`
+	Name               string     `json:"name"`
+	Prefix             string     `json:"prefix"`
+	KeyHash            string     `json:"-"`
+	RateLimitPerMinute int        `json:"rate_limit_per_minute"`
+	CreatedBy          string     `json:"created_by,omitempty"`
+	LastUsedAt         *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt          *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt          time.Time  `json:"created_at"`
+}
+
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// IssuedAPIKey is returned once, when a key is issued or rotated. Key holds the
+// plaintext secret, which cannot be recovered afterwards.
+type IssuedAPIKey struct {
+	APIKey
+	Key string `json:"key"`
+}
+
+// APIKeyRequest is the payload used to issue a key.
+type APIKeyRequest struct {
+	Name               string `json:"name"`
+	RateLimitPerMinute int    `json:"rate_limit_per_minute,omitempty"`
+}
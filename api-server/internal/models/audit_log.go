@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Audit event types
+const (
+	AuditEventTestimonialTransition = "testimonial_transition"
+)
+
+// Audit entity types
+const (
+	AuditEntityTestimonial = "testimonial"
+)
+
+// AuditLogEntry records a change to an entity. ActorID is the team member who made the
+// change, and is nil for changes made by the system.
+type AuditLogEntry struct {
+	ID          uuid.UUID  `json:"id" db:"id"`
+	WorkspaceID *uuid.UUID `json:"workspace_id,omitempty" db:"workspace_id"`
+	ActorID     *uuid.UUID `json:"actor_id,omitempty" db:"actor_id"`
+	EventType   string     `json:"event_type" db:"event_type"`
+	EntityType  string     `json:"entity_type" db:"entity_type"`
+	EntityID    uuid.UUID  `json:"entity_id" db:"entity_id"`
+	Details     JSONMap    `json:"details" db:"details"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,221 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CollectionPortal is a hosted form, reachable at /c/{slug}, through which customers
+// submit testimonials to a workspace.
+type CollectionPortal struct {
+	ID                   uuid.UUID          `json:"id"`
+	WorkspaceID          uuid.UUID          `json:"workspace_id"`
+	Name                 string             `json:"name"`
+	Slug                 string             `json:"slug"`
+	CollectionMethods    []CollectionMethod `json:"collection_methods"`
+	VerificationMethods  []VerificationType `json:"verification_methods"`
+	CustomFields         JSONMap            `json:"custom_fields"`
+	BrandingSettings     JSONMap            `json:"branding_settings"`
+	FormSettings         PortalFormSettings `json:"form_settings"`
+	NotificationSettings JSONMap            `json:"notification_settings"`
+	Active               bool               `json:"active"`
+	CreatedAt            time.Time          `json:"created_at"`
+	UpdatedAt            time.Time          `json:"updated_at"`
+}
+
+type PortalFieldType string
+
+const (
+	PortalFieldText     PortalFieldType = "text"
+	PortalFieldTextarea PortalFieldType = "textarea"
+	PortalFieldEmail    PortalFieldType = "email"
+	PortalFieldNumber   PortalFieldType = "number"
+	PortalFieldSelect   PortalFieldType = "select"
+	PortalFieldCheckbox PortalFieldType = "checkbox"
+)
+
+// PortalField is a custom question shown on a portal form. Answers are stored in the
+// testimonial's custom_fields under Key.
+type PortalField struct {
+	Key       string          `json:"key"`
+	Label     string          `json:"label"`
+	Type      PortalFieldType `json:"type"`
+	Required  bool            `json:"required"`
+	Options   []string        `json:"options,omitempty"`
+	MaxLength int             `json:"max_length,omitempty"`
+}
+
+// PortalFormSettings is the form definition persisted in collection_portals.form_settings.
+type PortalFormSettings struct {
+	Title          string          `json:"title,omitempty"`
+	Description    string          `json:"description,omitempty"`
+	SuccessMessage string          `json:"success_message,omitempty"`
+	AllowedFormats []ContentFormat `json:"allowed_formats,omitempty"`
+	RequireEmail   bool            `json:"require_email"`
+	Fields         []PortalField   `json:"fields"`
+}
+
+var portalFieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// Validate checks the form definition itself, before it is saved.
+func (s PortalFormSettings) Validate() error {
+	seen := make(map[string]bool, len(s.Fields))
+	for _, f := range s.Fields {
+		if !portalFieldKeyPattern.MatchString(f.Key) {
+			return fmt.Errorf("field key %q must be lowercase snake_case", f.Key)
+		}
+		if seen[f.Key] {
+			return fmt.Errorf("duplicate field key %q", f.Key)
+		}
+		seen[f.Key] = true
+
+		switch f.Type {
+		case PortalFieldText, PortalFieldTextarea, PortalFieldEmail, PortalFieldNumber, PortalFieldCheckbox:
+		case PortalFieldSelect:
+			if len(f.Options) == 0 {
+				return fmt.Errorf("select field %q requires options", f.Key)
+			}
+		default:
+			return fmt.Errorf("field %q has unknown type %q", f.Key, f.Type)
+		}
+	}
+	return nil
+}
+
+// AllowsFormat reports whether submissions in the given format are accepted. An empty
+// list accepts every format.
+func (s PortalFormSettings) AllowsFormat(format ContentFormat) bool {
+	if len(s.AllowedFormats) == 0 {
+		return true
+	}
+	for _, f := range s.AllowedFormats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateAnswers checks submitted custom field values against the form definition and
+// rejects keys the form does not declare.
+func (s PortalFormSettings) ValidateAnswers(values map[string]interface{}) error {
+	fields := make(map[string]PortalField, len(s.Fields))
+	for _, f := range s.Fields {
+		fields[f.Key] = f
+	}
+	for key := range values {
+		if _, ok := fields[key]; !ok {
+			return fmt.Errorf("unknown field %q", key)
+		}
+	}
+
+	for _, f := range s.Fields {
+		value, present := values[f.Key]
+		if !present || value == nil || value == "" {
+			if f.Required {
+				return fmt.Errorf("%s is required", f.Key)
+			}
+			continue
+		}
+		if err := f.validateValue(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f PortalField) validateValue(value interface{}) error {
+	switch f.Type {
+	case PortalFieldNumber:
+		if _, ok := value.(float64); !ok {
+			return fmt.Errorf("%s must be a number", f.Key)
+		}
+		return nil
+	case PortalFieldCheckbox:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s must be a boolean", f.Key)
+		}
+		return nil
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("%s must be a string", f.Key)
+	}
+	if f.MaxLength > 0 && len([]rune(str)) > f.MaxLength {
+		return fmt.Errorf("%s must be at most %d characters", f.Key, f.MaxLength)
+	}
+
+	switch f.Type {
+	case PortalFieldEmail:
+		if _, err := mail.ParseAddress(str); err != nil {
+			return fmt.Errorf("%s must be a valid email address", f.Key)
+		}
+	case PortalFieldSelect:
+		for _, option := range f.Options {
+			if option == str {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s must be one of the listed options", f.Key)
+	}
+	return nil
+}
+
+func (s *PortalFormSettings) Scan(value interface{}) error {
+	if value == nil {
+		*s = PortalFormSettings{}
+		return nil
+	}
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	default:
+		return fmt.Errorf("cannot scan type %T into PortalFormSettings", value)
+	}
+}
+
+func (s PortalFormSettings) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+// CollectionPortalRequest is the payload used to create or update a portal. An empty
+// slug is generated from the name.
+type CollectionPortalRequest struct {
+	Name                 string             `json:"name"`
+	Slug                 string             `json:"slug,omitempty"`
+	CollectionMethods    []CollectionMethod `json:"collection_methods,omitempty"`
+	VerificationMethods  []VerificationType `json:"verification_methods,omitempty"`
+	CustomFields         JSONMap            `json:"custom_fields,omitempty"`
+	BrandingSettings     JSONMap            `json:"branding_settings,omitempty"`
+	FormSettings         PortalFormSettings `json:"form_settings"`
+	NotificationSettings JSONMap            `json:"notification_settings,omitempty"`
+	Active               *bool              `json:"active,omitempty"`
+}
+
+// PublicPortal is the form definition served to anonymous visitors of a portal.
+type PublicPortal struct {
+	Name                string             `json:"name"`
+	Slug                string             `json:"slug"`
+	BrandingSettings    JSONMap            `json:"branding_settings"`
+	Form                PortalFormSettings `json:"form"`
+	VerificationMethods []VerificationType `json:"verification_methods"`
+	// FormToken must be echoed back with the submission.
+	FormToken string `json:"form_token"`
+}
+
+// PortalSubmission is a testimonial submitted through a hosted portal.
+type PortalSubmission struct {
+	TestimonialSubmission
+	FormToken string `json:"form_token"`
+	// Website is a honeypot. It is hidden from people, so only bots fill it in.
+	Website string `json:"website,omitempty"`
+}
@@ -0,0 +1,117 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Quota is a usage limit a plan places on a workspace.
+type Quota string
+
+const (
+	QuotaTestimonials Quota = "testimonials_per_month"
+	QuotaProviders    Quota = "connected_providers"
+	QuotaTeamSeats    Quota = "team_seats"
+	QuotaAIJobs       Quota = "ai_jobs_per_month"
+	QuotaVideoMinutes Quota = "video_minutes_per_month"
+)
+
+// Quotas lists every quota in the order usage is reported.
+var Quotas = []Quota{
+	QuotaTestimonials,
+	QuotaProviders,
+	QuotaTeamSeats,
+	QuotaAIJobs,
+	QuotaVideoMinutes,
+}
+
+// Monthly reports whether usage of the quota resets at the start of each calendar month.
+// The others count what the workspace has right now.
+func (q Quota) Monthly() bool {
+	switch q {
+	case QuotaTestimonials, QuotaAIJobs, QuotaVideoMinutes:
+		return true
+	}
+	return false
+}
+
+// Unlimited is the limit of a quota a plan does not cap.
+const Unlimited = -1
+
+// Entitlements maps each quota to the most a plan allows.
+type Entitlements map[Quota]int
+
+// Limit returns the plan's limit for the quota, and false when it is unlimited.
+func (e Entitlements) Limit(q Quota) (int, bool) {
+	limit, ok := e[q]
+	if !ok || limit == Unlimited {
+		return 0, false
+	}
+	return limit, true
+}
+
+var planEntitlements = map[Plan]Entitlements{
+	PlanEssential: {
+		QuotaTestimonials: 50,
+		QuotaProviders:    1,
+		QuotaTeamSeats:    2,
+		QuotaAIJobs:       100,
+		QuotaVideoMinutes: 10,
+	},
+	PlanGrowth: {
+		QuotaTestimonials: 500,
+		QuotaProviders:    3,
+		QuotaTeamSeats:    5,
+		QuotaAIJobs:       1000,
+		QuotaVideoMinutes: 60,
+	},
+	PlanAccelerate: {
+		QuotaTestimonials: 2500,
+		QuotaProviders:    6,
+		QuotaTeamSeats:    15,
+		QuotaAIJobs:       5000,
+		QuotaVideoMinutes: 300,
+	},
+	PlanTransform: {
+		QuotaTestimonials: 10000,
+		QuotaProviders:    Unlimited,
+		QuotaTeamSeats:    50,
+		QuotaAIJobs:       25000,
+		QuotaVideoMinutes: 1200,
+	},
+	PlanEnterprise: {
+		QuotaTestimonials: Unlimited,
+		QuotaProviders:    Unlimited,
+		QuotaTeamSeats:    Unlimited,
+		QuotaAIJobs:       Unlimited,
+		QuotaVideoMinutes: Unlimited,
+	},
+}
+
+// Entitlements returns the limits the plan includes. Unknown plans get the free plan's.
+func (p Plan) Entitlements() Entitlements {
+	if e, ok := planEntitlements[p]; ok {
+		return e
+	}
+	return planEntitlements[PlanEssential]
+}
+
+// QuotaUsage is how much of one quota a workspace has used.
+type QuotaUsage struct {
+	Quota Quota `json:"quota"`
+	Used  int   `json:"used"`
+	// Limit and Remaining are omitted for quotas the plan does not cap.
+	Limit     *int `json:"limit,omitempty"`
+	Remaining *int `json:"remaining,omitempty"`
+}
+
+// WorkspaceUsage is a workspace's usage of every quota on its plan. Monthly quotas
+// count usage between PeriodStart and PeriodEnd.
+type WorkspaceUsage struct {
+	WorkspaceID uuid.UUID    `json:"workspace_id"`
+	Plan        Plan         `json:"plan"`
+	PeriodStart time.Time    `json:"period_start"`
+	PeriodEnd   time.Time    `json:"period_end"`
+	Quotas      []QuotaUsage `json:"quotas"`
+}
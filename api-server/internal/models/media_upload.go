@@ -0,0 +1,146 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MediaUploadStatus string
+
+const (
+	MediaUploadInProgress MediaUploadStatus = "in_progress"
+	MediaUploadCompleted  MediaUploadStatus = "completed"
+	MediaUploadAborted    MediaUploadStatus = "aborted"
+)
+
+// mediaContentTypes lists the media types accepted for upload and the testimonial
+// format each one produces.
+var mediaContentTypes = map[string]ContentFormat{
+	"video/mp4":        ContentFormatVideo,
+	"video/quicktime":  ContentFormatVideo,
+	"video/webm":       ContentFormatVideo,
+	"video/x-matroska": ContentFormatVideo,
+	"video/ogg":        ContentFormatVideo,
+	"video/3gpp":       ContentFormatVideo,
+	"audio/mpeg":       ContentFormatAudio,
+	"audio/mp4":        ContentFormatAudio,
+	"audio/x-m4a":      ContentFormatAudio,
+	"audio/aac":        ContentFormatAudio,
+	"audio/wav":        ContentFormatAudio,
+	"audio/x-wav":      ContentFormatAudio,
+	"audio/webm":       ContentFormatAudio,
+	"audio/ogg":        ContentFormatAudio,
+}
+
+// MediaFormatForContentType returns the testimonial format for an accepted media type.
+// Parameters such as codecs are ignored.
+func MediaFormatForContentType(contentType string) (ContentFormat, bool) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", false
+	}
+	format, ok := mediaContentTypes[mediaType]
+	return format, ok
+}
+
+// MediaUpload is a chunked upload of a video or audio testimonial. Parts are numbered
+// from 1 and every part except the last is exactly ChunkSize bytes.
+type MediaUpload struct {
+	ID              uuid.UUID           `json:"id"`
+	WorkspaceID     uuid.UUID           `json:"workspace_id"`
+	CreatedBy       string              `json:"created_by,omitempty"`
+	Filename        string              `json:"filename"`
+	ContentType     string              `json:"content_type"`
+	Format          ContentFormat       `json:"format"`
+	SizeBytes       int64               `json:"size_bytes"`
+	ChunkSize       int64               `json:"chunk_size"`
+	StorageKey      string              `json:"-"`
+	StorageUploadID string              `json:"-"`
+	Status          MediaUploadStatus   `json:"status"`
+	Metadata        MediaUploadMetadata `json:"metadata"`
+	TestimonialID   *uuid.UUID          `json:"testimonial_id,omitempty"`
+	ReceivedParts   []int               `json:"received_parts"`
+	ExpiresAt       time.Time           `json:"expires_at"`
+	CreatedAt       time.Time           `json:"created_at"`
+	UpdatedAt       time.Time           `json:"updated_at"`
+}
+
+// TotalParts is the number of parts the upload is split into.
+func (u *MediaUpload) TotalParts() int {
+	if u.ChunkSize <= 0 {
+		return 0
+	}
+	return int((u.SizeBytes + u.ChunkSize - 1) / u.ChunkSize)
+}
+
+// PartSize is the exact size expected for the given part number.
+func (u *MediaUpload) PartSize(number int) int64 {
+	if number == u.TotalParts() {
+		return u.SizeBytes - int64(number-1)*u.ChunkSize
+	}
+	return u.ChunkSize
+}
+
+func (u *MediaUpload) IsExpired(now time.Time) bool {
+	return now.After(u.ExpiresAt)
+}
+
+// MarshalJSON adds total_parts so clients can resume without doing the arithmetic.
+func (u MediaUpload) MarshalJSON() ([]byte, error) {
+	type mediaUpload MediaUpload
+	return json.Marshal(struct {
+		mediaUpload
+		TotalParts int `json:"total_parts"`
+	}{
+		mediaUpload: mediaUpload(u),
+		TotalParts:  u.TotalParts(),
+	})
+}
+
+// MediaUploadMetadata describes the testimonial created when the upload completes.
+type MediaUploadMetadata struct {
+	Title    string              `json:"title,omitempty"`
+	Summary  string              `json:"summary,omitempty"`
+	Customer *SubmissionCustomer `json:"customer,omitempty"`
+	// DurationSeconds is the length of the recording, which video uploads count against
+	// the plan's video minutes.
+	DurationSeconds int `json:"duration_seconds,omitempty"`
+}
+
+func (m *MediaUploadMetadata) Scan(value interface{}) error {
+	if value == nil {
+		*m = MediaUploadMetadata{}
+		return nil
+	}
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, m)
+	case string:
+		return json.Unmarshal([]byte(v), m)
+	default:
+		return fmt.Errorf("cannot scan type %T into MediaUploadMetadata", value)
+	}
+}
+
+func (m MediaUploadMetadata) Value() (driver.Value, error) {
+	return json.Marshal(m)
+}
+
+type MediaUploadPart struct {
+	Number    int    `json:"part_number"`
+	ETag      string `json:"etag"`
+	SizeBytes int64  `json:"size_bytes"`
+}
+
+// MediaUploadRequest starts a chunked upload.
+type MediaUploadRequest struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+	SizeBytes   int64  `json:"size_bytes"`
+	MediaUploadMetadata
+}
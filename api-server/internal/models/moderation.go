@@ -0,0 +1,122 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	moderators = []MemberRole{Owner, Admin, Editor}
+	managers   = []MemberRole{Owner, Admin}
+)
+
+// testimonialTransitions is the moderation state machine. Each status maps to the
+// statuses a testimonial can move to next and the roles allowed to move it there.
+var testimonialTransitions = map[ContentStatus]map[ContentStatus][]MemberRole{
+	StatusPendingReview: {
+		StatusApproved: moderators,
+		StatusRejected: moderators,
+	},
+	StatusApproved: {
+		StatusFeatured:  moderators,
+		StatusScheduled: moderators,
+		StatusRejected:  moderators,
+		StatusArchived:  managers,
+	},
+	StatusRejected: {
+		StatusPendingReview: moderators,
+		StatusArchived:      managers,
+	},
+	StatusFeatured: {
+		StatusApproved: moderators,
+		StatusArchived: managers,
+	},
+	StatusScheduled: {
+		StatusScheduled: moderators,
+		StatusApproved:  moderators,
+		StatusArchived:  managers,
+	},
+	StatusArchived: {
+		StatusPendingReview: managers,
+	},
+}
+
+// IsValid reports whether s is a known status.
+func (s ContentStatus) IsValid() bool {
+	_, ok := testimonialTransitions[s]
+	return ok
+}
+
+// IsPublished reports whether testimonials in status s are shown in widgets and embeds.
+// Scheduled testimonials are published by the scheduled publisher when they are due.
+func (s ContentStatus) IsPublished() bool {
+	return s == StatusApproved || s == StatusFeatured
+}
+
+// TransitionRoles returns the roles allowed to move a testimonial between the two
+// statuses. It returns false when the state machine does not allow the move.
+func TransitionRoles(from, to ContentStatus) ([]MemberRole, bool) {
+	roles, ok := testimonialTransitions[from][to]
+	return roles, ok
+}
+
+// localPublishLayouts are the accepted forms of a publish time without a UTC offset.
+var localPublishLayouts = []string{"2006-01-02T15:04:05", "2006-01-02T15:04"}
+
+// TestimonialTransitionRequest is the payload used to move a testimonial to another
+// status. A reason is required to reject a testimonial, and a publish time to schedule
+// one. PublishAt is either RFC 3339 or a local time such as 2024-05-01T09:00 that is
+// read in Timezone, an IANA zone name.
+type TestimonialTransitionRequest struct {
+	Status    ContentStatus `json:"status"`
+	Reason    string        `json:"reason,omitempty"`
+	PublishAt string        `json:"publish_at,omitempty"`
+	Timezone  string        `json:"timezone,omitempty"`
+}
+
+// PublishTime resolves PublishAt to an instant. Local times are read in Timezone, so a
+// testimonial scheduled for 09:00 in Europe/Berlin goes live at 09:00 there whatever
+// the offset is on that day.
+func (r TestimonialTransitionRequest) PublishTime() (time.Time, error) {
+	if r.PublishAt == "" {
+		return time.Time{}, errors.New("publish_at is required")
+	}
+
+	loc := time.UTC
+	if r.Timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(r.Timezone); err != nil {
+			return time.Time{}, fmt.Errorf("unknown timezone %q", r.Timezone)
+		}
+	}
+
+	if t, err := time.Parse(time.RFC3339, r.PublishAt); err == nil {
+		return t, nil
+	}
+	if r.Timezone == "" {
+		return time.Time{}, errors.New("publish_at needs a UTC offset or a timezone")
+	}
+	for _, layout := range localPublishLayouts {
+		if t, err := time.ParseInLocation(layout, r.PublishAt, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("publish_at %q is not a valid time", r.PublishAt)
+}
+
+// TestimonialTransition is a status change. ActorID is nil for changes the system made,
+// such as publishing a scheduled testimonial. PublishAt is set when the testimonial was
+// scheduled.
+type TestimonialTransition struct {
+	ID            uuid.UUID     `json:"id"`
+	TestimonialID uuid.UUID     `json:"testimonial_id"`
+	From          ContentStatus `json:"from"`
+	To            ContentStatus `json:"to"`
+	Reason        string        `json:"reason,omitempty"`
+	PublishAt     *time.Time    `json:"publish_at,omitempty"`
+	ActorID       *uuid.UUID    `json:"actor_id,omitempty"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
@@ -0,0 +1,65 @@
+package models
+
+// Permission is an action a team member can take in a workspace. Each role grants a
+// default set, and a member's Permissions map can grant or revoke individual ones by
+// setting the permission name to true or false.
+type Permission string
+
+const (
+	PermWorkspaceRead        Permission = "workspace:read"
+	PermWorkspaceManage      Permission = "workspace:manage"
+	PermWorkspaceDelete      Permission = "workspace:delete"
+	PermTestimonialsRead     Permission = "testimonials:read"
+	PermTestimonialsWrite    Permission = "testimonials:write"
+	PermTestimonialsModerate Permission = "testimonials:moderate"
+	PermPortalsManage        Permission = "portals:manage"
+	PermProvidersManage      Permission = "providers:manage"
+	PermAPIKeysManage        Permission = "api_keys:manage"
+	PermMembersManage        Permission = "members:manage"
+	PermWebhooksManage       Permission = "webhooks:manage"
+)
+
+// rolePermissions are the permissions each role has unless a member's own settings
+// say otherwise.
+var rolePermissions = map[MemberRole][]Permission{
+	Owner: {
+		PermWorkspaceRead, PermWorkspaceManage, PermWorkspaceDelete,
+		PermTestimonialsRead, PermTestimonialsWrite, PermTestimonialsModerate,
+		PermPortalsManage, PermProvidersManage, PermAPIKeysManage, PermMembersManage,
+		PermWebhooksManage,
+	},
+	Admin: {
+		PermWorkspaceRead, PermWorkspaceManage,
+		PermTestimonialsRead, PermTestimonialsWrite, PermTestimonialsModerate,
+		PermPortalsManage, PermProvidersManage, PermAPIKeysManage, PermMembersManage,
+		PermWebhooksManage,
+	},
+	Editor: {
+		PermWorkspaceRead,
+		PermTestimonialsRead, PermTestimonialsWrite, PermTestimonialsModerate,
+		PermPortalsManage,
+	},
+	Viewer: {PermWorkspaceRead, PermTestimonialsRead},
+}
+
+// RoleHasPermission reports whether the role grants the permission by default.
+func RoleHasPermission(role MemberRole, permission Permission) bool {
+	for _, p := range rolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Can reports whether the member has the permission. An entry in the member's
+// Permissions map overrides the role's default. Owners cannot lose permissions, so a
+// workspace always has someone able to manage it.
+func (m *TeamMember) Can(permission Permission) bool {
+	if m.Role != Owner {
+		if granted, ok := m.Permissions[string(permission)].(bool); ok {
+			return granted
+		}
+	}
+	return RoleHasPermission(m.Role, permission)
+}
@@ -0,0 +1,140 @@
+package models
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+)
+
+// EmbeddingDimensions is the size of the vectors in the semantic index.
+const EmbeddingDimensions = 1536
+
+const (
+	DefaultSimilarTestimonialLimit = 10
+	MaxSimilarTestimonialLimit     = 50
+	maxSimilarQueryLength          = 500
+)
+
+// Vector is an embedding stored in a pgvector column.
+type Vector []float32
+
+// Scan implements the sql.Scanner interface for pgvector's text format, [1,2,3].
+func (v *Vector) Scan(value any) error {
+	var s string
+	switch src := value.(type) {
+	case nil:
+		*v = nil
+		return nil
+	case []byte:
+		s = string(src)
+	case string:
+		s = src
+	default:
+		return fmt.Errorf("cannot scan type %T into Vector", value)
+	}
+
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "["), "]")
+	if s == "" {
+		*v = Vector{}
+		return nil
+	}
+	parts := strings.Split(s, ",")
+	vec := make(Vector, len(parts))
+	for i, part := range parts {
+		f, err := strconv.ParseFloat(strings.TrimSpace(part), 32)
+		if err != nil {
+			return fmt.Errorf("invalid vector value %q: %w", part, err)
+		}
+		vec[i] = float32(f)
+	}
+	*v = vec
+	return nil
+}
+
+// Value implements the driver.Valuer interface for Vector
+func (v Vector) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, f := range v {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.FormatFloat(float64(f), 'g', -1, 32))
+	}
+	b.WriteByte(']')
+	return b.String(), nil
+}
+
+// SemanticIndex is the embedding of a testimonial's text. ContentHash identifies the text
+// that was embedded and SourceUpdatedAt is when the testimonial last changed at the time.
+type SemanticIndex struct {
+	TestimonialID   uuid.UUID `json:"testimonial_id" db:"testimonial_id"`
+	WorkspaceID     uuid.UUID `json:"workspace_id" db:"workspace_id"`
+	Model           string    `json:"embedding_model" db:"embedding_model"`
+	Embedding       Vector    `json:"-" db:"embedding"`
+	ContentHash     string    `json:"content_hash" db:"content_hash"`
+	SourceUpdatedAt time.Time `json:"source_updated_at" db:"source_updated_at"`
+}
+
+// SemanticIndexCandidate is a testimonial that has not been embedded, or has changed
+// since it was. ContentHash is the hash of the text embedded last, if any.
+type SemanticIndexCandidate struct {
+	Testimonial Testimonial
+	ContentHash *string
+}
+
+// SimilarTestimonialRequest finds testimonials similar to another testimonial, To, or to
+// a free text Query. Exactly one of them is set.
+type SimilarTestimonialRequest struct {
+	To    *uuid.UUID
+	Query string
+	Limit int
+}
+
+// GetSimilarFromParam reads a similarity request from the to, q and limit parameters.
+func GetSimilarFromParam(queryParams url.Values) (SimilarTestimonialRequest, error) {
+	req := SimilarTestimonialRequest{
+		Query: strings.TrimSpace(queryParams.Get("q")),
+		Limit: DefaultSimilarTestimonialLimit,
+	}
+
+	if toStr := queryParams.Get("to"); toStr != "" {
+		to, err := uuid.Parse(toStr)
+		if err != nil {
+			return req, fmt.Errorf("%w: to must be a testimonial ID", apperrors.ErrValidationFailed)
+		}
+		req.To = &to
+	}
+	if (req.To == nil) == (req.Query == "") {
+		return req, fmt.Errorf("%w: exactly one of to and q is required", apperrors.ErrValidationFailed)
+	}
+	if len(req.Query) > maxSimilarQueryLength {
+		return req, fmt.Errorf("%w: q must be at most %d characters", apperrors.ErrValidationFailed, maxSimilarQueryLength)
+	}
+
+	if limitStr := queryParams.Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > MaxSimilarTestimonialLimit {
+			return req, fmt.Errorf("%w: limit must be between 1 and %d", apperrors.ErrValidationFailed, MaxSimilarTestimonialLimit)
+		}
+		req.Limit = limit
+	}
+	return req, nil
+}
+
+// SimilarTestimonial is a testimonial close in meaning to a similarity request.
+// Similarity is the cosine similarity of the embeddings, up to 1 for identical meaning.
+// Only the fields needed to show a result are loaded.
+type SimilarTestimonial struct {
+	Testimonial Testimonial `json:"testimonial"`
+	Similarity  float64     `json:"similarity"`
+}
@@ -0,0 +1,163 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultSlackAlertRating is the rating at or below which a testimonial is sent as an
+// alert when a workspace has not chosen a threshold.
+const DefaultSlackAlertRating float32 = 2
+
+// SlackSettings controls which Slack notifications a workspace receives. Messages go to
+// the workspace's IntegrationSettings.SlackWebhookURL unless a routing rule sends them
+// elsewhere.
+type SlackSettings struct {
+	WorkspaceID uuid.UUID `json:"workspace_id"`
+	Enabled     bool      `json:"enabled"`
+	// WebhookURL is read from the workspace's integration settings.
+	WebhookURL string `json:"webhook_url,omitempty"`
+	// NotifyNew posts every new testimonial. Alerts are posted either way.
+	NotifyNew bool `json:"notify_new"`
+	// AlertRating is the rating at or below which a testimonial is posted as an alert.
+	AlertRating float32 `json:"alert_rating"`
+	// AlertSentiments are the sentiments that are posted as an alert.
+	AlertSentiments []Sentiment        `json:"alert_sentiments"`
+	Rules           []SlackRoutingRule `json:"rules"`
+	DigestEnabled   bool               `json:"digest_enabled"`
+	// DigestHour is the hour of the day, in Timezone, the daily digest is posted at.
+	DigestHour   int        `json:"digest_hour"`
+	Timezone     string     `json:"timezone"`
+	NextDigestAt *time.Time `json:"next_digest_at,omitempty"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// DefaultSlackSettings are used until a workspace saves its own.
+func DefaultSlackSettings(workspaceID uuid.UUID) *SlackSettings {
+	return &SlackSettings{
+		WorkspaceID:     workspaceID,
+		NotifyNew:       true,
+		AlertRating:     DefaultSlackAlertRating,
+		AlertSentiments: []Sentiment{SentimentVeryNegative},
+		Rules:           []SlackRoutingRule{},
+		DigestHour:      9,
+		Timezone:        "UTC",
+	}
+}
+
+// NextDigest returns the first digest time after the given time: DigestHour o'clock in
+// the workspace's timezone.
+func (s *SlackSettings) NextDigest(after time.Time) time.Time {
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	local := after.In(loc)
+	next := time.Date(local.Year(), local.Month(), local.Day(), s.DigestHour, 0, 0, 0, loc)
+	if !next.After(after) {
+		next = time.Date(local.Year(), local.Month(), local.Day()+1, s.DigestHour, 0, 0, 0, loc)
+	}
+	return next.UTC()
+}
+
+// SlackSettingsRequest replaces a workspace's Slack settings.
+type SlackSettingsRequest struct {
+	Enabled         bool               `json:"enabled"`
+	NotifyNew       bool               `json:"notify_new"`
+	AlertRating     float32            `json:"alert_rating"`
+	AlertSentiments []Sentiment        `json:"alert_sentiments"`
+	Rules           []SlackRoutingRule `json:"rules"`
+	DigestEnabled   bool               `json:"digest_enabled"`
+	DigestHour      int                `json:"digest_hour"`
+	Timezone        string             `json:"timezone"`
+}
+
+// SlackRoutingRule routes the testimonials it matches to a channel. Empty conditions
+// match everything. When a workspace has rules, only testimonials that match one of
+// them are posted, to the rule's WebhookURL or to the workspace's channel when it has
+// none.
+type SlackRoutingRule struct {
+	Name       string   `json:"name"`
+	MinRating  *float32 `json:"min_rating,omitempty"`
+	MaxRating  *float32 `json:"max_rating,omitempty"`
+	Providers  []string `json:"providers,omitempty"`
+	Tags       []string `json:"tags,omitempty"`
+	WebhookURL string   `json:"webhook_url,omitempty"`
+	// AlertsOnly limits the rule to alerts.
+	AlertsOnly bool `json:"alerts_only,omitempty"`
+}
+
+// Matches reports whether the rule applies to a testimonial from provider with the given
+// rating and tags.
+func (r SlackRoutingRule) Matches(provider string, rating *float32, tags []string, alert bool) bool {
+	if r.AlertsOnly && !alert {
+		return false
+	}
+	if r.MinRating != nil && (rating == nil || *rating < *r.MinRating) {
+		return false
+	}
+	if r.MaxRating != nil && (rating == nil || *rating > *r.MaxRating) {
+		return false
+	}
+	if len(r.Providers) > 0 && !containsFold(r.Providers, provider) {
+		return false
+	}
+	if len(r.Tags) > 0 {
+		for _, tag := range tags {
+			if containsFold(r.Tags, tag) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// SlackNotificationKind is the kind of message a testimonial was posted as. A
+// testimonial is posted at most once of each kind.
+type SlackNotificationKind string
+
+const (
+	SlackNotificationNew   SlackNotificationKind = "new"
+	SlackNotificationAlert SlackNotificationKind = "alert"
+)
+
+// SlackDigest summarizes a workspace's testimonials over a period.
+type SlackDigest struct {
+	WorkspaceID   uuid.UUID
+	WorkspaceName string
+	Since         time.Time
+	Until         time.Time
+	Total         int
+	AverageRating *float32
+	Negative      int
+	PendingReview int
+	// Top is the best rated testimonial of the period, if any.
+	Top *Testimonial
+}
+
+// SlackLinkRequest redeems the token a Slack user was sent to link their account.
+type SlackLinkRequest struct {
+	Token string `json:"token"`
+}
+
+// SlackUserLink connects a Slack user to a team member so their button clicks act as
+// that member.
+type SlackUserLink struct {
+	WorkspaceID  uuid.UUID `json:"workspace_id"`
+	TeamID       string    `json:"slack_team_id"`
+	UserID       string    `json:"slack_user_id"`
+	TeamMemberID uuid.UUID `json:"team_member_id"`
+	CreatedAt    time.Time `json:"created_at"`
+}
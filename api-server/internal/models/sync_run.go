@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SyncTrigger string
+
+const (
+	SyncTriggerScheduled SyncTrigger = "scheduled"
+	SyncTriggerManual    SyncTrigger = "manual"
+)
+
+type SyncRunStatus string
+
+const (
+	SyncRunRunning   SyncRunStatus = "running"
+	SyncRunSucceeded SyncRunStatus = "succeeded"
+	SyncRunFailed    SyncRunStatus = "failed"
+	SyncRunSkipped   SyncRunStatus = "skipped"
+)
+
+// SyncRun records a single execution of a provider sync for a workspace.
+type SyncRun struct {
+	ID                  uuid.UUID     `json:"id"`
+	WorkspaceID         uuid.UUID     `json:"workspace_id"`
+	ProviderName        string        `json:"provider_name"`
+	Trigger             SyncTrigger   `json:"trigger"`
+	Status              SyncRunStatus `json:"status"`
+	InstanceID          string        `json:"instance_id"`
+	TestimonialsFetched int           `json:"testimonials_fetched"`
+	Error               string        `json:"error,omitempty"`
+	StartedAt           time.Time     `json:"started_at"`
+	FinishedAt          *time.Time    `json:"finished_at,omitempty"`
+}
+
+// SyncCursor is the high-water mark of a workspace+provider pair. Since is the newest
+// upstream create/edit time already stored; Token is an optional provider specific
+// resume token.
+type SyncCursor struct {
+	WorkspaceID    uuid.UUID  `json:"workspace_id"`
+	ProviderName   string     `json:"provider_name"`
+	Since          time.Time  `json:"since"`
+	Token          string     `json:"token,omitempty"`
+	LastFullScanAt *time.Time `json:"last_full_scan_at,omitempty"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type InvitationStatus string
+
+const (
+	InvitationPending  InvitationStatus = "pending"
+	InvitationAccepted InvitationStatus = "accepted"
+	InvitationRevoked  InvitationStatus = "revoked"
+	InvitationExpired  InvitationStatus = "expired"
+)
+
+// TeamInvitation invites an email address to join a workspace with a role. The token
+// sent by email is signed and never stored.
+type TeamInvitation struct {
+	ID          uuid.UUID        `json:"id"`
+	WorkspaceID uuid.UUID        `json:"workspace_id"`
+	Email       string           `json:"email"`
+	Role        MemberRole       `json:"role"`
+	Status      InvitationStatus `json:"status"`
+	InvitedBy   *uuid.UUID       `json:"invited_by,omitempty"`
+	AcceptedBy  *uuid.UUID       `json:"accepted_by,omitempty"`
+	ExpiresAt   time.Time        `json:"expires_at"`
+	SentAt      time.Time        `json:"sent_at"`
+	AcceptedAt  *time.Time       `json:"accepted_at,omitempty"`
+	RevokedAt   *time.Time       `json:"revoked_at,omitempty"`
+	CreatedAt   time.Time        `json:"created_at"`
+}
+
+// IsExpired reports whether a pending invitation can no longer be accepted. Expired
+// invitations give up their seat; inviting the address again sends a new one.
+func (i *TeamInvitation) IsExpired(now time.Time) bool {
+	return !now.Before(i.ExpiresAt)
+}
+
+// InvitationRequest is the payload used to invite someone to a workspace.
+type InvitationRequest struct {
+	Email string     `json:"email"`
+	Role  MemberRole `json:"role"`
+}
+
+// AcceptInvitationRequest carries the token from an invitation email.
+type AcceptInvitationRequest struct {
+	Token string `json:"token"`
+}
+
+// TeamMemberSummary is a workspace member with the details shown in the team list.
+type TeamMemberSummary struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	Name      string     `json:"name"`
+	Email     string     `json:"email"`
+	Role      MemberRole `json:"role"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// TeamRoster lists a workspace's members together with its pending invitations.
+type TeamRoster struct {
+	Members     []TeamMemberSummary `json:"members"`
+	Invitations []TeamInvitation    `json:"invitations"`
+	// SeatLimit is omitted for plans without a limit.
+	SeatLimit *int `json:"seat_limit,omitempty"`
+	SeatsUsed int  `json:"seats_used"`
+}
@@ -96,6 +96,30 @@ const (
 	SentimentVeryPositive Sentiment = "very_positive"
 )
 
+func (s Sentiment) IsValid() bool {
+	switch s {
+	case SentimentVeryNegative, SentimentNegative, SentimentNeutral, SentimentPositive, SentimentVeryPositive:
+		return true
+	}
+	return false
+}
+
+// SentimentForScore buckets a sentiment score on the -1 to 1 scale into a sentiment.
+func SentimentForScore(score float32) Sentiment {
+	switch {
+	case score <= -0.6:
+		return SentimentVeryNegative
+	case score <= -0.2:
+		return SentimentNegative
+	case score < 0.2:
+		return SentimentNeutral
+	case score < 0.6:
+		return SentimentPositive
+	default:
+		return SentimentVeryPositive
+	}
+}
+
 type AIServiceCategory string
 
 const (
@@ -159,7 +183,9 @@ type Testimonial struct {
 	VerificationStatus string           `json:"verification_status" db:"verification_status"`
 	VerifiedAt         *time.Time       `json:"verified_at,omitempty" db:"verified_at"`
 	AuthenticityScore  *float32         `json:"authenticity_score,omitempty" db:"authenticity_score"`
-	SourceData         JSONMap          `json:"source_data,omitempty" db:"source_data"`
+	// HoldReason explains why the testimonial was held for review automatically.
+	HoldReason *string `json:"hold_reason,omitempty" db:"hold_reason"`
+	SourceData JSONMap `json:"source_data,omitempty" db:"source_data"`
 
 	// Publishing
 	Published          bool       `json:"published" db:"published"`
@@ -406,6 +432,83 @@ func (c CollectionMethod) Value() (driver.Value, error) {
 	return string(c), nil
 }
 
+// Value stores an unset verification method as NULL, since the column is a nullable enum.
 func (v VerificationType) Value() (driver.Value, error) {
+	if v == "" {
+		return nil, nil
+	}
 	return string(v), nil
 }
+
+// TestimonialSubmission is the payload accepted by the public submission API. It only
+// exposes fields a submitter may set; moderation and publishing state is always
+// decided server side.
+type TestimonialSubmission struct {
+	TestimonialType   TestimonialType `json:"testimonial_type"`
+	Format            ContentFormat   `json:"format"`
+	Language          string          `json:"language,omitempty"`
+	Title             string          `json:"title,omitempty"`
+	Summary           string          `json:"summary,omitempty"`
+	Content           string          `json:"content,omitempty"`
+	Rating            *float32        `json:"rating,omitempty"`
+	MediaURL          *string         `json:"media_url,omitempty"`
+	MediaURLs         StringArray     `json:"media_urls,omitempty"`
+	MediaDuration     *int            `json:"media_duration,omitempty"`
+	ThumbnailURL      *string         `json:"thumbnail_url,omitempty"`
+	ProductContext    JSONMap         `json:"product_context,omitempty"`
+	PurchaseContext   JSONMap         `json:"purchase_context,omitempty"`
+	ExperienceContext JSONMap         `json:"experience_context,omitempty"`
+	Tags              []string        `json:"tags,omitempty"`
+	Categories        []string        `json:"categories,omitempty"`
+	CustomFields      JSONMap         `json:"custom_fields,omitempty"`
+
+	Customer *SubmissionCustomer `json:"customer,omitempty"`
+
+	// SubmitterIP is the address of the person who submitted through a collection portal.
+	// It is set by the server. API submissions leave it empty, since they come from the
+	// workspace's own backend and would all look like one burst of submissions.
+	SubmitterIP string `json:"-"`
+}
+
+type SubmissionCustomer struct {
+	Name       string `json:"name"`
+	Email      string `json:"email,omitempty"`
+	ExternalID string `json:"external_id,omitempty"`
+}
+
+// ToTestimonial builds a pending testimonial for the workspace from the submission.
+func (s TestimonialSubmission) ToTestimonial(workspaceID uuid.UUID, method CollectionMethod) Testimonial {
+	t := Testimonial{
+		ID:                uuid.New(),
+		WorkspaceID:       workspaceID,
+		TestimonialType:   s.TestimonialType,
+		Format:            s.Format,
+		Status:            StatusPendingReview,
+		Language:          s.Language,
+		Title:             s.Title,
+		Summary:           s.Summary,
+		Content:           s.Content,
+		Rating:            s.Rating,
+		MediaURL:          s.MediaURL,
+		MediaURLs:         s.MediaURLs,
+		MediaDuration:     s.MediaDuration,
+		ThumbnailURL:      s.ThumbnailURL,
+		ProductContext:    s.ProductContext,
+		PurchaseContext:   s.PurchaseContext,
+		ExperienceContext: s.ExperienceContext,
+		CollectionMethod:  method,
+		Tags:              s.Tags,
+		Categories:        s.Categories,
+		CustomFields:      s.CustomFields,
+	}
+	if t.TestimonialType == "" {
+		t.TestimonialType = TestimonialTypeCustomer
+	}
+	if t.Format == "" {
+		t.Format = ContentFormatText
+	}
+	if s.SubmitterIP != "" {
+		t.SourceData = JSONMap{SourceDataSubmitterIP: s.SubmitterIP}
+	}
+	return t
+}
@@ -0,0 +1,155 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+)
+
+const (
+	DefaultTestimonialPageSize = 20
+	MaxTestimonialPageSize     = 100
+)
+
+type TestimonialSort string
+
+const (
+	SortByCreatedAt         TestimonialSort = "created_at"
+	SortByRating            TestimonialSort = "rating"
+	SortByViewCount         TestimonialSort = "view_count"
+	SortByAuthenticityScore TestimonialSort = "authenticity_score"
+)
+
+func (s TestimonialSort) IsValid() bool {
+	switch s {
+	case SortByCreatedAt, SortByRating, SortByViewCount, SortByAuthenticityScore:
+		return true
+	}
+	return false
+}
+
+// TestimonialListFields are the fields a testimonial listing can be narrowed to with
+// fields=. They match the JSON names of the Testimonial fields.
+var TestimonialListFields = []string{
+	"id", "workspace_id", "customer_profile_id", "testimonial_type", "format", "status", "language",
+	"title", "summary", "content", "transcript", "media_urls", "rating", "media_url", "media_duration",
+	"thumbnail_url", "additional_media", "custom_formatting", "product_context", "experience_context",
+	"collection_method", "verification_method", "verification_data", "verification_status",
+	"verified_at", "authenticity_score", "source_data", "published", "published_at", "scheduled_publish_at",
+	"tags", "categories", "custom_fields", "view_count", "share_count", "conversion_count", "engagement_metrics",
+	"created_at", "updated_at", "hold_reason",
+}
+
+// TestimonialCursor marks where a page of testimonials ended. It records the sort it was
+// made for, so it cannot be replayed against a different ordering. Time holds the sort
+// key when sorting by created_at and Value holds it otherwise.
+type TestimonialCursor struct {
+	Sort  TestimonialSort `json:"s"`
+	Desc  bool            `json:"d"`
+	Time  *time.Time      `json:"t,omitempty"`
+	Value *float64        `json:"v,omitempty"`
+	ID    uuid.UUID       `json:"id"`
+}
+
+// Encode returns the cursor as an opaque token.
+func (c TestimonialCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeTestimonialCursor(token string) (*TestimonialCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid cursor", apperrors.ErrValidationFailed)
+	}
+	var c TestimonialCursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == uuid.Nil || !c.Sort.IsValid() {
+		return nil, fmt.Errorf("%w: invalid cursor", apperrors.ErrValidationFailed)
+	}
+	if (c.Sort == SortByCreatedAt) != (c.Time != nil) || (c.Sort != SortByCreatedAt) != (c.Value != nil) {
+		return nil, fmt.Errorf("%w: invalid cursor", apperrors.ErrValidationFailed)
+	}
+	return &c, nil
+}
+
+// TestimonialPageRequest selects one page of a workspace's testimonials. An empty
+// Fields selects every listing field.
+type TestimonialPageRequest struct {
+	Filter TestimonialFilter
+	Sort   TestimonialSort
+	Desc   bool
+	Limit  int
+	Cursor *TestimonialCursor
+	Fields []string
+}
+
+// GetPageFromParam reads a page request from the query string. sort takes one of the
+// sort fields, prefixed with - for descending order, and defaults to -created_at.
+// fields is a comma separated list of TestimonialListFields; id is always included.
+func GetPageFromParam(queryParams url.Values) (TestimonialPageRequest, error) {
+	page := TestimonialPageRequest{
+		Filter: GetFilterFromParam(queryParams),
+		Sort:   SortByCreatedAt,
+		Desc:   true,
+		Limit:  DefaultTestimonialPageSize,
+	}
+
+	if sortStr := queryParams.Get("sort"); sortStr != "" {
+		page.Desc = strings.HasPrefix(sortStr, "-")
+		page.Sort = TestimonialSort(strings.TrimPrefix(sortStr, "-"))
+		if !page.Sort.IsValid() {
+			return page, fmt.Errorf("%w: cannot sort by %q", apperrors.ErrValidationFailed, page.Sort)
+		}
+	}
+
+	if limitStr := queryParams.Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > MaxTestimonialPageSize {
+			return page, fmt.Errorf("%w: limit must be between 1 and %d", apperrors.ErrValidationFailed, MaxTestimonialPageSize)
+		}
+		page.Limit = limit
+	}
+
+	if cursorStr := queryParams.Get("cursor"); cursorStr != "" {
+		cursor, err := DecodeTestimonialCursor(cursorStr)
+		if err != nil {
+			return page, err
+		}
+		if cursor.Sort != page.Sort || cursor.Desc != page.Desc {
+			return page, fmt.Errorf("%w: cursor was issued for a different sort", apperrors.ErrValidationFailed)
+		}
+		page.Cursor = cursor
+	}
+
+	if fieldsStr := queryParams.Get("fields"); fieldsStr != "" {
+		page.Fields = []string{"id"}
+		for _, field := range strings.Split(fieldsStr, ",") {
+			field = strings.TrimSpace(field)
+			if !slices.Contains(TestimonialListFields, field) {
+				return page, fmt.Errorf("%w: unknown field %q", apperrors.ErrValidationFailed, field)
+			}
+			if !slices.Contains(page.Fields, field) {
+				page.Fields = append(page.Fields, field)
+			}
+		}
+	}
+
+	return page, nil
+}
+
+// TestimonialPage is one page of a testimonial listing. Data holds full testimonials,
+// or objects with only the requested fields when the listing was narrowed with fields=.
+// Total counts every testimonial matching the filter, across all pages.
+type TestimonialPage struct {
+	Data       any    `json:"data"`
+	Total      int    `json:"total"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
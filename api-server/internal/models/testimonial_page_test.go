@@ -0,0 +1,150 @@
+package models
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestimonialCursorRoundTrip(t *testing.T) {
+	createdAt := time.Date(2026, 3, 14, 9, 26, 53, 589793000, time.UTC)
+	rating := 4.5
+
+	tests := []struct {
+		name   string
+		cursor TestimonialCursor
+	}{
+		{"CreatedAtDescending", TestimonialCursor{Sort: SortByCreatedAt, Desc: true, Time: &createdAt, ID: uuid.New()}},
+		{"CreatedAtAscending", TestimonialCursor{Sort: SortByCreatedAt, Time: &createdAt, ID: uuid.New()}},
+		{"Rating", TestimonialCursor{Sort: SortByRating, Desc: true, Value: &rating, ID: uuid.New()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded, err := DecodeTestimonialCursor(tt.cursor.Encode())
+			require.NoError(t, err)
+			assert.Equal(t, tt.cursor.Sort, decoded.Sort)
+			assert.Equal(t, tt.cursor.Desc, decoded.Desc)
+			assert.Equal(t, tt.cursor.ID, decoded.ID)
+			if tt.cursor.Time != nil {
+				require.NotNil(t, decoded.Time)
+				assert.True(t, tt.cursor.Time.Equal(*decoded.Time))
+			}
+			assert.Equal(t, tt.cursor.Value, decoded.Value)
+		})
+	}
+}
+
+func TestDecodeTestimonialCursorRejectsTampering(t *testing.T) {
+	encode := func(raw string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(raw))
+	}
+	id := uuid.New().String()
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"NotBase64", "not a cursor!"},
+		{"NotJSON", encode("created_at")},
+		{"MissingID", encode(`{"s":"created_at","d":true,"t":"2026-03-14T09:26:53Z"}`)},
+		{"UnknownSort", encode(`{"s":"title","d":true,"v":1,"id":"` + id + `"}`)},
+		{"CreatedAtWithoutTime", encode(`{"s":"created_at","d":true,"v":1,"id":"` + id + `"}`)},
+		{"RatingWithoutValue", encode(`{"s":"rating","d":true,"t":"2026-03-14T09:26:53Z","id":"` + id + `"}`)},
+		{"BothKeys", encode(`{"s":"rating","t":"2026-03-14T09:26:53Z","v":1,"id":"` + id + `"}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeTestimonialCursor(tt.token)
+			assert.ErrorIs(t, err, apperrors.ErrValidationFailed)
+		})
+	}
+}
+
+func TestGetPageFromParam(t *testing.T) {
+	createdAt := time.Now().UTC()
+	rating := 3.0
+	createdAtCursor := TestimonialCursor{Sort: SortByCreatedAt, Desc: true, Time: &createdAt, ID: uuid.New()}.Encode()
+	ratingCursor := TestimonialCursor{Sort: SortByRating, Value: &rating, ID: uuid.New()}.Encode()
+
+	tests := []struct {
+		name    string
+		query   url.Values
+		wantErr bool
+		check   func(t *testing.T, page TestimonialPageRequest)
+	}{
+		{
+			name:  "Defaults",
+			query: url.Values{},
+			check: func(t *testing.T, page TestimonialPageRequest) {
+				assert.Equal(t, SortByCreatedAt, page.Sort)
+				assert.True(t, page.Desc)
+				assert.Equal(t, DefaultTestimonialPageSize, page.Limit)
+				assert.Nil(t, page.Cursor)
+				assert.Nil(t, page.Fields)
+			},
+		},
+		{
+			name:  "AscendingSort",
+			query: url.Values{"sort": {"rating"}},
+			check: func(t *testing.T, page TestimonialPageRequest) {
+				assert.Equal(t, SortByRating, page.Sort)
+				assert.False(t, page.Desc)
+			},
+		},
+		{name: "UnknownSort", query: url.Values{"sort": {"-title"}}, wantErr: true},
+		{
+			name:  "SmallestLimit",
+			query: url.Values{"limit": {"1"}},
+			check: func(t *testing.T, page TestimonialPageRequest) { assert.Equal(t, 1, page.Limit) },
+		},
+		{
+			name:  "LargestLimit",
+			query: url.Values{"limit": {strconv.Itoa(MaxTestimonialPageSize)}},
+			check: func(t *testing.T, page TestimonialPageRequest) { assert.Equal(t, MaxTestimonialPageSize, page.Limit) },
+		},
+		{name: "ZeroLimit", query: url.Values{"limit": {"0"}}, wantErr: true},
+		{name: "LimitTooLarge", query: url.Values{"limit": {strconv.Itoa(MaxTestimonialPageSize + 1)}}, wantErr: true},
+		{name: "LimitNotANumber", query: url.Values{"limit": {"ten"}}, wantErr: true},
+		{
+			name:  "CursorForSameSort",
+			query: url.Values{"cursor": {createdAtCursor}},
+			check: func(t *testing.T, page TestimonialPageRequest) {
+				require.NotNil(t, page.Cursor)
+				assert.Equal(t, SortByCreatedAt, page.Cursor.Sort)
+			},
+		},
+		{name: "CursorForOtherSort", query: url.Values{"sort": {"-created_at"}, "cursor": {ratingCursor}}, wantErr: true},
+		{name: "CursorForOtherDirection", query: url.Values{"sort": {"created_at"}, "cursor": {createdAtCursor}}, wantErr: true},
+		{name: "TamperedCursor", query: url.Values{"cursor": {createdAtCursor[:len(createdAtCursor)-4]}}, wantErr: true},
+		{
+			name:  "FieldsAlwaysIncludeID",
+			query: url.Values{"fields": {"title, rating,title"}},
+			check: func(t *testing.T, page TestimonialPageRequest) {
+				assert.Equal(t, []string{"id", "title", "rating"}, page.Fields)
+			},
+		},
+		{name: "UnknownField", query: url.Values{"fields": {"title,password"}}, wantErr: true},
+		{name: "ColumnNotInListing", query: url.Values{"fields": {"submitter_ip"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := GetPageFromParam(tt.query)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, apperrors.ErrValidationFailed)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, page)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+)
+
+const (
+	DefaultTestimonialSearchLimit = 20
+	MaxTestimonialSearchLimit     = 50
+	maxTestimonialSearchLength    = 200
+)
+
+// Search snippets mark matched words with these delimiters. They are control characters,
+// so they cannot collide with testimonial text and are swapped for <mark> tags once the
+// rest of the snippet has been escaped.
+const (
+	HighlightStart = "\x02"
+	HighlightStop  = "\x03"
+)
+
+// TestimonialSearchRequest searches a workspace's testimonials. Query uses web search
+// syntax: "quoted phrases" match words in order, -word excludes testimonials that
+// contain the word, and or combines alternatives.
+type TestimonialSearchRequest struct {
+	Query  string
+	Limit  int
+	Offset int
+}
+
+// GetSearchFromParam reads a search request from the q, limit and offset parameters.
+func GetSearchFromParam(queryParams url.Values) (TestimonialSearchRequest, error) {
+	req := TestimonialSearchRequest{
+		Query: strings.TrimSpace(queryParams.Get("q")),
+		Limit: DefaultTestimonialSearchLimit,
+	}
+	if req.Query == "" {
+		return req, fmt.Errorf("%w: q is required", apperrors.ErrValidationFailed)
+	}
+	if len(req.Query) > maxTestimonialSearchLength {
+		return req, fmt.Errorf("%w: q must be at most %d characters", apperrors.ErrValidationFailed, maxTestimonialSearchLength)
+	}
+
+	if limitStr := queryParams.Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > MaxTestimonialSearchLimit {
+			return req, fmt.Errorf("%w: limit must be between 1 and %d", apperrors.ErrValidationFailed, MaxTestimonialSearchLimit)
+		}
+		req.Limit = limit
+	}
+	if offsetStr := queryParams.Get("offset"); offsetStr != "" {
+		offset, err := strconv.Atoi(offsetStr)
+		if err != nil || offset < 0 {
+			return req, fmt.Errorf("%w: offset must not be negative", apperrors.ErrValidationFailed)
+		}
+		req.Offset = offset
+	}
+	return req, nil
+}
+
+// SearchTerms splits a web search query into the words to match fuzzily and the words
+// it excludes. Quotes and the or keyword only matter to the full-text match.
+func SearchTerms(query string) (fuzzy, excluded []string) {
+	for _, word := range strings.Fields(strings.ReplaceAll(query, `"`, " ")) {
+		switch {
+		case strings.EqualFold(word, "or"):
+		case strings.HasPrefix(word, "-"):
+			if word = strings.TrimLeft(word, "-"); word != "" {
+				excluded = append(excluded, word)
+			}
+		default:
+			fuzzy = append(fuzzy, word)
+		}
+	}
+	return fuzzy, excluded
+}
+
+// TestimonialSearchHit is a testimonial matching a search. Only the fields needed to
+// show a result are loaded, so transcripts and source data are left out. Highlights
+// holds HTML snippets of the matching title, content and transcript with the matched
+// words wrapped in <mark> tags.
+type TestimonialSearchHit struct {
+	Testimonial     Testimonial       `json:"testimonial"`
+	CustomerName    string            `json:"customer_name,omitempty"`
+	CustomerCompany string            `json:"customer_company,omitempty"`
+	Rank            float64           `json:"rank"`
+	Highlights      map[string]string `json:"highlights,omitempty"`
+}
@@ -0,0 +1,106 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxBeaconEvents caps how many events one beacon can report.
+const MaxBeaconEvents = 50
+
+// MaxConversionValue is the largest value a single conversion can report.
+const MaxConversionValue = 1_000_000
+
+type TrackingEventType string
+
+const (
+	TrackingImpression TrackingEventType = "impression"
+	TrackingClick      TrackingEventType = "click"
+	TrackingConversion TrackingEventType = "conversion"
+)
+
+// IsValid reports whether t is a known event type.
+func (t TrackingEventType) IsValid() bool {
+	switch t {
+	case TrackingImpression, TrackingClick, TrackingConversion:
+		return true
+	}
+	return false
+}
+
+// BeaconEvent is something a visitor did with a testimonial shown in a widget.
+type BeaconEvent struct {
+	Type          TrackingEventType `json:"type"`
+	TestimonialID uuid.UUID         `json:"testimonial_id"`
+	// ConversionType and Value describe a conversion, such as a signup or a purchase.
+	ConversionType string  `json:"conversion_type,omitempty"`
+	Value          float64 `json:"value,omitempty"`
+}
+
+// BeaconRequest is what an embedded widget reports, usually with navigator.sendBeacon.
+type BeaconRequest struct {
+	WidgetID uuid.UUID `json:"widget_id"`
+	// VisitorID is an opaque identifier the embed keeps for the browser. Without it the
+	// visitor is told apart by IP address and user agent.
+	VisitorID string        `json:"visitor_id,omitempty"`
+	Events    []BeaconEvent `json:"events"`
+}
+
+// BeaconClient is what is known about the browser that sent a beacon.
+type BeaconClient struct {
+	IP        string
+	UserAgent string
+}
+
+// PerformanceCounts is what visitors did with the testimonials shown to them.
+type PerformanceCounts struct {
+	Impressions      int64   `json:"impressions"`
+	Clicks           int64   `json:"clicks"`
+	Conversions      int64   `json:"conversions"`
+	ConversionValue  float64 `json:"conversion_value"`
+	ClickThroughRate float64 `json:"click_through_rate"`
+	ConversionRate   float64 `json:"conversion_rate"`
+}
+
+// Add adds o's counts to c. Rates are left for ComputeRates.
+func (c *PerformanceCounts) Add(o PerformanceCounts) {
+	c.Impressions += o.Impressions
+	c.Clicks += o.Clicks
+	c.Conversions += o.Conversions
+	c.ConversionValue += o.ConversionValue
+}
+
+// ComputeRates fills in the rates from the counts. Both are fractions of impressions.
+func (c *PerformanceCounts) ComputeRates() {
+	c.ClickThroughRate, c.ConversionRate = 0, 0
+	if c.Impressions > 0 {
+		c.ClickThroughRate = float64(c.Clicks) / float64(c.Impressions)
+		c.ConversionRate = float64(c.Conversions) / float64(c.Impressions)
+	}
+}
+
+// TestimonialPerformance is how a testimonial did in a workspace's widgets.
+type TestimonialPerformance struct {
+	TestimonialID uuid.UUID `json:"testimonial_id"`
+	Title         string    `json:"title,omitempty"`
+	PerformanceCounts
+}
+
+// TestimonialPerformanceQuery selects the days, and optionally the widget, reported on.
+// From and To are inclusive days.
+type TestimonialPerformanceQuery struct {
+	From     time.Time  `json:"from"`
+	To       time.Time  `json:"to"`
+	WidgetID *uuid.UUID `json:"widget_id,omitempty"`
+	Limit    int        `json:"limit"`
+}
+
+// TestimonialPerformanceReport lists testimonials by conversions, most first.
+type TestimonialPerformanceReport struct {
+	From         time.Time                `json:"from"`
+	To           time.Time                `json:"to"`
+	WidgetID     *uuid.UUID               `json:"widget_id,omitempty"`
+	Totals       PerformanceCounts        `json:"totals"`
+	Testimonials []TestimonialPerformance `json:"testimonials"`
+}
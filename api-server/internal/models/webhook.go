@@ -0,0 +1,97 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookEndpoint is a URL a workspace receives events at.
+type WebhookEndpoint struct {
+	ID          uuid.UUID `json:"id"`
+	WorkspaceID uuid.UUID `json:"workspace_id"`
+	URL         string    `json:"url"`
+	Description string    `json:"description,omitempty"`
+	Events      []string  `json:"events"`
+	IsActive    bool      `json:"is_active"`
+	// Secret signs the requests sent to the endpoint. It is only returned when the
+	// endpoint is created and when the secret is rotated.
+	Secret    string     `json:"secret,omitempty"`
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// WebhookEndpointRequest creates or updates an endpoint. IsActive defaults to true on
+// creation and is left unchanged on update when omitted.
+type WebhookEndpointRequest struct {
+	URL         string   `json:"url"`
+	Description string   `json:"description,omitempty"`
+	Events      []string `json:"events"`
+	IsActive    *bool    `json:"is_active,omitempty"`
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending deliveries are waiting for their first or next attempt.
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryDead deliveries ran out of attempts and wait to be redelivered.
+	WebhookDeliveryDead WebhookDeliveryStatus = "dead"
+)
+
+// IsValid reports whether s is a known delivery status.
+func (s WebhookDeliveryStatus) IsValid() bool {
+	switch s {
+	case WebhookDeliveryPending, WebhookDeliverySucceeded, WebhookDeliveryDead:
+		return true
+	}
+	return false
+}
+
+// WebhookDelivery is one event sent to one endpoint, with the outcome of its latest
+// attempt.
+type WebhookDelivery struct {
+	ID             uuid.UUID             `json:"id"`
+	EndpointID     uuid.UUID             `json:"endpoint_id"`
+	WorkspaceID    uuid.UUID             `json:"workspace_id"`
+	EventID        uuid.UUID             `json:"event_id"`
+	EventType      string                `json:"event_type"`
+	Payload        json.RawMessage       `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	NextAttemptAt  time.Time             `json:"next_attempt_at"`
+	LastAttemptAt  *time.Time            `json:"last_attempt_at,omitempty"`
+	ResponseStatus *int                  `json:"response_status,omitempty"`
+	ResponseBody   string                `json:"response_body,omitempty"`
+	Error          string                `json:"error,omitempty"`
+	// RedeliveryOf is the delivery this one was manually resent from.
+	RedeliveryOf *uuid.UUID `json:"redelivery_of,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// WebhookDispatch is a due delivery with what is needed to send it.
+type WebhookDispatch struct {
+	Delivery WebhookDelivery
+	URL      string
+	Secret   string
+}
+
+// WebhookAttempt is the outcome of sending a delivery once.
+type WebhookAttempt struct {
+	Status         WebhookDeliveryStatus
+	AttemptedAt    time.Time
+	NextAttemptAt  time.Time
+	ResponseStatus *int
+	ResponseBody   string
+	Error          string
+}
+
+// WebhookDeliveryFilter narrows the delivery log. Zero values match everything.
+type WebhookDeliveryFilter struct {
+	EndpointID *uuid.UUID
+	Status     WebhookDeliveryStatus
+	Limit      int
+}
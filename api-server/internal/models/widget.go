@@ -0,0 +1,252 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxWidgetTestimonials caps how many testimonials a widget shows.
+const MaxWidgetTestimonials = 50
+
+// DefaultWidgetTestimonials is how many testimonials a widget without a limit shows.
+const DefaultWidgetTestimonials = 12
+
+type WidgetLayout string
+
+const (
+	WidgetLayoutCarousel WidgetLayout = "carousel"
+	WidgetLayoutGrid     WidgetLayout = "grid"
+	WidgetLayoutWall     WidgetLayout = "wall"
+	WidgetLayoutSingle   WidgetLayout = "single"
+)
+
+// IsValid reports whether l is a known layout.
+func (l WidgetLayout) IsValid() bool {
+	switch l {
+	case WidgetLayoutCarousel, WidgetLayoutGrid, WidgetLayoutWall, WidgetLayoutSingle:
+		return true
+	}
+	return false
+}
+
+// DisplayWidget is a block of published testimonials that customers embed on their own
+// sites. It is served publicly at /embed/{id}, styled with the workspace's default
+// brand guide.
+type DisplayWidget struct {
+	ID          uuid.UUID      `json:"id"`
+	WorkspaceID uuid.UUID      `json:"workspace_id"`
+	Name        string         `json:"name"`
+	Layout      WidgetLayout   `json:"layout"`
+	Settings    WidgetSettings `json:"settings"`
+	Active      bool           `json:"active"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+// WidgetSettings chooses which published testimonials a widget shows. Filters are
+// combined; a testimonial must have at least one of Tags when any are given.
+type WidgetSettings struct {
+	Title     string          `json:"title,omitempty"`
+	MinRating *float32        `json:"min_rating,omitempty"`
+	Tags      []string        `json:"tags,omitempty"`
+	Formats   []ContentFormat `json:"formats,omitempty"`
+	// TestimonialIDs pins the widget to these testimonials, shown in this order.
+	TestimonialIDs []uuid.UUID `json:"testimonial_ids,omitempty"`
+	Limit          int         `json:"limit,omitempty"`
+}
+
+// Validate checks the settings before they are saved.
+func (s WidgetSettings) Validate() error {
+	if s.MinRating != nil && (*s.MinRating < 1 || *s.MinRating > 5) {
+		return errors.New("min_rating must be between 1 and 5")
+	}
+	if s.Limit < 0 || s.Limit > MaxWidgetTestimonials {
+		return fmt.Errorf("limit must be between 1 and %d", MaxWidgetTestimonials)
+	}
+	if len(s.TestimonialIDs) > MaxWidgetTestimonials {
+		return fmt.Errorf("a widget can pin at most %d testimonials", MaxWidgetTestimonials)
+	}
+	return nil
+}
+
+// EffectiveLimit is the number of testimonials the widget shows. A single testimonial
+// widget always shows one.
+func (s WidgetSettings) EffectiveLimit(layout WidgetLayout) int {
+	switch {
+	case layout == WidgetLayoutSingle:
+		return 1
+	case s.Limit > 0:
+		return s.Limit
+	case len(s.TestimonialIDs) > 0:
+		return len(s.TestimonialIDs)
+	}
+	return DefaultWidgetTestimonials
+}
+
+func (s *WidgetSettings) Scan(value interface{}) error {
+	if value == nil {
+		*s = WidgetSettings{}
+		return nil
+	}
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	default:
+		return fmt.Errorf("cannot scan type %T into WidgetSettings", value)
+	}
+}
+
+func (s WidgetSettings) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+// DisplayWidgetRequest is the payload used to create or update a widget.
+type DisplayWidgetRequest struct {
+	Name     string         `json:"name"`
+	Layout   WidgetLayout   `json:"layout"`
+	Settings WidgetSettings `json:"settings"`
+	Active   *bool          `json:"active,omitempty"`
+}
+
+// WidgetStyle is the part of a brand guide that styles an embedded widget.
+type WidgetStyle struct {
+	Style       string  `json:"style"`
+	Shape       string  `json:"shape"`
+	ShowRating  bool    `json:"show_rating"`
+	ShowAvatar  bool    `json:"show_avatar"`
+	ShowDate    bool    `json:"show_date"`
+	ShowCompany bool    `json:"show_company"`
+	Animation   bool    `json:"animation"`
+	Shadow      string  `json:"shadow"`
+	Border      bool    `json:"border"`
+	RatingStyle string  `json:"rating_style"`
+	Colors      JSONMap `json:"colors"`
+	Typography  JSONMap `json:"typography"`
+}
+
+// DefaultWidgetStyle is used by workspaces without a default brand guide. It matches
+// the column defaults of brand_guides.
+func DefaultWidgetStyle() WidgetStyle {
+	return WidgetStyle{
+		Style:       "card",
+		Shape:       "rounded",
+		ShowRating:  true,
+		ShowAvatar:  true,
+		ShowDate:    true,
+		ShowCompany: true,
+		Animation:   true,
+		Shadow:      "md",
+		Border:      true,
+		RatingStyle: "stars",
+		Colors:      JSONMap{},
+		Typography:  JSONMap{},
+	}
+}
+
+// WidgetStyleFromBrandGuide takes a widget's style from a brand guide.
+func WidgetStyleFromBrandGuide(guide *BrandGuide) WidgetStyle {
+	style := WidgetStyle{
+		Style:       guide.TestimonialStyle,
+		Shape:       guide.TestimonialShape,
+		ShowRating:  guide.ShowRating,
+		ShowAvatar:  guide.ShowAvatar,
+		ShowDate:    guide.ShowDate,
+		ShowCompany: guide.ShowCompany,
+		Animation:   guide.Animation,
+		Shadow:      guide.Shadow,
+		Border:      guide.Border,
+		RatingStyle: guide.RatingStyle,
+		Colors:      guide.Colors,
+		Typography:  guide.Typography,
+	}
+	defaults := DefaultWidgetStyle()
+	if style.Style == "" {
+		style.Style = defaults.Style
+	}
+	if style.Shape == "" {
+		style.Shape = defaults.Shape
+	}
+	if style.RatingStyle == "" {
+		style.RatingStyle = defaults.RatingStyle
+	}
+	if style.Colors == nil {
+		style.Colors = JSONMap{}
+	}
+	if style.Typography == nil {
+		style.Typography = JSONMap{}
+	}
+	return style
+}
+
+// EmbedTestimonial is the public view of a published testimonial. Fields the brand
+// guide hides are left empty.
+type EmbedTestimonial struct {
+	ID            uuid.UUID     `json:"id"`
+	Title         string        `json:"title,omitempty"`
+	Content       string        `json:"content,omitempty"`
+	Rating        *float32      `json:"rating,omitempty"`
+	Format        ContentFormat `json:"format"`
+	MediaURL      string        `json:"media_url,omitempty"`
+	ThumbnailURL  string        `json:"thumbnail_url,omitempty"`
+	AuthorName    string        `json:"author_name,omitempty"`
+	AuthorTitle   string        `json:"author_title,omitempty"`
+	AuthorCompany string        `json:"author_company,omitempty"`
+	AvatarURL     string        `json:"avatar_url,omitempty"`
+	PublishedAt   *time.Time    `json:"published_at,omitempty"`
+}
+
+// WidgetEmbed is what an embedded widget renders.
+type WidgetEmbed struct {
+	ID           uuid.UUID          `json:"id"`
+	Layout       WidgetLayout       `json:"layout"`
+	Title        string             `json:"title,omitempty"`
+	Style        WidgetStyle        `json:"style"`
+	Testimonials []EmbedTestimonial `json:"testimonials"`
+	// Hosts are the workspace's domains, the only sites allowed to fetch the widget.
+	Hosts []string `json:"-"`
+}
+
+// EmbedHosts returns the domains of a workspace's website and custom domain.
+func EmbedHosts(workspace *Workspace) []string {
+	var hosts []string
+	for _, raw := range []string{workspace.WebsiteURL, workspace.CustomDomain} {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		if !strings.Contains(raw, "://") {
+			raw = "https://" + raw
+		}
+		u, err := url.Parse(raw)
+		if err != nil || u.Hostname() == "" {
+			continue
+		}
+		hosts = append(hosts, strings.TrimPrefix(strings.ToLower(u.Hostname()), "www."))
+	}
+	return hosts
+}
+
+// AllowsOrigin reports whether a page at origin may fetch the widget: it must be served
+// from one of the workspace's domains or their subdomains.
+func (e *WidgetEmbed) AllowsOrigin(origin string) bool {
+	u, err := url.Parse(origin)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, allowed := range e.Hosts {
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxAnalyticsBuckets caps how many buckets a workspace analytics timeline can have.
+const MaxAnalyticsBuckets = 400
+
+// AnalyticsTopN is how many tags, categories and competitors are reported.
+const AnalyticsTopN = 10
+
+// DirectSource is the source of testimonials that were not imported from a platform.
+const DirectSource = "direct"
+
+type AnalyticsGranularity string
+
+const (
+	GranularityDay   AnalyticsGranularity = "day"
+	GranularityWeek  AnalyticsGranularity = "week"
+	GranularityMonth AnalyticsGranularity = "month"
+)
+
+// IsValid reports whether g is a known granularity.
+func (g AnalyticsGranularity) IsValid() bool {
+	switch g {
+	case GranularityDay, GranularityWeek, GranularityMonth:
+		return true
+	}
+	return false
+}
+
+// Truncate returns the start of the bucket t falls in. Weeks start on Monday, as they
+// do for Postgres' date_trunc.
+func (g AnalyticsGranularity) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch g {
+	case GranularityWeek:
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case GranularityMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return day
+}
+
+// Next returns the start of the bucket after the one starting at start.
+func (g AnalyticsGranularity) Next(start time.Time) time.Time {
+	switch g {
+	case GranularityWeek:
+		return start.AddDate(0, 0, 7)
+	case GranularityMonth:
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// WorkspaceAnalyticsQuery selects the days reported on and how they are bucketed. From
+// and To are inclusive days in UTC.
+type WorkspaceAnalyticsQuery struct {
+	From        time.Time            `json:"from"`
+	To          time.Time            `json:"to"`
+	Granularity AnalyticsGranularity `json:"granularity"`
+}
+
+// AnalyticsBucket is what was collected in one period of the timeline. The averages are
+// nil when nothing in the bucket was rated or analyzed.
+type AnalyticsBucket struct {
+	Start            time.Time               `json:"start"`
+	Total            int64                   `json:"total"`
+	ByStatus         map[ContentStatus]int64 `json:"by_status"`
+	AverageRating    *float64                `json:"average_rating"`
+	AverageSentiment *float64                `json:"average_sentiment"`
+}
+
+// AnalyticsCount is the number of testimonials with a value, such as a tag or a source.
+type AnalyticsCount struct {
+	Key   string `json:"key"`
+	Count int64  `json:"count"`
+}
+
+type RatingCount struct {
+	Rating int   `json:"rating"`
+	Count  int64 `json:"count"`
+}
+
+// CompetitorMentionCount is how often a competitor came up in the period's testimonials.
+type CompetitorMentionCount struct {
+	Competitor       string   `json:"competitor"`
+	Mentions         int64    `json:"mentions"`
+	Positive         int64    `json:"positive"`
+	Negative         int64    `json:"negative"`
+	AverageSentiment *float64 `json:"average_sentiment"`
+}
+
+// AnalyticsFunnel follows testimonials from collection to the conversions they drove.
+// The first three count testimonials collected in the period; the rest count what
+// visitors did with any testimonial during it.
+type AnalyticsFunnel struct {
+	Collected   int64 `json:"collected"`
+	Approved    int64 `json:"approved"`
+	Published   int64 `json:"published"`
+	Impressions int64 `json:"impressions"`
+	Clicks      int64 `json:"clicks"`
+	Conversions int64 `json:"conversions"`
+}
+
+// WorkspaceAnalytics aggregates the testimonials a workspace collected over a period.
+type WorkspaceAnalytics struct {
+	WorkspaceID        uuid.UUID                `json:"workspace_id"`
+	From               time.Time                `json:"from"`
+	To                 time.Time                `json:"to"`
+	Granularity        AnalyticsGranularity     `json:"granularity"`
+	Total              int64                    `json:"total"`
+	AverageRating      *float64                 `json:"average_rating"`
+	AverageSentiment   *float64                 `json:"average_sentiment"`
+	Timeline           []AnalyticsBucket        `json:"timeline"`
+	BySource           []AnalyticsCount         `json:"by_source"`
+	ByCollectionMethod []AnalyticsCount         `json:"by_collection_method"`
+	RatingDistribution []RatingCount            `json:"rating_distribution"`
+	TopTags            []AnalyticsCount         `json:"top_tags"`
+	TopCategories      []AnalyticsCount         `json:"top_categories"`
+	CompetitorMentions []CompetitorMentionCount `json:"competitor_mentions"`
+	Funnel             AnalyticsFunnel          `json:"funnel"`
+	GeneratedAt        time.Time                `json:"generated_at"`
+}
@@ -96,6 +96,14 @@ func ValidateWorkspace(updates map[string]any) error {
 					}
 				}
 			}
+		case "settings":
+			if settings, ok := value.(map[string]any); ok {
+				if threshold, ok := settings["authenticity_threshold"]; ok {
+					if err := validate.Var(threshold, "number,min=0,max=1"); err != nil {
+						return fmt.Errorf("%w: invalid authenticity threshold: %w", apperrors.ErrValidationFailed, err)
+					}
+				}
+			}
 		case "branding_settings":
 			if settings, ok := value.(*BrandingSettings); ok && settings != nil {
 				if err := validate.Struct(settings); err != nil {
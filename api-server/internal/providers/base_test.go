@@ -0,0 +1,54 @@
+package providers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviewScan(t *testing.T) {
+	since := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	cursor := models.SyncCursor{Since: since, Token: "token"}
+
+	t.Run("IncrementalImportsChangedReviews", func(t *testing.T) {
+		scan := newReviewScan(cursor, false)
+
+		assert.True(t, scan.observe("new", since.Add(time.Hour)))
+		// Reviews around the previous boundary are fetched again
+		assert.True(t, scan.observe("overlap", since.Add(-time.Minute)))
+		assert.False(t, scan.observe("old", since.Add(-time.Hour)))
+
+		result := scan.result(cursor, nil, false, true)
+		assert.Equal(t, since.Add(time.Hour), result.Cursor.Since)
+		assert.Equal(t, "token", result.Cursor.Token)
+		assert.Nil(t, result.LiveIDs)
+	})
+
+	t.Run("FullScansImportAndReportEverything", func(t *testing.T) {
+		scan := newReviewScan(cursor, true)
+
+		assert.True(t, scan.observe("old", since.Add(-time.Hour)))
+		assert.True(t, scan.observe("new", since.Add(time.Hour)))
+
+		result := scan.result(cursor, nil, true, true)
+		assert.Equal(t, []string{"old", "new"}, result.LiveIDs)
+	})
+
+	t.Run("PartialScansKeepTheCursor", func(t *testing.T) {
+		scan := newReviewScan(cursor, true)
+		scan.observe("new", since.Add(time.Hour))
+
+		result := scan.result(cursor, nil, true, false)
+		assert.Equal(t, since, result.Cursor.Since)
+		assert.Nil(t, result.LiveIDs)
+	})
+
+	t.Run("EditsCountAsChanges", func(t *testing.T) {
+		scan := newReviewScan(cursor, false)
+
+		assert.True(t, scan.observe("edited", modifiedAt(since.AddDate(0, -1, 0), since.Add(time.Hour))))
+		assert.False(t, scan.observe("untouched", modifiedAt(since.AddDate(0, -1, 0), time.Time{})))
+	})
+}
@@ -23,6 +23,33 @@ type FacebookProvider struct {
 	db                  repositories.DB
 }
 
+var facebookDefinition = Definition{
+	Name:        "facebook",
+	DisplayName: "Facebook",
+	AuthType:    AuthTypeOAuth,
+	Credentials: []CredentialField{
+		{Key: "clientID", Label: "App ID", Required: true},
+		{Key: "clientSecret", Label: "App Secret", Required: true, Secret: true},
+	},
+	RateLimit:       200,
+	RateWindow:      time.Hour,
+	DefaultSchedule: "@hourly",
+	Factory: func(credentials map[string]string, deps Dependencies) Provider {
+		return NewFacebookProvider(
+			credentials["clientID"],
+			credentials["clientSecret"],
+			deps.OAuthService,
+			deps.SentimentService,
+			deps.CustomerProfileRepo,
+			deps.DB,
+		)
+	},
+}
+
+func init() {
+	register(facebookDefinition)
+}
+
 // New constructor that doesn't require workspaceID at creation time
 func NewFacebookProvider(
 	clientID string,
@@ -42,7 +69,30 @@ func NewFacebookProvider(
 	}
 }
 
+const facebookGraphURL = "https://graph.facebook.com/v19.0"
+
 func (p *FacebookProvider) Fetch(ctx context.Context, userID string, workspaceID uuid.UUID) ([]models.Testimonial, error) {
+	result, err := p.FetchSince(ctx, userID, workspaceID, models.SyncCursor{}, false)
+	if err != nil {
+		return nil, err
+	}
+	return result.Testimonials, nil
+}
+
+// facebookEditLookback widens the Graph API's since filter, which only looks at when
+// ratings and posts were created, so that incremental syncs still pick up the ones
+// edited within a week of being written. Older edits are picked up by the full scan.
+const facebookEditLookback = 7 * 24 * time.Hour
+
+// facebookSinceParam returns the since filter for a Graph API edge, if any.
+func facebookSinceParam(scan *reviewScan) string {
+	if scan.since.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("&since=%d", scan.since.Add(-facebookEditLookback).Unix())
+}
+
+func (p *FacebookProvider) FetchSince(ctx context.Context, userID string, workspaceID uuid.UUID, cursor models.SyncCursor, fullScan bool) (*FetchResult, error) {
 	// Get the user's access token
 	token, err := p.oauthService.GetToken(userID, "facebook")
 	if err != nil {
@@ -55,30 +105,34 @@ func (p *FacebookProvider) Fetch(ctx context.Context, userID string, workspaceID
 		return nil, fmt.Errorf("failed to get user pages: %w", err)
 	}
 
-	var allTestimonials []models.Testimonial
+	scan := newReviewScan(cursor, fullScan)
+	var testimonials []models.Testimonial
+	complete := true
 
 	// For each page, get both recommendations and posts
 	for _, page := range pages {
 		// Get page recommendations/reviews
-		recommendations, err := p.getPageRecommendations(ctx, page.ID, page.AccessToken, workspaceID)
+		recommendations, err := p.getPageRecommendations(ctx, page.ID, page.AccessToken, workspaceID, scan)
 		if err != nil {
 			// Log error but continue with other pages
 			fmt.Printf("Error getting recommendations for page %s: %v\n", page.ID, err)
+			complete = false
 		} else {
-			allTestimonials = append(allTestimonials, recommendations...)
+			testimonials = append(testimonials, recommendations...)
 		}
 
 		// Get page posts and comments for sentiment analysis
-		posts, err := p.getPagePosts(ctx, page.ID, page.AccessToken, workspaceID)
+		posts, err := p.getPagePosts(ctx, page.ID, page.AccessToken, workspaceID, scan)
 		if err != nil {
 			// Log error but continue
 			fmt.Printf("Error getting posts for page %s: %v\n", page.ID, err)
+			complete = false
 		} else {
-			allTestimonials = append(allTestimonials, posts...)
+			testimonials = append(testimonials, posts...)
 		}
 	}
 
-	return allTestimonials, nil
+	return scan.result(cursor, testimonials, fullScan, complete), nil
 }
 
 type FacebookPage struct {
@@ -87,71 +141,121 @@ type FacebookPage struct {
 	AccessToken string `json:"access_token"`
 }
 
-func (p *FacebookProvider) getUserPages(ctx context.Context, accessToken string) ([]FacebookPage, error) {
-	url := fmt.Sprintf("https://graph.facebook.com/v19.0/me/accounts?access_token=%s", accessToken)
+// graphTime parses the timestamp format returned by the Graph API (e.g. 2024-01-02T15:04:05+0000).
+type graphTime struct {
+	time.Time
+}
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("facebook request creation failed: %w", err)
+func (t *graphTime) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw == "" {
+		return nil
 	}
 
-	client := &http.Client{Timeout: 10 * time.Second}
-	resp, err := client.Do(req)
+	parsed, err := time.Parse("2006-01-02T15:04:05-0700", raw)
 	if err != nil {
-		return nil, fmt.Errorf("facebook API request failed: %w", err)
+		parsed, err = time.Parse(time.RFC3339, raw)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("facebook API returned status %d", resp.StatusCode)
+	if err != nil {
+		return fmt.Errorf("invalid graph timestamp %q: %w", raw, err)
 	}
+	t.Time = parsed
+	return nil
+}
 
-	var result struct {
-		Data []FacebookPage `json:"data"`
-	}
+func (p *FacebookProvider) getUserPages(ctx context.Context, accessToken string) ([]FacebookPage, error) {
+	url := fmt.Sprintf("%s/me/accounts?access_token=%s", facebookGraphURL, accessToken)
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode facebook response: %w", err)
+	var pages []FacebookPage
+	err := p.walkEdge(ctx, url, func(item json.RawMessage) error {
+		var page FacebookPage
+		if err := json.Unmarshal(item, &page); err != nil {
+			return err
+		}
+		pages = append(pages, page)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return result.Data, nil
+	return pages, nil
 }
 
-func (p *FacebookProvider) getPageRecommendations(ctx context.Context, pageID, pageToken string, workspaceID uuid.UUID) ([]models.Testimonial, error) {
-	url := fmt.Sprintf("https://graph.facebook.com/v19.0/%s/ratings?access_token=%s", pageID, pageToken)
+// walkEdge requests a Graph API edge and follows its paging links until exhausted.
+func (p *FacebookProvider) walkEdge(ctx context.Context, url string, each func(item json.RawMessage) error) error {
+	client := &http.Client{Timeout: 10 * time.Second}
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("facebook request creation failed: %w", err)
-	}
+	for url != "" {
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return fmt.Errorf("facebook request creation failed: %w", err)
+		}
 
-	client := &http.Client{Timeout: 10 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("facebook API request failed: %w", err)
-	}
-	defer resp.Body.Close()
+		resp, err := client.Do(req)
+		if err != nil {
+			return fmt.Errorf("facebook API request failed: %w", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return fmt.Errorf("facebook API returned status %d", resp.StatusCode)
+		}
+
+		var result struct {
+			Data   []json.RawMessage `json:"data"`
+			Paging struct {
+				Next string `json:"next"`
+			} `json:"paging"`
+		}
+
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to decode facebook response: %w", err)
+		}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("facebook API returned status %d", resp.StatusCode)
+		for _, item := range result.Data {
+			if err := each(item); err != nil {
+				return fmt.Errorf("failed to decode facebook item: %w", err)
+			}
+		}
+
+		url = result.Paging.Next
 	}
 
-	var result struct {
-		Data []struct {
+	return nil
+}
+
+func (p *FacebookProvider) getPageRecommendations(ctx context.Context, pageID, pageToken string, workspaceID uuid.UUID, scan *reviewScan) ([]models.Testimonial, error) {
+	url := fmt.Sprintf("%s/%s/ratings?fields=id,review_text,rating,created_time,reviewer&access_token=%s", facebookGraphURL, pageID, pageToken)
+	url += facebookSinceParam(scan)
+
+	var testimonials []models.Testimonial
+	err := p.walkEdge(ctx, url, func(item json.RawMessage) error {
+		var review struct {
 			ID          string                 `json:"id"`
 			ReviewText  string                 `json:"review_text"`
 			Rating      float32                `json:"rating"`
-			CreatedTime time.Time              `json:"created_time"`
+			CreatedTime graphTime              `json:"created_time"`
 			Reviewer    contracts.ReviewerData `json:"reviewer"`
-		} `json:"data"`
-	}
+		}
+		if err := json.Unmarshal(item, &review); err != nil {
+			return err
+		}
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode facebook response: %w", err)
-	}
+		// Ratings do not always expose an ID, fall back to the reviewer on this page
+		reviewID := review.ID
+		if reviewID == "" {
+			reviewID = fmt.Sprintf("%s_%s", pageID, review.Reviewer.ExternalID)
+		}
+		// Ratings do not say when they were edited, so everything within the lookback
+		// is imported again and the upsert keeps the latest text
+		scan.observe(reviewID, review.CreatedTime.Time)
 
-	var testimonials []models.Testimonial
-	for _, review := range result.Data {
 		// Create or get customer profile
 		profile, err := p.customerProfileRepo.GetOrCreate(
 			ctx,
@@ -162,7 +266,7 @@ func (p *FacebookProvider) getPageRecommendations(ctx context.Context, pageID, p
 		)
 		if err != nil {
 			fmt.Printf("Error creating customer profile: %v\n", err)
-			continue
+			return nil
 		}
 
 		// Calculate sentiment score (1-5) if no explicit rating is provided
@@ -170,8 +274,7 @@ func (p *FacebookProvider) getPageRecommendations(ctx context.Context, pageID, p
 		if rating == 0 && review.ReviewText != "" {
 			sentiment, err := p.sentimentService.AnalyzeText(review.ReviewText)
 			if err == nil {
-				// Convert sentiment (-1 to 1) to a 1-5 scale
-				rating = float32(((sentiment+1)/2)*4) + 1
+				rating = sentimentToRating(sentiment)
 			}
 		}
 
@@ -180,83 +283,75 @@ func (p *FacebookProvider) getPageRecommendations(ctx context.Context, pageID, p
 			CustomerProfileID: &profile.ID,
 			TestimonialType:   models.TestimonialTypeCustomer,
 			Format:            models.ContentFormatSocialPost,
+			Status:            models.StatusPendingReview,
+			CollectionMethod:  models.CollectionMethodSocialImport,
 			Content:           review.ReviewText,
 			Rating:            &rating,
 			SourceData: map[string]interface{}{
-				"page_id":  pageID,
-				"platform": "facebook",
+				"page_id":   pageID,
+				"platform":  "facebook",
+				"review_id": reviewID,
 			},
-			UpdatedAt: time.Now(),
-			CreatedAt: time.Now(),
+			UpdatedAt: review.CreatedTime.Time,
+			CreatedAt: review.CreatedTime.Time,
 		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return testimonials, nil
 }
 
-func (p *FacebookProvider) getPagePosts(ctx context.Context, pageID, pageToken string, workspaceID uuid.UUID) ([]models.Testimonial, error) {
+func (p *FacebookProvider) getPagePosts(ctx context.Context, pageID, pageToken string, workspaceID uuid.UUID, scan *reviewScan) ([]models.Testimonial, error) {
 	// Get posts from the page
-	url := fmt.Sprintf("https://graph.facebook.com/v19.0/%s/feed?fields=id,message,created_time,from&access_token=%s", pageID, pageToken)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("facebook request creation failed: %w", err)
-	}
-
-	client := &http.Client{Timeout: 10 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("facebook API request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("facebook API returned status %d", resp.StatusCode)
-	}
+	url := fmt.Sprintf("%s/%s/feed?fields=id,message,created_time,updated_time,from&access_token=%s", facebookGraphURL, pageID, pageToken)
+	url += facebookSinceParam(scan)
 
-	var result struct {
-		Data []struct {
+	var testimonials []models.Testimonial
+	err := p.walkEdge(ctx, url, func(item json.RawMessage) error {
+		var post struct {
 			ID          string    `json:"id"`
 			Message     string    `json:"message"`
-			CreatedTime time.Time `json:"created_time"`
+			CreatedTime graphTime `json:"created_time"`
+			UpdatedTime graphTime `json:"updated_time"`
 			From        struct {
 				Name  string `json:"name"`
 				ID    string `json:"id"`
 				Email string `json:"email"`
 			} `json:"from"`
-		} `json:"data"`
-	}
-
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode facebook response: %w", err)
-	}
+		}
+		if err := json.Unmarshal(item, &post); err != nil {
+			return err
+		}
 
-	var testimonials []models.Testimonial
-	for _, post := range result.Data {
 		// Skip page's own posts (we want posts from others on the page)
 		if post.From.ID == pageID {
-			continue
+			return nil
 		}
 
-		// Skip posts without messages
-		if post.Message == "" {
-			continue
+		modified := modifiedAt(post.CreatedTime.Time, post.UpdatedTime.Time)
+		changed := scan.observe(post.ID, modified)
+
+		// Skip posts without messages or that were not touched since the last sync
+		if post.Message == "" || !changed {
+			return nil
 		}
 
 		// Analyze sentiment
 		sentiment, err := p.sentimentService.AnalyzeText(post.Message)
 		if err != nil {
 			fmt.Printf("Error analyzing sentiment: %v\n", err)
-			continue
+			return nil
 		}
 
 		// Skip posts with negative sentiment or neutral posts that don't seem like testimonials
-		if sentiment < 0.2 {
-			continue
+		if sentiment < minTestimonialSentiment {
+			return nil
 		}
 
-		// Convert sentiment (-1 to 1) to a 1-5 scale
-		rating := float32(((sentiment+1)/2)*4) + 1
+		rating := sentimentToRating(sentiment)
 
 		// Create or get customer profile
 		reviewer := contracts.ReviewerData{
@@ -274,7 +369,7 @@ func (p *FacebookProvider) getPagePosts(ctx context.Context, pageID, pageToken s
 		)
 		if err != nil {
 			fmt.Printf("Error creating customer profile: %v\n", err)
-			continue
+			return nil
 		}
 
 		testimonials = append(testimonials, models.Testimonial{
@@ -282,35 +377,42 @@ func (p *FacebookProvider) getPagePosts(ctx context.Context, pageID, pageToken s
 			CustomerProfileID: &profile.ID,
 			TestimonialType:   models.TestimonialTypeCustomer,
 			Format:            models.ContentFormatSocialPost,
+			Status:            models.StatusPendingReview,
+			CollectionMethod:  models.CollectionMethodSocialImport,
 			Content:           post.Message,
 			Rating:            &rating,
 			SourceData: map[string]interface{}{
-				"page_id":  pageID,
-				"platform": "facebook",
-				"post_id":  post.ID,
+				"page_id":   pageID,
+				"platform":  "facebook",
+				"post_id":   post.ID,
+				"review_id": post.ID,
 			},
-			UpdatedAt: post.CreatedTime,
-			CreatedAt: post.CreatedTime,
+			UpdatedAt: modified,
+			CreatedAt: post.CreatedTime.Time,
 		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return testimonials, nil
 }
 
 func (p *FacebookProvider) Name() string {
-	return "facebook"
+	return facebookDefinition.Name
 }
 
 func (p *FacebookProvider) RateLimit() int {
-	return 200
+	return facebookDefinition.RateLimit
 }
 
 func (p *FacebookProvider) RateWindow() time.Duration {
-	return time.Hour
+	return facebookDefinition.RateWindow
 }
 
 func (p *FacebookProvider) Schedule() string {
-	return "@hourly"
+	return facebookDefinition.DefaultSchedule
 }
 
 func (p *FacebookProvider) IsConfigured() bool {
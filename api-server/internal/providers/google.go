@@ -6,145 +6,278 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/contracts"
 	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
 	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
 )
 
 type GoogleMyBusiness struct {
 	BaseProvider
-	client      *http.Client
-	accountName string
+	accountName         string
+	oauthService        contracts.OAuthService
+	customerProfileRepo repositories.CustomerProfileRepository
+	db                  repositories.DB
 }
 
 type LocationsResponse struct {
 	Locations []struct {
 		Name string `json:"name"`
 	} `json:"locations"`
+	NextPageToken string `json:"nextPageToken"`
 }
 
-func NewGoogleProvider(clientID, clientSecret, accountName string, token *oauth2.Token) *GoogleMyBusiness {
-	conf := &oauth2.Config{
-		ClientID:     clientID,
-		ClientSecret: clientSecret,
-		Scopes:       []string{"https://www.googleapis.com/auth/business.manage"},
-		Endpoint: oauth2.Endpoint{
-			AuthURL:  "https://accounts.google.com/o/oauth2/auth",
-			TokenURL: "https://oauth2.googleapis.com/token",
-		},
+// googleStarRatings maps the Business Profile StarRating enum to a numeric rating.
+var googleStarRatings = map[string]float32{
+	"ONE":   1,
+	"TWO":   2,
+	"THREE": 3,
+	"FOUR":  4,
+	"FIVE":  5,
+}
+
+var googleDefinition = Definition{
+	Name:        "google",
+	DisplayName: "Google Business Profile",
+	AuthType:    AuthTypeOAuth,
+	Credentials: []CredentialField{
+		{Key: "clientID", Label: "Client ID", Required: true},
+		{Key: "clientSecret", Label: "Client Secret", Required: true, Secret: true},
+		{Key: "accountName", Label: "Account ID", Required: true},
+	},
+	RateLimit:       100,         // 100 requests
+	RateWindow:      time.Minute, // per minute
+	DefaultSchedule: "@hourly",
+}
+
+func init() {
+	// The factory is attached here because NewGoogleProvider reads googleDefinition,
+	// which would otherwise be an initialization cycle.
+	googleDefinition.Factory = func(credentials map[string]string, deps Dependencies) Provider {
+		return NewGoogleProvider(
+			credentials["clientID"],
+			credentials["clientSecret"],
+			credentials["accountName"],
+			deps.OAuthService,
+			deps.CustomerProfileRepo,
+			deps.DB,
+		)
 	}
+	register(googleDefinition)
+}
 
+func NewGoogleProvider(
+	clientID string,
+	clientSecret string,
+	accountName string,
+	oauthService contracts.OAuthService,
+	customerProfileRepo repositories.CustomerProfileRepository,
+	db repositories.DB,
+) *GoogleMyBusiness {
 	return &GoogleMyBusiness{
 		BaseProvider: BaseProvider{
-			name:       "google_my_business",
-			rateLimit:  100,         // 100 requests
-			rateWindow: time.Minute, // per minute
-			schedule:   "@hourly",
+			name:       googleDefinition.Name,
+			rateLimit:  googleDefinition.RateLimit,
+			rateWindow: googleDefinition.RateWindow,
+			schedule:   googleDefinition.DefaultSchedule,
 			oauthConfig: &OAuthConfig{
 				ClientID:     clientID,
 				ClientSecret: clientSecret,
-				AuthURL:      conf.Endpoint.AuthURL,
-				TokenURL:     conf.Endpoint.TokenURL,
-				Scopes:       conf.Scopes,
+				AuthURL:      google.Endpoint.AuthURL,
+				TokenURL:     google.Endpoint.TokenURL,
+				Scopes:       []string{"https://www.googleapis.com/auth/business.manage"},
 			},
 		},
-		client: conf.Client(context.Background(), token),
+		accountName:         accountName,
+		oauthService:        oauthService,
+		customerProfileRepo: customerProfileRepo,
+		db:                  db,
 	}
 }
 
-func (p *GoogleMyBusiness) Fetch(ctx context.Context) ([]models.Testimonial, error) {
-	locations, err := p.fetchLocations(ctx)
+func (g *GoogleMyBusiness) Fetch(ctx context.Context, userID string, workspaceID uuid.UUID) ([]models.Testimonial, error) {
+	result, err := g.FetchSince(ctx, userID, workspaceID, models.SyncCursor{}, false)
+	if err != nil {
+		return nil, err
+	}
+	return result.Testimonials, nil
+}
+
+// FetchSince lists each location's reviews most recently updated first, so an
+// incremental sync stops paging once it reaches reviews that did not change since the
+// cursor. Edited reviews get a new update time and are picked up again.
+func (g *GoogleMyBusiness) FetchSince(ctx context.Context, userID string, workspaceID uuid.UUID, cursor models.SyncCursor, fullScan bool) (*FetchResult, error) {
+	// Tokens are refreshed by the OAuth service, so a static source is enough here
+	token, err := g.oauthService.GetToken(userID, "google")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get google token: %w", err)
+	}
+	client := oauth2.NewClient(ctx, oauth2.StaticTokenSource(token))
+	client.Timeout = 10 * time.Second
+
+	locations, err := g.fetchLocations(ctx, client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch locations: %w", err)
 	}
 
+	scan := newReviewScan(cursor, fullScan)
 	var allTestimonials []models.Testimonial
+	complete := true
 	for _, location := range locations {
-		reviews, err := p.fetchReviews(ctx, location)
+		reviews, err := g.fetchReviews(ctx, client, location, workspaceID, scan)
 		if err != nil {
+			fmt.Printf("Error getting reviews for location %s: %v\n", location, err)
+			complete = false
 			continue
 		}
 		allTestimonials = append(allTestimonials, reviews...)
 	}
 
-	return allTestimonials, nil
+	return scan.result(cursor, allTestimonials, fullScan, complete), nil
 }
 
-func (g *GoogleMyBusiness) fetchLocations(ctx context.Context) ([]string, error) {
-	locationUrl := fmt.Sprintf("https://mybusiness.googleapis.com/v4/accounts/%s/locations", g.accountName)
-	req, err := http.NewRequestWithContext(ctx, "GET", locationUrl, nil)
-	if err != nil {
-		return nil, fmt.Errorf("google locations request failed: %w", err)
-	}
+func (g *GoogleMyBusiness) fetchLocations(ctx context.Context, client *http.Client) ([]string, error) {
+	var locationIDs []string
+	pageToken := ""
 
-	resp, err := g.client.Do(req.WithContext(ctx))
-	if err != nil {
-		return nil, fmt.Errorf("google API request failed: %w", err)
-	}
-	defer resp.Body.Close()
+	for {
+		locationUrl := fmt.Sprintf("https://mybusiness.googleapis.com/v4/accounts/%s/locations", g.accountName)
+		if pageToken != "" {
+			locationUrl += "?pageToken=" + url.QueryEscape(pageToken)
+		}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("google locations API returned status %d", resp.StatusCode)
-	}
+		req, err := http.NewRequestWithContext(ctx, "GET", locationUrl, nil)
+		if err != nil {
+			return nil, fmt.Errorf("google locations request failed: %w", err)
+		}
 
-	var locationsResponse LocationsResponse
-	if err := json.NewDecoder(resp.Body).Decode(&locationsResponse); err != nil {
-		return nil, fmt.Errorf("failed to decode google locations response: %w", err)
-	}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("google API request failed: %w", err)
+		}
 
-	locationIDs := make([]string, len(locationsResponse.Locations))
-	for _, location := range locationsResponse.Locations {
-		locationIDs = append(locationIDs, location.Name)
-	}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("google locations API returned status %d", resp.StatusCode)
+		}
 
-	return locationIDs, nil
-}
+		var locationsResponse LocationsResponse
+		err = json.NewDecoder(resp.Body).Decode(&locationsResponse)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode google locations response: %w", err)
+		}
 
-func (g *GoogleMyBusiness) fetchReviews(ctx context.Context, locationID string) ([]models.Testimonial, error) {
-	url := fmt.Sprintf("https://mybusiness.googleapis.com/v4/%s/reviews", locationID)
-	req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
-	resp, err := g.client.Do(req.WithContext(ctx))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	var result struct {
-		Reviews []struct {
-			ReviewID   string    `json:"reviewId"`
-			Comment    string    `json:"comment"`
-			StarRating float32   `json:"starRating"`
-			CreateTime time.Time `json:"createTime"`
-			Reviewer   struct {
-				DisplayName string `json:"displayName"`
-			} `json:"reviewer"`
-		} `json:"reviews"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode google reviews response: %w", err)
+		for _, location := range locationsResponse.Locations {
+			locationIDs = append(locationIDs, location.Name)
+		}
+
+		if locationsResponse.NextPageToken == "" {
+			return locationIDs, nil
+		}
+		pageToken = locationsResponse.NextPageToken
 	}
+}
 
-	// Convert to testimonial models
+func (g *GoogleMyBusiness) fetchReviews(ctx context.Context, client *http.Client, locationID string, workspaceID uuid.UUID, scan *reviewScan) ([]models.Testimonial, error) {
 	var testimonials []models.Testimonial
-	// for _, r := range result.Reviews {
-	// 	testimonials = append(testimonials, models.Testimonial{
-	// 		CustomerName: r.Reviewer.DisplayName,
-	// 		Content:      r.Comment,
-	// 		Rating:       &r.StarRating,
-	// 		SourceData: map[string]interface{}{
-	// 			"source":               g.Name(),
-	// 			"location":             locationID,
-	// 			"trustpilot_review_id": r.ReviewID,
-	// 		},
-	// 		CreatedAt: r.CreateTime,
-	// 	})
-	// }
-	return testimonials, nil
-}
+	query := url.Values{}
+	query.Set("orderBy", "updateTime desc")
+
+	for {
+		reviewsUrl := fmt.Sprintf("https://mybusiness.googleapis.com/v4/%s/reviews?%s", locationID, query.Encode())
 
-func (p *GoogleMyBusiness) Name() string {
-	return "google"
+		req, err := http.NewRequestWithContext(ctx, "GET", reviewsUrl, nil)
+		if err != nil {
+			return nil, fmt.Errorf("google reviews request failed: %w", err)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("google API request failed: %w", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("google reviews API returned status %d", resp.StatusCode)
+		}
+
+		var result struct {
+			Reviews []struct {
+				ReviewID   string    `json:"reviewId"`
+				Comment    string    `json:"comment"`
+				StarRating string    `json:"starRating"`
+				CreateTime time.Time `json:"createTime"`
+				UpdateTime time.Time `json:"updateTime"`
+				Reviewer   struct {
+					DisplayName     string `json:"displayName"`
+					ProfilePhotoURL string `json:"profilePhotoUrl"`
+				} `json:"reviewer"`
+			} `json:"reviews"`
+			NextPageToken string `json:"nextPageToken"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode google reviews response: %w", err)
+		}
+
+		for _, r := range result.Reviews {
+			// Everything after the first unchanged review is older still
+			if !scan.observe(r.ReviewID, modifiedAt(r.CreateTime, r.UpdateTime)) {
+				return testimonials, nil
+			}
+
+			// Google does not expose a stable reviewer ID, so the review itself identifies
+			// the reviewer to keep profiles from being duplicated on every sync.
+			profile, err := g.customerProfileRepo.GetOrCreate(
+				ctx,
+				contracts.ReviewerData{
+					Name:       r.Reviewer.DisplayName,
+					ExternalID: "google_review:" + r.ReviewID,
+				},
+				workspaceID,
+				"google",
+				g.db,
+			)
+			if err != nil {
+				fmt.Printf("Error creating customer profile: %v\n", err)
+				continue
+			}
+
+			testimonial := models.Testimonial{
+				WorkspaceID:       workspaceID,
+				CustomerProfileID: &profile.ID,
+				TestimonialType:   models.TestimonialTypeCustomer,
+				Format:            models.ContentFormatText,
+				Status:            models.StatusPendingReview,
+				Content:           r.Comment,
+				CollectionMethod:  models.CollectionMethodSocialImport,
+				SourceData: map[string]interface{}{
+					"platform":  "google",
+					"location":  locationID,
+					"review_id": r.ReviewID,
+				},
+				CreatedAt: r.CreateTime,
+				UpdatedAt: modifiedAt(r.CreateTime, r.UpdateTime),
+			}
+			if rating, ok := googleStarRatings[r.StarRating]; ok {
+				testimonial.Rating = &rating
+			}
+
+			testimonials = append(testimonials, testimonial)
+		}
+
+		if result.NextPageToken == "" {
+			return testimonials, nil
+		}
+		query.Set("pageToken", result.NextPageToken)
+	}
 }
 
 func (g *GoogleMyBusiness) IsConfigured() bool {
@@ -6,90 +6,315 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/contracts"
 	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
 )
 
+type instagramMedia struct {
+	ID        string `json:"id"`
+	Permalink string `json:"permalink"`
+	Username  string `json:"username"`
+}
+
 type InstagramProvider struct {
-	accessToken string
-	userID      string
-	httpClient  *http.Client
+	accessToken         string
+	userID              string
+	httpClient          *http.Client
+	oauthService        contracts.OAuthService
+	sentimentService    contracts.SentimentService
+	customerProfileRepo repositories.CustomerProfileRepository
+	db                  repositories.DB
+}
+
+var instagramDefinition = Definition{
+	Name:        "instagram",
+	DisplayName: "Instagram",
+	AuthType:    AuthTypeOAuth,
+	// Both values are optional: without them the connected user's OAuth token and
+	// linked business account are used.
+	Credentials: []CredentialField{
+		{Key: "accessToken", Label: "Access Token", Secret: true},
+		{Key: "instagramUserID", Label: "Instagram Business Account ID"},
+	},
+	RateLimit:       200, // 200/hr
+	RateWindow:      time.Hour,
+	DefaultSchedule: "@hourly",
+	Factory: func(credentials map[string]string, deps Dependencies) Provider {
+		return NewInstagramProvider(
+			credentials["accessToken"],
+			credentials["instagramUserID"],
+			deps.OAuthService,
+			deps.SentimentService,
+			deps.CustomerProfileRepo,
+			deps.DB,
+		)
+	},
+}
+
+func init() {
+	register(instagramDefinition)
 }
 
-func NewInstagramProvider(accessToken, userID string) *InstagramProvider {
+// NewInstagramProvider creates a provider for an Instagram business account. When
+// accessToken is empty the connecting user's OAuth token is used, and when userID is
+// empty the business account linked to the user's first Facebook page is used.
+func NewInstagramProvider(
+	accessToken string,
+	userID string,
+	oauthService contracts.OAuthService,
+	sentimentService contracts.SentimentService,
+	customerProfileRepo repositories.CustomerProfileRepository,
+	db repositories.DB,
+) *InstagramProvider {
 	return &InstagramProvider{
 		accessToken: accessToken,
 		userID:      userID,
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
+		oauthService:        oauthService,
+		sentimentService:    sentimentService,
+		customerProfileRepo: customerProfileRepo,
+		db:                  db,
 	}
 }
 
-func (p *InstagramProvider) Fetch(ctx context.Context) ([]models.Testimonial, error) {
-	url := fmt.Sprintf("https://graph.instagram.com/v19.0/%s/media?access_token=%s",
-		p.userID,
-		p.accessToken,
-	)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+// Fetch collects comments left by other users on the account's media and keeps the
+// ones with positive sentiment.
+func (p *InstagramProvider) Fetch(ctx context.Context, userID string, workspaceID uuid.UUID) ([]models.Testimonial, error) {
+	result, err := p.FetchSince(ctx, userID, workspaceID, models.SyncCursor{}, false)
 	if err != nil {
-		return nil, fmt.Errorf("instagram request creation failed: %w", err)
+		return nil, err
+	}
+	return result.Testimonials, nil
+}
+
+// FetchSince imports the comments written since the cursor. New comments can arrive on
+// old media and the comments edge has no time filter, so every media item is still
+// listed, but only new comments are analyzed. Instagram comments cannot be edited.
+func (p *InstagramProvider) FetchSince(ctx context.Context, userID string, workspaceID uuid.UUID, cursor models.SyncCursor, fullScan bool) (*FetchResult, error) {
+	accessToken := p.accessToken
+	if accessToken == "" {
+		token, err := p.oauthService.GetToken(userID, "instagram")
+		if err != nil {
+			return nil, fmt.Errorf("failed to get instagram token: %w", err)
+		}
+		accessToken = token.AccessToken
+	}
+
+	igUserID := p.userID
+	if igUserID == "" {
+		discovered, err := p.getBusinessAccountID(ctx, accessToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find instagram business account: %w", err)
+		}
+		igUserID = discovered
 	}
 
-	resp, err := p.httpClient.Do(req)
+	mediaUrl := fmt.Sprintf("%s/%s/media?fields=id,permalink,username&access_token=%s",
+		facebookGraphURL, igUserID, url.QueryEscape(accessToken))
+
+	var mediaItems []instagramMedia
+	err := p.walkEdge(ctx, mediaUrl, func(item json.RawMessage) error {
+		var media instagramMedia
+		if err := json.Unmarshal(item, &media); err != nil {
+			return err
+		}
+		mediaItems = append(mediaItems, media)
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("instagram API request failed: %w", err)
+		return nil, fmt.Errorf("failed to fetch instagram media: %w", err)
 	}
-	defer resp.Body.Close()
 
-	var result struct {
-		Data []struct {
-			ID        string    `json:"id"`
-			Caption   string    `json:"caption"`
-			MediaType string    `json:"media_type"`
-			MediaURL  string    `json:"media_url"`
-			Timestamp time.Time `json:"timestamp"`
-			Username  string    `json:"username"`
-		} `json:"data"`
+	scan := newReviewScan(cursor, fullScan)
+	var testimonials []models.Testimonial
+	complete := true
+	for _, media := range mediaItems {
+		comments, err := p.getMediaComments(ctx, media, accessToken, workspaceID, scan)
+		if err != nil {
+			// Log error but continue with other media
+			fmt.Printf("Error getting comments for media %s: %v\n", media.ID, err)
+			complete = false
+			continue
+		}
+		testimonials = append(testimonials, comments...)
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode instagram response: %w", err)
+	return scan.result(cursor, testimonials, fullScan, complete), nil
+}
+
+// getBusinessAccountID returns the Instagram business account linked to the first of
+// the user's Facebook pages that has one.
+func (p *InstagramProvider) getBusinessAccountID(ctx context.Context, accessToken string) (string, error) {
+	accountsUrl := fmt.Sprintf("%s/me/accounts?fields=instagram_business_account&access_token=%s",
+		facebookGraphURL, url.QueryEscape(accessToken))
+
+	var accountID string
+	err := p.walkEdge(ctx, accountsUrl, func(item json.RawMessage) error {
+		var page struct {
+			InstagramBusinessAccount struct {
+				ID string `json:"id"`
+			} `json:"instagram_business_account"`
+		}
+		if err := json.Unmarshal(item, &page); err != nil {
+			return err
+		}
+		if accountID == "" {
+			accountID = page.InstagramBusinessAccount.ID
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if accountID == "" {
+		return "", fmt.Errorf("no facebook page is linked to an instagram business account")
 	}
 
+	return accountID, nil
+}
+
+func (p *InstagramProvider) getMediaComments(ctx context.Context, media instagramMedia, accessToken string, workspaceID uuid.UUID, scan *reviewScan) ([]models.Testimonial, error) {
+	commentsUrl := fmt.Sprintf("%s/%s/comments?fields=id,text,timestamp,username,from&access_token=%s",
+		facebookGraphURL, media.ID, url.QueryEscape(accessToken))
+
 	var testimonials []models.Testimonial
-	// for _, media := range result.Data {
-	// 	t := models.Testimonial{
-	// 		Type:      models.TestimonialTypeImage,
-	// 		Content:   media.Caption,
-	// 		CreatedAt: media.Timestamp,
-	// 		SourceData: map[string]interface{}{
-	// 			"media_id":   media.ID,
-	// 			"media_type": media.MediaType,
-	// 			"platform":   "instagram",
-	// 			"source":     p.Name(),
-	// 		},
-	// 		CollectionMethod: models.CollectionAPI,
-	// 	}
-
-	// 	if media.MediaType == "VIDEO" {
-	// 		t.Type = models.TestimonialTypeVideo
-	// 	}
-
-	// 	t.MediaURLs = append(t.MediaURLs, media.MediaURL)
-	// 	testimonials = append(testimonials, t)
-	// }
+	err := p.walkEdge(ctx, commentsUrl, func(item json.RawMessage) error {
+		var comment struct {
+			ID        string    `json:"id"`
+			Text      string    `json:"text"`
+			Timestamp graphTime `json:"timestamp"`
+			Username  string    `json:"username"`
+			From      struct {
+				ID       string `json:"id"`
+				Username string `json:"username"`
+			} `json:"from"`
+		}
+		if err := json.Unmarshal(item, &comment); err != nil {
+			return err
+		}
+
+		changed := scan.observe(comment.ID, comment.Timestamp.Time)
+
+		// Skip comments seen by earlier syncs, the account's own replies and empty comments
+		if !changed || comment.Text == "" || comment.Username == media.Username {
+			return nil
+		}
+
+		sentiment, err := p.sentimentService.AnalyzeText(comment.Text)
+		if err != nil {
+			fmt.Printf("Error analyzing sentiment: %v\n", err)
+			return nil
+		}
+		if sentiment < minTestimonialSentiment {
+			return nil
+		}
+
+		externalID := comment.From.ID
+		if externalID == "" {
+			externalID = comment.Username
+		}
+
+		profile, err := p.customerProfileRepo.GetOrCreate(
+			ctx,
+			contracts.ReviewerData{
+				Name:       comment.Username,
+				ExternalID: externalID,
+			},
+			workspaceID,
+			"instagram",
+			p.db,
+		)
+		if err != nil {
+			fmt.Printf("Error creating customer profile: %v\n", err)
+			return nil
+		}
+
+		rating := sentimentToRating(sentiment)
+		testimonials = append(testimonials, models.Testimonial{
+			WorkspaceID:       workspaceID,
+			CustomerProfileID: &profile.ID,
+			TestimonialType:   models.TestimonialTypeCustomer,
+			Format:            models.ContentFormatSocialPost,
+			Status:            models.StatusPendingReview,
+			Content:           comment.Text,
+			Rating:            &rating,
+			CollectionMethod:  models.CollectionMethodSocialImport,
+			SourceData: map[string]interface{}{
+				"platform":  "instagram",
+				"media_id":  media.ID,
+				"permalink": media.Permalink,
+				"review_id": comment.ID,
+			},
+			CreatedAt: comment.Timestamp.Time,
+			UpdatedAt: comment.Timestamp.Time,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return testimonials, nil
 }
 
+// walkEdge requests a Graph API edge and follows its paging links until exhausted.
+func (p *InstagramProvider) walkEdge(ctx context.Context, url string, each func(item json.RawMessage) error) error {
+	for url != "" {
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return fmt.Errorf("instagram request creation failed: %w", err)
+		}
+
+		resp, err := p.httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("instagram API request failed: %w", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return fmt.Errorf("instagram API returned status %d", resp.StatusCode)
+		}
+
+		var result struct {
+			Data   []json.RawMessage `json:"data"`
+			Paging struct {
+				Next string `json:"next"`
+			} `json:"paging"`
+		}
+
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to decode instagram response: %w", err)
+		}
+
+		for _, item := range result.Data {
+			if err := each(item); err != nil {
+				return fmt.Errorf("failed to decode instagram item: %w", err)
+			}
+		}
+
+		url = result.Paging.Next
+	}
+
+	return nil
+}
+
 func (p *InstagramProvider) Name() string {
-	return "instagram"
+	return instagramDefinition.Name
 }
 
-func (i *InstagramProvider) RateLimit() int            { return 200 } // 200/hr
-func (i *InstagramProvider) RateWindow() time.Duration { return time.Hour }
-func (i *InstagramProvider) Schedule() string          { return "@hourly" }
-func (i *InstagramProvider) IsConfigured() bool        { return i.accessToken != "" && i.userID != "" }
+func (i *InstagramProvider) RateLimit() int            { return instagramDefinition.RateLimit }
+func (i *InstagramProvider) RateWindow() time.Duration { return instagramDefinition.RateWindow }
+func (i *InstagramProvider) Schedule() string          { return instagramDefinition.DefaultSchedule }
+
+// IsConfigured reports whether the provider can run without a connected user; accounts
+// connected through OAuth are built per workspace instead.
+func (i *InstagramProvider) IsConfigured() bool { return i.accessToken != "" && i.userID != "" }
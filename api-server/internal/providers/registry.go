@@ -0,0 +1,152 @@
+// internal/providers/registry.go
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/contracts"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+)
+
+// AuthType describes how a workspace connects a provider.
+type AuthType string
+
+const (
+	// AuthTypeOAuth providers fetch with the connecting user's OAuth token.
+	AuthTypeOAuth AuthType = "oauth"
+	// AuthTypeAPIKey providers fetch with keys supplied by the workspace.
+	AuthTypeAPIKey AuthType = "api_key"
+)
+
+// CredentialField is a single value the UI must collect to connect a provider.
+type CredentialField struct {
+	Key      string `json:"key"`
+	Label    string `json:"label"`
+	Required bool   `json:"required"`
+	// Secret fields should be masked when entered and never echoed back.
+	Secret bool `json:"secret"`
+}
+
+// Dependencies are the shared services a factory may hand to the provider it builds.
+type Dependencies struct {
+	OAuthService        contracts.OAuthService
+	SentimentService    contracts.SentimentService
+	CustomerProfileRepo repositories.CustomerProfileRepository
+	DB                  repositories.DB
+}
+
+// Factory builds a provider from a workspace's credentials. Required credentials have
+// already been validated against the definition when it is called.
+type Factory func(credentials map[string]string, deps Dependencies) Provider
+
+// Definition describes a provider that workspaces can connect.
+type Definition struct {
+	Name            string            `json:"name"`
+	DisplayName     string            `json:"display_name"`
+	AuthType        AuthType          `json:"auth_type"`
+	Credentials     []CredentialField `json:"credentials"`
+	RateLimit       int               `json:"rate_limit"`
+	RateWindow      time.Duration     `json:"-"`
+	DefaultSchedule string            `json:"default_schedule"`
+	Factory         Factory           `json:"-"`
+}
+
+// MarshalJSON reports the rate window in seconds rather than nanoseconds.
+func (d Definition) MarshalJSON() ([]byte, error) {
+	type definition Definition
+	return json.Marshal(struct {
+		definition
+		RateWindowSeconds int64 `json:"rate_window_seconds"`
+	}{
+		definition:        definition(d),
+		RateWindowSeconds: int64(d.RateWindow / time.Second),
+	})
+}
+
+// Validate checks that every required credential is present.
+func (d Definition) Validate(credentials map[string]string) error {
+	for _, field := range d.Credentials {
+		if field.Required && credentials[field.Key] == "" {
+			return fmt.Errorf("%w: missing %s", apperrors.ErrValidationFailed, field.Key)
+		}
+	}
+	return nil
+}
+
+// Registry holds the definitions of every provider that can be connected.
+type Registry struct {
+	mu          sync.RWMutex
+	definitions map[string]Definition
+}
+
+func NewRegistry() *Registry {
+	return &Registry{definitions: make(map[string]Definition)}
+}
+
+// Register adds a provider definition. Names must be unique.
+func (r *Registry) Register(def Definition) error {
+	if def.Name == "" || def.Factory == nil {
+		return fmt.Errorf("provider definition requires a name and a factory")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.definitions[def.Name]; exists {
+		return fmt.Errorf("provider %q is already registered", def.Name)
+	}
+	r.definitions[def.Name] = def
+	return nil
+}
+
+func (r *Registry) Get(name string) (Definition, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	def, ok := r.definitions[name]
+	return def, ok
+}
+
+// List returns every registered definition ordered by name.
+func (r *Registry) List() []Definition {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	defs := make([]Definition, 0, len(r.definitions))
+	for _, def := range r.definitions {
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Name < defs[j].Name })
+	return defs
+}
+
+// Build validates credentials against the provider's schema and builds it.
+func (r *Registry) Build(name string, credentials map[string]string, deps Dependencies) (Provider, error) {
+	def, ok := r.Get(name)
+	if !ok {
+		return nil, apperrors.ErrProviderNotFound
+	}
+	if err := def.Validate(credentials); err != nil {
+		return nil, err
+	}
+	return def.Factory(credentials, deps), nil
+}
+
+var defaultRegistry = NewRegistry()
+
+// DefaultRegistry returns the registry the built-in providers register themselves with.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// register adds a built-in provider to the default registry during package init.
+func register(def Definition) {
+	if err := defaultRegistry.Register(def); err != nil {
+		panic(err)
+	}
+}
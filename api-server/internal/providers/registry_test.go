@@ -0,0 +1,53 @@
+package providers
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Run("BuildValidatesCredentials", func(t *testing.T) {
+		r := NewRegistry()
+		require.NoError(t, r.Register(yelpDefinition))
+
+		_, err := r.Build("yelp", map[string]string{"apiKey": "key"}, Dependencies{})
+		assert.ErrorIs(t, err, apperrors.ErrValidationFailed)
+
+		provider, err := r.Build("yelp", map[string]string{"apiKey": "key", "businessID": "biz"}, Dependencies{})
+		require.NoError(t, err)
+		assert.Equal(t, "yelp", provider.Name())
+		assert.True(t, provider.IsConfigured())
+
+		_, err = r.Build("myspace", nil, Dependencies{})
+		assert.ErrorIs(t, err, apperrors.ErrProviderNotFound)
+	})
+
+	t.Run("RejectsDuplicates", func(t *testing.T) {
+		r := NewRegistry()
+		require.NoError(t, r.Register(yelpDefinition))
+		assert.Error(t, r.Register(yelpDefinition))
+	})
+
+	t.Run("DefaultRegistryListsBuiltins", func(t *testing.T) {
+		var names []string
+		for _, def := range DefaultRegistry().List() {
+			names = append(names, def.Name)
+		}
+		assert.Equal(t, []string{"facebook", "google", "instagram", "trustpilot", "twitter", "yelp"}, names)
+	})
+
+	t.Run("MarshalsRateWindowInSeconds", func(t *testing.T) {
+		data, err := json.Marshal(Definition{Name: "yelp", RateWindow: 15 * time.Minute})
+		require.NoError(t, err)
+
+		var out map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &out))
+		assert.Equal(t, float64(900), out["rate_window_seconds"])
+		assert.Equal(t, "yelp", out["name"])
+	})
+}
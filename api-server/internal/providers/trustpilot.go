@@ -6,85 +6,190 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/contracts"
 	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
 )
 
+// trustpilotPageSize is the largest page the public reviews endpoint allows.
+const trustpilotPageSize = 100
+
 type TrustpilotProvider struct {
-	apiKey     string
-	businessID string
-	httpClient *http.Client
+	apiKey              string
+	businessID          string
+	httpClient          *http.Client
+	customerProfileRepo repositories.CustomerProfileRepository
+	db                  repositories.DB
+}
+
+var trustpilotDefinition = Definition{
+	Name:        "trustpilot",
+	DisplayName: "Trustpilot",
+	AuthType:    AuthTypeAPIKey,
+	Credentials: []CredentialField{
+		{Key: "apiKey", Label: "API Key", Required: true, Secret: true},
+		{Key: "businessID", Label: "Business Unit ID", Required: true},
+	},
+	RateLimit:       100, // 100/min
+	RateWindow:      time.Minute,
+	DefaultSchedule: "@hourly",
+	Factory: func(credentials map[string]string, deps Dependencies) Provider {
+		return NewTrustpilotProvider(credentials["apiKey"], credentials["businessID"], deps.CustomerProfileRepo, deps.DB)
+	},
 }
 
-func NewTrustpilotProvider(apiKey, businessID string) *TrustpilotProvider {
+func init() {
+	register(trustpilotDefinition)
+}
+
+func NewTrustpilotProvider(
+	apiKey string,
+	businessID string,
+	customerProfileRepo repositories.CustomerProfileRepository,
+	db repositories.DB,
+) *TrustpilotProvider {
 	return &TrustpilotProvider{
 		apiKey:     apiKey,
 		businessID: businessID,
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
+		customerProfileRepo: customerProfileRepo,
+		db:                  db,
 	}
 }
 
-func (p *TrustpilotProvider) Fetch(ctx context.Context) ([]models.Testimonial, error) {
-	url := fmt.Sprintf("https://api.trustpilot.com/v1/business-units/%s/reviews", p.businessID)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+// Fetch uses the business unit API key, so userID is not needed to authenticate.
+func (p *TrustpilotProvider) Fetch(ctx context.Context, userID string, workspaceID uuid.UUID) ([]models.Testimonial, error) {
+	result, err := p.FetchSince(ctx, userID, workspaceID, models.SyncCursor{}, false)
 	if err != nil {
-		return nil, fmt.Errorf("trustpilot request creation failed: %w", err)
+		return nil, err
 	}
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", p.apiKey))
-	req.Header.Set("Accept", "application/json")
+	return result.Testimonials, nil
+}
 
-	resp, err := p.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("trustpilot API request failed: %w", err)
-	}
-	defer resp.Body.Close()
+// FetchSince imports the reviews created or edited since the cursor. The reviews API
+// cannot filter or sort by update time, so every page is still listed; only the
+// changed reviews are imported.
+func (p *TrustpilotProvider) FetchSince(ctx context.Context, userID string, workspaceID uuid.UUID, cursor models.SyncCursor, fullScan bool) (*FetchResult, error) {
+	scan := newReviewScan(cursor, fullScan)
+	var testimonials []models.Testimonial
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("trustpilot API returned status %d", resp.StatusCode)
-	}
+	for page := 1; ; page++ {
+		query := url.Values{}
+		query.Set("apikey", p.apiKey)
+		query.Set("page", fmt.Sprint(page))
+		query.Set("perPage", fmt.Sprint(trustpilotPageSize))
+		reviewsUrl := fmt.Sprintf("https://api.trustpilot.com/v1/business-units/%s/reviews?%s", p.businessID, query.Encode())
 
-	var result struct {
-		Reviews []struct {
-			ID        string    `json:"id"`
-			Text      string    `json:"text"`
-			Rating    float32   `json:"stars"`
-			CreatedAt time.Time `json:"createdAt"`
-			Consumer  struct {
-				DisplayName string `json:"displayName"`
-			} `json:"consumer"`
-		} `json:"reviews"`
-	}
+		req, err := http.NewRequestWithContext(ctx, "GET", reviewsUrl, nil)
+		if err != nil {
+			return nil, fmt.Errorf("trustpilot request creation failed: %w", err)
+		}
+		req.Header.Set("Accept", "application/json")
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode trustpilot response: %w", err)
+		resp, err := p.httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("trustpilot API request failed: %w", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("trustpilot API returned status %d", resp.StatusCode)
+		}
+
+		var result struct {
+			Reviews []struct {
+				ID        string    `json:"id"`
+				Title     string    `json:"title"`
+				Text      string    `json:"text"`
+				Stars     int       `json:"stars"`
+				CreatedAt time.Time `json:"createdAt"`
+				UpdatedAt time.Time `json:"updatedAt"`
+				Consumer  struct {
+					ID          string `json:"id"`
+					DisplayName string `json:"displayName"`
+				} `json:"consumer"`
+			} `json:"reviews"`
+			Links []trustpilotLink `json:"links"`
+		}
+
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode trustpilot response: %w", err)
+		}
+
+		for _, review := range result.Reviews {
+			updatedAt := modifiedAt(review.CreatedAt, review.UpdatedAt)
+			if !scan.observe(review.ID, updatedAt) {
+				continue
+			}
+
+			profile, err := p.customerProfileRepo.GetOrCreate(
+				ctx,
+				contracts.ReviewerData{
+					Name:       review.Consumer.DisplayName,
+					ExternalID: review.Consumer.ID,
+				},
+				workspaceID,
+				"trustpilot",
+				p.db,
+			)
+			if err != nil {
+				fmt.Printf("Error creating customer profile: %v\n", err)
+				continue
+			}
+
+			rating := float32(review.Stars)
+			testimonials = append(testimonials, models.Testimonial{
+				WorkspaceID:       workspaceID,
+				CustomerProfileID: &profile.ID,
+				TestimonialType:   models.TestimonialTypeCustomer,
+				Format:            models.ContentFormatText,
+				Status:            models.StatusPendingReview,
+				Title:             review.Title,
+				Content:           review.Text,
+				Rating:            &rating,
+				CollectionMethod:  models.CollectionMethodSocialImport,
+				SourceData: map[string]interface{}{
+					"platform":    "trustpilot",
+					"business_id": p.businessID,
+					"review_id":   review.ID,
+				},
+				CreatedAt: review.CreatedAt,
+				UpdatedAt: updatedAt,
+			})
+		}
+
+		if !hasTrustpilotNextPage(result.Links) {
+			return scan.result(cursor, testimonials, fullScan, true), nil
+		}
 	}
+}
 
-	var testimonials []models.Testimonial
-	// for _, review := range result.Reviews {
-	// 	testimonials = append(testimonials, models.Testimonial{
-	// 		CustomerName: review.Consumer.DisplayName,
-	// 		Content:      review.Text,
-	// 		Rating:       &review.Rating,
-	// 		SourceData: map[string]interface{}{
-	// 			"source":               p.Name(),
-	// 			"trustpilot_review_id": review.ID,
-	// 		},
-	// 		CreatedAt: review.CreatedAt,
-	// 	})
-	// }
-
-	return testimonials, nil
+type trustpilotLink struct {
+	Rel string `json:"rel"`
+}
+
+func hasTrustpilotNextPage(links []trustpilotLink) bool {
+	for _, link := range links {
+		if link.Rel == "next-page" {
+			return true
+		}
+	}
+	return false
 }
 
 func (p *TrustpilotProvider) Name() string {
-	return "trustpilot"
+	return trustpilotDefinition.Name
 }
 
-func (t *TrustpilotProvider) RateLimit() int            { return 100 } // 100/min
-func (t *TrustpilotProvider) RateWindow() time.Duration { return time.Minute }
-func (t *TrustpilotProvider) Schedule() string          { return "@hourly" }
+func (t *TrustpilotProvider) RateLimit() int            { return trustpilotDefinition.RateLimit }
+func (t *TrustpilotProvider) RateWindow() time.Duration { return trustpilotDefinition.RateWindow }
+func (t *TrustpilotProvider) Schedule() string          { return trustpilotDefinition.DefaultSchedule }
 func (t *TrustpilotProvider) IsConfigured() bool        { return t.apiKey != "" && t.businessID != "" }
@@ -7,18 +7,25 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/g8rswimmer/go-twitter/v2"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/contracts"
 	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
 )
 
 type TwitterProvider struct {
-	apiKey      string
-	apiSecret   string
-	bearerToken string
-	username    string
-	httpClient  *http.Client
+	apiKey              string
+	apiSecret           string
+	bearerToken         string
+	username            string
+	httpClient          *http.Client
+	sentimentService    contracts.SentimentService
+	customerProfileRepo repositories.CustomerProfileRepository
+	db                  repositories.DB
 }
 
 // Methods belonging to the go-twitter package
@@ -30,70 +37,223 @@ func (a authorize) Add(req *http.Request) {
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", a.Token))
 }
 
-func NewTwitterProvider(bearerToken, username, apiKey, apiSecret string) *TwitterProvider {
+var twitterDefinition = Definition{
+	Name:        "twitter",
+	DisplayName: "X (Twitter)",
+	AuthType:    AuthTypeAPIKey,
+	Credentials: []CredentialField{
+		{Key: "bearerToken", Label: "Bearer Token", Required: true, Secret: true},
+		{Key: "username", Label: "Account Username", Required: true},
+		{Key: "apiKey", Label: "API Key"},
+		{Key: "apiSecret", Label: "API Secret", Secret: true},
+	},
+	RateLimit:       450, // Twitter v2 API allows 450 requests per 15-minute window
+	RateWindow:      15 * time.Minute,
+	DefaultSchedule: "*/5 * * * *", // Every 5 minutes
+	Factory: func(credentials map[string]string, deps Dependencies) Provider {
+		return NewTwitterProvider(
+			credentials["bearerToken"],
+			credentials["username"],
+			credentials["apiKey"],
+			credentials["apiSecret"],
+			deps.SentimentService,
+			deps.CustomerProfileRepo,
+			deps.DB,
+		)
+	},
+}
+
+func init() {
+	register(twitterDefinition)
+}
+
+func NewTwitterProvider(
+	bearerToken string,
+	username string,
+	apiKey string,
+	apiSecret string,
+	sentimentService contracts.SentimentService,
+	customerProfileRepo repositories.CustomerProfileRepository,
+	db repositories.DB,
+) *TwitterProvider {
 	return &TwitterProvider{
 		bearerToken: bearerToken,
 		username:    username,
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
-		apiKey:    apiKey,
-		apiSecret: apiSecret,
+		apiKey:              apiKey,
+		apiSecret:           apiSecret,
+		sentimentService:    sentimentService,
+		customerProfileRepo: customerProfileRepo,
+		db:                  db,
 	}
 }
 
 func (t *TwitterProvider) Name() string {
-	return "twitter"
+	return twitterDefinition.Name
 }
 
-func (t *TwitterProvider) Fetch(ctx context.Context) ([]models.Testimonial, error) {
-	url := fmt.Sprintf("https://api.x.com/2/tweets/search/recent?query=from:%s", t.username)
+// twitterSearchWindow is how far back recent search reaches. Older since_id values are
+// rejected, so syncs that fell behind search the whole window again.
+const twitterSearchWindow = 7*24*time.Hour - time.Hour
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+// Fetch searches recent tweets that mention the account, excluding its own tweets and
+// retweets, and keeps the ones with positive sentiment. It authenticates with the app
+// bearer token, so userID is not needed.
+func (t *TwitterProvider) Fetch(ctx context.Context, userID string, workspaceID uuid.UUID) ([]models.Testimonial, error) {
+	result, err := t.FetchSince(ctx, userID, workspaceID, models.SyncCursor{}, false)
 	if err != nil {
-		return nil, fmt.Errorf("twitter request creation failed: %w", err)
+		return nil, err
 	}
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", t.bearerToken))
+	return result.Testimonials, nil
+}
 
-	resp, err := t.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("twitter API request failed: %w", err)
+// FetchSince only searches tweets newer than the last one seen, kept in cursor.Token.
+// Tweets cannot be edited in place, so nothing older needs fetching again. Recent
+// search only reaches back a week, so it cannot list every live tweet: fullScan is
+// ignored and no LiveIDs are reported, which leaves deleted tweets in place.
+func (t *TwitterProvider) FetchSince(ctx context.Context, userID string, workspaceID uuid.UUID, cursor models.SyncCursor, fullScan bool) (*FetchResult, error) {
+	query := url.Values{}
+	query.Set("query", fmt.Sprintf("@%s -from:%s -is:retweet", t.username, t.username))
+	query.Set("max_results", "100")
+	query.Set("expansions", "author_id")
+	query.Set("tweet.fields", "created_at,author_id")
+	query.Set("user.fields", "name,username,created_at")
+	if cursor.Token != "" && time.Since(cursor.Since) < twitterSearchWindow {
+		query.Set("since_id", cursor.Token)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("twitter API returned status %d", resp.StatusCode)
-	}
+	result := &FetchResult{Cursor: cursor}
+	nextToken := ""
 
-	var result struct {
-		Data []struct {
-			ID        string    `json:"id"`
-			Text      string    `json:"text"`
-			CreatedAt time.Time `json:"created_at"`
-			AuthorID  string    `json:"author_id"`
-		} `json:"data"`
-	}
+	for {
+		if nextToken != "" {
+			query.Set("next_token", nextToken)
+		}
+		searchUrl := "https://api.x.com/2/tweets/search/recent?" + query.Encode()
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode twitter response: %w", err)
-	}
+		req, err := http.NewRequestWithContext(ctx, "GET", searchUrl, nil)
+		if err != nil {
+			return nil, fmt.Errorf("twitter request creation failed: %w", err)
+		}
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", t.bearerToken))
+
+		resp, err := t.httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("twitter API request failed: %w", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("twitter API returned status %d", resp.StatusCode)
+		}
 
-	var testimonials []models.Testimonial
-	// for _, tweet := range result.Data {
-	// 	testimonials = append(testimonials, models.Testimonial{
-	// 		Content:   tweet.Text,
-	// 		CreatedAt: tweet.CreatedAt,
-	// 		Type:      models.TestimonialTypeText,
-	// 		SourceData: map[string]interface{}{
-	// 			"tweet_id":  tweet.ID,
-	// 			"author_id": tweet.AuthorID,
-	// 			"source":    t.Name(),
-	// 		},
-	// 		CollectionMethod: models.CollectionAPI,
-	// 	})
-	// }
-
-	return testimonials, nil
+		var page struct {
+			Data []struct {
+				ID        string    `json:"id"`
+				Text      string    `json:"text"`
+				CreatedAt time.Time `json:"created_at"`
+				AuthorID  string    `json:"author_id"`
+			} `json:"data"`
+			Includes struct {
+				Users []struct {
+					ID        string    `json:"id"`
+					Name      string    `json:"name"`
+					Username  string    `json:"username"`
+					CreatedAt time.Time `json:"created_at"`
+				} `json:"users"`
+			} `json:"includes"`
+			Meta struct {
+				NewestID  string `json:"newest_id"`
+				NextToken string `json:"next_token"`
+			} `json:"meta"`
+		}
+
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode twitter response: %w", err)
+		}
+
+		// Results are newest first, so the first page holds the next cursor
+		if nextToken == "" && page.Meta.NewestID != "" {
+			result.Cursor.Token = page.Meta.NewestID
+		}
+
+		authors := make(map[string]contracts.ReviewerData, len(page.Includes.Users))
+		accountCreated := make(map[string]time.Time, len(page.Includes.Users))
+		for _, user := range page.Includes.Users {
+			authors[user.ID] = contracts.ReviewerData{
+				Name:       user.Name,
+				ExternalID: user.ID,
+			}
+			accountCreated[user.ID] = user.CreatedAt
+		}
+
+		for _, tweet := range page.Data {
+			if tweet.CreatedAt.After(result.Cursor.Since) {
+				result.Cursor.Since = tweet.CreatedAt
+			}
+
+			sentiment, err := t.sentimentService.AnalyzeText(tweet.Text)
+			if err != nil {
+				fmt.Printf("Error analyzing sentiment: %v\n", err)
+				continue
+			}
+			if sentiment < minTestimonialSentiment {
+				continue
+			}
+
+			reviewer, ok := authors[tweet.AuthorID]
+			if !ok {
+				reviewer = contracts.ReviewerData{ExternalID: tweet.AuthorID}
+			}
+
+			profile, err := t.customerProfileRepo.GetOrCreate(
+				ctx,
+				reviewer,
+				workspaceID,
+				"twitter",
+				t.db,
+			)
+			if err != nil {
+				fmt.Printf("Error creating customer profile: %v\n", err)
+				continue
+			}
+
+			sourceData := map[string]interface{}{
+				"platform":  "twitter",
+				"author_id": tweet.AuthorID,
+				"tweet_id":  tweet.ID,
+				"review_id": tweet.ID,
+			}
+			// The authenticity check treats new accounts as a risk
+			if createdAt := accountCreated[tweet.AuthorID]; !createdAt.IsZero() {
+				sourceData[models.SourceDataAccountCreatedAt] = createdAt.Format(time.RFC3339)
+			}
+
+			rating := sentimentToRating(sentiment)
+			result.Testimonials = append(result.Testimonials, models.Testimonial{
+				WorkspaceID:       workspaceID,
+				CustomerProfileID: &profile.ID,
+				TestimonialType:   models.TestimonialTypeCustomer,
+				Format:            models.ContentFormatSocialPost,
+				Status:            models.StatusPendingReview,
+				Content:           tweet.Text,
+				Rating:            &rating,
+				CollectionMethod:  models.CollectionMethodSocialImport,
+				SourceData:        sourceData,
+				CreatedAt:         tweet.CreatedAt,
+				UpdatedAt:         tweet.CreatedAt,
+			})
+		}
+
+		if page.Meta.NextToken == "" {
+			return result, nil
+		}
+		nextToken = page.Meta.NextToken
+	}
 }
 
 func (t *TwitterProvider) FetchViaGoTwitter(ctx context.Context) {
@@ -122,15 +282,15 @@ func (t *TwitterProvider) FetchViaGoTwitter(ctx context.Context) {
 }
 
 func (t *TwitterProvider) RateLimit() int {
-	return 450 // Twitter v2 API allows 450 requests per 15-minute window
+	return twitterDefinition.RateLimit
 }
 
 func (t *TwitterProvider) RateWindow() time.Duration {
-	return 15 * time.Minute
+	return twitterDefinition.RateWindow
 }
 
 func (t *TwitterProvider) Schedule() string {
-	return "*/5 * * * *" // Every 5 minutes
+	return twitterDefinition.DefaultSchedule
 }
 
 func (t *TwitterProvider) IsConfigured() bool {
@@ -8,31 +8,74 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/contracts"
 	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
 )
 
+// yelpTimeLayout is the format of time_created in the Yelp Fusion API.
+const yelpTimeLayout = "2006-01-02 15:04:05"
+
+// YelpProvider imports a business's reviews from the Yelp Fusion API. The API only
+// returns excerpts of the three newest reviews, with no paging or time filter, so Yelp
+// is not an IncrementalProvider: every sync fetches those three again, and because the
+// rest of the history is never listed, deleted reviews cannot be detected.
 type YelpProvider struct {
-	apiKey     string
-	businessID string
-	httpClient *http.Client
+	apiKey              string
+	businessID          string
+	httpClient          *http.Client
+	customerProfileRepo repositories.CustomerProfileRepository
+	db                  repositories.DB
+}
+
+var yelpDefinition = Definition{
+	Name:        "yelp",
+	DisplayName: "Yelp",
+	AuthType:    AuthTypeAPIKey,
+	Credentials: []CredentialField{
+		{Key: "apiKey", Label: "API Key", Required: true, Secret: true},
+		{Key: "businessID", Label: "Business ID", Required: true},
+	},
+	RateLimit:       5000, // Daily limit
+	RateWindow:      24 * time.Hour,
+	DefaultSchedule: "@daily",
+	Factory: func(credentials map[string]string, deps Dependencies) Provider {
+		return NewYelpProvider(credentials["apiKey"], credentials["businessID"], deps.CustomerProfileRepo, deps.DB)
+	},
 }
 
-func NewYelpProvider(apiKey, businessID string) *YelpProvider {
+func init() {
+	register(yelpDefinition)
+}
+
+func NewYelpProvider(
+	apiKey string,
+	businessID string,
+	customerProfileRepo repositories.CustomerProfileRepository,
+	db repositories.DB,
+) *YelpProvider {
 	return &YelpProvider{
 		apiKey:     apiKey,
 		businessID: businessID,
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
+		customerProfileRepo: customerProfileRepo,
+		db:                  db,
 	}
 }
 
-func (y *YelpProvider) Name() string { return "yelp" }
+func (y *YelpProvider) Name() string { return yelpDefinition.Name }
 
-func (y *YelpProvider) Fetch(ctx context.Context) ([]models.Testimonial, error) {
-	url := fmt.Sprintf("https://api.yelp.com/v3/businesses/%s/reviews", y.businessID)
+// Fetch uses the business API key, so userID is not needed to authenticate.
+func (y *YelpProvider) Fetch(ctx context.Context, userID string, workspaceID uuid.UUID) ([]models.Testimonial, error) {
+	url := fmt.Sprintf("https://api.yelp.com/v3/businesses/%s/reviews?sort_by=newest", y.businessID)
 
-	req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("yelp request creation failed: %w", err)
+	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", y.apiKey))
 
 	resp, err := y.httpClient.Do(req)
@@ -41,13 +84,19 @@ func (y *YelpProvider) Fetch(ctx context.Context) ([]models.Testimonial, error)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("yelp API returned status %d", resp.StatusCode)
+	}
+
 	var result struct {
 		Reviews []struct {
-			ID          string    `json:"id"`
-			Text        string    `json:"text"`
-			Rating      float32   `json:"rating"`
-			TimeCreated time.Time `json:"time_created"`
+			ID          string  `json:"id"`
+			URL         string  `json:"url"`
+			Text        string  `json:"text"`
+			Rating      float32 `json:"rating"`
+			TimeCreated string  `json:"time_created"`
 			User        struct {
+				ID   string `json:"id"`
 				Name string `json:"name"`
 			} `json:"user"`
 		} `json:"reviews"`
@@ -58,26 +107,52 @@ func (y *YelpProvider) Fetch(ctx context.Context) ([]models.Testimonial, error)
 	}
 
 	var testimonials []models.Testimonial
-	// for _, review := range result.Reviews {
-	// 	testimonials = append(testimonials, models.Testimonial{
-	// 		Content:          review.Text,
-	// 		Rating:           &review.Rating,
-	// 		CustomerName:     review.User.Name,
-	// 		CreatedAt:        review.TimeCreated,
-	// 		Type:             models.TestimonialTypeText,
-	// 		CollectionMethod: models.CollectionAPI,
-	// 		SourceData: map[string]interface{}{
-	// 			"yelp_review_id":   review.ID,
-	// 			"source":           y.Name(),
-	// 			"yelp_business_id": y.businessID,
-	// 		},
-	// 	})
-	// }
+	for _, review := range result.Reviews {
+		profile, err := y.customerProfileRepo.GetOrCreate(
+			ctx,
+			contracts.ReviewerData{
+				Name:       review.User.Name,
+				ExternalID: review.User.ID,
+			},
+			workspaceID,
+			"yelp",
+			y.db,
+		)
+		if err != nil {
+			fmt.Printf("Error creating customer profile: %v\n", err)
+			continue
+		}
+
+		createdAt, err := time.Parse(yelpTimeLayout, review.TimeCreated)
+		if err != nil {
+			createdAt = time.Now()
+		}
+
+		rating := review.Rating
+		testimonials = append(testimonials, models.Testimonial{
+			WorkspaceID:       workspaceID,
+			CustomerProfileID: &profile.ID,
+			TestimonialType:   models.TestimonialTypeCustomer,
+			Format:            models.ContentFormatText,
+			Status:            models.StatusPendingReview,
+			Content:           review.Text,
+			Rating:            &rating,
+			CollectionMethod:  models.CollectionMethodSocialImport,
+			SourceData: map[string]interface{}{
+				"platform":    "yelp",
+				"business_id": y.businessID,
+				"review_id":   review.ID,
+				"url":         review.URL,
+			},
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		})
+	}
 
 	return testimonials, nil
 }
 
-func (y *YelpProvider) RateLimit() int            { return 5000 } // Daily limit
-func (y *YelpProvider) RateWindow() time.Duration { return 24 * time.Hour }
-func (y *YelpProvider) Schedule() string          { return "@daily" }
+func (y *YelpProvider) RateLimit() int            { return yelpDefinition.RateLimit }
+func (y *YelpProvider) RateWindow() time.Duration { return yelpDefinition.RateWindow }
+func (y *YelpProvider) Schedule() string          { return yelpDefinition.DefaultSchedule }
 func (y *YelpProvider) IsConfigured() bool        { return y.apiKey != "" && y.businessID != "" }
@@ -0,0 +1,230 @@
+package repositories
+
+//go:generate mockery --name=AIJobRepository --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+type AIJobRepository interface {
+	Create(ctx context.Context, job *models.AIJob, db DB) error
+	Get(ctx context.Context, id uuid.UUID, db DB) (*models.AIJob, error)
+	ListByTestimonial(ctx context.Context, testimonialID uuid.UUID, db DB) ([]models.AIJob, error)
+	// Claim takes the most urgent job that is due, or a running job whose lease has
+	// expired and has attempts left, and leases it to the caller. Running jobs whose lease
+	// expired on their last attempt are marked failed. It returns sql.ErrNoRows when no
+	// job is ready. Concurrent callers never claim the same job.
+	Claim(ctx context.Context, lease time.Duration, db DB) (*models.AIJob, error)
+	Complete(ctx context.Context, id uuid.UUID, output models.JSONMap, outputReferenceID *uuid.UUID, db DB) error
+	// Retry returns a running job to the queue to be attempted again at runAt.
+	Retry(ctx context.Context, id uuid.UUID, runAt time.Time, details models.JSONMap, db DB) error
+	Fail(ctx context.Context, id uuid.UUID, details models.JSONMap, db DB) error
+}
+
+type aiJobRepository struct {
+	*BaseRepository[models.AIJob]
+}
+
+func NewAIJobRepository(redis *redis.Client) AIJobRepository {
+	return &aiJobRepository{
+		BaseRepository: NewBaseRepository[models.AIJob](redis, "ai_jobs"),
+	}
+}
+
+const aiJobColumns = `
+	id, testimonial_id, job_type, status, priority, input_parameters, attempts,
+	max_attempts, run_at, started_at, completed_at, error_details, output_data,
+	output_reference_id, created_at, updated_at
+`
+
+func scanAIJob(row rowScanner) (*models.AIJob, error) {
+	var (
+		job               models.AIJob
+		startedAt         sql.NullTime
+		completedAt       sql.NullTime
+		outputReferenceID uuid.NullUUID
+	)
+	err := row.Scan(
+		&job.ID,
+		&job.TestimonialID,
+		&job.JobType,
+		&job.Status,
+		&job.Priority,
+		&job.InputParameters,
+		&job.Attempts,
+		&job.MaxAttempts,
+		&job.RunAt,
+		&startedAt,
+		&completedAt,
+		&job.ErrorDetails,
+		&job.OutputData,
+		&outputReferenceID,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if startedAt.Valid {
+		job.StartedAt = &startedAt.Time
+	}
+	if completedAt.Valid {
+		job.CompletedAt = &completedAt.Time
+	}
+	if outputReferenceID.Valid {
+		job.OutputReferenceID = &outputReferenceID.UUID
+	}
+	return &job, nil
+}
+
+func (r *aiJobRepository) Create(ctx context.Context, job *models.AIJob, db DB) error {
+	query := `
+		INSERT INTO ai_jobs (id, testimonial_id, job_type, status, priority, input_parameters, max_attempts, run_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING created_at, updated_at
+	`
+
+	err := db.QueryRowContext(ctx, query,
+		job.ID,
+		job.TestimonialID,
+		job.JobType,
+		job.Status,
+		job.Priority,
+		job.InputParameters,
+		job.MaxAttempts,
+		job.RunAt,
+	).Scan(&job.CreatedAt, &job.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("error creating ai job: %w", err)
+	}
+	return nil
+}
+
+func (r *aiJobRepository) Get(ctx context.Context, id uuid.UUID, db DB) (*models.AIJob, error) {
+	query := `SELECT ` + aiJobColumns + ` FROM ai_jobs WHERE id = $1`
+
+	job, err := scanAIJob(db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching ai job: %w", err)
+	}
+	return job, nil
+}
+
+func (r *aiJobRepository) ListByTestimonial(ctx context.Context, testimonialID uuid.UUID, db DB) ([]models.AIJob, error) {
+	query := `
+		SELECT ` + aiJobColumns + `
+		FROM ai_jobs
+		WHERE testimonial_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := db.QueryContext(ctx, query, testimonialID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching ai jobs: %w", err)
+	}
+	defer rows.Close()
+
+	jobs := []models.AIJob{}
+	for rows.Next() {
+		job, err := scanAIJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning ai job: %w", err)
+		}
+		jobs = append(jobs, *job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ai jobs: %w", err)
+	}
+	return jobs, nil
+}
+
+func (r *aiJobRepository) Claim(ctx context.Context, lease time.Duration, db DB) (*models.AIJob, error) {
+	// A job whose worker died on every attempt would otherwise be reclaimed forever
+	exhausted := `
+		UPDATE ai_jobs
+		SET status = $2,
+			error_details = jsonb_build_object('error', 'lease expired before the job finished', 'attempts', attempts),
+			locked_until = NULL, completed_at = NOW(), updated_at = NOW()
+		WHERE status = $1 AND locked_until < NOW() AND attempts >= max_attempts
+	`
+	if _, err := db.ExecContext(ctx, exhausted, models.AIJobStatusRunning, models.AIJobStatusFailed); err != nil {
+		return nil, fmt.Errorf("error failing exhausted ai jobs: %w", err)
+	}
+
+	query := `
+		UPDATE ai_jobs
+		SET status = $1,
+			attempts = attempts + 1,
+			started_at = NOW(),
+			locked_until = NOW() + make_interval(secs => $3),
+			updated_at = NOW()
+		WHERE id = (
+			SELECT id FROM ai_jobs
+			WHERE (status = $2 AND run_at <= NOW())
+				OR (status = $1 AND locked_until < NOW() AND attempts < max_attempts)
+			ORDER BY priority DESC, run_at, created_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + aiJobColumns
+
+	job, err := scanAIJob(db.QueryRowContext(ctx, query, models.AIJobStatusRunning, models.AIJobStatusPending, lease.Seconds()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error claiming ai job: %w", err)
+	}
+	return job, nil
+}
+
+func (r *aiJobRepository) Complete(ctx context.Context, id uuid.UUID, output models.JSONMap, outputReferenceID *uuid.UUID, db DB) error {
+	query := `
+		UPDATE ai_jobs
+		SET status = $2, output_data = $3, output_reference_id = $4, error_details = NULL,
+			locked_until = NULL, completed_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+	`
+	_, err := db.ExecContext(ctx, query, id, models.AIJobStatusCompleted, output, uuid.NullUUID{UUID: derefUUID(outputReferenceID), Valid: outputReferenceID != nil})
+	if err != nil {
+		return fmt.Errorf("error completing ai job: %w", err)
+	}
+	return nil
+}
+
+func (r *aiJobRepository) Retry(ctx context.Context, id uuid.UUID, runAt time.Time, details models.JSONMap, db DB) error {
+	query := `
+		UPDATE ai_jobs
+		SET status = $2, run_at = $3, error_details = $4, locked_until = NULL, updated_at = NOW()
+		WHERE id = $1
+	`
+	if _, err := db.ExecContext(ctx, query, id, models.AIJobStatusPending, runAt, details); err != nil {
+		return fmt.Errorf("error rescheduling ai job: %w", err)
+	}
+	return nil
+}
+
+func (r *aiJobRepository) Fail(ctx context.Context, id uuid.UUID, details models.JSONMap, db DB) error {
+	query := `
+		UPDATE ai_jobs
+		SET status = $2, error_details = $3, locked_until = NULL, completed_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+	`
+	if _, err := db.ExecContext(ctx, query, id, models.AIJobStatusFailed, details); err != nil {
+		return fmt.Errorf("error failing ai job: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAIJobClaim(t *testing.T) {
+	db, mock := setupMockDB()
+	defer db.Close()
+
+	repo := repositories.NewAIJobRepository(redis.NewClient(&redis.Options{}))
+
+	// Stale jobs on their last attempt are failed rather than reclaimed
+	mock.ExpectExec(`UPDATE ai_jobs SET status = \$2, .* WHERE status = \$1 AND locked_until < NOW\(\) AND attempts >= max_attempts`).
+		WithArgs(models.AIJobStatusRunning, models.AIJobStatusFailed).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`OR \(status = \$1 AND locked_until < NOW\(\) AND attempts < max_attempts\)`).
+		WithArgs(models.AIJobStatusRunning, models.AIJobStatusPending, float64(90)).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := repo.Claim(context.Background(), 90*time.Second, db)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
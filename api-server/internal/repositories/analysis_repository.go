@@ -0,0 +1,128 @@
+package repositories
+
+//go:generate mockery --name=AnalysisRepository --output=./mocks --case=underscore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+type AnalysisRepository interface {
+	// Upsert stores an analysis, replacing any earlier analysis of the same type for
+	// the testimonial. The stored ID is written back to the analysis.
+	Upsert(ctx context.Context, analysis *models.TestimonialAnalysis, db DB) error
+	GetByTestimonial(ctx context.Context, testimonialID uuid.UUID, db DB) ([]models.TestimonialAnalysis, error)
+}
+
+type analysisRepository struct {
+	*BaseRepository[models.TestimonialAnalysis]
+}
+
+func NewAnalysisRepository(redis *redis.Client) AnalysisRepository {
+	return &analysisRepository{
+		BaseRepository: NewBaseRepository[models.TestimonialAnalysis](redis, "testimonial_analyses"),
+	}
+}
+
+func (r *analysisRepository) Upsert(ctx context.Context, analysis *models.TestimonialAnalysis, db DB) error {
+	query := `
+		INSERT INTO testimonial_analyses (
+			id, testimonial_id, analysis_type, sentiment_score, authenticity_score,
+			emotional_score, narrative_score, business_value_score, credibility_score,
+			analysis_data, extracted_insights, analysis_version
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (testimonial_id, analysis_type) DO UPDATE SET
+			sentiment_score = EXCLUDED.sentiment_score,
+			authenticity_score = EXCLUDED.authenticity_score,
+			emotional_score = EXCLUDED.emotional_score,
+			narrative_score = EXCLUDED.narrative_score,
+			business_value_score = EXCLUDED.business_value_score,
+			credibility_score = EXCLUDED.credibility_score,
+			analysis_data = EXCLUDED.analysis_data,
+			extracted_insights = EXCLUDED.extracted_insights,
+			analysis_version = EXCLUDED.analysis_version,
+			updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+
+	if analysis.ID == uuid.Nil {
+		analysis.ID = uuid.New()
+	}
+	if analysis.AnalysisData == nil {
+		analysis.AnalysisData = models.JSONMap{}
+	}
+	if analysis.ExtractedInsights == nil {
+		analysis.ExtractedInsights = models.JSONArray{}
+	}
+
+	err := db.QueryRowContext(ctx, query,
+		analysis.ID,
+		analysis.TestimonialID,
+		analysis.AnalysisType,
+		analysis.SentimentScore,
+		analysis.AuthenticityScore,
+		analysis.EmotionalScore,
+		analysis.NarrativeScore,
+		analysis.BusinessValueScore,
+		analysis.CredibilityScore,
+		analysis.AnalysisData,
+		analysis.ExtractedInsights,
+		analysis.AnalysisVersion,
+	).Scan(&analysis.ID, &analysis.CreatedAt, &analysis.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("error saving testimonial analysis: %w", err)
+	}
+	return nil
+}
+
+func (r *analysisRepository) GetByTestimonial(ctx context.Context, testimonialID uuid.UUID, db DB) ([]models.TestimonialAnalysis, error) {
+	query := `
+		SELECT
+			id, testimonial_id, analysis_type, sentiment_score, authenticity_score,
+			emotional_score, narrative_score, business_value_score, credibility_score,
+			analysis_data, extracted_insights, COALESCE(analysis_version, ''),
+			created_at, updated_at
+		FROM testimonial_analyses
+		WHERE testimonial_id = $1
+		ORDER BY analysis_type
+	`
+
+	rows, err := db.QueryContext(ctx, query, testimonialID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching testimonial analyses: %w", err)
+	}
+	defer rows.Close()
+
+	var analyses []models.TestimonialAnalysis
+	for rows.Next() {
+		var a models.TestimonialAnalysis
+		if err := rows.Scan(
+			&a.ID,
+			&a.TestimonialID,
+			&a.AnalysisType,
+			&a.SentimentScore,
+			&a.AuthenticityScore,
+			&a.EmotionalScore,
+			&a.NarrativeScore,
+			&a.BusinessValueScore,
+			&a.CredibilityScore,
+			&a.AnalysisData,
+			&a.ExtractedInsights,
+			&a.AnalysisVersion,
+			&a.CreatedAt,
+			&a.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning testimonial analysis: %w", err)
+		}
+		analyses = append(analyses, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating testimonial analyses: %w", err)
+	}
+	return analyses, nil
+}
@@ -0,0 +1,506 @@
+package repositories
+
+//go:generate mockery --name=AnalyticsRepository --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/pkg/tracking"
+	"github.com/lib/pq"
+	"github.com/redis/go-redis/v9"
+)
+
+type AnalyticsRepository interface {
+	// ApplyTrackingBatch adds a drained batch of beacon hits to the display stats, the
+	// testimonials' counters and conversion_tracking. Hits for widgets or testimonials
+	// that do not exist, or that belong to different workspaces, are dropped. A batch
+	// whose ID was already applied is skipped, so db should be the transaction the batch
+	// is applied in.
+	ApplyTrackingBatch(ctx context.Context, batch tracking.Batch, db DB) error
+	TestimonialPerformance(ctx context.Context, workspaceID uuid.UUID, query models.TestimonialPerformanceQuery, db DB) ([]models.TestimonialPerformance, error)
+	PerformanceTotals(ctx context.Context, workspaceID uuid.UUID, query models.TestimonialPerformanceQuery, db DB) (models.PerformanceCounts, error)
+	// WorkspaceAnalytics aggregates the testimonials collected over the query's days. The
+	// timeline only has the buckets something was collected in.
+	WorkspaceAnalytics(ctx context.Context, workspaceID uuid.UUID, query models.WorkspaceAnalyticsQuery, db DB) (*models.WorkspaceAnalytics, error)
+}
+
+type analyticsRepository struct {
+	*BaseRepository[models.TestimonialPerformance]
+}
+
+func NewAnalyticsRepository(redis *redis.Client) AnalyticsRepository {
+	return &analyticsRepository{
+		BaseRepository: NewBaseRepository[models.TestimonialPerformance](redis, "testimonial_display_stats"),
+	}
+}
+
+func (r *analyticsRepository) ApplyTrackingBatch(ctx context.Context, batch tracking.Batch, db DB) error {
+	applied, err := r.recordDrain(ctx, batch.ID, db)
+	if err != nil {
+		return err
+	}
+	if applied {
+		return nil
+	}
+
+	if len(batch.Counts) > 0 {
+		if err := r.applyCounts(ctx, batch.Counts, db); err != nil {
+			return err
+		}
+	}
+	if len(batch.Conversions) > 0 {
+		if err := r.insertConversions(ctx, batch.Conversions, db); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// trackingDrainRetention is how long applied batch IDs are remembered. A batch is only
+// handed out again while its drain keeps failing, which never lasts this long.
+const trackingDrainRetention = 7 * 24 * time.Hour
+
+// recordDrain records a batch ID and reports whether it had already been applied.
+func (r *analyticsRepository) recordDrain(ctx context.Context, id uuid.UUID, db DB) (bool, error) {
+	prune := `DELETE FROM tracking_drains WHERE applied_at < NOW() - make_interval(secs => $1)`
+	if _, err := db.ExecContext(ctx, prune, trackingDrainRetention.Seconds()); err != nil {
+		return false, fmt.Errorf("error pruning tracking drains: %w", err)
+	}
+
+	result, err := db.ExecContext(ctx, `INSERT INTO tracking_drains (id) VALUES ($1) ON CONFLICT (id) DO NOTHING`, id)
+	if err != nil {
+		return false, fmt.Errorf("error recording tracking drain: %w", err)
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error recording tracking drain: %w", err)
+	}
+	return inserted == 0, nil
+}
+
+// applyCounts upserts the daily stats and bumps the testimonials' own counters in one
+// statement, so both are only ever written together.
+func (r *analyticsRepository) applyCounts(ctx context.Context, counts []tracking.Count, db DB) error {
+	query := `
+		WITH batch AS (
+			SELECT *
+			FROM unnest($1::uuid[], $2::uuid[], $3::date[], $4::bigint[], $5::bigint[], $6::bigint[], $7::bigint[])
+				AS b(display_id, testimonial_id, day, impressions, clicks, conversions, value_cents)
+		), valid AS (
+			SELECT b.*, t.workspace_id
+			FROM batch b
+			JOIN display_widgets d ON d.id = b.display_id
+			JOIN testimonials t ON t.id = b.testimonial_id AND t.workspace_id = d.workspace_id
+		), stats AS (
+			INSERT INTO testimonial_display_stats
+				(testimonial_id, display_id, workspace_id, day, impressions, clicks, conversions, conversion_value)
+			SELECT testimonial_id, display_id, workspace_id, day, impressions, clicks, conversions, value_cents / 100.0
+			FROM valid
+			ON CONFLICT (testimonial_id, display_id, day) DO UPDATE SET
+				impressions = testimonial_display_stats.impressions + EXCLUDED.impressions,
+				clicks = testimonial_display_stats.clicks + EXCLUDED.clicks,
+				conversions = testimonial_display_stats.conversions + EXCLUDED.conversions,
+				conversion_value = testimonial_display_stats.conversion_value + EXCLUDED.conversion_value
+		)
+		UPDATE testimonials t SET
+			view_count = COALESCE(t.view_count, 0) + v.impressions,
+			conversion_count = COALESCE(t.conversion_count, 0) + v.conversions
+		FROM (
+			SELECT testimonial_id, sum(impressions) AS impressions, sum(conversions) AS conversions
+			FROM valid
+			GROUP BY testimonial_id
+		) v
+		WHERE t.id = v.testimonial_id
+	`
+
+	n := len(counts)
+	displays := make(pq.StringArray, n)
+	testimonials := make(pq.StringArray, n)
+	days := make(pq.StringArray, n)
+	impressions := make(pq.Int64Array, n)
+	clicks := make(pq.Int64Array, n)
+	conversions := make(pq.Int64Array, n)
+	values := make(pq.Int64Array, n)
+	for i, c := range counts {
+		displays[i] = c.DisplayID.String()
+		testimonials[i] = c.TestimonialID.String()
+		days[i] = c.Day.Format("2006-01-02")
+		impressions[i] = c.Impressions
+		clicks[i] = c.Clicks
+		conversions[i] = c.Conversions
+		values[i] = c.ValueCents
+	}
+
+	if _, err := db.ExecContext(ctx, query, displays, testimonials, days, impressions, clicks, conversions, values); err != nil {
+		return fmt.Errorf("error applying display stats: %w", err)
+	}
+	return nil
+}
+
+func (r *analyticsRepository) insertConversions(ctx context.Context, conversions []tracking.ConversionRecord, db DB) error {
+	query := `
+		INSERT INTO conversion_tracking (testimonial_id, display_id, conversion_type, conversion_value, created_at)
+		SELECT c.testimonial_id, c.display_id, NULLIF(c.conversion_type, ''), NULLIF(c.value_cents, 0) / 100.0, c.at
+		FROM unnest($1::uuid[], $2::uuid[], $3::text[], $4::bigint[], $5::timestamptz[])
+			AS c(display_id, testimonial_id, conversion_type, value_cents, at)
+		JOIN display_widgets d ON d.id = c.display_id
+		JOIN testimonials t ON t.id = c.testimonial_id AND t.workspace_id = d.workspace_id
+	`
+
+	n := len(conversions)
+	displays := make(pq.StringArray, n)
+	testimonials := make(pq.StringArray, n)
+	types := make(pq.StringArray, n)
+	values := make(pq.Int64Array, n)
+	times := make(pq.StringArray, n)
+	for i, c := range conversions {
+		displays[i] = c.DisplayID.String()
+		testimonials[i] = c.TestimonialID.String()
+		types[i] = c.Type
+		values[i] = c.ValueCents
+		times[i] = c.At.UTC().Format("2006-01-02T15:04:05.999999Z07:00")
+	}
+
+	if _, err := db.ExecContext(ctx, query, displays, testimonials, types, values, times); err != nil {
+		return fmt.Errorf("error recording conversions: %w", err)
+	}
+	return nil
+}
+
+const displayStatsFilter = `
+	WHERE s.workspace_id = $1
+	  AND s.day BETWEEN $2::date AND $3::date
+	  AND ($4::uuid IS NULL OR s.display_id = $4)
+`
+
+// TestimonialPerformance sums each testimonial's stats over the query's days, ordered by
+// conversions, then clicks, then impressions. Rates are left to the caller.
+func (r *analyticsRepository) TestimonialPerformance(ctx context.Context, workspaceID uuid.UUID, query models.TestimonialPerformanceQuery, db DB) ([]models.TestimonialPerformance, error) {
+	stmt := `
+		SELECT s.testimonial_id, coalesce(t.title, ''),
+			sum(s.impressions), sum(s.clicks), sum(s.conversions), sum(s.conversion_value)::float8
+		FROM testimonial_display_stats s
+		JOIN testimonials t ON t.id = s.testimonial_id
+	` + displayStatsFilter + `
+		GROUP BY s.testimonial_id, t.title
+		ORDER BY sum(s.conversions) DESC, sum(s.clicks) DESC, sum(s.impressions) DESC, s.testimonial_id
+		LIMIT $5
+	`
+
+	rows, err := db.QueryContext(ctx, stmt, workspaceID, query.From, query.To, query.WidgetID, query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching testimonial performance: %w", err)
+	}
+	defer rows.Close()
+
+	performance := []models.TestimonialPerformance{}
+	for rows.Next() {
+		var p models.TestimonialPerformance
+		if err := rows.Scan(&p.TestimonialID, &p.Title, &p.Impressions, &p.Clicks, &p.Conversions, &p.ConversionValue); err != nil {
+			return nil, fmt.Errorf("error scanning testimonial performance: %w", err)
+		}
+		performance = append(performance, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating testimonial performance: %w", err)
+	}
+	return performance, nil
+}
+
+// PerformanceTotals sums the stats of every testimonial over the query's days.
+func (r *analyticsRepository) PerformanceTotals(ctx context.Context, workspaceID uuid.UUID, query models.TestimonialPerformanceQuery, db DB) (models.PerformanceCounts, error) {
+	stmt := `
+		SELECT coalesce(sum(s.impressions), 0), coalesce(sum(s.clicks), 0),
+			coalesce(sum(s.conversions), 0), coalesce(sum(s.conversion_value), 0)::float8
+		FROM testimonial_display_stats s
+	` + displayStatsFilter
+
+	var totals models.PerformanceCounts
+	err := db.QueryRowContext(ctx, stmt, workspaceID, query.From, query.To, query.WidgetID).
+		Scan(&totals.Impressions, &totals.Clicks, &totals.Conversions, &totals.ConversionValue)
+	if err != nil {
+		return models.PerformanceCounts{}, fmt.Errorf("error fetching performance totals: %w", err)
+	}
+	return totals, nil
+}
+
+// collectedBetween restricts testimonials t to the workspace ($1) and to those created
+// from $2 up to, but not including, $3.
+const collectedBetween = `t.workspace_id = $1 AND t.created_at >= $2 AND t.created_at < $3`
+
+func (r *analyticsRepository) WorkspaceAnalytics(ctx context.Context, workspaceID uuid.UUID, query models.WorkspaceAnalyticsQuery, db DB) (*models.WorkspaceAnalytics, error) {
+	analytics := &models.WorkspaceAnalytics{
+		WorkspaceID: workspaceID,
+		From:        query.From,
+		To:          query.To,
+		Granularity: query.Granularity,
+	}
+	// To is the last day reported on
+	until := query.To.AddDate(0, 0, 1)
+
+	if err := r.analyticsTimeline(ctx, analytics, workspaceID, query.From, until, db); err != nil {
+		return nil, err
+	}
+	if err := r.analyticsBreakdown(ctx, analytics, workspaceID, query.From, until, db); err != nil {
+		return nil, err
+	}
+	if err := r.competitorMentions(ctx, analytics, workspaceID, query.From, until, db); err != nil {
+		return nil, err
+	}
+	if err := r.analyticsFunnel(ctx, analytics, workspaceID, query.From, until, db); err != nil {
+		return nil, err
+	}
+	return analytics, nil
+}
+
+// runningAverage accumulates a sum and a count so averages can be combined.
+type runningAverage struct {
+	sum   float64
+	count int64
+}
+
+func (a *runningAverage) add(sum float64, count int64) {
+	a.sum += sum
+	a.count += count
+}
+
+func (a runningAverage) value() *float64 {
+	if a.count == 0 {
+		return nil
+	}
+	avg := a.sum / float64(a.count)
+	return &avg
+}
+
+func (r *analyticsRepository) analyticsTimeline(ctx context.Context, analytics *models.WorkspaceAnalytics, workspaceID uuid.UUID, from, until time.Time, db DB) error {
+	query := `
+		SELECT
+			date_trunc($4, t.created_at AT TIME ZONE 'UTC') AS bucket,
+			t.status,
+			count(*),
+			coalesce(sum(t.rating), 0)::float8, count(t.rating),
+			coalesce(sum(a.sentiment_score), 0)::float8, count(a.sentiment_score)
+		FROM testimonials t
+		LEFT JOIN testimonial_analyses a ON a.testimonial_id = t.id AND a.analysis_type = 'sentiment'
+		WHERE ` + collectedBetween + `
+		GROUP BY bucket, t.status
+		ORDER BY bucket
+	`
+
+	rows, err := db.QueryContext(ctx, query, workspaceID, from, until, string(analytics.Granularity))
+	if err != nil {
+		return fmt.Errorf("error fetching analytics timeline: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		ratings, sentiments             runningAverage
+		bucketRatings, bucketSentiments runningAverage
+		bucket                          *models.AnalyticsBucket
+	)
+	closeBucket := func() {
+		if bucket != nil {
+			bucket.AverageRating = bucketRatings.value()
+			bucket.AverageSentiment = bucketSentiments.value()
+			analytics.Timeline = append(analytics.Timeline, *bucket)
+		}
+		bucketRatings, bucketSentiments = runningAverage{}, runningAverage{}
+	}
+
+	for rows.Next() {
+		var (
+			start                   time.Time
+			status                  sql.NullString
+			count, rated, analyzed  int64
+			ratingSum, sentimentSum float64
+		)
+		if err := rows.Scan(&start, &status, &count, &ratingSum, &rated, &sentimentSum, &analyzed); err != nil {
+			return fmt.Errorf("error scanning analytics timeline: %w", err)
+		}
+		start = start.UTC()
+		if bucket == nil || !bucket.Start.Equal(start) {
+			closeBucket()
+			bucket = &models.AnalyticsBucket{Start: start, ByStatus: map[models.ContentStatus]int64{}}
+		}
+
+		key := models.StatusPendingReview
+		if status.Valid {
+			key = models.ContentStatus(status.String)
+		}
+		bucket.ByStatus[key] += count
+		bucket.Total += count
+		analytics.Total += count
+		bucketRatings.add(ratingSum, rated)
+		bucketSentiments.add(sentimentSum, analyzed)
+		ratings.add(ratingSum, rated)
+		sentiments.add(sentimentSum, analyzed)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating analytics timeline: %w", err)
+	}
+	closeBucket()
+
+	analytics.AverageRating = ratings.value()
+	analytics.AverageSentiment = sentiments.value()
+	return nil
+}
+
+// analyticsBreakdown counts the period's testimonials by source, collection method,
+// rating, tag and category in one round trip.
+func (r *analyticsRepository) analyticsBreakdown(ctx context.Context, analytics *models.WorkspaceAnalytics, workspaceID uuid.UUID, from, until time.Time, db DB) error {
+	query := `
+		(SELECT 'source', coalesce(nullif(t.source_data->>'platform', ''), '` + models.DirectSource + `'), count(*)
+			FROM testimonials t WHERE ` + collectedBetween + ` GROUP BY 2)
+		UNION ALL
+		(SELECT 'collection_method', coalesce(t.collection_method::text, 'unknown'), count(*)
+			FROM testimonials t WHERE ` + collectedBetween + ` GROUP BY 2)
+		UNION ALL
+		(SELECT 'rating', t.rating::text, count(*)
+			FROM testimonials t WHERE ` + collectedBetween + ` AND t.rating IS NOT NULL GROUP BY 2)
+		UNION ALL
+		(SELECT 'tag', tag, count(*)
+			FROM testimonials t, unnest(t.tags) AS tag WHERE ` + collectedBetween + `
+			GROUP BY 2 ORDER BY 3 DESC, 2 LIMIT $4)
+		UNION ALL
+		(SELECT 'category', category, count(*)
+			FROM testimonials t, unnest(t.categories) AS category WHERE ` + collectedBetween + `
+			GROUP BY 2 ORDER BY 3 DESC, 2 LIMIT $4)
+	`
+
+	rows, err := db.QueryContext(ctx, query, workspaceID, from, until, models.AnalyticsTopN)
+	if err != nil {
+		return fmt.Errorf("error fetching analytics breakdown: %w", err)
+	}
+	defer rows.Close()
+
+	ratings := make([]models.RatingCount, 5)
+	for i := range ratings {
+		ratings[i].Rating = i + 1
+	}
+	analytics.BySource = []models.AnalyticsCount{}
+	analytics.ByCollectionMethod = []models.AnalyticsCount{}
+	analytics.TopTags = []models.AnalyticsCount{}
+	analytics.TopCategories = []models.AnalyticsCount{}
+
+	for rows.Next() {
+		var dimension string
+		var count models.AnalyticsCount
+		if err := rows.Scan(&dimension, &count.Key, &count.Count); err != nil {
+			return fmt.Errorf("error scanning analytics breakdown: %w", err)
+		}
+		switch dimension {
+		case "source":
+			analytics.BySource = append(analytics.BySource, count)
+		case "collection_method":
+			analytics.ByCollectionMethod = append(analytics.ByCollectionMethod, count)
+		case "rating":
+			if rating, err := strconv.Atoi(count.Key); err == nil && rating >= 1 && rating <= 5 {
+				ratings[rating-1].Count = count.Count
+			}
+		case "tag":
+			analytics.TopTags = append(analytics.TopTags, count)
+		case "category":
+			analytics.TopCategories = append(analytics.TopCategories, count)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating analytics breakdown: %w", err)
+	}
+
+	for _, counts := range [][]models.AnalyticsCount{analytics.BySource, analytics.ByCollectionMethod, analytics.TopTags, analytics.TopCategories} {
+		sort.SliceStable(counts, func(i, j int) bool {
+			if counts[i].Count != counts[j].Count {
+				return counts[i].Count > counts[j].Count
+			}
+			return counts[i].Key < counts[j].Key
+		})
+	}
+	analytics.RatingDistribution = ratings
+	return nil
+}
+
+func (r *analyticsRepository) competitorMentions(ctx context.Context, analytics *models.WorkspaceAnalytics, workspaceID uuid.UUID, from, until time.Time, db DB) error {
+	query := `
+		SELECT
+			cm.competitor_name,
+			count(*),
+			count(*) FILTER (WHERE cm.sentiment IN ('positive', 'very_positive')),
+			count(*) FILTER (WHERE cm.sentiment IN ('negative', 'very_negative')),
+			avg(cm.sentiment_score)
+		FROM competitor_mentions cm
+		JOIN testimonials t ON t.id = cm.testimonial_id
+		WHERE ` + collectedBetween + `
+		GROUP BY cm.competitor_name
+		ORDER BY count(*) DESC, cm.competitor_name
+		LIMIT $4
+	`
+
+	rows, err := db.QueryContext(ctx, query, workspaceID, from, until, models.AnalyticsTopN)
+	if err != nil {
+		return fmt.Errorf("error fetching competitor mentions: %w", err)
+	}
+	defer rows.Close()
+
+	analytics.CompetitorMentions = []models.CompetitorMentionCount{}
+	for rows.Next() {
+		var (
+			mention   models.CompetitorMentionCount
+			sentiment sql.NullFloat64
+		)
+		if err := rows.Scan(&mention.Competitor, &mention.Mentions, &mention.Positive, &mention.Negative, &sentiment); err != nil {
+			return fmt.Errorf("error scanning competitor mentions: %w", err)
+		}
+		if sentiment.Valid {
+			mention.AverageSentiment = &sentiment.Float64
+		}
+		analytics.CompetitorMentions = append(analytics.CompetitorMentions, mention)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating competitor mentions: %w", err)
+	}
+	return nil
+}
+
+// analyticsFunnel counts testimonials that were approved or published at any point,
+// which includes featured and scheduled ones, then what visitors did with the
+// workspace's widgets over the same days.
+func (r *analyticsRepository) analyticsFunnel(ctx context.Context, analytics *models.WorkspaceAnalytics, workspaceID uuid.UUID, from, until time.Time, db DB) error {
+	query := `
+		SELECT c.collected, c.approved, c.published,
+			coalesce(s.impressions, 0), coalesce(s.clicks, 0), coalesce(s.conversions, 0)
+		FROM (
+			SELECT
+				count(*) AS collected,
+				count(*) FILTER (WHERE t.status IN ('approved', 'featured', 'scheduled') OR t.published) AS approved,
+				count(*) FILTER (WHERE t.published) AS published
+			FROM testimonials t
+			WHERE ` + collectedBetween + `
+		) c, (
+			SELECT sum(impressions) AS impressions, sum(clicks) AS clicks, sum(conversions) AS conversions
+			FROM testimonial_display_stats
+			WHERE workspace_id = $1 AND day >= $2::date AND day < $3::date
+		) s
+	`
+
+	funnel := &analytics.Funnel
+	err := db.QueryRowContext(ctx, query, workspaceID, from, until).Scan(
+		&funnel.Collected,
+		&funnel.Approved,
+		&funnel.Published,
+		&funnel.Impressions,
+		&funnel.Clicks,
+		&funnel.Conversions,
+	)
+	if err != nil {
+		return fmt.Errorf("error fetching analytics funnel: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pkg/tracking"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyTrackingBatch(t *testing.T) {
+	ctx := context.Background()
+	repo := repositories.NewAnalyticsRepository(redis.NewClient(&redis.Options{}))
+	batch := tracking.Batch{
+		ID: uuid.New(),
+		Counts: []tracking.Count{{
+			DisplayID:     uuid.New(),
+			TestimonialID: uuid.New(),
+			Day:           time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			Impressions:   3,
+		}},
+	}
+
+	t.Run("AppliesNewBatch", func(t *testing.T) {
+		db, mock := setupMockDB()
+		defer db.Close()
+
+		mock.ExpectExec(`DELETE FROM tracking_drains`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`INSERT INTO tracking_drains \(id\) VALUES \(\$1\) ON CONFLICT \(id\) DO NOTHING`).
+			WithArgs(batch.ID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO testimonial_display_stats`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.ApplyTrackingBatch(ctx, batch, db))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SkipsAppliedBatch", func(t *testing.T) {
+		db, mock := setupMockDB()
+		defer db.Close()
+
+		// Nothing else runs once the ID turns out to be recorded already
+		mock.ExpectExec(`DELETE FROM tracking_drains`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`INSERT INTO tracking_drains`).
+			WithArgs(batch.ID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		assert.NoError(t, repo.ApplyTrackingBatch(ctx, batch, db))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
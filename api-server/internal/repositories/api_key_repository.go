@@ -0,0 +1,187 @@
+package repositories
+
+//go:generate mockery --name=APIKeyRepository --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *models.APIKey, db DB) error
+	Get(ctx context.Context, workspaceID, id uuid.UUID, db DB) (*models.APIKey, error)
+	GetByHash(ctx context.Context, keyHash string, db DB) (*models.APIKey, error)
+	GetByWorkspace(ctx context.Context, workspaceID uuid.UUID, db DB) ([]models.APIKey, error)
+	Revoke(ctx context.Context, workspaceID, id uuid.UUID, db DB) error
+	TouchLastUsed(ctx context.Context, id uuid.UUID, db DB) error
+}
+
+type apiKeyRepository struct {
+	*BaseRepository[models.APIKey]
+}
+
+func NewAPIKeyRepository(redis *redis.Client) APIKeyRepository {
+	return &apiKeyRepository{
+		BaseRepository: NewBaseRepository[models.APIKey](redis, "workspace_api_keys"),
+	}
+}
+
+const apiKeyColumns = `
+	id, workspace_id, name, key_prefix, key_hash, rate_limit_per_minute,
+	created_by, last_used_at, revoked_at, created_at
+`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAPIKey(row rowScanner) (*models.APIKey, error) {
+	var (
+		key        models.APIKey
+		createdBy  sql.NullString
+		lastUsedAt sql.NullTime
+		revokedAt  sql.NullTime
+	)
+
+	if err := row.Scan(
+		&key.ID,
+		&key.WorkspaceID,
+		&key.Name,
+		&key.Prefix,
+		&key.KeyHash,
+		&key.RateLimitPerMinute,
+		&createdBy,
+		&lastUsedAt,
+		&revokedAt,
+		&key.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	key.CreatedBy = createdBy.String
+	if lastUsedAt.Valid {
+		key.LastUsedAt = &lastUsedAt.Time
+	}
+	if revokedAt.Valid {
+		key.RevokedAt = &revokedAt.Time
+	}
+	return &key, nil
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, key *models.APIKey, db DB) error {
+	query := `
+		INSERT INTO workspace_api_keys (
+			id, workspace_id, name, key_prefix, key_hash, rate_limit_per_minute, created_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING created_at
+	`
+
+	err := db.QueryRowContext(ctx, query,
+		key.ID,
+		key.WorkspaceID,
+		key.Name,
+		key.Prefix,
+		key.KeyHash,
+		key.RateLimitPerMinute,
+		sql.NullString{String: key.CreatedBy, Valid: key.CreatedBy != ""},
+	).Scan(&key.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error creating api key: %w", err)
+	}
+	return nil
+}
+
+func (r *apiKeyRepository) Get(ctx context.Context, workspaceID, id uuid.UUID, db DB) (*models.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM workspace_api_keys WHERE id = $1 AND workspace_id = $2`
+
+	key, err := scanAPIKey(db.QueryRowContext(ctx, query, id, workspaceID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching api key: %w", err)
+	}
+	return key, nil
+}
+
+// GetByHash returns the key with the given hash, including revoked keys, so callers
+// can tell a revoked key from an unknown one.
+func (r *apiKeyRepository) GetByHash(ctx context.Context, keyHash string, db DB) (*models.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM workspace_api_keys WHERE key_hash = $1`
+
+	key, err := scanAPIKey(db.QueryRowContext(ctx, query, keyHash))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching api key: %w", err)
+	}
+	return key, nil
+}
+
+func (r *apiKeyRepository) GetByWorkspace(ctx context.Context, workspaceID uuid.UUID, db DB) ([]models.APIKey, error) {
+	query := `
+		SELECT ` + apiKeyColumns + `
+		FROM workspace_api_keys
+		WHERE workspace_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := db.QueryContext(ctx, query, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching api keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []models.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning api key: %w", err)
+		}
+		keys = append(keys, *key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating api keys: %w", err)
+	}
+	return keys, nil
+}
+
+// Revoke marks an active key as revoked. It returns sql.ErrNoRows when the workspace
+// has no such active key.
+func (r *apiKeyRepository) Revoke(ctx context.Context, workspaceID, id uuid.UUID, db DB) error {
+	query := `
+		UPDATE workspace_api_keys
+		SET revoked_at = NOW()
+		WHERE id = $1 AND workspace_id = $2 AND revoked_at IS NULL
+	`
+
+	result, err := db.ExecContext(ctx, query, id, workspaceID)
+	if err != nil {
+		return fmt.Errorf("error revoking api key: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error revoking api key: %w", err)
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *apiKeyRepository) TouchLastUsed(ctx context.Context, id uuid.UUID, db DB) error {
+	_, err := db.ExecContext(ctx, `UPDATE workspace_api_keys SET last_used_at = NOW() WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("error updating api key usage: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package repositories
+
+//go:generate mockery --name=AuditLogRepository --output=./mocks --case=underscore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+type AuditLogRepository interface {
+	Create(ctx context.Context, entry *models.AuditLogEntry, db DB) error
+	// ListForEntity returns the events of an entity, oldest first.
+	ListForEntity(ctx context.Context, entityType string, entityID uuid.UUID, db DB) ([]models.AuditLogEntry, error)
+}
+
+type auditLogRepository struct {
+	*BaseRepository[models.AuditLogEntry]
+}
+
+func NewAuditLogRepository(redis *redis.Client) AuditLogRepository {
+	return &auditLogRepository{
+		BaseRepository: NewBaseRepository[models.AuditLogEntry](redis, "audit_log"),
+	}
+}
+
+const auditLogColumns = `
+	id, workspace_id, actor_id, event_type, entity_type, entity_id, details, created_at
+`
+
+func scanAuditLogEntry(row rowScanner) (*models.AuditLogEntry, error) {
+	var (
+		entry       models.AuditLogEntry
+		workspaceID uuid.NullUUID
+		actorID     uuid.NullUUID
+	)
+
+	if err := row.Scan(
+		&entry.ID,
+		&workspaceID,
+		&actorID,
+		&entry.EventType,
+		&entry.EntityType,
+		&entry.EntityID,
+		&entry.Details,
+		&entry.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if workspaceID.Valid {
+		entry.WorkspaceID = &workspaceID.UUID
+	}
+	if actorID.Valid {
+		entry.ActorID = &actorID.UUID
+	}
+	return &entry, nil
+}
+
+func (r *auditLogRepository) Create(ctx context.Context, entry *models.AuditLogEntry, db DB) error {
+	query := `
+		INSERT INTO audit_log (workspace_id, actor_id, event_type, entity_type, entity_id, details)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at
+	`
+
+	if entry.Details == nil {
+		entry.Details = models.JSONMap{}
+	}
+	err := db.QueryRowContext(ctx, query,
+		entry.WorkspaceID,
+		entry.ActorID,
+		entry.EventType,
+		entry.EntityType,
+		entry.EntityID,
+		entry.Details,
+	).Scan(&entry.ID, &entry.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error creating audit log entry: %w", err)
+	}
+	return nil
+}
+
+func (r *auditLogRepository) ListForEntity(ctx context.Context, entityType string, entityID uuid.UUID, db DB) ([]models.AuditLogEntry, error) {
+	query := `
+		SELECT ` + auditLogColumns + `
+		FROM audit_log
+		WHERE entity_type = $1 AND entity_id = $2
+		ORDER BY created_at, id
+	`
+
+	rows, err := db.QueryContext(ctx, query, entityType, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching audit log: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []models.AuditLogEntry{}
+	for rows.Next() {
+		entry, err := scanAuditLogEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning audit log entry: %w", err)
+		}
+		entries = append(entries, *entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating audit log: %w", err)
+	}
+	return entries, nil
+}
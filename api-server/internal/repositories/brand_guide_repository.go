@@ -1,6 +1,8 @@
 // repositories/brand_guide_repository.go
 package repositories
 
+//go:generate mockery --name=BrandGuideRepository --output=./mocks --case=underscore
+
 import (
 	"context"
 	"database/sql"
@@ -13,11 +15,11 @@ import (
 )
 
 type BrandGuideRepository interface {
-	// Create(ctx context.Context, guide *models.BrandGuide, db DB) error
+	Create(ctx context.Context, guide *models.BrandGuide, db DB) error
 	// Update(ctx context.Context, guide *models.BrandGuide, db DB) error
 	// FetchByID(ctx context.Context, id uuid.UUID, db DB) (*models.BrandGuide, error)
 	// FetchByWorkspaceID(ctx context.Context, workspaceID uuid.UUID, db DB) ([]models.BrandGuide, error)
-	// FetchDefault(ctx context.Context, workspaceID uuid.UUID, db DB) (*models.BrandGuide, error)
+	FetchDefault(ctx context.Context, workspaceID uuid.UUID, db DB) (*models.BrandGuide, error)
 	// SetDefault(ctx context.Context, id uuid.UUID, workspaceID uuid.UUID, db DB) error
 	// Delete(ctx context.Context, id uuid.UUID, db DB) error
 }
@@ -73,7 +75,7 @@ func (r *brandGuideRepository) FetchDefault(ctx context.Context, workspaceID uui
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("no default brand guide found for workspace ID %s", workspaceID)
+			return nil, sql.ErrNoRows
 		}
 		return nil, fmt.Errorf("error fetching default brand guide: %w", err)
 	}
@@ -0,0 +1,244 @@
+package repositories
+
+//go:generate mockery --name=CollectionPortalRepository --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/lib/pq"
+	"github.com/redis/go-redis/v9"
+)
+
+type CollectionPortalRepository interface {
+	Create(ctx context.Context, portal *models.CollectionPortal, db DB) error
+	Get(ctx context.Context, workspaceID, id uuid.UUID, db DB) (*models.CollectionPortal, error)
+	GetBySlug(ctx context.Context, slug string, db DB) (*models.CollectionPortal, error)
+	GetByWorkspace(ctx context.Context, workspaceID uuid.UUID, db DB) ([]models.CollectionPortal, error)
+	Update(ctx context.Context, portal *models.CollectionPortal, db DB) error
+	Delete(ctx context.Context, workspaceID, id uuid.UUID, db DB) error
+}
+
+type collectionPortalRepository struct {
+	*BaseRepository[models.CollectionPortal]
+}
+
+func NewCollectionPortalRepository(redis *redis.Client) CollectionPortalRepository {
+	return &collectionPortalRepository{
+		BaseRepository: NewBaseRepository[models.CollectionPortal](redis, "collection_portals"),
+	}
+}
+
+// The enum arrays are read back as text so they scan into plain string arrays.
+const collectionPortalColumns = `
+	id, workspace_id, name, slug,
+	collection_methods::text[], verification_methods::text[],
+	custom_fields, branding_settings, form_settings, notification_settings,
+	active, created_at, updated_at
+`
+
+func scanCollectionPortal(row rowScanner) (*models.CollectionPortal, error) {
+	var (
+		portal              models.CollectionPortal
+		collectionMethods   pq.StringArray
+		verificationMethods pq.StringArray
+	)
+
+	if err := row.Scan(
+		&portal.ID,
+		&portal.WorkspaceID,
+		&portal.Name,
+		&portal.Slug,
+		&collectionMethods,
+		&verificationMethods,
+		&portal.CustomFields,
+		&portal.BrandingSettings,
+		&portal.FormSettings,
+		&portal.NotificationSettings,
+		&portal.Active,
+		&portal.CreatedAt,
+		&portal.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	portal.CollectionMethods = make([]models.CollectionMethod, len(collectionMethods))
+	for i, m := range collectionMethods {
+		portal.CollectionMethods[i] = models.CollectionMethod(m)
+	}
+	portal.VerificationMethods = make([]models.VerificationType, len(verificationMethods))
+	for i, v := range verificationMethods {
+		portal.VerificationMethods[i] = models.VerificationType(v)
+	}
+	return &portal, nil
+}
+
+func collectionMethodArray(methods []models.CollectionMethod) pq.StringArray {
+	arr := make(pq.StringArray, len(methods))
+	for i, m := range methods {
+		arr[i] = string(m)
+	}
+	return arr
+}
+
+func verificationTypeArray(types []models.VerificationType) pq.StringArray {
+	arr := make(pq.StringArray, len(types))
+	for i, v := range types {
+		arr[i] = string(v)
+	}
+	return arr
+}
+
+// isUniqueViolation reports whether err is a Postgres unique constraint violation.
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+func (r *collectionPortalRepository) Create(ctx context.Context, portal *models.CollectionPortal, db DB) error {
+	query := `
+		INSERT INTO collection_portals (
+			id, workspace_id, name, slug, collection_methods, verification_methods,
+			custom_fields, branding_settings, form_settings, notification_settings, active
+		) VALUES ($1, $2, $3, $4, $5::collection_method[], $6::verification_type[], $7, $8, $9, $10, $11)
+		RETURNING created_at, updated_at
+	`
+
+	err := db.QueryRowContext(ctx, query,
+		portal.ID,
+		portal.WorkspaceID,
+		portal.Name,
+		portal.Slug,
+		collectionMethodArray(portal.CollectionMethods),
+		verificationTypeArray(portal.VerificationMethods),
+		portal.CustomFields,
+		portal.BrandingSettings,
+		portal.FormSettings,
+		portal.NotificationSettings,
+		portal.Active,
+	).Scan(&portal.CreatedAt, &portal.UpdatedAt)
+	if isUniqueViolation(err) {
+		return apperrors.ErrDuplicateEntry
+	}
+	if err != nil {
+		return fmt.Errorf("error creating collection portal: %w", err)
+	}
+	return nil
+}
+
+func (r *collectionPortalRepository) Get(ctx context.Context, workspaceID, id uuid.UUID, db DB) (*models.CollectionPortal, error) {
+	query := `SELECT ` + collectionPortalColumns + ` FROM collection_portals WHERE id = $1 AND workspace_id = $2`
+
+	portal, err := scanCollectionPortal(db.QueryRowContext(ctx, query, id, workspaceID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching collection portal: %w", err)
+	}
+	return portal, nil
+}
+
+func (r *collectionPortalRepository) GetBySlug(ctx context.Context, slug string, db DB) (*models.CollectionPortal, error) {
+	query := `SELECT ` + collectionPortalColumns + ` FROM collection_portals WHERE slug = $1`
+
+	portal, err := scanCollectionPortal(db.QueryRowContext(ctx, query, slug))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching collection portal: %w", err)
+	}
+	return portal, nil
+}
+
+func (r *collectionPortalRepository) GetByWorkspace(ctx context.Context, workspaceID uuid.UUID, db DB) ([]models.CollectionPortal, error) {
+	query := `
+		SELECT ` + collectionPortalColumns + `
+		FROM collection_portals
+		WHERE workspace_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := db.QueryContext(ctx, query, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching collection portals: %w", err)
+	}
+	defer rows.Close()
+
+	var portals []models.CollectionPortal
+	for rows.Next() {
+		portal, err := scanCollectionPortal(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning collection portal: %w", err)
+		}
+		portals = append(portals, *portal)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating collection portals: %w", err)
+	}
+	return portals, nil
+}
+
+func (r *collectionPortalRepository) Update(ctx context.Context, portal *models.CollectionPortal, db DB) error {
+	query := `
+		UPDATE collection_portals SET
+			name = $3,
+			slug = $4,
+			collection_methods = $5::collection_method[],
+			verification_methods = $6::verification_type[],
+			custom_fields = $7,
+			branding_settings = $8,
+			form_settings = $9,
+			notification_settings = $10,
+			active = $11
+		WHERE id = $1 AND workspace_id = $2
+		RETURNING updated_at
+	`
+
+	err := db.QueryRowContext(ctx, query,
+		portal.ID,
+		portal.WorkspaceID,
+		portal.Name,
+		portal.Slug,
+		collectionMethodArray(portal.CollectionMethods),
+		verificationTypeArray(portal.VerificationMethods),
+		portal.CustomFields,
+		portal.BrandingSettings,
+		portal.FormSettings,
+		portal.NotificationSettings,
+		portal.Active,
+	).Scan(&portal.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return sql.ErrNoRows
+	}
+	if isUniqueViolation(err) {
+		return apperrors.ErrDuplicateEntry
+	}
+	if err != nil {
+		return fmt.Errorf("error updating collection portal: %w", err)
+	}
+	return nil
+}
+
+func (r *collectionPortalRepository) Delete(ctx context.Context, workspaceID, id uuid.UUID, db DB) error {
+	result, err := db.ExecContext(ctx, `DELETE FROM collection_portals WHERE id = $1 AND workspace_id = $2`, id, workspaceID)
+	if err != nil {
+		return fmt.Errorf("error deleting collection portal: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error deleting collection portal: %w", err)
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
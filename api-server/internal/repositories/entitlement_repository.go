@@ -0,0 +1,103 @@
+package repositories
+
+//go:generate mockery --name=EntitlementRepository --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+type EntitlementRepository interface {
+	GetPlan(ctx context.Context, workspaceID uuid.UUID, db DB) (models.Plan, error)
+	// LockPlan returns the workspace's plan and locks the workspace row, so quota checks
+	// made in the same transaction cannot race.
+	LockPlan(ctx context.Context, workspaceID uuid.UUID, db DB) (models.Plan, error)
+	// CountUsage counts the workspace's usage of a quota. Monthly quotas only count
+	// usage from since onwards.
+	CountUsage(ctx context.Context, workspaceID uuid.UUID, quota models.Quota, since time.Time, db DB) (int, error)
+}
+
+type entitlementRepository struct {
+	*BaseRepository[models.Workspace]
+}
+
+func NewEntitlementRepository(redis *redis.Client) EntitlementRepository {
+	return &entitlementRepository{
+		BaseRepository: NewBaseRepository[models.Workspace](redis, "workspaces"),
+	}
+}
+
+func (r *entitlementRepository) GetPlan(ctx context.Context, workspaceID uuid.UUID, db DB) (models.Plan, error) {
+	return r.plan(ctx, `SELECT plan FROM workspaces WHERE id = $1`, workspaceID, db)
+}
+
+func (r *entitlementRepository) LockPlan(ctx context.Context, workspaceID uuid.UUID, db DB) (models.Plan, error) {
+	return r.plan(ctx, `SELECT plan FROM workspaces WHERE id = $1 FOR UPDATE`, workspaceID, db)
+}
+
+func (r *entitlementRepository) plan(ctx context.Context, query string, workspaceID uuid.UUID, db DB) (models.Plan, error) {
+	var plan models.Plan
+	err := db.QueryRowContext(ctx, query, workspaceID).Scan(&plan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", sql.ErrNoRows
+	}
+	if err != nil {
+		return "", fmt.Errorf("error fetching workspace plan: %w", err)
+	}
+	return plan, nil
+}
+
+// usageQueries counts each quota. Every query takes the workspace ID, and the monthly
+// ones also take the start of the period.
+var usageQueries = map[models.Quota]string{
+	models.QuotaTestimonials: `
+		SELECT COUNT(*) FROM testimonials
+		WHERE workspace_id = $1 AND created_at >= $2
+	`,
+	models.QuotaProviders: `
+		SELECT COUNT(*) FROM platform_integrations
+		WHERE workspace_id = $1
+	`,
+	// Pending invitations hold a seat until they are accepted, revoked or expire
+	models.QuotaTeamSeats: `
+		SELECT
+			(SELECT COUNT(*) FROM team_members WHERE workspace_id = $1) +
+			(SELECT COUNT(*) FROM team_invitations
+			 WHERE workspace_id = $1 AND status = 'pending' AND expires_at > NOW())
+	`,
+	models.QuotaAIJobs: `
+		SELECT COUNT(*) FROM ai_jobs j
+		INNER JOIN testimonials t ON j.testimonial_id = t.id
+		WHERE t.workspace_id = $1 AND j.created_at >= $2
+	`,
+	// Each video counts as whole minutes, rounded up, and at least one minute when its
+	// length is unknown, the same as the check made before it is stored
+	models.QuotaVideoMinutes: `
+		SELECT COALESCE(SUM(GREATEST(1, CEIL(COALESCE(media_duration, 0) / 60.0))), 0)::INTEGER FROM testimonials
+		WHERE workspace_id = $1 AND format = 'video' AND created_at >= $2
+	`,
+}
+
+func (r *entitlementRepository) CountUsage(ctx context.Context, workspaceID uuid.UUID, quota models.Quota, since time.Time, db DB) (int, error) {
+	query, ok := usageQueries[quota]
+	if !ok {
+		return 0, fmt.Errorf("unknown quota %q", quota)
+	}
+	args := []any{workspaceID}
+	if quota.Monthly() {
+		args = append(args, since)
+	}
+
+	var used int
+	if err := db.QueryRowContext(ctx, query, args...).Scan(&used); err != nil {
+		return 0, fmt.Errorf("error counting %s usage: %w", quota, err)
+	}
+	return used, nil
+}
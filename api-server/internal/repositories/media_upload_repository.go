@@ -0,0 +1,195 @@
+package repositories
+
+//go:generate mockery --name=MediaUploadRepository --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+type MediaUploadRepository interface {
+	Create(ctx context.Context, upload *models.MediaUpload, db DB) error
+	// Get returns the upload with ReceivedParts populated.
+	Get(ctx context.Context, workspaceID, id uuid.UUID, db DB) (*models.MediaUpload, error)
+	SavePart(ctx context.Context, uploadID uuid.UUID, part models.MediaUploadPart, db DB) error
+	GetParts(ctx context.Context, uploadID uuid.UUID, db DB) ([]models.MediaUploadPart, error)
+	UpdateStatus(ctx context.Context, id uuid.UUID, status models.MediaUploadStatus, testimonialID *uuid.UUID, db DB) error
+}
+
+type mediaUploadRepository struct {
+	*BaseRepository[models.MediaUpload]
+}
+
+func NewMediaUploadRepository(redis *redis.Client) MediaUploadRepository {
+	return &mediaUploadRepository{
+		BaseRepository: NewBaseRepository[models.MediaUpload](redis, "media_uploads"),
+	}
+}
+
+func (r *mediaUploadRepository) Create(ctx context.Context, upload *models.MediaUpload, db DB) error {
+	query := `
+		INSERT INTO media_uploads (
+			id, workspace_id, created_by, filename, content_type, format, size_bytes,
+			chunk_size, storage_key, storage_upload_id, status, metadata, expires_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING created_at, updated_at
+	`
+
+	err := db.QueryRowContext(ctx, query,
+		upload.ID,
+		upload.WorkspaceID,
+		sql.NullString{String: upload.CreatedBy, Valid: upload.CreatedBy != ""},
+		upload.Filename,
+		upload.ContentType,
+		upload.Format,
+		upload.SizeBytes,
+		upload.ChunkSize,
+		upload.StorageKey,
+		upload.StorageUploadID,
+		upload.Status,
+		upload.Metadata,
+		upload.ExpiresAt,
+	).Scan(&upload.CreatedAt, &upload.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("error creating media upload: %w", err)
+	}
+	return nil
+}
+
+func (r *mediaUploadRepository) Get(ctx context.Context, workspaceID, id uuid.UUID, db DB) (*models.MediaUpload, error) {
+	query := `
+		SELECT
+			id, workspace_id, created_by, filename, content_type, format, size_bytes,
+			chunk_size, storage_key, storage_upload_id, status, metadata, testimonial_id,
+			expires_at, created_at, updated_at
+		FROM media_uploads
+		WHERE id = $1 AND workspace_id = $2
+	`
+
+	var (
+		upload        models.MediaUpload
+		createdBy     sql.NullString
+		testimonialID uuid.NullUUID
+	)
+	err := db.QueryRowContext(ctx, query, id, workspaceID).Scan(
+		&upload.ID,
+		&upload.WorkspaceID,
+		&createdBy,
+		&upload.Filename,
+		&upload.ContentType,
+		&upload.Format,
+		&upload.SizeBytes,
+		&upload.ChunkSize,
+		&upload.StorageKey,
+		&upload.StorageUploadID,
+		&upload.Status,
+		&upload.Metadata,
+		&testimonialID,
+		&upload.ExpiresAt,
+		&upload.CreatedAt,
+		&upload.UpdatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching media upload: %w", err)
+	}
+
+	upload.CreatedBy = createdBy.String
+	if testimonialID.Valid {
+		upload.TestimonialID = &testimonialID.UUID
+	}
+
+	parts, err := r.GetParts(ctx, upload.ID, db)
+	if err != nil {
+		return nil, err
+	}
+	upload.ReceivedParts = make([]int, len(parts))
+	for i, p := range parts {
+		upload.ReceivedParts[i] = p.Number
+	}
+	return &upload, nil
+}
+
+// SavePart records a received part. A retried part replaces the earlier attempt.
+func (r *mediaUploadRepository) SavePart(ctx context.Context, uploadID uuid.UUID, part models.MediaUploadPart, db DB) error {
+	query := `
+		INSERT INTO media_upload_parts (upload_id, part_number, etag, size_bytes)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (upload_id, part_number)
+		DO UPDATE SET etag = EXCLUDED.etag, size_bytes = EXCLUDED.size_bytes, created_at = NOW()
+	`
+
+	if _, err := db.ExecContext(ctx, query, uploadID, part.Number, part.ETag, part.SizeBytes); err != nil {
+		return fmt.Errorf("error saving media upload part: %w", err)
+	}
+	return nil
+}
+
+func (r *mediaUploadRepository) GetParts(ctx context.Context, uploadID uuid.UUID, db DB) ([]models.MediaUploadPart, error) {
+	query := `
+		SELECT part_number, etag, size_bytes
+		FROM media_upload_parts
+		WHERE upload_id = $1
+		ORDER BY part_number
+	`
+
+	rows, err := db.QueryContext(ctx, query, uploadID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching media upload parts: %w", err)
+	}
+	defer rows.Close()
+
+	var parts []models.MediaUploadPart
+	for rows.Next() {
+		var p models.MediaUploadPart
+		if err := rows.Scan(&p.Number, &p.ETag, &p.SizeBytes); err != nil {
+			return nil, fmt.Errorf("error scanning media upload part: %w", err)
+		}
+		parts = append(parts, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating media upload parts: %w", err)
+	}
+	return parts, nil
+}
+
+// UpdateStatus moves an in-progress upload to its final status. It returns
+// sql.ErrNoRows when the upload is no longer in progress, so concurrent completions
+// cannot both succeed.
+func (r *mediaUploadRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status models.MediaUploadStatus, testimonialID *uuid.UUID, db DB) error {
+	query := `
+		UPDATE media_uploads
+		SET status = $2, testimonial_id = COALESCE($3, testimonial_id)
+		WHERE id = $1 AND status = $4
+	`
+
+	result, err := db.ExecContext(ctx, query, id, status, uuid.NullUUID{UUID: derefUUID(testimonialID), Valid: testimonialID != nil}, models.MediaUploadInProgress)
+	if err != nil {
+		return fmt.Errorf("error updating media upload: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error updating media upload: %w", err)
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func derefUUID(id *uuid.UUID) uuid.UUID {
+	if id == nil {
+		return uuid.Nil
+	}
+	return *id
+}
@@ -54,6 +54,36 @@ func (_m *TeamMemberRepository) Delete(ctx context.Context, id uuid.UUID, db rep
 	return r0
 }
 
+// GetByFirebaseUID provides a mock function with given fields: ctx, workspaceID, firebaseUID, db
+func (_m *TeamMemberRepository) GetByFirebaseUID(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, db repositories.DB) (*models.TeamMember, error) {
+	ret := _m.Called(ctx, workspaceID, firebaseUID, db)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByFirebaseUID")
+	}
+
+	var r0 *models.TeamMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, repositories.DB) (*models.TeamMember, error)); ok {
+		return rf(ctx, workspaceID, firebaseUID, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, repositories.DB) *models.TeamMember); ok {
+		r0 = rf(ctx, workspaceID, firebaseUID, db)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TeamMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, repositories.DB) error); ok {
+		r1 = rf(ctx, workspaceID, firebaseUID, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByID provides a mock function with given fields: ctx, id, db
 func (_m *TeamMemberRepository) GetByID(ctx context.Context, id uuid.UUID, db repositories.DB) (*models.TeamMember, error) {
 	ret := _m.Called(ctx, id, db)
@@ -174,6 +204,64 @@ func (_m *TeamMemberRepository) GetDataByUserID(_a0 context.Context, _a1 uuid.UU
 	return r0, r1
 }
 
+// GetRoleByFirebaseUID provides a mock function with given fields: ctx, workspaceID, firebaseUID, db
+func (_m *TeamMemberRepository) GetRoleByFirebaseUID(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, db repositories.DB) (models.MemberRole, error) {
+	ret := _m.Called(ctx, workspaceID, firebaseUID, db)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoleByFirebaseUID")
+	}
+
+	var r0 models.MemberRole
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, repositories.DB) (models.MemberRole, error)); ok {
+		return rf(ctx, workspaceID, firebaseUID, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, repositories.DB) models.MemberRole); ok {
+		r0 = rf(ctx, workspaceID, firebaseUID, db)
+	} else {
+		r0 = ret.Get(0).(models.MemberRole)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, repositories.DB) error); ok {
+		r1 = rf(ctx, workspaceID, firebaseUID, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSummaries provides a mock function with given fields: ctx, workspaceID, db
+func (_m *TeamMemberRepository) ListSummaries(ctx context.Context, workspaceID uuid.UUID, db repositories.DB) ([]models.TeamMemberSummary, error) {
+	ret := _m.Called(ctx, workspaceID, db)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSummaries")
+	}
+
+	var r0 []models.TeamMemberSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repositories.DB) ([]models.TeamMemberSummary, error)); ok {
+		return rf(ctx, workspaceID, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repositories.DB) []models.TeamMemberSummary); ok {
+		r0 = rf(ctx, workspaceID, db)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TeamMemberSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, repositories.DB) error); ok {
+		r1 = rf(ctx, workspaceID, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, entity, id, db
 func (_m *TeamMemberRepository) Update(ctx context.Context, entity *models.TeamMember, id uuid.UUID, db repositories.DB) error {
 	ret := _m.Called(ctx, entity, id, db)
@@ -12,6 +12,8 @@ import (
 
 	sql "database/sql"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -20,22 +22,108 @@ type TestimonialRepository struct {
 	mock.Mock
 }
 
+// ApplyAuthenticity provides a mock function with given fields: ctx, id, score, holdReason, db
+func (_m *TestimonialRepository) ApplyAuthenticity(ctx context.Context, id uuid.UUID, score float32, holdReason string, db repositories.DB) error {
+	ret := _m.Called(ctx, id, score, holdReason, db)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyAuthenticity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, float32, string, repositories.DB) error); ok {
+		r0 = rf(ctx, id, score, holdReason, db)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ArchiveBySourceIDs provides a mock function with given fields: ctx, workspaceID, platform, reviewIDs, db
+func (_m *TestimonialRepository) ArchiveBySourceIDs(ctx context.Context, workspaceID uuid.UUID, platform string, reviewIDs []string, db repositories.DB) (int64, error) {
+	ret := _m.Called(ctx, workspaceID, platform, reviewIDs, db)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveBySourceIDs")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []string, repositories.DB) (int64, error)); ok {
+		return rf(ctx, workspaceID, platform, reviewIDs, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []string, repositories.DB) int64); ok {
+		r0 = rf(ctx, workspaceID, platform, reviewIDs, db)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, []string, repositories.DB) error); ok {
+		r1 = rf(ctx, workspaceID, platform, reviewIDs, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ArchiveMissingFromSource provides a mock function with given fields: ctx, workspaceID, platform, liveReviewIDs, db
+func (_m *TestimonialRepository) ArchiveMissingFromSource(ctx context.Context, workspaceID uuid.UUID, platform string, liveReviewIDs []string, db repositories.DB) (int64, error) {
+	ret := _m.Called(ctx, workspaceID, platform, liveReviewIDs, db)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveMissingFromSource")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []string, repositories.DB) (int64, error)); ok {
+		return rf(ctx, workspaceID, platform, liveReviewIDs, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []string, repositories.DB) int64); ok {
+		r0 = rf(ctx, workspaceID, platform, liveReviewIDs, db)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, []string, repositories.DB) error); ok {
+		r1 = rf(ctx, workspaceID, platform, liveReviewIDs, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BatchUpsert provides a mock function with given fields: ctx, testimonials, db
-func (_m *TestimonialRepository) BatchUpsert(ctx context.Context, testimonials []models.Testimonial, db *sql.DB) error {
+func (_m *TestimonialRepository) BatchUpsert(ctx context.Context, testimonials []models.Testimonial, db *sql.DB) ([]uuid.UUID, error) {
 	ret := _m.Called(ctx, testimonials, db)
 
 	if len(ret) == 0 {
 		panic("no return value specified for BatchUpsert")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, []models.Testimonial, *sql.DB) error); ok {
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.Testimonial, *sql.DB) ([]uuid.UUID, error)); ok {
+		return rf(ctx, testimonials, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []models.Testimonial, *sql.DB) []uuid.UUID); ok {
 		r0 = rf(ctx, testimonials, db)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, []models.Testimonial, *sql.DB) error); ok {
+		r1 = rf(ctx, testimonials, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // CountByWorkspaceID provides a mock function with given fields: ctx, workspaceID, filter, db
@@ -270,6 +358,45 @@ func (_m *TestimonialRepository) FetchMostRecent(ctx context.Context, workspaceI
 	return r0, r1
 }
 
+// FetchPage provides a mock function with given fields: ctx, workspaceID, page, db
+func (_m *TestimonialRepository) FetchPage(ctx context.Context, workspaceID uuid.UUID, page models.TestimonialPageRequest, db repositories.DB) ([]models.Testimonial, *models.TestimonialCursor, error) {
+	ret := _m.Called(ctx, workspaceID, page, db)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchPage")
+	}
+
+	var r0 []models.Testimonial
+	var r1 *models.TestimonialCursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.TestimonialPageRequest, repositories.DB) ([]models.Testimonial, *models.TestimonialCursor, error)); ok {
+		return rf(ctx, workspaceID, page, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.TestimonialPageRequest, repositories.DB) []models.Testimonial); ok {
+		r0 = rf(ctx, workspaceID, page, db)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Testimonial)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.TestimonialPageRequest, repositories.DB) *models.TestimonialCursor); ok {
+		r1 = rf(ctx, workspaceID, page, db)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*models.TestimonialCursor)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, models.TestimonialPageRequest, repositories.DB) error); ok {
+		r2 = rf(ctx, workspaceID, page, db)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // FetchTopRated provides a mock function with given fields: ctx, workspaceID, limit, db
 func (_m *TestimonialRepository) FetchTopRated(ctx context.Context, workspaceID uuid.UUID, limit int, db repositories.DB) ([]models.Testimonial, error) {
 	ret := _m.Called(ctx, workspaceID, limit, db)
@@ -300,6 +427,36 @@ func (_m *TestimonialRepository) FetchTopRated(ctx context.Context, workspaceID
 	return r0, r1
 }
 
+// GetAuthenticitySignals provides a mock function with given fields: ctx, id, burstWindow, db
+func (_m *TestimonialRepository) GetAuthenticitySignals(ctx context.Context, id uuid.UUID, burstWindow time.Duration, db repositories.DB) (*models.AuthenticitySignals, error) {
+	ret := _m.Called(ctx, id, burstWindow, db)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthenticitySignals")
+	}
+
+	var r0 *models.AuthenticitySignals
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration, repositories.DB) (*models.AuthenticitySignals, error)); ok {
+		return rf(ctx, id, burstWindow, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration, repositories.DB) *models.AuthenticitySignals); ok {
+		r0 = rf(ctx, id, burstWindow, db)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.AuthenticitySignals)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Duration, repositories.DB) error); ok {
+		r1 = rf(ctx, id, burstWindow, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByID provides a mock function with given fields: ctx, id, db
 func (_m *TestimonialRepository) GetByID(ctx context.Context, id uuid.UUID, db repositories.DB) (*models.Testimonial, error) {
 	ret := _m.Called(ctx, id, db)
@@ -330,6 +487,62 @@ func (_m *TestimonialRepository) GetByID(ctx context.Context, id uuid.UUID, db r
 	return r0, r1
 }
 
+// GetStatus provides a mock function with given fields: ctx, workspaceID, id, db
+func (_m *TestimonialRepository) GetStatus(ctx context.Context, workspaceID uuid.UUID, id uuid.UUID, db repositories.DB) (models.ContentStatus, error) {
+	ret := _m.Called(ctx, workspaceID, id, db)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatus")
+	}
+
+	var r0 models.ContentStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, repositories.DB) (models.ContentStatus, error)); ok {
+		return rf(ctx, workspaceID, id, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, repositories.DB) models.ContentStatus); ok {
+		r0 = rf(ctx, workspaceID, id, db)
+	} else {
+		r0 = ret.Get(0).(models.ContentStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, repositories.DB) error); ok {
+		r1 = rf(ctx, workspaceID, id, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWorkspaceID provides a mock function with given fields: ctx, id, db
+func (_m *TestimonialRepository) GetWorkspaceID(ctx context.Context, id uuid.UUID, db repositories.DB) (uuid.UUID, error) {
+	ret := _m.Called(ctx, id, db)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceID")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repositories.DB) (uuid.UUID, error)); ok {
+		return rf(ctx, id, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repositories.DB) uuid.UUID); ok {
+		r0 = rf(ctx, id, db)
+	} else {
+		r0 = ret.Get(0).(uuid.UUID)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, repositories.DB) error); ok {
+		r1 = rf(ctx, id, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MarkAsVerified provides a mock function with given fields: ctx, id, verificationMethod, verificationData, db
 func (_m *TestimonialRepository) MarkAsVerified(ctx context.Context, id uuid.UUID, verificationMethod models.VerificationType, verificationData map[string]interface{}, db repositories.DB) error {
 	ret := _m.Called(ctx, id, verificationMethod, verificationData, db)
@@ -348,6 +561,84 @@ func (_m *TestimonialRepository) MarkAsVerified(ctx context.Context, id uuid.UUI
 	return r0
 }
 
+// PublishDue provides a mock function with given fields: ctx, now, limit, db
+func (_m *TestimonialRepository) PublishDue(ctx context.Context, now time.Time, limit int, db repositories.DB) ([]models.Testimonial, error) {
+	ret := _m.Called(ctx, now, limit, db)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishDue")
+	}
+
+	var r0 []models.Testimonial
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, repositories.DB) ([]models.Testimonial, error)); ok {
+		return rf(ctx, now, limit, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, repositories.DB) []models.Testimonial); ok {
+		r0 = rf(ctx, now, limit, db)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Testimonial)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int, repositories.DB) error); ok {
+		r1 = rf(ctx, now, limit, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Search provides a mock function with given fields: ctx, workspaceID, req, db
+func (_m *TestimonialRepository) Search(ctx context.Context, workspaceID uuid.UUID, req models.TestimonialSearchRequest, db repositories.DB) ([]models.TestimonialSearchHit, error) {
+	ret := _m.Called(ctx, workspaceID, req, db)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []models.TestimonialSearchHit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.TestimonialSearchRequest, repositories.DB) ([]models.TestimonialSearchHit, error)); ok {
+		return rf(ctx, workspaceID, req, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.TestimonialSearchRequest, repositories.DB) []models.TestimonialSearchHit); ok {
+		r0 = rf(ctx, workspaceID, req, db)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TestimonialSearchHit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.TestimonialSearchRequest, repositories.DB) error); ok {
+		r1 = rf(ctx, workspaceID, req, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransitionStatus provides a mock function with given fields: ctx, id, from, to, publishAt, db
+func (_m *TestimonialRepository) TransitionStatus(ctx context.Context, id uuid.UUID, from models.ContentStatus, to models.ContentStatus, publishAt *time.Time, db repositories.DB) error {
+	ret := _m.Called(ctx, id, from, to, publishAt, db)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ContentStatus, models.ContentStatus, *time.Time, repositories.DB) error); ok {
+		r0 = rf(ctx, id, from, to, publishAt, db)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, entity, id, db
 func (_m *TestimonialRepository) Update(ctx context.Context, entity *models.Testimonial, id uuid.UUID, db repositories.DB) error {
 	ret := _m.Called(ctx, entity, id, db)
@@ -384,17 +675,17 @@ func (_m *TestimonialRepository) UpdateMetrics(ctx context.Context, id uuid.UUID
 	return r0
 }
 
-// UpdateStatus provides a mock function with given fields: ctx, id, status, db
-func (_m *TestimonialRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status models.ContentStatus, db repositories.DB) error {
-	ret := _m.Called(ctx, id, status, db)
+// UpdateTranscript provides a mock function with given fields: ctx, id, transcript, summary, db
+func (_m *TestimonialRepository) UpdateTranscript(ctx context.Context, id uuid.UUID, transcript string, summary string, db repositories.DB) error {
+	ret := _m.Called(ctx, id, transcript, summary, db)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateStatus")
+		panic("no return value specified for UpdateTranscript")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ContentStatus, repositories.DB) error); ok {
-		r0 = rf(ctx, id, status, db)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, repositories.DB) error); ok {
+		r0 = rf(ctx, id, transcript, summary, db)
 	} else {
 		r0 = ret.Error(0)
 	}
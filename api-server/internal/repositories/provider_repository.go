@@ -1,40 +1,228 @@
 // repositories/provider_config_repository.go
 package repositories
 
+//go:generate mockery --name=ProviderConfigRepository --output=./mocks --case=underscore
+
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
 	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/pkg/envelope"
 	"github.com/redis/go-redis/v9"
 )
 
+// errNoCredentialsKey is returned for anything that needs secrets encrypted or decrypted
+// while CREDENTIALS_ENCRYPTION_KEY is not configured.
+var errNoCredentialsKey = fmt.Errorf("%w: CREDENTIALS_ENCRYPTION_KEY is not configured", apperrors.ErrServiceUnavailable)
+
+const (
+	integrationStatusActive   = "active"
+	integrationStatusInactive = "inactive"
+)
+
+// socialProviders are stored with the social_media integration type, every
+// other provider is treated as a review platform.
+var socialProviders = map[string]bool{
+	"facebook":  true,
+	"twitter":   true,
+	"instagram": true,
+}
+
 type ProviderConfigRepository interface {
 	Save(ctx context.Context, config models.ProviderConfig, db DB) error
 	GetByWorkspace(ctx context.Context, workspaceID uuid.UUID, db DB) ([]models.ProviderConfig, error)
 	GetByProvider(ctx context.Context, providerName string, db DB) ([]models.ProviderConfig, error)
+	GetActive(ctx context.Context, db DB) ([]models.ProviderConfig, error)
 }
 
 type providerConfigRepository struct {
 	redisClient *redis.Client
+	cipher      *envelope.Cipher
 }
 
-func NewProviderConfigRepository(redisClient *redis.Client) ProviderConfigRepository {
+func NewProviderConfigRepository(redisClient *redis.Client, cipher *envelope.Cipher) ProviderConfigRepository {
 	return &providerConfigRepository{
 		redisClient: redisClient,
+		cipher:      cipher,
 	}
 }
 
+const providerConfigColumns = `
+	id, user_id, workspace_id, platform_name, credentials,
+	status, sync_frequency, created_at, updated_at
+`
+
 func (pr *providerConfigRepository) Save(ctx context.Context, config models.ProviderConfig, db DB) error {
+	credentials, err := pr.sealCredentials(config.Credentials)
+	if err != nil {
+		return err
+	}
+
+	integrationType := "review_platform"
+	if socialProviders[config.ProviderName] {
+		integrationType = "social_media"
+	}
+
+	status := integrationStatusInactive
+	if config.IsActive {
+		status = integrationStatusActive
+	}
+
+	query := `
+		INSERT INTO platform_integrations (
+			id, user_id, workspace_id, platform_name, integration_type,
+			credentials, status, sync_frequency, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (workspace_id, platform_name) DO UPDATE SET
+			user_id = EXCLUDED.user_id,
+			credentials = EXCLUDED.credentials,
+			status = EXCLUDED.status,
+			sync_frequency = EXCLUDED.sync_frequency,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err = db.ExecContext(ctx, query,
+		config.ID,
+		config.UserID,
+		config.WorkspaceID,
+		config.ProviderName,
+		integrationType,
+		credentials,
+		status,
+		config.Schedule,
+		config.CreatedAt,
+		config.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error saving provider config: %w", err)
+	}
 	return nil
 }
 
 func (pr *providerConfigRepository) GetByWorkspace(ctx context.Context, workspaceID uuid.UUID, db DB) ([]models.ProviderConfig, error) {
-	return nil, nil
+	query := `SELECT ` + providerConfigColumns + `
+		FROM platform_integrations
+		WHERE workspace_id = $1
+		ORDER BY platform_name`
+
+	return pr.query(ctx, db, query, workspaceID)
 }
 
 func (pr *providerConfigRepository) GetByProvider(ctx context.Context, providerName string, db DB) ([]models.ProviderConfig, error) {
-	return nil, nil
+	query := `SELECT ` + providerConfigColumns + `
+		FROM platform_integrations
+		WHERE platform_name = $1
+		ORDER BY created_at`
+
+	return pr.query(ctx, db, query, providerName)
+}
+
+// GetActive returns every active configuration across all workspaces. It is used to
+// rebuild the sync schedule when the service boots.
+func (pr *providerConfigRepository) GetActive(ctx context.Context, db DB) ([]models.ProviderConfig, error) {
+	query := `SELECT ` + providerConfigColumns + `
+		FROM platform_integrations
+		WHERE status = $1
+		ORDER BY created_at`
+
+	return pr.query(ctx, db, query, integrationStatusActive)
 }
 
-// Implement the repository methods...
+func (pr *providerConfigRepository) query(ctx context.Context, db DB, query string, args ...any) ([]models.ProviderConfig, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching provider configs: %w", err)
+	}
+	defer rows.Close()
+
+	var configs []models.ProviderConfig
+	for rows.Next() {
+		var (
+			config      models.ProviderConfig
+			userID      *string
+			schedule    *string
+			status      *string
+			credentials []byte
+		)
+
+		if err := rows.Scan(
+			&config.ID,
+			&userID,
+			&config.WorkspaceID,
+			&config.ProviderName,
+			&credentials,
+			&status,
+			&schedule,
+			&config.CreatedAt,
+			&config.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning provider config: %w", err)
+		}
+
+		if userID != nil {
+			config.UserID = *userID
+		}
+		if schedule != nil {
+			config.Schedule = *schedule
+		}
+		config.IsActive = status != nil && *status == integrationStatusActive
+
+		config.Credentials, err = pr.openCredentials(credentials)
+		if err != nil {
+			return nil, fmt.Errorf("error decrypting credentials for %s: %w", config.ProviderName, err)
+		}
+
+		configs = append(configs, config)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating provider configs: %w", err)
+	}
+	return configs, nil
+}
+
+func (pr *providerConfigRepository) sealCredentials(credentials map[string]string) ([]byte, error) {
+	if pr.cipher == nil {
+		return nil, errNoCredentialsKey
+	}
+	if credentials == nil {
+		credentials = map[string]string{}
+	}
+
+	plaintext, err := json.Marshal(credentials)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling credentials: %w", err)
+	}
+
+	env, err := pr.cipher.Seal(plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("error encrypting credentials: %w", err)
+	}
+
+	return json.Marshal(env)
+}
+
+func (pr *providerConfigRepository) openCredentials(data []byte) (map[string]string, error) {
+	if pr.cipher == nil {
+		return nil, errNoCredentialsKey
+	}
+	var env envelope.Envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, err
+	}
+
+	plaintext, err := pr.cipher.Open(&env)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := map[string]string{}
+	if err := json.Unmarshal(plaintext, &credentials); err != nil {
+		return nil, err
+	}
+	return credentials, nil
+}
@@ -0,0 +1,224 @@
+package repositories
+
+//go:generate mockery --name=SemanticIndexRepository --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+type SemanticIndexRepository interface {
+	// FetchStale returns testimonials with text that have no embedding or have changed
+	// since they were embedded, least recently changed first.
+	FetchStale(ctx context.Context, limit int, db DB) ([]models.SemanticIndexCandidate, error)
+	// GetCandidate returns a workspace's testimonial with the text it would be embedded
+	// with, whether or not it is stale.
+	GetCandidate(ctx context.Context, workspaceID, testimonialID uuid.UUID, db DB) (*models.SemanticIndexCandidate, error)
+	Get(ctx context.Context, workspaceID, testimonialID uuid.UUID, db DB) (*models.SemanticIndex, error)
+	Upsert(ctx context.Context, index *models.SemanticIndex, db DB) error
+	// MarkCurrent records that a testimonial changed without changing its embedded text.
+	MarkCurrent(ctx context.Context, testimonialID uuid.UUID, sourceUpdatedAt time.Time, db DB) error
+	// FindSimilar returns the workspace's testimonials closest to the embedding, most
+	// similar first. Only embeddings from the same model are compared, and exclude is
+	// left out of the results. Run it in a transaction, so the scan setting it makes
+	// does not outlive the query.
+	FindSimilar(ctx context.Context, workspaceID uuid.UUID, model string, embedding models.Vector, exclude uuid.UUID, limit int, db DB) ([]models.SimilarTestimonial, error)
+}
+
+type semanticIndexRepository struct {
+	*BaseRepository[models.SemanticIndex]
+}
+
+func NewSemanticIndexRepository(redis *redis.Client) SemanticIndexRepository {
+	return &semanticIndexRepository{
+		BaseRepository: NewBaseRepository[models.SemanticIndex](redis, "semantic_indices"),
+	}
+}
+
+const semanticIndexCandidateColumns = `
+	t.id, t.workspace_id, t.title, t.summary, t.content, t.transcript, t.updated_at, s.content_hash
+`
+
+func scanSemanticIndexCandidate(row rowScanner) (*models.SemanticIndexCandidate, error) {
+	var (
+		c                       models.SemanticIndexCandidate
+		title, summary, content sql.NullString
+		hash                    sql.NullString
+	)
+	t := &c.Testimonial
+	if err := row.Scan(&t.ID, &t.WorkspaceID, &title, &summary, &content, &t.Transcript, &t.UpdatedAt, &hash); err != nil {
+		return nil, err
+	}
+	t.Title, t.Summary, t.Content = title.String, summary.String, content.String
+	if hash.Valid {
+		c.ContentHash = &hash.String
+	}
+	return &c, nil
+}
+
+func (r *semanticIndexRepository) FetchStale(ctx context.Context, limit int, db DB) ([]models.SemanticIndexCandidate, error) {
+	query := `SELECT ` + semanticIndexCandidateColumns + `
+		FROM testimonials t
+		LEFT JOIN semantic_indices s ON s.testimonial_id = t.id
+		WHERE (s.testimonial_id IS NULL OR s.source_updated_at < t.updated_at)
+		  AND btrim(coalesce(t.title, '') || coalesce(t.summary, '') || coalesce(t.content, '') || coalesce(t.transcript, '')) <> ''
+		ORDER BY t.updated_at, t.id
+		LIMIT $1
+	`
+	rows, err := db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching testimonials to embed: %w", err)
+	}
+	defer rows.Close()
+
+	candidates := []models.SemanticIndexCandidate{}
+	for rows.Next() {
+		c, err := scanSemanticIndexCandidate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning testimonial to embed: %w", err)
+		}
+		candidates = append(candidates, *c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating testimonials to embed: %w", err)
+	}
+	return candidates, nil
+}
+
+func (r *semanticIndexRepository) GetCandidate(ctx context.Context, workspaceID, testimonialID uuid.UUID, db DB) (*models.SemanticIndexCandidate, error) {
+	query := `SELECT ` + semanticIndexCandidateColumns + `
+		FROM testimonials t
+		LEFT JOIN semantic_indices s ON s.testimonial_id = t.id
+		WHERE t.id = $1 AND t.workspace_id = $2
+	`
+	c, err := scanSemanticIndexCandidate(db.QueryRowContext(ctx, query, testimonialID, workspaceID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching testimonial to embed: %w", err)
+	}
+	return c, nil
+}
+
+func (r *semanticIndexRepository) Get(ctx context.Context, workspaceID, testimonialID uuid.UUID, db DB) (*models.SemanticIndex, error) {
+	query := `
+		SELECT testimonial_id, workspace_id, embedding_model, embedding, content_hash, source_updated_at
+		FROM semantic_indices
+		WHERE testimonial_id = $1 AND workspace_id = $2
+	`
+	var index models.SemanticIndex
+	err := db.QueryRowContext(ctx, query, testimonialID, workspaceID).Scan(
+		&index.TestimonialID, &index.WorkspaceID, &index.Model, &index.Embedding, &index.ContentHash, &index.SourceUpdatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching semantic index: %w", err)
+	}
+	return &index, nil
+}
+
+func (r *semanticIndexRepository) Upsert(ctx context.Context, index *models.SemanticIndex, db DB) error {
+	query := `
+		INSERT INTO semantic_indices (testimonial_id, workspace_id, embedding_model, embedding, content_hash, source_updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (testimonial_id) DO UPDATE SET
+			workspace_id = EXCLUDED.workspace_id,
+			embedding_model = EXCLUDED.embedding_model,
+			embedding = EXCLUDED.embedding,
+			content_hash = EXCLUDED.content_hash,
+			source_updated_at = EXCLUDED.source_updated_at
+	`
+	_, err := db.ExecContext(ctx, query,
+		index.TestimonialID, index.WorkspaceID, index.Model, index.Embedding, index.ContentHash, index.SourceUpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error storing semantic index: %w", err)
+	}
+	return nil
+}
+
+func (r *semanticIndexRepository) MarkCurrent(ctx context.Context, testimonialID uuid.UUID, sourceUpdatedAt time.Time, db DB) error {
+	_, err := db.ExecContext(ctx,
+		`UPDATE semantic_indices SET source_updated_at = $1 WHERE testimonial_id = $2`,
+		sourceUpdatedAt, testimonialID,
+	)
+	if err != nil {
+		return fmt.Errorf("error updating semantic index: %w", err)
+	}
+	return nil
+}
+
+func (r *semanticIndexRepository) FindSimilar(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	model string,
+	embedding models.Vector,
+	exclude uuid.UUID,
+	limit int,
+	db DB,
+) ([]models.SimilarTestimonial, error) {
+	// The workspace filter applies after the HNSW index is searched, which can leave a
+	// plain index scan short of results. Iterative scans keep searching until the limit
+	// is met, at the cost of a loosely ordered result that is sorted again below.
+	if _, err := db.ExecContext(ctx, `SET LOCAL hnsw.iterative_scan = relaxed_order`); err != nil {
+		return nil, fmt.Errorf("error configuring similarity search: %w", err)
+	}
+
+	query := `
+		WITH nearest AS MATERIALIZED (
+			SELECT s.testimonial_id, s.embedding <=> $3 AS distance
+			FROM semantic_indices s
+			WHERE s.workspace_id = $1 AND s.embedding_model = $2 AND s.testimonial_id <> $4
+			ORDER BY distance
+			LIMIT $5
+		)
+		SELECT
+			t.id, t.workspace_id, t.customer_profile_id, t.testimonial_type, t.format, t.status, t.language,
+			t.title, t.summary, t.content, t.rating, t.media_url, t.thumbnail_url, t.authenticity_score,
+			t.published, t.published_at, t.created_at, t.updated_at,
+			1 - n.distance
+		FROM nearest n
+		JOIN testimonials t ON t.id = n.testimonial_id
+		ORDER BY n.distance, t.id
+	`
+	rows, err := db.QueryContext(ctx, query, workspaceID, model, embedding, exclude, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error finding similar testimonials: %w", err)
+	}
+	defer rows.Close()
+
+	results := []models.SimilarTestimonial{}
+	for rows.Next() {
+		var (
+			result                  models.SimilarTestimonial
+			title, summary, content sql.NullString
+		)
+		t := &result.Testimonial
+		if err := rows.Scan(
+			&t.ID, &t.WorkspaceID, &t.CustomerProfileID, &t.TestimonialType, &t.Format, &t.Status, &t.Language,
+			&title, &summary, &content, &t.Rating, &t.MediaURL, &t.ThumbnailURL, &t.AuthenticityScore,
+			&t.Published, &t.PublishedAt, &t.CreatedAt, &t.UpdatedAt,
+			&result.Similarity,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning similar testimonial: %w", err)
+		}
+		t.Title, t.Summary, t.Content = title.String, summary.String, content.String
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating similar testimonials: %w", err)
+	}
+	return results, nil
+}
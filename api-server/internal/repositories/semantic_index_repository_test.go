@@ -0,0 +1,75 @@
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSemanticIndexGet(t *testing.T) {
+	db, mock := setupMockDB()
+	defer db.Close()
+
+	repo := repositories.NewSemanticIndexRepository(redis.NewClient(&redis.Options{}))
+	ctx := context.Background()
+	workspaceID, testimonialID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(`SELECT testimonial_id, workspace_id, embedding_model, embedding, content_hash, source_updated_at FROM semantic_indices`).
+		WithArgs(testimonialID, workspaceID).
+		WillReturnRows(sqlmock.NewRows([]string{"testimonial_id", "workspace_id", "embedding_model", "embedding", "content_hash", "source_updated_at"}).
+			AddRow(testimonialID, workspaceID, "text-embedding-3-small", []byte("[0.5,-1,2.25e-3]"), "abc", now))
+
+	index, err := repo.Get(ctx, workspaceID, testimonialID, db)
+	assert.NoError(t, err)
+	if assert.NotNil(t, index) {
+		assert.Equal(t, models.Vector{0.5, -1, 0.00225}, index.Embedding)
+		assert.Equal(t, "text-embedding-3-small", index.Model)
+	}
+
+	mock.ExpectQuery(`FROM semantic_indices`).WithArgs(testimonialID, workspaceID).WillReturnError(sql.ErrNoRows)
+	_, err = repo.Get(ctx, workspaceID, testimonialID, db)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFindSimilar(t *testing.T) {
+	db, mock := setupMockDB()
+	defer db.Close()
+
+	repo := repositories.NewSemanticIndexRepository(redis.NewClient(&redis.Options{}))
+	ctx := context.Background()
+	workspaceID, source, match := uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+	columns := []string{
+		"id", "workspace_id", "customer_profile_id", "testimonial_type", "format", "status", "language",
+		"title", "summary", "content", "rating", "media_url", "thumbnail_url", "authenticity_score",
+		"published", "published_at", "created_at", "updated_at", "similarity",
+	}
+
+	mock.ExpectExec(`SET LOCAL hnsw.iterative_scan = relaxed_order`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`WITH nearest AS MATERIALIZED .* s.embedding <=> \$3 .* s.testimonial_id <> \$4 .* LIMIT \$5`).
+		WithArgs(workspaceID, "text-embedding-3-small", "[0.5,0.25]", source, 5).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(
+			match, workspaceID, nil, "customer", "text", "approved", "en",
+			nil, nil, "Support answered within minutes", 5, nil, nil, 0.9,
+			true, now, now, now, 0.87,
+		))
+
+	results, err := repo.FindSimilar(ctx, workspaceID, "text-embedding-3-small", models.Vector{0.5, 0.25}, source, 5, db)
+	assert.NoError(t, err)
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, match, results[0].Testimonial.ID)
+		assert.Equal(t, "Support answered within minutes", results[0].Testimonial.Content)
+		assert.Equal(t, 0.87, results[0].Similarity)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
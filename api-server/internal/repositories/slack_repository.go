@@ -0,0 +1,365 @@
+package repositories
+
+//go:generate mockery --name=SlackRepository --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/lib/pq"
+	"github.com/redis/go-redis/v9"
+)
+
+type SlackRepository interface {
+	// GetSettings returns the workspace's settings, or the defaults when it has not saved
+	// any. It returns sql.ErrNoRows when the workspace does not exist.
+	GetSettings(ctx context.Context, workspaceID uuid.UUID, db DB) (*models.SlackSettings, error)
+	SaveSettings(ctx context.Context, settings *models.SlackSettings, db DB) error
+	// ClaimNotification records that a testimonial is being posted as kind. It returns
+	// false when it already has been, so a testimonial is never posted twice.
+	ClaimNotification(ctx context.Context, workspaceID, testimonialID uuid.UUID, kind models.SlackNotificationKind, db DB) (bool, error)
+	// ReleaseNotification forgets a claim whose message could not be posted, so the
+	// testimonial is posted the next time it qualifies.
+	ReleaseNotification(ctx context.Context, testimonialID uuid.UUID, kind models.SlackNotificationKind, db DB) error
+	// ClaimDueDigests takes up to limit workspaces whose digest is due at now. Claimed
+	// workspaces are pushed back by lease, so another server only picks one up again if
+	// this one dies before scheduling the next digest.
+	ClaimDueDigests(ctx context.Context, now time.Time, lease time.Duration, limit int, db DB) ([]models.SlackSettings, error)
+	ScheduleDigest(ctx context.Context, workspaceID uuid.UUID, next time.Time, db DB) error
+	// GetDigest summarizes the testimonials the workspace received in [since, until).
+	// Testimonials rated at or below alertRating count as negative.
+	GetDigest(ctx context.Context, workspaceID uuid.UUID, since, until time.Time, alertRating float32, db DB) (*models.SlackDigest, error)
+	SaveUserLink(ctx context.Context, link *models.SlackUserLink, db DB) error
+	// GetLinkedFirebaseUID returns the Firebase UID of the member the Slack user is
+	// linked to. It returns sql.ErrNoRows when the user is not linked.
+	GetLinkedFirebaseUID(ctx context.Context, workspaceID uuid.UUID, teamID, userID string, db DB) (string, error)
+}
+
+type slackRepository struct {
+	*BaseRepository[models.SlackSettings]
+}
+
+func NewSlackRepository(redis *redis.Client) SlackRepository {
+	return &slackRepository{
+		BaseRepository: NewBaseRepository[models.SlackSettings](redis, "slack_settings"),
+	}
+}
+
+const slackSettingsColumns = `
+	s.workspace_id, s.enabled, s.notify_new, s.alert_rating, s.alert_sentiments, s.rules,
+	s.digest_enabled, s.digest_hour, s.timezone, s.next_digest_at, s.updated_at
+`
+
+func scanSlackSettings(row rowScanner, extra ...any) (*models.SlackSettings, error) {
+	var (
+		settings   models.SlackSettings
+		sentiments pq.StringArray
+		rules      []byte
+		next       sql.NullTime
+	)
+
+	dest := []any{
+		&settings.WorkspaceID,
+		&settings.Enabled,
+		&settings.NotifyNew,
+		&settings.AlertRating,
+		&sentiments,
+		&rules,
+		&settings.DigestEnabled,
+		&settings.DigestHour,
+		&settings.Timezone,
+		&next,
+		&settings.UpdatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	settings.AlertSentiments = make([]models.Sentiment, len(sentiments))
+	for i, s := range sentiments {
+		settings.AlertSentiments[i] = models.Sentiment(s)
+	}
+	settings.Rules = []models.SlackRoutingRule{}
+	if len(rules) > 0 {
+		if err := json.Unmarshal(rules, &settings.Rules); err != nil {
+			return nil, fmt.Errorf("error decoding slack routing rules: %w", err)
+		}
+	}
+	if next.Valid {
+		settings.NextDigestAt = &next.Time
+	}
+	return &settings, nil
+}
+
+func (r *slackRepository) GetSettings(ctx context.Context, workspaceID uuid.UUID, db DB) (*models.SlackSettings, error) {
+	query := `
+		SELECT w.integration_settings->>'slack_webhook_url', s.workspace_id IS NOT NULL
+		FROM workspaces w
+		LEFT JOIN slack_settings s ON s.workspace_id = w.id
+		WHERE w.id = $1
+	`
+
+	var (
+		webhookURL sql.NullString
+		saved      bool
+	)
+	err := db.QueryRowContext(ctx, query, workspaceID).Scan(&webhookURL, &saved)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching slack settings: %w", err)
+	}
+
+	settings := models.DefaultSlackSettings(workspaceID)
+	if saved {
+		row := db.QueryRowContext(ctx, `SELECT `+slackSettingsColumns+` FROM slack_settings s WHERE s.workspace_id = $1`, workspaceID)
+		if settings, err = scanSlackSettings(row); err != nil {
+			return nil, fmt.Errorf("error fetching slack settings: %w", err)
+		}
+	}
+	settings.WebhookURL = webhookURL.String
+	return settings, nil
+}
+
+func (r *slackRepository) SaveSettings(ctx context.Context, settings *models.SlackSettings, db DB) error {
+	query := `
+		INSERT INTO slack_settings (
+			workspace_id, enabled, notify_new, alert_rating, alert_sentiments, rules,
+			digest_enabled, digest_hour, timezone, next_digest_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (workspace_id) DO UPDATE SET
+			enabled = EXCLUDED.enabled,
+			notify_new = EXCLUDED.notify_new,
+			alert_rating = EXCLUDED.alert_rating,
+			alert_sentiments = EXCLUDED.alert_sentiments,
+			rules = EXCLUDED.rules,
+			digest_enabled = EXCLUDED.digest_enabled,
+			digest_hour = EXCLUDED.digest_hour,
+			timezone = EXCLUDED.timezone,
+			next_digest_at = EXCLUDED.next_digest_at
+		RETURNING updated_at
+	`
+
+	rules, err := json.Marshal(settings.Rules)
+	if err != nil {
+		return fmt.Errorf("error encoding slack routing rules: %w", err)
+	}
+	sentiments := make(pq.StringArray, len(settings.AlertSentiments))
+	for i, s := range settings.AlertSentiments {
+		sentiments[i] = string(s)
+	}
+
+	err = db.QueryRowContext(ctx, query,
+		settings.WorkspaceID,
+		settings.Enabled,
+		settings.NotifyNew,
+		settings.AlertRating,
+		sentiments,
+		rules,
+		settings.DigestEnabled,
+		settings.DigestHour,
+		settings.Timezone,
+		settings.NextDigestAt,
+	).Scan(&settings.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("error saving slack settings: %w", err)
+	}
+	return nil
+}
+
+func (r *slackRepository) ClaimNotification(ctx context.Context, workspaceID, testimonialID uuid.UUID, kind models.SlackNotificationKind, db DB) (bool, error) {
+	query := `
+		INSERT INTO slack_notifications (workspace_id, testimonial_id, kind)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (testimonial_id, kind) DO NOTHING
+	`
+
+	result, err := db.ExecContext(ctx, query, workspaceID, testimonialID, kind)
+	if err != nil {
+		return false, fmt.Errorf("error claiming slack notification: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error claiming slack notification: %w", err)
+	}
+	return n == 1, nil
+}
+
+func (r *slackRepository) ReleaseNotification(ctx context.Context, testimonialID uuid.UUID, kind models.SlackNotificationKind, db DB) error {
+	_, err := db.ExecContext(ctx,
+		"DELETE FROM slack_notifications WHERE testimonial_id = $1 AND kind = $2",
+		testimonialID, kind,
+	)
+	if err != nil {
+		return fmt.Errorf("error releasing slack notification: %w", err)
+	}
+	return nil
+}
+
+func (r *slackRepository) ClaimDueDigests(ctx context.Context, now time.Time, lease time.Duration, limit int, db DB) ([]models.SlackSettings, error) {
+	query := `
+		WITH due AS (
+			SELECT workspace_id
+			FROM slack_settings
+			WHERE enabled AND digest_enabled AND next_digest_at <= $1
+			ORDER BY next_digest_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE slack_settings s
+		SET next_digest_at = $2
+		FROM due, workspaces w
+		WHERE s.workspace_id = due.workspace_id AND w.id = s.workspace_id
+		RETURNING ` + slackSettingsColumns + `, w.integration_settings->>'slack_webhook_url'
+	`
+
+	rows, err := db.QueryContext(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("error claiming slack digests: %w", err)
+	}
+	defer rows.Close()
+
+	due := []models.SlackSettings{}
+	for rows.Next() {
+		var webhookURL sql.NullString
+		settings, err := scanSlackSettings(rows, &webhookURL)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning slack settings: %w", err)
+		}
+		settings.WebhookURL = webhookURL.String
+		due = append(due, *settings)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating slack digests: %w", err)
+	}
+	return due, nil
+}
+
+func (r *slackRepository) ScheduleDigest(ctx context.Context, workspaceID uuid.UUID, next time.Time, db DB) error {
+	_, err := db.ExecContext(ctx,
+		"UPDATE slack_settings SET next_digest_at = $2 WHERE workspace_id = $1",
+		workspaceID, next,
+	)
+	if err != nil {
+		return fmt.Errorf("error scheduling slack digest: %w", err)
+	}
+	return nil
+}
+
+func (r *slackRepository) GetDigest(ctx context.Context, workspaceID uuid.UUID, since, until time.Time, alertRating float32, db DB) (*models.SlackDigest, error) {
+	query := `
+		SELECT
+			w.name,
+			COUNT(t.id),
+			AVG(t.rating),
+			COUNT(t.id) FILTER (WHERE t.rating <= $4),
+			(SELECT COUNT(*) FROM testimonials p WHERE p.workspace_id = w.id AND p.status = 'pending_review')
+		FROM workspaces w
+		LEFT JOIN testimonials t ON t.workspace_id = w.id AND t.created_at >= $2 AND t.created_at < $3
+		WHERE w.id = $1
+		GROUP BY w.id, w.name
+	`
+
+	digest := models.SlackDigest{WorkspaceID: workspaceID, Since: since, Until: until}
+	var average sql.NullFloat64
+	err := db.QueryRowContext(ctx, query, workspaceID, since, until, alertRating).Scan(
+		&digest.WorkspaceName,
+		&digest.Total,
+		&average,
+		&digest.Negative,
+		&digest.PendingReview,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error summarizing testimonials: %w", err)
+	}
+	if average.Valid {
+		avg := float32(average.Float64)
+		digest.AverageRating = &avg
+	}
+	if digest.Total == 0 {
+		return &digest, nil
+	}
+
+	topQuery := `
+		SELECT id, content, rating, collection_method, source_data
+		FROM testimonials
+		WHERE workspace_id = $1 AND created_at >= $2 AND created_at < $3 AND rating IS NOT NULL
+		ORDER BY rating DESC, created_at DESC
+		LIMIT 1
+	`
+
+	var (
+		top     models.Testimonial
+		content sql.NullString
+		rating  sql.NullFloat64
+	)
+	err = db.QueryRowContext(ctx, topQuery, workspaceID, since, until).Scan(
+		&top.ID,
+		&content,
+		&rating,
+		&top.CollectionMethod,
+		&top.SourceData,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &digest, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching top testimonial: %w", err)
+	}
+	top.WorkspaceID = workspaceID
+	top.Content = content.String
+	if rating.Valid {
+		value := float32(rating.Float64)
+		top.Rating = &value
+	}
+	digest.Top = &top
+	return &digest, nil
+}
+
+func (r *slackRepository) SaveUserLink(ctx context.Context, link *models.SlackUserLink, db DB) error {
+	query := `
+		INSERT INTO slack_user_links (workspace_id, slack_team_id, slack_user_id, team_member_id)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (workspace_id, slack_team_id, slack_user_id)
+		DO UPDATE SET team_member_id = EXCLUDED.team_member_id, created_at = CURRENT_TIMESTAMP
+		RETURNING created_at
+	`
+
+	err := db.QueryRowContext(ctx, query, link.WorkspaceID, link.TeamID, link.UserID, link.TeamMemberID).
+		Scan(&link.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error saving slack user link: %w", err)
+	}
+	return nil
+}
+
+func (r *slackRepository) GetLinkedFirebaseUID(ctx context.Context, workspaceID uuid.UUID, teamID, userID string, db DB) (string, error) {
+	query := `
+		SELECT u.firebase_uid
+		FROM slack_user_links l
+		INNER JOIN team_members m ON m.id = l.team_member_id
+		INNER JOIN users u ON u.id = m.user_id
+		WHERE l.workspace_id = $1 AND l.slack_team_id = $2 AND l.slack_user_id = $3
+	`
+
+	var firebaseUID string
+	err := db.QueryRowContext(ctx, query, workspaceID, teamID, userID).Scan(&firebaseUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", sql.ErrNoRows
+	}
+	if err != nil {
+		return "", fmt.Errorf("error fetching slack user link: %w", err)
+	}
+	return firebaseUID, nil
+}
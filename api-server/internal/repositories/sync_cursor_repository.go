@@ -0,0 +1,94 @@
+package repositories
+
+//go:generate mockery --name=SyncCursorRepository --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+type SyncCursorRepository interface {
+	Get(ctx context.Context, workspaceID uuid.UUID, providerName string, db DB) (*models.SyncCursor, error)
+	Save(ctx context.Context, cursor *models.SyncCursor, db DB) error
+}
+
+type syncCursorRepository struct {
+	*BaseRepository[models.SyncCursor]
+}
+
+func NewSyncCursorRepository(redis *redis.Client) SyncCursorRepository {
+	return &syncCursorRepository{
+		BaseRepository: NewBaseRepository[models.SyncCursor](redis, "provider_sync_cursors"),
+	}
+}
+
+// Get returns the stored cursor, or an empty cursor when the pair has never synced.
+func (r *syncCursorRepository) Get(ctx context.Context, workspaceID uuid.UUID, providerName string, db DB) (*models.SyncCursor, error) {
+	query := `
+		SELECT since_at, resume_token, last_full_scan_at, updated_at
+		FROM provider_sync_cursors
+		WHERE workspace_id = $1 AND provider_name = $2
+	`
+
+	cursor := &models.SyncCursor{
+		WorkspaceID:  workspaceID,
+		ProviderName: providerName,
+	}
+
+	var (
+		since      sql.NullTime
+		token      sql.NullString
+		lastFullAt sql.NullTime
+	)
+
+	err := db.QueryRowContext(ctx, query, workspaceID, providerName).Scan(
+		&since,
+		&token,
+		&lastFullAt,
+		&cursor.UpdatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return cursor, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching sync cursor: %w", err)
+	}
+
+	cursor.Since = since.Time
+	cursor.Token = token.String
+	if lastFullAt.Valid {
+		cursor.LastFullScanAt = &lastFullAt.Time
+	}
+	return cursor, nil
+}
+
+func (r *syncCursorRepository) Save(ctx context.Context, cursor *models.SyncCursor, db DB) error {
+	query := `
+		INSERT INTO provider_sync_cursors (
+			workspace_id, provider_name, since_at, resume_token, last_full_scan_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, NOW())
+		ON CONFLICT (workspace_id, provider_name) DO UPDATE SET
+			since_at = EXCLUDED.since_at,
+			resume_token = EXCLUDED.resume_token,
+			last_full_scan_at = COALESCE(EXCLUDED.last_full_scan_at, provider_sync_cursors.last_full_scan_at),
+			updated_at = NOW()
+	`
+
+	_, err := db.ExecContext(ctx, query,
+		cursor.WorkspaceID,
+		cursor.ProviderName,
+		sql.NullTime{Time: cursor.Since, Valid: !cursor.Since.IsZero()},
+		sql.NullString{String: cursor.Token, Valid: cursor.Token != ""},
+		cursor.LastFullScanAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error saving sync cursor: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package repositories
+
+//go:generate mockery --name=SyncRunRepository --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+type SyncRunRepository interface {
+	Create(ctx context.Context, run *models.SyncRun, db DB) error
+	Finish(ctx context.Context, run *models.SyncRun, db DB) error
+	GetByWorkspace(ctx context.Context, workspaceID uuid.UUID, limit int, db DB) ([]models.SyncRun, error)
+}
+
+type syncRunRepository struct {
+	*BaseRepository[models.SyncRun]
+}
+
+func NewSyncRunRepository(redis *redis.Client) SyncRunRepository {
+	return &syncRunRepository{
+		BaseRepository: NewBaseRepository[models.SyncRun](redis, "provider_sync_runs"),
+	}
+}
+
+func (r *syncRunRepository) Create(ctx context.Context, run *models.SyncRun, db DB) error {
+	query := `
+		INSERT INTO provider_sync_runs (
+			id, workspace_id, provider_name, trigger_type, status,
+			instance_id, testimonials_fetched, error, started_at, finished_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err := db.ExecContext(ctx, query,
+		run.ID,
+		nullableUUID(run.WorkspaceID),
+		run.ProviderName,
+		run.Trigger,
+		run.Status,
+		run.InstanceID,
+		run.TestimonialsFetched,
+		sql.NullString{String: run.Error, Valid: run.Error != ""},
+		run.StartedAt,
+		run.FinishedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating sync run: %w", err)
+	}
+	return nil
+}
+
+func (r *syncRunRepository) Finish(ctx context.Context, run *models.SyncRun, db DB) error {
+	query := `
+		UPDATE provider_sync_runs
+		SET status = $1, testimonials_fetched = $2, error = $3, finished_at = $4
+		WHERE id = $5
+	`
+
+	_, err := db.ExecContext(ctx, query,
+		run.Status,
+		run.TestimonialsFetched,
+		sql.NullString{String: run.Error, Valid: run.Error != ""},
+		run.FinishedAt,
+		run.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("error finishing sync run: %w", err)
+	}
+	return nil
+}
+
+func (r *syncRunRepository) GetByWorkspace(ctx context.Context, workspaceID uuid.UUID, limit int, db DB) ([]models.SyncRun, error) {
+	query := `
+		SELECT id, workspace_id, provider_name, trigger_type, status, instance_id,
+			testimonials_fetched, error, started_at, finished_at
+		FROM provider_sync_runs
+		WHERE workspace_id = $1
+		ORDER BY started_at DESC
+		LIMIT $2
+	`
+
+	rows, err := db.QueryContext(ctx, query, workspaceID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching sync runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []models.SyncRun
+	for rows.Next() {
+		var (
+			run        models.SyncRun
+			instanceID sql.NullString
+			runErr     sql.NullString
+			finishedAt sql.NullTime
+		)
+
+		if err := rows.Scan(
+			&run.ID,
+			&run.WorkspaceID,
+			&run.ProviderName,
+			&run.Trigger,
+			&run.Status,
+			&instanceID,
+			&run.TestimonialsFetched,
+			&runErr,
+			&run.StartedAt,
+			&finishedAt,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning sync run: %w", err)
+		}
+
+		run.InstanceID = instanceID.String
+		run.Error = runErr.String
+		if finishedAt.Valid {
+			run.FinishedAt = &finishedAt.Time
+		}
+		runs = append(runs, run)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating sync runs: %w", err)
+	}
+	return runs, nil
+}
+
+func nullableUUID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,252 @@
+package repositories
+
+//go:generate mockery --name=TeamInvitationRepository --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+type TeamInvitationRepository interface {
+	// Create stores a pending invitation, first marking any lapsed invitation to the same
+	// address expired. It returns apperrors.ErrDuplicateEntry when the address already has
+	// an unexpired pending invitation to the workspace.
+	Create(ctx context.Context, invitation *models.TeamInvitation, db DB) error
+	Get(ctx context.Context, workspaceID, id uuid.UUID, db DB) (*models.TeamInvitation, error)
+	// GetForUpdate returns an invitation by ID alone and locks it until the transaction ends.
+	GetForUpdate(ctx context.Context, id uuid.UUID, db DB) (*models.TeamInvitation, error)
+	// ListPending returns the invitations that can still be accepted.
+	ListPending(ctx context.Context, workspaceID uuid.UUID, db DB) ([]models.TeamInvitation, error)
+	// Renew gives an unexpired pending invitation a new expiry. It returns sql.ErrNoRows
+	// when the workspace has no such invitation.
+	Renew(ctx context.Context, workspaceID, id uuid.UUID, expiresAt time.Time, db DB) error
+	// Revoke returns sql.ErrNoRows when the workspace has no such pending invitation.
+	Revoke(ctx context.Context, workspaceID, id uuid.UUID, db DB) error
+	MarkAccepted(ctx context.Context, id, userID uuid.UUID, db DB) error
+	// HasMemberWithEmail reports whether a user with the address already belongs to the workspace.
+	HasMemberWithEmail(ctx context.Context, workspaceID uuid.UUID, email string, db DB) (bool, error)
+}
+
+type teamInvitationRepository struct {
+	*BaseRepository[models.TeamInvitation]
+}
+
+func NewTeamInvitationRepository(redis *redis.Client) TeamInvitationRepository {
+	return &teamInvitationRepository{
+		BaseRepository: NewBaseRepository[models.TeamInvitation](redis, "team_invitations"),
+	}
+}
+
+const teamInvitationColumns = `
+	id, workspace_id, email, role, status, invited_by, accepted_by,
+	expires_at, sent_at, accepted_at, revoked_at, created_at
+`
+
+func scanTeamInvitation(row rowScanner) (*models.TeamInvitation, error) {
+	var (
+		invitation models.TeamInvitation
+		invitedBy  uuid.NullUUID
+		acceptedBy uuid.NullUUID
+		acceptedAt sql.NullTime
+		revokedAt  sql.NullTime
+	)
+
+	if err := row.Scan(
+		&invitation.ID,
+		&invitation.WorkspaceID,
+		&invitation.Email,
+		&invitation.Role,
+		&invitation.Status,
+		&invitedBy,
+		&acceptedBy,
+		&invitation.ExpiresAt,
+		&invitation.SentAt,
+		&acceptedAt,
+		&revokedAt,
+		&invitation.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if invitedBy.Valid {
+		invitation.InvitedBy = &invitedBy.UUID
+	}
+	if acceptedBy.Valid {
+		invitation.AcceptedBy = &acceptedBy.UUID
+	}
+	if acceptedAt.Valid {
+		invitation.AcceptedAt = &acceptedAt.Time
+	}
+	if revokedAt.Valid {
+		invitation.RevokedAt = &revokedAt.Time
+	}
+	return &invitation, nil
+}
+
+func (r *teamInvitationRepository) Create(ctx context.Context, invitation *models.TeamInvitation, db DB) error {
+	// A lapsed invitation no longer holds the address's open-invitation slot
+	expire := `
+		UPDATE team_invitations
+		SET status = 'expired'
+		WHERE workspace_id = $1 AND LOWER(email) = LOWER($2) AND status = 'pending' AND expires_at <= NOW()
+	`
+	if _, err := db.ExecContext(ctx, expire, invitation.WorkspaceID, invitation.Email); err != nil {
+		return fmt.Errorf("error expiring team invitation: %w", err)
+	}
+
+	query := `
+		INSERT INTO team_invitations (id, workspace_id, email, role, status, invited_by, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING sent_at, created_at
+	`
+
+	var invitedBy uuid.NullUUID
+	if invitation.InvitedBy != nil {
+		invitedBy = uuid.NullUUID{UUID: *invitation.InvitedBy, Valid: true}
+	}
+
+	err := db.QueryRowContext(ctx, query,
+		invitation.ID,
+		invitation.WorkspaceID,
+		invitation.Email,
+		invitation.Role,
+		invitation.Status,
+		invitedBy,
+		invitation.ExpiresAt,
+	).Scan(&invitation.SentAt, &invitation.CreatedAt)
+	if isUniqueViolation(err) {
+		return apperrors.ErrDuplicateEntry
+	}
+	if err != nil {
+		return fmt.Errorf("error creating team invitation: %w", err)
+	}
+	return nil
+}
+
+func (r *teamInvitationRepository) Get(ctx context.Context, workspaceID, id uuid.UUID, db DB) (*models.TeamInvitation, error) {
+	query := `SELECT ` + teamInvitationColumns + ` FROM team_invitations WHERE id = $1 AND workspace_id = $2`
+
+	invitation, err := scanTeamInvitation(db.QueryRowContext(ctx, query, id, workspaceID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching team invitation: %w", err)
+	}
+	return invitation, nil
+}
+
+func (r *teamInvitationRepository) GetForUpdate(ctx context.Context, id uuid.UUID, db DB) (*models.TeamInvitation, error) {
+	query := `SELECT ` + teamInvitationColumns + ` FROM team_invitations WHERE id = $1 FOR UPDATE`
+
+	invitation, err := scanTeamInvitation(db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching team invitation: %w", err)
+	}
+	return invitation, nil
+}
+
+func (r *teamInvitationRepository) ListPending(ctx context.Context, workspaceID uuid.UUID, db DB) ([]models.TeamInvitation, error) {
+	query := `
+		SELECT ` + teamInvitationColumns + `
+		FROM team_invitations
+		WHERE workspace_id = $1 AND status = 'pending' AND expires_at > NOW()
+		ORDER BY created_at DESC
+	`
+
+	rows, err := db.QueryContext(ctx, query, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching team invitations: %w", err)
+	}
+	defer rows.Close()
+
+	invitations := []models.TeamInvitation{}
+	for rows.Next() {
+		invitation, err := scanTeamInvitation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning team invitation: %w", err)
+		}
+		invitations = append(invitations, *invitation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating team invitations: %w", err)
+	}
+	return invitations, nil
+}
+
+func (r *teamInvitationRepository) Renew(ctx context.Context, workspaceID, id uuid.UUID, expiresAt time.Time, db DB) error {
+	query := `
+		UPDATE team_invitations
+		SET expires_at = $3, sent_at = NOW()
+		WHERE id = $1 AND workspace_id = $2 AND status = 'pending' AND expires_at > NOW()
+	`
+	return r.execPending(ctx, "renewing", db, query, id, workspaceID, expiresAt)
+}
+
+func (r *teamInvitationRepository) Revoke(ctx context.Context, workspaceID, id uuid.UUID, db DB) error {
+	query := `
+		UPDATE team_invitations
+		SET status = 'revoked', revoked_at = NOW()
+		WHERE id = $1 AND workspace_id = $2 AND status = 'pending'
+	`
+	return r.execPending(ctx, "revoking", db, query, id, workspaceID)
+}
+
+// execPending runs an update against a pending invitation and returns sql.ErrNoRows
+// when it matched nothing.
+func (r *teamInvitationRepository) execPending(ctx context.Context, action string, db DB, query string, args ...any) error {
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("error %s team invitation: %w", action, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error %s team invitation: %w", action, err)
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *teamInvitationRepository) MarkAccepted(ctx context.Context, id, userID uuid.UUID, db DB) error {
+	query := `
+		UPDATE team_invitations
+		SET status = 'accepted', accepted_by = $2, accepted_at = NOW()
+		WHERE id = $1
+	`
+	if _, err := db.ExecContext(ctx, query, id, userID); err != nil {
+		return fmt.Errorf("error accepting team invitation: %w", err)
+	}
+	return nil
+}
+
+func (r *teamInvitationRepository) HasMemberWithEmail(ctx context.Context, workspaceID uuid.UUID, email string, db DB) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM team_members t
+			INNER JOIN users u ON t.user_id = u.id
+			WHERE t.workspace_id = $1 AND LOWER(u.email) = LOWER($2)
+		)
+	`
+
+	var exists bool
+	if err := db.QueryRowContext(ctx, query, workspaceID, email).Scan(&exists); err != nil {
+		return false, fmt.Errorf("error checking workspace members: %w", err)
+	}
+	return exists, nil
+}
@@ -0,0 +1,86 @@
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/lib/pq"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamInvitationExpiry(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := uuid.New()
+	repo := repositories.NewTeamInvitationRepository(redis.NewClient(&redis.Options{}))
+
+	t.Run("CreateExpiresLapsedInvitationFirst", func(t *testing.T) {
+		db, mock := setupMockDB()
+		defer db.Close()
+
+		now := time.Now()
+		invitation := &models.TeamInvitation{
+			ID:          uuid.New(),
+			WorkspaceID: workspaceID,
+			Email:       "ada@example.com",
+			Role:        models.Editor,
+			Status:      models.InvitationPending,
+			ExpiresAt:   now.Add(7 * 24 * time.Hour),
+		}
+
+		mock.ExpectExec(`UPDATE team_invitations SET status = 'expired' WHERE workspace_id = \$1 AND LOWER\(email\) = LOWER\(\$2\) AND status = 'pending' AND expires_at <= NOW\(\)`).
+			WithArgs(workspaceID, "ada@example.com").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`INSERT INTO team_invitations`).
+			WillReturnRows(sqlmock.NewRows([]string{"sent_at", "created_at"}).AddRow(now, now))
+
+		assert.NoError(t, repo.Create(ctx, invitation, db))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreateRejectsUnexpiredDuplicate", func(t *testing.T) {
+		db, mock := setupMockDB()
+		defer db.Close()
+
+		mock.ExpectExec(`SET status = 'expired'`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`INSERT INTO team_invitations`).WillReturnError(&pq.Error{Code: "23505"})
+
+		err := repo.Create(ctx, &models.TeamInvitation{ID: uuid.New(), WorkspaceID: workspaceID, Email: "ada@example.com"}, db)
+		assert.ErrorIs(t, err, apperrors.ErrDuplicateEntry)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListPendingLeavesOutExpired", func(t *testing.T) {
+		db, mock := setupMockDB()
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM team_invitations WHERE workspace_id = \$1 AND status = 'pending' AND expires_at > NOW\(\)`).
+			WithArgs(workspaceID).
+			WillReturnRows(sqlmock.NewRows(nil))
+
+		invitations, err := repo.ListPending(ctx, workspaceID, db)
+		assert.NoError(t, err)
+		assert.Empty(t, invitations)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RenewSkipsExpired", func(t *testing.T) {
+		db, mock := setupMockDB()
+		defer db.Close()
+
+		id, expiresAt := uuid.New(), time.Now().Add(time.Hour)
+		mock.ExpectExec(`WHERE id = \$1 AND workspace_id = \$2 AND status = 'pending' AND expires_at > NOW\(\)`).
+			WithArgs(id, workspaceID, expiresAt).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		assert.ErrorIs(t, repo.Renew(ctx, workspaceID, id, expiresAt, db), sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
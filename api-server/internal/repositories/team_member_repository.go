@@ -18,6 +18,11 @@ type TeamMemberRepository interface {
 	GetDataByID(context.Context, uuid.UUID, DB) (*models.TeamMemberGetParams, error)
 	GetDataByUserID(context.Context, uuid.UUID, DB) (*models.TeamMemberGetParams, error)
 	GetByWorkspaceID(context.Context, uuid.UUID, int, int, DB) ([]*models.TeamMember, error)
+	GetRoleByFirebaseUID(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, db DB) (models.MemberRole, error)
+	// GetByFirebaseUID returns the user's membership of the workspace, or sql.ErrNoRows.
+	GetByFirebaseUID(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, db DB) (*models.TeamMember, error)
+	// ListSummaries returns every member of the workspace with their name and email.
+	ListSummaries(ctx context.Context, workspaceID uuid.UUID, db DB) ([]models.TeamMemberSummary, error)
 }
 
 type teamMemberRepository struct {
@@ -197,6 +202,55 @@ func (r *teamMemberRepository) GetDataByUserID(ctx context.Context, userID uuid.
 	return r.getTeamMemberData(ctx, query, userID, scanByUserID, db)
 }
 
+// GetRoleByFirebaseUID returns the role the authenticated user holds in a workspace,
+// or sql.ErrNoRows when the user is not a member.
+func (r *teamMemberRepository) GetRoleByFirebaseUID(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, db DB) (models.MemberRole, error) {
+	query := `
+		SELECT t.role
+		FROM team_members t
+		INNER JOIN users u ON t.user_id = u.id
+		WHERE t.workspace_id = $1 AND u.firebase_uid = $2
+	`
+
+	var role models.MemberRole
+	if err := db.QueryRowContext(ctx, query, workspaceID, firebaseUID).Scan(&role); err != nil {
+		return "", err
+	}
+	return role, nil
+}
+
+func (r *teamMemberRepository) GetByFirebaseUID(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, db DB) (*models.TeamMember, error) {
+	query := `
+		SELECT t.id, t.workspace_id, t.user_id, t.role, t.permissions
+		FROM team_members t
+		INNER JOIN users u ON t.user_id = u.id
+		WHERE t.workspace_id = $1 AND u.firebase_uid = $2
+	`
+
+	var (
+		member          models.TeamMember
+		permissionsJSON []byte
+	)
+	err := db.QueryRowContext(ctx, query, workspaceID, firebaseUID).Scan(
+		&member.ID,
+		&member.WorkspaceID,
+		&member.UserID,
+		&member.Role,
+		&permissionsJSON,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	member.Permissions = make(map[string]any)
+	if len(permissionsJSON) > 0 {
+		if err := json.Unmarshal(permissionsJSON, &member.Permissions); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal permissions JSON: %v", err)
+		}
+	}
+	return &member, nil
+}
+
 func (r *teamMemberRepository) Create(ctx context.Context, team_member *models.TeamMember, db DB) error {
 	query := `
 		INSERT INTO team_members 
@@ -264,3 +318,40 @@ func (r *teamMemberRepository) GetByWorkspaceID(ctx context.Context, id uuid.UUI
 	}
 	return teamMembers, nil
 }
+
+func (r *teamMemberRepository) ListSummaries(ctx context.Context, workspaceID uuid.UUID, db DB) ([]models.TeamMemberSummary, error) {
+	query := `
+		SELECT t.id, t.user_id, COALESCE(u.name, ''), u.email, t.role, t.created_at
+		FROM team_members t
+		INNER JOIN users u ON t.user_id = u.id
+		WHERE t.workspace_id = $1
+		ORDER BY t.created_at
+	`
+
+	rows, err := db.QueryContext(ctx, query, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching team members: %w", err)
+	}
+	defer rows.Close()
+
+	members := []models.TeamMemberSummary{}
+	for rows.Next() {
+		var member models.TeamMemberSummary
+		if err := rows.Scan(
+			&member.ID,
+			&member.UserID,
+			&member.Name,
+			&member.Email,
+			&member.Role,
+			&member.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning team member: %w", err)
+		}
+		members = append(members, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating team members: %w", err)
+	}
+	return members, nil
+}
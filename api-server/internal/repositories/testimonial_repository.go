@@ -6,24 +6,49 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"log/slog"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/lib/pq"
 	"github.com/redis/go-redis/v9"
 )
 
 type TestimonialRepository interface {
 	Repository[models.Testimonial]
 	FetchByWorkspaceID(ctx context.Context, workspaceID uuid.UUID, filter models.TestimonialFilter, db DB) ([]models.Testimonial, error)
-	UpdateStatus(ctx context.Context, id uuid.UUID, status models.ContentStatus, db DB) error
-	BatchUpsert(ctx context.Context, testimonials []models.Testimonial, db *sql.DB) error
+	// FetchPage returns one page of a workspace's testimonials in keyset order, with only
+	// the requested fields loaded. The returned cursor is nil on the last page.
+	FetchPage(ctx context.Context, workspaceID uuid.UUID, page models.TestimonialPageRequest, db DB) ([]models.Testimonial, *models.TestimonialCursor, error)
+	// GetStatus returns the status of a testimonial in the workspace, or sql.ErrNoRows.
+	GetStatus(ctx context.Context, workspaceID, id uuid.UUID, db DB) (models.ContentStatus, error)
+	// TransitionStatus moves a testimonial from one status to another, clears its hold
+	// reason and sets when it is scheduled to be published, which is nil unless it moves
+	// to scheduled. Moving to approved or featured publishes it, keeping the time it was
+	// first published; any other status unpublishes it. It returns sql.ErrNoRows when the
+	// testimonial is no longer in the from status.
+	TransitionStatus(ctx context.Context, id uuid.UUID, from, to models.ContentStatus, publishAt *time.Time, db DB) error
+	// PublishDue publishes up to limit scheduled testimonials whose publish time is not
+	// after now and approves them. Rows locked by another publisher are skipped. The
+	// returned testimonials carry their ID, workspace, scheduled and published times.
+	PublishDue(ctx context.Context, now time.Time, limit int, db DB) ([]models.Testimonial, error)
+	// BatchUpsert stores the testimonials and sets the ID of each. It returns the IDs of
+	// the testimonials that were not stored before.
+	BatchUpsert(ctx context.Context, testimonials []models.Testimonial, db *sql.DB) ([]uuid.UUID, error)
 	Upsert(ctx context.Context, testimonial models.Testimonial, db DB) error
 
+	// Search ranks a workspace's testimonials against a web search query. Highlights are
+	// delimited with models.HighlightStart and models.HighlightStop and are not escaped.
+	Search(ctx context.Context, workspaceID uuid.UUID, req models.TestimonialSearchRequest, db DB) ([]models.TestimonialSearchHit, error)
 	FetchByID(ctx context.Context, id uuid.UUID, db DB) (*models.Testimonial, error)
+	// GetWorkspaceID returns the workspace a testimonial belongs to, or sql.ErrNoRows.
+	GetWorkspaceID(ctx context.Context, id uuid.UUID, db DB) (uuid.UUID, error)
 	FetchByCustomerEmail(ctx context.Context, workspaceID uuid.UUID, email string, db DB) ([]models.Testimonial, error)
 	CountByWorkspaceID(ctx context.Context, workspaceID uuid.UUID, filter models.TestimonialFilter, db DB) (int, error)
 	FetchTopRated(ctx context.Context, workspaceID uuid.UUID, limit int, db DB) ([]models.Testimonial, error)
@@ -32,6 +57,17 @@ type TestimonialRepository interface {
 	DeleteByID(ctx context.Context, id uuid.UUID, db DB) error
 	UpdateMetrics(ctx context.Context, id uuid.UUID, viewCount, shareCount, conversionCount int, db DB) error
 	MarkAsVerified(ctx context.Context, id uuid.UUID, verificationMethod models.VerificationType, verificationData map[string]interface{}, db DB) error
+	UpdateTranscript(ctx context.Context, id uuid.UUID, transcript, summary string, db DB) error
+	// GetAuthenticitySignals gathers what the authenticity checks need about a
+	// testimonial. Submissions from the same address within burstWindow of it count
+	// towards SubmissionsFromIP. It returns sql.ErrNoRows for an unknown testimonial.
+	GetAuthenticitySignals(ctx context.Context, id uuid.UUID, burstWindow time.Duration, db DB) (*models.AuthenticitySignals, error)
+	// ApplyAuthenticity stores the authenticity score. A non-empty holdReason flags a
+	// testimonial that is still pending review; testimonials a moderator already decided
+	// on keep their status and hold reason, so a late or retried check cannot undo it.
+	ApplyAuthenticity(ctx context.Context, id uuid.UUID, score float32, holdReason string, db DB) error
+	ArchiveBySourceIDs(ctx context.Context, workspaceID uuid.UUID, platform string, reviewIDs []string, db DB) (int64, error)
+	ArchiveMissingFromSource(ctx context.Context, workspaceID uuid.UUID, platform string, liveReviewIDs []string, db DB) (int64, error)
 }
 
 type testimonialRepository struct {
@@ -235,7 +271,7 @@ func (r *testimonialRepository) FetchByWorkspaceID(ctx context.Context, workspac
 		  collection_method, verification_method, verification_data, verification_status,
 	  	  verified_at, authenticity_score, source_data, published, published_at, scheduled_publish_at,
 	  	  tags, categories, custom_fields, view_count, share_count, conversion_count, engagement_metrics,
-	  	  created_at, updated_at
+	  	  created_at, updated_at, hold_reason
 		FROM testimonials
 		WHERE workspace_id = $1
 `
@@ -296,6 +332,7 @@ func (r *testimonialRepository) FetchByWorkspaceID(ctx context.Context, workspac
 			&t.EngagementMetrics,
 			&t.CreatedAt,
 			&t.UpdatedAt,
+			&t.HoldReason,
 		); err != nil {
 			return nil, fmt.Errorf("error scanning testimonial row: %w", err)
 		}
@@ -314,6 +351,245 @@ func (r *testimonialRepository) FetchByWorkspaceID(ctx context.Context, workspac
 	return testimonials, nil
 }
 
+// testimonialFieldTargets maps each listing field to where its column is scanned.
+var testimonialFieldTargets = map[string]func(t *models.Testimonial) any{
+	"id":                   func(t *models.Testimonial) any { return &t.ID },
+	"workspace_id":         func(t *models.Testimonial) any { return &t.WorkspaceID },
+	"customer_profile_id":  func(t *models.Testimonial) any { return &t.CustomerProfileID },
+	"testimonial_type":     func(t *models.Testimonial) any { return &t.TestimonialType },
+	"format":               func(t *models.Testimonial) any { return &t.Format },
+	"status":               func(t *models.Testimonial) any { return &t.Status },
+	"language":             func(t *models.Testimonial) any { return &t.Language },
+	"title":                func(t *models.Testimonial) any { return &t.Title },
+	"summary":              func(t *models.Testimonial) any { return &t.Summary },
+	"content":              func(t *models.Testimonial) any { return &t.Content },
+	"transcript":           func(t *models.Testimonial) any { return &t.Transcript },
+	"media_urls":           func(t *models.Testimonial) any { return &t.MediaURLs },
+	"rating":               func(t *models.Testimonial) any { return &t.Rating },
+	"media_url":            func(t *models.Testimonial) any { return &t.MediaURL },
+	"media_duration":       func(t *models.Testimonial) any { return &t.MediaDuration },
+	"thumbnail_url":        func(t *models.Testimonial) any { return &t.ThumbnailURL },
+	"additional_media":     func(t *models.Testimonial) any { return &t.AdditionalMedia },
+	"custom_formatting":    func(t *models.Testimonial) any { return &t.CustomFormatting },
+	"product_context":      func(t *models.Testimonial) any { return &t.ProductContext },
+	"experience_context":   func(t *models.Testimonial) any { return &t.ExperienceContext },
+	"collection_method":    func(t *models.Testimonial) any { return &t.CollectionMethod },
+	"verification_method":  func(t *models.Testimonial) any { return &t.VerificationMethod },
+	"verification_data":    func(t *models.Testimonial) any { return &t.VerificationData },
+	"verification_status":  func(t *models.Testimonial) any { return &t.VerificationStatus },
+	"verified_at":          func(t *models.Testimonial) any { return &t.VerifiedAt },
+	"authenticity_score":   func(t *models.Testimonial) any { return &t.AuthenticityScore },
+	"source_data":          func(t *models.Testimonial) any { return &t.SourceData },
+	"published":            func(t *models.Testimonial) any { return &t.Published },
+	"published_at":         func(t *models.Testimonial) any { return &t.PublishedAt },
+	"scheduled_publish_at": func(t *models.Testimonial) any { return &t.ScheduledPublishAt },
+	"tags":                 func(t *models.Testimonial) any { return &t.Tags },
+	"categories":           func(t *models.Testimonial) any { return &t.Categories },
+	"custom_fields":        func(t *models.Testimonial) any { return &t.CustomFields },
+	"view_count":           func(t *models.Testimonial) any { return &t.ViewCount },
+	"share_count":          func(t *models.Testimonial) any { return &t.ShareCount },
+	"conversion_count":     func(t *models.Testimonial) any { return &t.ConversionCount },
+	"engagement_metrics":   func(t *models.Testimonial) any { return &t.EngagementMetrics },
+	"created_at":           func(t *models.Testimonial) any { return &t.CreatedAt },
+	"updated_at":           func(t *models.Testimonial) any { return &t.UpdatedAt },
+	"hold_reason":          func(t *models.Testimonial) any { return &t.HoldReason },
+}
+
+// testimonialSortKeys are the expressions testimonials are ordered by. Missing ratings
+// and scores sort below every real value.
+var testimonialSortKeys = map[models.TestimonialSort]string{
+	models.SortByCreatedAt:         "created_at",
+	models.SortByRating:            "COALESCE(rating, 0)::float8",
+	models.SortByViewCount:         "COALESCE(view_count, 0)::float8",
+	models.SortByAuthenticityScore: "COALESCE(authenticity_score, -1)::float8",
+}
+
+func (r *testimonialRepository) FetchPage(ctx context.Context, workspaceID uuid.UUID, page models.TestimonialPageRequest, db DB) ([]models.Testimonial, *models.TestimonialCursor, error) {
+	fields := page.Fields
+	if len(fields) == 0 {
+		fields = models.TestimonialListFields
+	}
+	// The cursor needs the ID of the last row
+	if !slices.Contains(fields, "id") {
+		fields = append([]string{"id"}, fields...)
+	}
+	sortKey, ok := testimonialSortKeys[page.Sort]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown testimonial sort %q", page.Sort)
+	}
+
+	columns := make([]string, 0, len(fields)+1)
+	for _, field := range fields {
+		if _, ok := testimonialFieldTargets[field]; !ok {
+			return nil, nil, fmt.Errorf("unknown testimonial field %q", field)
+		}
+		columns = append(columns, field)
+	}
+	columns = append(columns, sortKey+" AS sort_key")
+
+	query := "SELECT " + strings.Join(columns, ", ") + " FROM testimonials WHERE workspace_id = $1"
+	query, args := r.buildFilterQuery(query, workspaceID, page.Filter)
+
+	direction, comparison := "ASC", ">"
+	if page.Desc {
+		direction, comparison = "DESC", "<"
+	}
+	if c := page.Cursor; c != nil {
+		var key any = c.Value
+		if c.Time != nil {
+			key = *c.Time
+		}
+		query += fmt.Sprintf(" AND (%s, id) %s ($%d, $%d)", sortKey, comparison, len(args)+1, len(args)+2)
+		args = append(args, key, c.ID)
+	}
+	// One extra row tells whether there is another page
+	query += fmt.Sprintf(" ORDER BY %s %s, id %s LIMIT $%d", sortKey, direction, direction, len(args)+1)
+	args = append(args, page.Limit+1)
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error querying testimonials: %w", err)
+	}
+	defer rows.Close()
+
+	testimonials := []models.Testimonial{}
+	var last models.TestimonialCursor
+	hasMore := false
+	for rows.Next() {
+		if len(testimonials) == page.Limit {
+			hasMore = true
+			break
+		}
+
+		var (
+			t        models.Testimonial
+			sortTime time.Time
+			sortNum  float64
+		)
+		targets := make([]any, 0, len(columns))
+		for _, field := range fields {
+			targets = append(targets, testimonialFieldTargets[field](&t))
+		}
+		if page.Sort == models.SortByCreatedAt {
+			targets = append(targets, &sortTime)
+		} else {
+			targets = append(targets, &sortNum)
+		}
+		if err := rows.Scan(targets...); err != nil {
+			return nil, nil, fmt.Errorf("error scanning testimonial row: %w", err)
+		}
+		testimonials = append(testimonials, t)
+
+		last = models.TestimonialCursor{Sort: page.Sort, Desc: page.Desc, ID: t.ID}
+		if page.Sort == models.SortByCreatedAt {
+			last.Time = &sortTime
+		} else {
+			last.Value = &sortNum
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("error iterating testimonial rows: %w", err)
+	}
+	if !hasMore {
+		return testimonials, nil, nil
+	}
+	return testimonials, &last, nil
+}
+
+// testimonialHeadlineOptions are the ts_headline options for search snippets.
+var testimonialHeadlineOptions = fmt.Sprintf(
+	`StartSel="%s", StopSel="%s", MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=" … "`,
+	models.HighlightStart, models.HighlightStop,
+)
+
+func (r *testimonialRepository) Search(ctx context.Context, workspaceID uuid.UUID, req models.TestimonialSearchRequest, db DB) ([]models.TestimonialSearchHit, error) {
+	fuzzy, excluded := models.SearchTerms(req.Query)
+
+	// Each testimonial is matched and stemmed in its own language. Customer names and
+	// companies are matched without stemming, and misspellings are caught by trigram
+	// similarity on the title, content and customer.
+	query := `
+		SELECT
+			t.id, t.workspace_id, t.customer_profile_id, t.testimonial_type, t.format, t.status, t.language,
+			t.title, t.summary, t.content, t.rating, t.media_url, t.thumbnail_url, t.authenticity_score,
+			t.published, t.published_at, t.created_at, t.updated_at,
+			coalesce(cp.name, ''), coalesce(cp.company, ''),
+			ts_rank_cd(t.search_vector, q.query) + greatest(
+				word_similarity($3, coalesce(t.title, '')),
+				word_similarity($3, coalesce(t.content, '')),
+				word_similarity($3, coalesce(cp.name, '')),
+				word_similarity($3, coalesce(cp.company, ''))
+			) AS rank,
+			ts_headline(q.config, coalesce(t.title, ''), q.query, $4),
+			ts_headline(q.config, coalesce(t.content, ''), q.query, $4),
+			CASE WHEN to_tsvector(q.config, coalesce(t.transcript, '')) @@ q.query
+				THEN ts_headline(q.config, t.transcript, q.query, $4) ELSE '' END
+		FROM testimonials t
+		LEFT JOIN customer_profiles cp ON cp.id = t.customer_profile_id
+		CROSS JOIN LATERAL (
+			SELECT testimonial_search_config(t.language) AS config,
+				websearch_to_tsquery(testimonial_search_config(t.language), $2) AS query
+		) q
+		WHERE t.workspace_id = $1
+		  AND (
+			t.search_vector @@ q.query
+			OR to_tsvector('simple', coalesce(cp.name, '') || ' ' || coalesce(cp.company, '')) @@ websearch_to_tsquery('simple', $2)
+			OR ($3 <> '' AND ($3 <% t.title OR $3 <% t.content OR $3 <% cp.name OR $3 <% cp.company))
+		  )
+	`
+	args := []any{workspaceID, req.Query, strings.Join(fuzzy, " "), testimonialHeadlineOptions}
+
+	// Fuzzy and customer matches must still honour exclusions
+	if len(excluded) > 0 {
+		args = append(args, strings.Join(excluded, " or "))
+		query += fmt.Sprintf(" AND NOT t.search_vector @@ websearch_to_tsquery(q.config, $%d)", len(args))
+	}
+	query += fmt.Sprintf(" ORDER BY rank DESC, t.created_at DESC, t.id LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	args = append(args, req.Limit, req.Offset)
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error searching testimonials: %w", err)
+	}
+	defer rows.Close()
+
+	hits := []models.TestimonialSearchHit{}
+	for rows.Next() {
+		var (
+			hit                              models.TestimonialSearchHit
+			title, summary, content          sql.NullString
+			titleHL, contentHL, transcriptHL string
+		)
+		t := &hit.Testimonial
+		if err := rows.Scan(
+			&t.ID, &t.WorkspaceID, &t.CustomerProfileID, &t.TestimonialType, &t.Format, &t.Status, &t.Language,
+			&title, &summary, &content, &t.Rating, &t.MediaURL, &t.ThumbnailURL, &t.AuthenticityScore,
+			&t.Published, &t.PublishedAt, &t.CreatedAt, &t.UpdatedAt,
+			&hit.CustomerName, &hit.CustomerCompany,
+			&hit.Rank,
+			&titleHL, &contentHL, &transcriptHL,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning search result: %w", err)
+		}
+		t.Title, t.Summary, t.Content = title.String, summary.String, content.String
+
+		hit.Highlights = map[string]string{}
+		for field, snippet := range map[string]string{"title": titleHL, "content": contentHL, "transcript": transcriptHL} {
+			// ts_headline returns the start of the text when nothing in it matched
+			if strings.Contains(snippet, models.HighlightStart) {
+				hit.Highlights[field] = snippet
+			}
+		}
+		hits = append(hits, hit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating search results: %w", err)
+	}
+	return hits, nil
+}
+
 // Helper function to map slice elements
 func mapSlice[T any, R any](slice []T, mapFunc func(T) R) []R {
 	result := make([]R, len(slice))
@@ -323,9 +599,27 @@ func mapSlice[T any, R any](slice []T, mapFunc func(T) R) []R {
 	return result
 }
 
-func (r *testimonialRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status models.ContentStatus, db DB) error {
-	query := "UPDATE testimonials SET status = $1 WHERE id = $2"
-	res, err := db.ExecContext(ctx, query, status, id)
+func (r *testimonialRepository) GetStatus(ctx context.Context, workspaceID, id uuid.UUID, db DB) (models.ContentStatus, error) {
+	var status models.ContentStatus
+	err := db.QueryRowContext(ctx, `SELECT status FROM testimonials WHERE id = $1 AND workspace_id = $2`, id, workspaceID).Scan(&status)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", sql.ErrNoRows
+	}
+	if err != nil {
+		return "", fmt.Errorf("error fetching testimonial status: %w", err)
+	}
+	return status, nil
+}
+
+func (r *testimonialRepository) TransitionStatus(ctx context.Context, id uuid.UUID, from, to models.ContentStatus, publishAt *time.Time, db DB) error {
+	query := `
+		UPDATE testimonials
+		SET status = $1, hold_reason = NULL, scheduled_publish_at = $2,
+			published = $5,
+			published_at = CASE WHEN $5 THEN COALESCE(published_at, NOW()) ELSE NULL END,
+			updated_at = NOW()
+		WHERE id = $3 AND status = $4`
+	res, err := db.ExecContext(ctx, query, to, publishAt, id, from, to.IsPublished())
 	if err != nil {
 		return fmt.Errorf("error updating testimonial status: %w", err)
 	}
@@ -334,11 +628,57 @@ func (r *testimonialRepository) UpdateStatus(ctx context.Context, id uuid.UUID,
 		return fmt.Errorf("error getting rows affected: %w", err)
 	}
 	if rowsAffected == 0 {
-		return fmt.Errorf("no testimonial found with ID %s", id)
+		return sql.ErrNoRows
 	}
 	return nil
 }
 
+func (r *testimonialRepository) PublishDue(ctx context.Context, now time.Time, limit int, db DB) ([]models.Testimonial, error) {
+	query := `
+		WITH due AS (
+			SELECT id, scheduled_publish_at
+			FROM testimonials
+			WHERE status = 'scheduled' AND scheduled_publish_at <= $1
+			ORDER BY scheduled_publish_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE testimonials t
+		SET status = 'approved', published = TRUE, published_at = $1, scheduled_publish_at = NULL
+		FROM due
+		WHERE t.id = due.id
+		RETURNING t.id, t.workspace_id, due.scheduled_publish_at, t.published_at
+	`
+
+	rows, err := db.QueryContext(ctx, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error publishing scheduled testimonials: %w", err)
+	}
+	defer rows.Close()
+
+	published := []models.Testimonial{}
+	for rows.Next() {
+		var (
+			t           models.Testimonial
+			scheduledAt time.Time
+			publishedAt time.Time
+		)
+		if err := rows.Scan(&t.ID, &t.WorkspaceID, &scheduledAt, &publishedAt); err != nil {
+			return nil, fmt.Errorf("error scanning published testimonial: %w", err)
+		}
+		t.Status = models.StatusApproved
+		t.Published = true
+		t.ScheduledPublishAt = &scheduledAt
+		t.PublishedAt = &publishedAt
+		published = append(published, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating published testimonials: %w", err)
+	}
+	return published, nil
+}
+
 func (r *testimonialRepository) Create(ctx context.Context, t *models.Testimonial, db DB) error {
 	query := `
 	INSERT INTO testimonials (
@@ -420,10 +760,10 @@ func (r *testimonialRepository) Create(ctx context.Context, t *models.Testimonia
 // 	return nil
 // }
 
-func (r *testimonialRepository) BatchUpsert(ctx context.Context, testimonials []models.Testimonial, db *sql.DB) error {
+func (r *testimonialRepository) BatchUpsert(ctx context.Context, testimonials []models.Testimonial, db *sql.DB) ([]uuid.UUID, error) {
 	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	defer func() {
@@ -436,18 +776,31 @@ func (r *testimonialRepository) BatchUpsert(ctx context.Context, testimonials []
 		}
 	}()
 
-	for _, t := range testimonials {
-		if err := r.Upsert(ctx, t, db); err != nil {
+	inserted := []uuid.UUID{}
+	for i := range testimonials {
+		id, isNew, err := r.upsert(ctx, testimonials[i], db)
+		if err != nil {
 			tx.Rollback()
 			slog.Error("transaction failed rollback")
-			return err
+			return nil, err
+		}
+		testimonials[i].ID = id
+		if isNew {
+			inserted = append(inserted, id)
 		}
 	}
 
-	return tx.Commit()
+	return inserted, tx.Commit()
 }
 
 func (r *testimonialRepository) Upsert(ctx context.Context, testimonial models.Testimonial, db DB) error {
+	_, _, err := r.upsert(ctx, testimonial, db)
+	return err
+}
+
+// upsert stores a testimonial and returns its ID, which is the existing one when the
+// testimonial was imported before, and whether it was inserted.
+func (r *testimonialRepository) upsert(ctx context.Context, testimonial models.Testimonial, db DB) (uuid.UUID, bool, error) {
 	query := `
 	INSERT INTO testimonials (
 		workspace_id, customer_profile_id, testimonial_type, format, status, language,
@@ -464,9 +817,8 @@ func (r *testimonialRepository) Upsert(ctx context.Context, testimonial models.T
 		$25, $26, $27, $28, $29,
 		$30, $31, $32, $33, $34, $35, $36, $37
 	)
-	ON CONFLICT (workspace_id, customer_profile_id)
-	DO UPDATE SET 
-		status = EXCLUDED.status,
+	ON CONFLICT (workspace_id, (source_data->>'platform'), (source_data->>'review_id')) WHERE source_data->>'review_id' IS NOT NULL
+	DO UPDATE SET
 		title = EXCLUDED.title,
 		summary = EXCLUDED.summary,
 		content = EXCLUDED.content,
@@ -477,31 +829,14 @@ func (r *testimonialRepository) Upsert(ctx context.Context, testimonial models.T
 		media_duration = EXCLUDED.media_duration,
 		thumbnail_url = EXCLUDED.thumbnail_url,
 		additional_media = EXCLUDED.additional_media,
-		custom_formatting = EXCLUDED.custom_formatting,
-		product_context = EXCLUDED.product_context,
-		purchase_context = EXCLUDED.purchase_context,
-		experience_context = EXCLUDED.experience_context,
-		collection_method = EXCLUDED.collection_method,
-		verification_method = EXCLUDED.verification_method,
-		verification_data = EXCLUDED.verification_data,
-		verification_status = EXCLUDED.verification_status,
-		verified_at = EXCLUDED.verified_at,
-		authenticity_score = EXCLUDED.authenticity_score,
 		source_data = EXCLUDED.source_data,
-		published = EXCLUDED.published,
-		published_at = EXCLUDED.published_at,
-		scheduled_publish_at = EXCLUDED.scheduled_publish_at,
-		tags = EXCLUDED.tags,
-		categories = EXCLUDED.categories,
-		custom_fields = EXCLUDED.custom_fields,
-		view_count = EXCLUDED.view_count,
-		share_count = EXCLUDED.share_count,
-		conversion_count = EXCLUDED.conversion_count,
-		engagement_metrics = EXCLUDED.engagement_metrics
-	WHERE testimonials.source_data->>'review_id' IS NOT NULL
-	RETURNING id;
+		updated_at = NOW()
+	RETURNING id, (xmax = 0) AS inserted;
 	`
-	var id string
+	var (
+		id       uuid.UUID
+		inserted bool
+	)
 
 	err := db.QueryRowContext(ctx, query,
 		testimonial.WorkspaceID,
@@ -541,12 +876,12 @@ func (r *testimonialRepository) Upsert(ctx context.Context, testimonial models.T
 		testimonial.ShareCount,
 		testimonial.ConversionCount,
 		testimonial.EngagementMetrics,
-	).Scan(&id)
+	).Scan(&id, &inserted)
 
 	if err != nil {
-		return fmt.Errorf("error inserting testimonial: %w", err)
+		return uuid.Nil, false, fmt.Errorf("error inserting testimonial: %w", err)
 	}
-	return nil
+	return id, inserted, nil
 }
 
 // func (r *testimonialRepository) FetchByID(ctx context.Context, id uuid.UUID, db DB) (*models.Testimonial, error) {
@@ -744,6 +1079,7 @@ func (r *testimonialRepository) FetchByID(ctx context.Context, id uuid.UUID, db
             t.engagement_metrics,
             t.created_at,
             t.updated_at,
+            t.hold_reason,
             (SELECT json_agg(a.*) FROM testimonial_analyses a WHERE a.testimonial_id = t.id) AS analyses,
             (SELECT json_agg(cm.*) FROM competitor_mentions cm WHERE cm.testimonial_id = t.id) AS competitor_mentions,
             (SELECT json_agg(j.*) FROM ai_jobs j WHERE j.testimonial_id = t.id) AS ai_jobs
@@ -820,9 +1156,10 @@ func (r *testimonialRepository) FetchByID(ctx context.Context, id uuid.UUID, db
 		&testimonial.EngagementMetrics,  // 39: engagement_metrics
 		&testimonial.CreatedAt,          // 40: created_at
 		&testimonial.UpdatedAt,          // 41: updated_at
-		&analysesJSON,                   // 42: analyses (JSON aggregation)
-		&competitorMentionsJSON,         // 43: competitor_mentions (JSON aggregation)
-		&aiJobsJSON,                     // 44: ai_jobs (JSON aggregation)
+		&testimonial.HoldReason,         // 42: hold_reason
+		&analysesJSON,                   // 43: analyses (JSON aggregation)
+		&competitorMentionsJSON,         // 44: competitor_mentions (JSON aggregation)
+		&aiJobsJSON,                     // 45: ai_jobs (JSON aggregation)
 	)
 
 	if err != nil {
@@ -1403,3 +1740,179 @@ func (r *testimonialRepository) MarkAsVerified(ctx context.Context, id uuid.UUID
 
 	return nil
 }
+
+func (r *testimonialRepository) GetWorkspaceID(ctx context.Context, id uuid.UUID, db DB) (uuid.UUID, error) {
+	var workspaceID uuid.UUID
+	err := db.QueryRowContext(ctx, "SELECT workspace_id FROM testimonials WHERE id = $1", id).Scan(&workspaceID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("error fetching testimonial workspace: %w", err)
+	}
+	return workspaceID, nil
+}
+
+// UpdateTranscript stores the transcript of a media testimonial. The generated summary
+// only fills in an empty summary, so one written by the customer is kept.
+func (r *testimonialRepository) UpdateTranscript(ctx context.Context, id uuid.UUID, transcript, summary string, db DB) error {
+	query := `
+		UPDATE testimonials
+		SET transcript = $1,
+			summary = CASE WHEN COALESCE(summary, '') = '' THEN $2 ELSE summary END,
+			updated_at = NOW()
+		WHERE id = $3
+	`
+
+	res, err := db.ExecContext(ctx, query, transcript, summary, id)
+	if err != nil {
+		return fmt.Errorf("error updating testimonial transcript: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no testimonial found with ID %s", id)
+	}
+
+	return nil
+}
+
+// ArchiveBySourceIDs archives imported testimonials whose upstream reviews were reported
+// as deleted by the provider.
+func (r *testimonialRepository) ArchiveBySourceIDs(ctx context.Context, workspaceID uuid.UUID, platform string, reviewIDs []string, db DB) (int64, error) {
+	query := `
+		UPDATE testimonials
+		SET status = 'archived', updated_at = NOW()
+		WHERE workspace_id = $1
+		AND source_data->>'platform' = $2
+		AND source_data->>'review_id' = ANY($3)
+		AND status <> 'archived'
+	`
+
+	result, err := db.ExecContext(ctx, query, workspaceID, platform, pq.Array(reviewIDs))
+	if err != nil {
+		return 0, fmt.Errorf("error archiving testimonials: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+// ArchiveMissingFromSource archives imported testimonials that no longer appear in a
+// full listing of the upstream source.
+func (r *testimonialRepository) ArchiveMissingFromSource(ctx context.Context, workspaceID uuid.UUID, platform string, liveReviewIDs []string, db DB) (int64, error) {
+	query := `
+		UPDATE testimonials
+		SET status = 'archived', updated_at = NOW()
+		WHERE workspace_id = $1
+		AND source_data->>'platform' = $2
+		AND source_data->>'review_id' IS NOT NULL
+		AND NOT (source_data->>'review_id' = ANY($3))
+		AND status <> 'archived'
+	`
+
+	result, err := db.ExecContext(ctx, query, workspaceID, platform, pq.Array(liveReviewIDs))
+	if err != nil {
+		return 0, fmt.Errorf("error archiving missing testimonials: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+func (r *testimonialRepository) GetAuthenticitySignals(ctx context.Context, id uuid.UUID, burstWindow time.Duration, db DB) (*models.AuthenticitySignals, error) {
+	query := `
+		SELECT
+			t.workspace_id,
+			t.status,
+			COALESCE(NULLIF(btrim(t.content), ''), btrim(t.transcript), ''),
+			t.customer_profile_id,
+			COALESCE(t.source_data, '{}'),
+			CASE WHEN jsonb_typeof(w.settings->'authenticity_threshold') = 'number'
+				THEN (w.settings->>'authenticity_threshold')::float END,
+			CASE WHEN COALESCE(btrim(t.content), '') = '' THEN 0 ELSE (
+				SELECT COUNT(*) FROM testimonials d
+				WHERE d.workspace_id = t.workspace_id
+					AND d.id <> t.id
+					AND d.content IS NOT NULL AND btrim(d.content) <> ''
+					AND md5(lower(btrim(d.content))) = md5(lower(btrim(t.content)))
+					AND d.customer_profile_id IS DISTINCT FROM t.customer_profile_id
+			) END,
+			(
+				SELECT COUNT(*) FROM testimonials i
+				WHERE i.workspace_id = t.workspace_id
+					AND i.source_data ? 'submitter_ip'
+					AND i.source_data->>'submitter_ip' = t.source_data->>'submitter_ip'
+					AND i.created_at BETWEEN t.created_at - make_interval(secs => $2)
+						AND t.created_at + make_interval(secs => $2)
+			),
+			ARRAY(
+				SELECT p.content FROM testimonials p
+				WHERE t.customer_profile_id IS NOT NULL
+					AND p.customer_profile_id = t.customer_profile_id
+					AND p.id <> t.id
+					AND COALESCE(btrim(p.content), '') <> ''
+				ORDER BY p.created_at DESC
+				LIMIT 10
+			)
+		FROM testimonials t
+		JOIN workspaces w ON w.id = t.workspace_id
+		WHERE t.id = $1
+	`
+
+	var (
+		signals   models.AuthenticitySignals
+		threshold sql.NullFloat64
+		previous  pq.StringArray
+	)
+	err := db.QueryRowContext(ctx, query, id, burstWindow.Seconds()).Scan(
+		&signals.WorkspaceID,
+		&signals.Status,
+		&signals.Text,
+		&signals.CustomerProfileID,
+		&signals.SourceData,
+		&threshold,
+		&signals.DuplicateCount,
+		&signals.SubmissionsFromIP,
+		&previous,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching authenticity signals: %w", err)
+	}
+
+	if threshold.Valid {
+		signals.Threshold = &threshold.Float64
+	}
+	signals.PreviousTestimonials = previous
+	return &signals, nil
+}
+
+func (r *testimonialRepository) ApplyAuthenticity(ctx context.Context, id uuid.UUID, score float32, holdReason string, db DB) error {
+	query := `
+		UPDATE testimonials
+		SET authenticity_score = $2,
+			hold_reason = CASE
+				WHEN status = 'pending_review' THEN NULLIF($3, '')
+				ELSE hold_reason
+			END,
+			updated_at = NOW()
+		WHERE id = $1
+	`
+
+	res, err := db.ExecContext(ctx, query, id, score, holdReason)
+	if err != nil {
+		return fmt.Errorf("error storing authenticity score: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
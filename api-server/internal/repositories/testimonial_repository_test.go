@@ -225,7 +225,7 @@ func TestFetchByWorkspaceID(t *testing.T) {
 	engagementMetricsJSON, _ := json.Marshal(testimonial.EngagementMetrics)
 
 	// Expected query only includes conditions for filters that are actually set
-	expectedQuery := `SELECT id, workspace_id, customer_profile_id, testimonial_type, format, status, language, title, summary, content, transcript, media_urls, rating, media_url, media_duration, thumbnail_url, additional_media, custom_formatting, product_context, experience_context, collection_method, verification_method, verification_data, verification_status, verified_at, authenticity_score, source_data, published, published_at, scheduled_publish_at, tags, categories, custom_fields, view_count, share_count, conversion_count, engagement_metrics, created_at, updated_at FROM testimonials WHERE workspace_id = \$1 AND testimonial_type = ANY\(\$2::text\[\]\) AND status = ANY\(\$3::text\[\]\) AND rating >= \$4 AND rating <= \$5 AND tags @> \$6::text\[\] AND categories @> \$7::text\[\] AND created_at >= \$8 AND created_at <= \$9 AND content ILIKE '%' \|\| \$10 \|\| '%' ORDER BY created_at DESC`
+	expectedQuery := `SELECT id, workspace_id, customer_profile_id, testimonial_type, format, status, language, title, summary, content, transcript, media_urls, rating, media_url, media_duration, thumbnail_url, additional_media, custom_formatting, product_context, experience_context, collection_method, verification_method, verification_data, verification_status, verified_at, authenticity_score, source_data, published, published_at, scheduled_publish_at, tags, categories, custom_fields, view_count, share_count, conversion_count, engagement_metrics, created_at, updated_at, hold_reason FROM testimonials WHERE workspace_id = \$1 AND testimonial_type = ANY\(\$2::text\[\]\) AND status = ANY\(\$3::text\[\]\) AND rating >= \$4 AND rating <= \$5 AND tags @> \$6::text\[\] AND categories @> \$7::text\[\] AND created_at >= \$8 AND created_at <= \$9 AND content ILIKE '%' \|\| \$10 \|\| '%' ORDER BY created_at DESC`
 
 	rows := sqlmock.NewRows([]string{
 		"id", "workspace_id", "customer_profile_id", "testimonial_type", "format", "status", "language",
@@ -233,7 +233,7 @@ func TestFetchByWorkspaceID(t *testing.T) {
 		"thumbnail_url", "additional_media", "custom_formatting", "product_context", "experience_context", "collection_method",
 		"verification_method", "verification_data", "verification_status", "verified_at", "authenticity_score",
 		"source_data", "published", "published_at", "scheduled_publish_at", "tags", "categories",
-		"custom_fields", "view_count", "share_count", "conversion_count", "engagement_metrics", "created_at", "updated_at",
+		"custom_fields", "view_count", "share_count", "conversion_count", "engagement_metrics", "created_at", "updated_at", "hold_reason",
 	}).AddRow(
 		testimonial.ID, testimonial.WorkspaceID, testimonial.CustomerProfileID,
 		testimonial.TestimonialType, testimonial.Format, testimonial.Status, testimonial.Language,
@@ -247,7 +247,7 @@ func TestFetchByWorkspaceID(t *testing.T) {
 		testimonial.Published, testimonial.PublishedAt, testimonial.ScheduledPublishAt,
 		tagsStr, categoriesStr, string(customFieldsJSON),
 		testimonial.ViewCount, testimonial.ShareCount, testimonial.ConversionCount,
-		string(engagementMetricsJSON), testimonial.CreatedAt, testimonial.UpdatedAt,
+		string(engagementMetricsJSON), testimonial.CreatedAt, testimonial.UpdatedAt, nil,
 	)
 
 	// Prepare expected arguments.
@@ -325,7 +325,7 @@ func TestFetchByWorkspaceID_EmptyFilters(t *testing.T) {
 	engagementMetricsJSON, _ := json.Marshal(testimonial.EngagementMetrics)
 
 	// Expected query with no additional filters
-	expectedQuery := `SELECT id, workspace_id, customer_profile_id, testimonial_type, format, status, language, title, summary, content, transcript, media_urls, rating, media_url, media_duration, thumbnail_url, additional_media, custom_formatting, product_context, experience_context, collection_method, verification_method, verification_data, verification_status, verified_at, authenticity_score, source_data, published, published_at, scheduled_publish_at, tags, categories, custom_fields, view_count, share_count, conversion_count, engagement_metrics, created_at, updated_at FROM testimonials WHERE workspace_id = \$1 ORDER BY created_at DESC`
+	expectedQuery := `SELECT id, workspace_id, customer_profile_id, testimonial_type, format, status, language, title, summary, content, transcript, media_urls, rating, media_url, media_duration, thumbnail_url, additional_media, custom_formatting, product_context, experience_context, collection_method, verification_method, verification_data, verification_status, verified_at, authenticity_score, source_data, published, published_at, scheduled_publish_at, tags, categories, custom_fields, view_count, share_count, conversion_count, engagement_metrics, created_at, updated_at, hold_reason FROM testimonials WHERE workspace_id = \$1 ORDER BY created_at DESC`
 
 	rows := sqlmock.NewRows([]string{
 		"id", "workspace_id", "customer_profile_id", "testimonial_type", "format", "status", "language",
@@ -333,7 +333,7 @@ func TestFetchByWorkspaceID_EmptyFilters(t *testing.T) {
 		"thumbnail_url", "additional_media", "custom_formatting", "product_context", "experience_context", "collection_method",
 		"verification_method", "verification_data", "verification_status", "verified_at", "authenticity_score",
 		"source_data", "published", "published_at", "scheduled_publish_at", "tags", "categories",
-		"custom_fields", "view_count", "share_count", "conversion_count", "engagement_metrics", "created_at", "updated_at",
+		"custom_fields", "view_count", "share_count", "conversion_count", "engagement_metrics", "created_at", "updated_at", "hold_reason",
 	}).AddRow(
 		testimonial.ID, testimonial.WorkspaceID, testimonial.CustomerProfileID,
 		testimonial.TestimonialType, testimonial.Format, testimonial.Status, testimonial.Language,
@@ -347,7 +347,7 @@ func TestFetchByWorkspaceID_EmptyFilters(t *testing.T) {
 		testimonial.Published, testimonial.PublishedAt, testimonial.ScheduledPublishAt,
 		tagsStr, categoriesStr, string(customFieldsJSON),
 		testimonial.ViewCount, testimonial.ShareCount, testimonial.ConversionCount,
-		string(engagementMetricsJSON), testimonial.CreatedAt, testimonial.UpdatedAt,
+		string(engagementMetricsJSON), testimonial.CreatedAt, testimonial.UpdatedAt, nil,
 	)
 
 	mock.ExpectQuery(expectedQuery).
@@ -391,7 +391,7 @@ func TestFetchByWorkspaceID_WithCollectionMethods(t *testing.T) {
 	engagementMetricsJSON, _ := json.Marshal(testimonial.EngagementMetrics)
 
 	// Expected query with collection methods filter
-	expectedQuery := `SELECT id, workspace_id, customer_profile_id, testimonial_type, format, status, language, title, summary, content, transcript, media_urls, rating, media_url, media_duration, thumbnail_url, additional_media, custom_formatting, product_context, experience_context, collection_method, verification_method, verification_data, verification_status, verified_at, authenticity_score, source_data, published, published_at, scheduled_publish_at, tags, categories, custom_fields, view_count, share_count, conversion_count, engagement_metrics, created_at, updated_at FROM testimonials WHERE workspace_id = \$1 AND collection_method = ANY\(\$2::text\[\]\) ORDER BY created_at DESC`
+	expectedQuery := `SELECT id, workspace_id, customer_profile_id, testimonial_type, format, status, language, title, summary, content, transcript, media_urls, rating, media_url, media_duration, thumbnail_url, additional_media, custom_formatting, product_context, experience_context, collection_method, verification_method, verification_data, verification_status, verified_at, authenticity_score, source_data, published, published_at, scheduled_publish_at, tags, categories, custom_fields, view_count, share_count, conversion_count, engagement_metrics, created_at, updated_at, hold_reason FROM testimonials WHERE workspace_id = \$1 AND collection_method = ANY\(\$2::text\[\]\) ORDER BY created_at DESC`
 
 	rows := sqlmock.NewRows([]string{
 		"id", "workspace_id", "customer_profile_id", "testimonial_type", "format", "status", "language",
@@ -399,7 +399,7 @@ func TestFetchByWorkspaceID_WithCollectionMethods(t *testing.T) {
 		"thumbnail_url", "additional_media", "custom_formatting", "product_context", "experience_context", "collection_method",
 		"verification_method", "verification_data", "verification_status", "verified_at", "authenticity_score",
 		"source_data", "published", "published_at", "scheduled_publish_at", "tags", "categories",
-		"custom_fields", "view_count", "share_count", "conversion_count", "engagement_metrics", "created_at", "updated_at",
+		"custom_fields", "view_count", "share_count", "conversion_count", "engagement_metrics", "created_at", "updated_at", "hold_reason",
 	}).AddRow(
 		testimonial.ID, testimonial.WorkspaceID, testimonial.CustomerProfileID,
 		testimonial.TestimonialType, testimonial.Format, testimonial.Status, testimonial.Language,
@@ -413,7 +413,7 @@ func TestFetchByWorkspaceID_WithCollectionMethods(t *testing.T) {
 		testimonial.Published, testimonial.PublishedAt, testimonial.ScheduledPublishAt,
 		tagsStr, categoriesStr, string(customFieldsJSON),
 		testimonial.ViewCount, testimonial.ShareCount, testimonial.ConversionCount,
-		string(engagementMetricsJSON), testimonial.CreatedAt, testimonial.UpdatedAt,
+		string(engagementMetricsJSON), testimonial.CreatedAt, testimonial.UpdatedAt, nil,
 	)
 
 	// Prepare expected arguments.
@@ -433,7 +433,7 @@ func TestFetchByWorkspaceID_WithCollectionMethods(t *testing.T) {
 	assert.Equal(t, testimonial.Content, testimonials[0].Content)
 }
 
-func TestUpdateStatus(t *testing.T) {
+func TestTransitionStatus(t *testing.T) {
 	db, mock := setupMockDB()
 	defer db.Close()
 
@@ -442,33 +442,137 @@ func TestUpdateStatus(t *testing.T) {
 
 	ctx := context.Background()
 	id := uuid.New()
-	status := models.StatusApproved
+	from, to := models.StatusPendingReview, models.StatusApproved
+	query := `UPDATE testimonials SET status = \$1, hold_reason = NULL, scheduled_publish_at = \$2, published = \$5, published_at = CASE WHEN \$5 THEN COALESCE\(published_at, NOW\(\)\) ELSE NULL END, updated_at = NOW\(\) WHERE id = \$3 AND status = \$4`
 
 	// Success case
-	mock.ExpectExec("UPDATE testimonials SET status = \\$1 WHERE id = \\$2").
-		WithArgs(status, id).
+	mock.ExpectExec(query).
+		WithArgs(to, nil, id, from, true).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
-	err := repo.UpdateStatus(ctx, id, status, db)
+	err := repo.TransitionStatus(ctx, id, from, to, nil, db)
 	assert.NoError(t, err)
 
-	// Error case: no rows affected
-	mock.ExpectExec("UPDATE testimonials SET status = \\$1 WHERE id = \\$2").
-		WithArgs(status, id).
+	// Error case: the testimonial is no longer in the from status
+	mock.ExpectExec(query).
+		WithArgs(to, nil, id, from, true).
 		WillReturnResult(sqlmock.NewResult(0, 0))
 
-	err = repo.UpdateStatus(ctx, id, status, db)
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "no testimonial found")
+	err = repo.TransitionStatus(ctx, id, from, to, nil, db)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
 
 	// Error case: query error
-	mock.ExpectExec("UPDATE testimonials SET status = \\$1 WHERE id = \\$2").
-		WithArgs(status, id).
+	mock.ExpectExec(query).
+		WithArgs(to, nil, id, from, true).
 		WillReturnError(sql.ErrConnDone)
 
-	err = repo.UpdateStatus(ctx, id, status, db)
+	err = repo.TransitionStatus(ctx, id, from, to, nil, db)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "error updating testimonial status")
+
+	// Success case: scheduling sets the publish time and unpublishes until it is due
+	publishAt := time.Now().Add(time.Hour)
+	mock.ExpectExec(query).
+		WithArgs(models.StatusScheduled, &publishAt, id, models.StatusApproved, false).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.TransitionStatus(ctx, id, models.StatusApproved, models.StatusScheduled, &publishAt, db)
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTransitionStatusPublishing(t *testing.T) {
+	db, mock := setupMockDB()
+	defer db.Close()
+
+	redisClient := redis.NewClient(&redis.Options{})
+	repo := repositories.NewTestimonialRepository(redisClient)
+
+	ctx := context.Background()
+	id := uuid.New()
+
+	tests := []struct {
+		from, to  models.ContentStatus
+		published bool
+	}{
+		{models.StatusPendingReview, models.StatusApproved, true},
+		{models.StatusApproved, models.StatusFeatured, true},
+		{models.StatusScheduled, models.StatusApproved, true},
+		{models.StatusPendingReview, models.StatusRejected, false},
+		{models.StatusApproved, models.StatusArchived, false},
+		{models.StatusRejected, models.StatusPendingReview, false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"_to_"+string(tt.to), func(t *testing.T) {
+			mock.ExpectExec(`UPDATE testimonials SET .* published = \$5, published_at = CASE WHEN \$5 THEN COALESCE\(published_at, NOW\(\)\) ELSE NULL END, updated_at = NOW\(\)`).
+				WithArgs(tt.to, nil, id, tt.from, tt.published).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+
+			assert.NoError(t, repo.TransitionStatus(ctx, id, tt.from, tt.to, nil, db))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestApplyAuthenticity(t *testing.T) {
+	db, mock := setupMockDB()
+	defer db.Close()
+
+	redisClient := redis.NewClient(&redis.Options{})
+	repo := repositories.NewTestimonialRepository(redisClient)
+
+	ctx := context.Background()
+	id := uuid.New()
+
+	// The status is never changed, and the hold only applies while pending review
+	mock.ExpectExec(`UPDATE testimonials SET authenticity_score = \$2, hold_reason = CASE WHEN status = 'pending_review' THEN NULLIF\(\$3, ''\) ELSE hold_reason END, updated_at = NOW\(\) WHERE id = \$1`).
+		WithArgs(id, float32(0.2), "duplicate_content").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	assert.NoError(t, repo.ApplyAuthenticity(ctx, id, 0.2, "duplicate_content", db))
+
+	mock.ExpectExec(`UPDATE testimonials SET authenticity_score`).
+		WithArgs(id, float32(0.9), "").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, repo.ApplyAuthenticity(ctx, id, 0.9, "", db), sql.ErrNoRows)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPublishDue(t *testing.T) {
+	db, mock := setupMockDB()
+	defer db.Close()
+
+	redisClient := redis.NewClient(&redis.Options{})
+	repo := repositories.NewTestimonialRepository(redisClient)
+
+	ctx := context.Background()
+	now := time.Now()
+	scheduledAt := now.Add(-time.Minute)
+	id, workspaceID := uuid.New(), uuid.New()
+
+	mock.ExpectQuery(`FOR UPDATE SKIP LOCKED`).
+		WithArgs(now, 50).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "workspace_id", "scheduled_publish_at", "published_at"}).
+			AddRow(id, workspaceID, scheduledAt, now))
+
+	published, err := repo.PublishDue(ctx, now, 50, db)
+	assert.NoError(t, err)
+	assert.Len(t, published, 1)
+	assert.Equal(t, id, published[0].ID)
+	assert.Equal(t, workspaceID, published[0].WorkspaceID)
+	assert.True(t, published[0].Published)
+	assert.Equal(t, models.StatusApproved, published[0].Status)
+	assert.Equal(t, scheduledAt, *published[0].ScheduledPublishAt)
+
+	mock.ExpectQuery(`FOR UPDATE SKIP LOCKED`).
+		WithArgs(now, 50).
+		WillReturnError(sql.ErrConnDone)
+
+	_, err = repo.PublishDue(ctx, now, 50, db)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "error publishing scheduled testimonials")
+	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestCreate(t *testing.T) {
@@ -599,13 +703,15 @@ func TestBatchUpsert(t *testing.T) {
 	returnedID := uuid.New().String()
 	for range testimonials {
 		mock.ExpectQuery("INSERT INTO testimonials").
-			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(returnedID))
+			WillReturnRows(sqlmock.NewRows([]string{"id", "inserted"}).AddRow(returnedID, true))
 	}
 
 	mock.ExpectCommit()
 
-	err := repo.BatchUpsert(ctx, testimonials, db)
+	inserted, err := repo.BatchUpsert(ctx, testimonials, db)
 	assert.NoError(t, err)
+	assert.Len(t, inserted, 2)
+	assert.Equal(t, returnedID, testimonials[0].ID.String())
 
 	// Test transaction error.
 	mock.ExpectBegin()
@@ -613,7 +719,7 @@ func TestBatchUpsert(t *testing.T) {
 		WillReturnError(errors.New("database error"))
 	mock.ExpectRollback()
 
-	err = repo.BatchUpsert(ctx, []models.Testimonial{createTestTestimonial()}, db)
+	_, err = repo.BatchUpsert(ctx, []models.Testimonial{createTestTestimonial()}, db)
 	assert.Error(t, err)
 }
 
@@ -668,7 +774,7 @@ func TestUpsert(t *testing.T) {
 			testimonial.ConversionCount,
 			testimonial.EngagementMetrics,
 		).
-		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(returnedID))
+		WillReturnRows(sqlmock.NewRows([]string{"id", "inserted"}).AddRow(returnedID, true))
 
 	err := repo.Upsert(ctx, testimonial, db)
 	assert.NoError(t, err)
@@ -1105,3 +1211,99 @@ func TestFetchByIDWithPopulatedRelatedData(t *testing.T) {
 
 	mock.ExpectationsWereMet()
 }
+
+func TestFetchPage(t *testing.T) {
+	db, mock := setupMockDB()
+	defer db.Close()
+
+	redisClient := redis.NewClient(&redis.Options{})
+	repo := repositories.NewTestimonialRepository(redisClient)
+
+	ctx := context.Background()
+	workspaceID := uuid.New()
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+	columns := []string{"id", "content", "rating", "sort_key"}
+
+	// First page: the extra row means there is another page
+	page := models.TestimonialPageRequest{
+		Sort:   models.SortByRating,
+		Desc:   true,
+		Limit:  2,
+		Fields: []string{"id", "content", "rating"},
+	}
+	mock.ExpectQuery(`SELECT id, content, rating, COALESCE\(rating, 0\)::float8 AS sort_key FROM testimonials WHERE workspace_id = \$1 ORDER BY COALESCE\(rating, 0\)::float8 DESC, id DESC LIMIT \$2`).
+		WithArgs(workspaceID, 3).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(first, "Great", 5, 5.0).
+			AddRow(second, "Good", 4, 4.0).
+			AddRow(third, "Fine", 4, 4.0))
+
+	testimonials, next, err := repo.FetchPage(ctx, workspaceID, page, db)
+	assert.NoError(t, err)
+	assert.Len(t, testimonials, 2)
+	assert.Equal(t, "Good", testimonials[1].Content)
+	if assert.NotNil(t, next) {
+		assert.Equal(t, second, next.ID)
+		assert.Equal(t, 4.0, *next.Value)
+		assert.Nil(t, next.Time)
+	}
+
+	// Next page continues after the cursor and is the last one
+	page.Cursor = next
+	mock.ExpectQuery(`AND \(COALESCE\(rating, 0\)::float8, id\) < \(\$2, \$3\) ORDER BY COALESCE\(rating, 0\)::float8 DESC, id DESC LIMIT \$4`).
+		WithArgs(workspaceID, 4.0, second, 3).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(third, "Fine", 4, 4.0))
+
+	testimonials, next, err = repo.FetchPage(ctx, workspaceID, page, db)
+	assert.NoError(t, err)
+	assert.Len(t, testimonials, 1)
+	assert.Nil(t, next)
+
+	// Unknown fields never reach the query
+	_, _, err = repo.FetchPage(ctx, workspaceID, models.TestimonialPageRequest{
+		Sort: models.SortByCreatedAt, Limit: 2, Fields: []string{"id; DROP TABLE testimonials"},
+	}, db)
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearch(t *testing.T) {
+	db, mock := setupMockDB()
+	defer db.Close()
+
+	redisClient := redis.NewClient(&redis.Options{})
+	repo := repositories.NewTestimonialRepository(redisClient)
+
+	ctx := context.Background()
+	workspaceID := uuid.New()
+	id := uuid.New()
+	now := time.Now()
+	columns := []string{
+		"id", "workspace_id", "customer_profile_id", "testimonial_type", "format", "status", "language",
+		"title", "summary", "content", "rating", "media_url", "thumbnail_url", "authenticity_score",
+		"published", "published_at", "created_at", "updated_at", "name", "company", "rank",
+		"title_hl", "content_hl", "transcript_hl",
+	}
+
+	req := models.TestimonialSearchRequest{Query: `"fast shipping" -refund`, Limit: 20}
+	mock.ExpectQuery(`websearch_to_tsquery\(testimonial_search_config\(t.language\), \$2\).*AND NOT t.search_vector @@ websearch_to_tsquery\(q.config, \$5\) ORDER BY rank DESC, t.created_at DESC, t.id LIMIT \$6 OFFSET \$7`).
+		WithArgs(workspaceID, req.Query, "fast shipping", sqlmock.AnyArg(), "refund", 20, 0).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(
+			id, workspaceID, nil, "customer", "text", "approved", "en",
+			"Great service", nil, "Really fast shipping", 5, nil, nil, 0.9,
+			true, now, now, now, "Ada Lovelace", "Analytical Engines", 0.42,
+			"Great service", "Really \x02fast\x03 \x02shipping\x03", "",
+		))
+
+	hits, err := repo.Search(ctx, workspaceID, req, db)
+	assert.NoError(t, err)
+	if assert.Len(t, hits, 1) {
+		assert.Equal(t, id, hits[0].Testimonial.ID)
+		assert.Equal(t, "Really fast shipping", hits[0].Testimonial.Content)
+		assert.Equal(t, "Ada Lovelace", hits[0].CustomerName)
+		assert.Equal(t, 0.42, hits[0].Rank)
+		// Snippets without a match are left out
+		assert.Equal(t, map[string]string{"content": "Really \x02fast\x03 \x02shipping\x03"}, hits[0].Highlights)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,477 @@
+package repositories
+
+//go:generate mockery --name=WebhookRepository --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/pkg/envelope"
+	"github.com/lib/pq"
+	"github.com/redis/go-redis/v9"
+)
+
+const defaultWebhookDeliveryLimit = 50
+
+type WebhookRepository interface {
+	// CreateEndpoint stores a new endpoint with its secret encrypted.
+	CreateEndpoint(ctx context.Context, endpoint *models.WebhookEndpoint, db DB) error
+	GetEndpoint(ctx context.Context, workspaceID, id uuid.UUID, db DB) (*models.WebhookEndpoint, error)
+	ListEndpoints(ctx context.Context, workspaceID uuid.UUID, db DB) ([]models.WebhookEndpoint, error)
+	// UpdateEndpoint saves an endpoint's URL, description, events and active flag. It
+	// returns sql.ErrNoRows when the workspace has no such endpoint.
+	UpdateEndpoint(ctx context.Context, endpoint *models.WebhookEndpoint, db DB) error
+	// UpdateSecret returns sql.ErrNoRows when the workspace has no such endpoint.
+	UpdateSecret(ctx context.Context, workspaceID, id uuid.UUID, secret string, db DB) error
+	// DeleteEndpoint removes an endpoint and its deliveries. It returns sql.ErrNoRows
+	// when the workspace has no such endpoint.
+	DeleteEndpoint(ctx context.Context, workspaceID, id uuid.UUID, db DB) error
+	// ListSubscribed returns the workspace's active endpoints subscribed to the event type.
+	ListSubscribed(ctx context.Context, workspaceID uuid.UUID, eventType string, db DB) ([]models.WebhookEndpoint, error)
+
+	CreateDelivery(ctx context.Context, delivery *models.WebhookDelivery, db DB) error
+	GetDelivery(ctx context.Context, workspaceID, id uuid.UUID, db DB) (*models.WebhookDelivery, error)
+	// ListDeliveries returns the workspace's deliveries, newest first.
+	ListDeliveries(ctx context.Context, workspaceID uuid.UUID, filter models.WebhookDeliveryFilter, db DB) ([]models.WebhookDelivery, error)
+	// ClaimDue takes up to limit pending deliveries to active endpoints that are due at
+	// now. Claimed deliveries are pushed back by lease, so another server only picks one
+	// up again if this one dies before recording the attempt.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int, db DB) ([]models.WebhookDispatch, error)
+	RecordAttempt(ctx context.Context, id uuid.UUID, attempt models.WebhookAttempt, db DB) error
+}
+
+type webhookRepository struct {
+	*BaseRepository[models.WebhookEndpoint]
+	cipher *envelope.Cipher
+}
+
+func NewWebhookRepository(redis *redis.Client, cipher *envelope.Cipher) WebhookRepository {
+	return &webhookRepository{
+		BaseRepository: NewBaseRepository[models.WebhookEndpoint](redis, "webhook_endpoints"),
+		cipher:         cipher,
+	}
+}
+
+const webhookEndpointColumns = `
+	id, workspace_id, url, description, events, is_active, created_by, created_at, updated_at
+`
+
+const webhookDeliveryColumns = `
+	d.id, d.endpoint_id, d.workspace_id, d.event_id, d.event_type, d.payload, d.status,
+	d.attempts, d.next_attempt_at, d.last_attempt_at, d.response_status, d.response_body,
+	d.error, d.redelivery_of, d.created_at
+`
+
+func scanWebhookEndpoint(row rowScanner) (*models.WebhookEndpoint, error) {
+	var (
+		endpoint    models.WebhookEndpoint
+		events      pq.StringArray
+		description sql.NullString
+		createdBy   uuid.NullUUID
+	)
+
+	if err := row.Scan(
+		&endpoint.ID,
+		&endpoint.WorkspaceID,
+		&endpoint.URL,
+		&description,
+		&events,
+		&endpoint.IsActive,
+		&createdBy,
+		&endpoint.CreatedAt,
+		&endpoint.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	endpoint.Events = events
+	endpoint.Description = description.String
+	if createdBy.Valid {
+		endpoint.CreatedBy = &createdBy.UUID
+	}
+	return &endpoint, nil
+}
+
+func scanWebhookDelivery(row rowScanner, extra ...any) (*models.WebhookDelivery, error) {
+	var (
+		delivery       models.WebhookDelivery
+		payload        []byte
+		lastAttemptAt  sql.NullTime
+		responseStatus sql.NullInt64
+		responseBody   sql.NullString
+		deliveryErr    sql.NullString
+		redeliveryOf   uuid.NullUUID
+	)
+
+	dest := []any{
+		&delivery.ID,
+		&delivery.EndpointID,
+		&delivery.WorkspaceID,
+		&delivery.EventID,
+		&delivery.EventType,
+		&payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+		&lastAttemptAt,
+		&responseStatus,
+		&responseBody,
+		&deliveryErr,
+		&redeliveryOf,
+		&delivery.CreatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	delivery.Payload = json.RawMessage(payload)
+	if lastAttemptAt.Valid {
+		delivery.LastAttemptAt = &lastAttemptAt.Time
+	}
+	if responseStatus.Valid {
+		status := int(responseStatus.Int64)
+		delivery.ResponseStatus = &status
+	}
+	delivery.ResponseBody = responseBody.String
+	delivery.Error = deliveryErr.String
+	if redeliveryOf.Valid {
+		delivery.RedeliveryOf = &redeliveryOf.UUID
+	}
+	return &delivery, nil
+}
+
+func (r *webhookRepository) CreateEndpoint(ctx context.Context, endpoint *models.WebhookEndpoint, db DB) error {
+	secret, err := r.sealSecret(endpoint.Secret)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO webhook_endpoints (id, workspace_id, url, description, events, secret, is_active, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING created_at, updated_at
+	`
+
+	var createdBy uuid.NullUUID
+	if endpoint.CreatedBy != nil {
+		createdBy = uuid.NullUUID{UUID: *endpoint.CreatedBy, Valid: true}
+	}
+
+	err = db.QueryRowContext(ctx, query,
+		endpoint.ID,
+		endpoint.WorkspaceID,
+		endpoint.URL,
+		sql.NullString{String: endpoint.Description, Valid: endpoint.Description != ""},
+		pq.StringArray(endpoint.Events),
+		secret,
+		endpoint.IsActive,
+		createdBy,
+	).Scan(&endpoint.CreatedAt, &endpoint.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("error creating webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookRepository) GetEndpoint(ctx context.Context, workspaceID, id uuid.UUID, db DB) (*models.WebhookEndpoint, error) {
+	query := `SELECT ` + webhookEndpointColumns + ` FROM webhook_endpoints WHERE id = $1 AND workspace_id = $2`
+
+	endpoint, err := scanWebhookEndpoint(db.QueryRowContext(ctx, query, id, workspaceID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching webhook endpoint: %w", err)
+	}
+	return endpoint, nil
+}
+
+func (r *webhookRepository) ListEndpoints(ctx context.Context, workspaceID uuid.UUID, db DB) ([]models.WebhookEndpoint, error) {
+	query := `SELECT ` + webhookEndpointColumns + `
+		FROM webhook_endpoints
+		WHERE workspace_id = $1
+		ORDER BY created_at`
+
+	return r.queryEndpoints(ctx, db, query, workspaceID)
+}
+
+func (r *webhookRepository) ListSubscribed(ctx context.Context, workspaceID uuid.UUID, eventType string, db DB) ([]models.WebhookEndpoint, error) {
+	query := `SELECT ` + webhookEndpointColumns + `
+		FROM webhook_endpoints
+		WHERE workspace_id = $1 AND is_active AND $2 = ANY(events)`
+
+	return r.queryEndpoints(ctx, db, query, workspaceID, eventType)
+}
+
+func (r *webhookRepository) queryEndpoints(ctx context.Context, db DB, query string, args ...any) ([]models.WebhookEndpoint, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching webhook endpoints: %w", err)
+	}
+	defer rows.Close()
+
+	endpoints := []models.WebhookEndpoint{}
+	for rows.Next() {
+		endpoint, err := scanWebhookEndpoint(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning webhook endpoint: %w", err)
+		}
+		endpoints = append(endpoints, *endpoint)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating webhook endpoints: %w", err)
+	}
+	return endpoints, nil
+}
+
+func (r *webhookRepository) UpdateEndpoint(ctx context.Context, endpoint *models.WebhookEndpoint, db DB) error {
+	query := `
+		UPDATE webhook_endpoints
+		SET url = $3, description = $4, events = $5, is_active = $6
+		WHERE id = $1 AND workspace_id = $2
+		RETURNING updated_at
+	`
+
+	err := db.QueryRowContext(ctx, query,
+		endpoint.ID,
+		endpoint.WorkspaceID,
+		endpoint.URL,
+		sql.NullString{String: endpoint.Description, Valid: endpoint.Description != ""},
+		pq.StringArray(endpoint.Events),
+		endpoint.IsActive,
+	).Scan(&endpoint.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return sql.ErrNoRows
+	}
+	if err != nil {
+		return fmt.Errorf("error updating webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookRepository) UpdateSecret(ctx context.Context, workspaceID, id uuid.UUID, secret string, db DB) error {
+	sealed, err := r.sealSecret(secret)
+	if err != nil {
+		return err
+	}
+	query := `UPDATE webhook_endpoints SET secret = $3 WHERE id = $1 AND workspace_id = $2`
+	return execAffectingOne(ctx, db, "updating webhook secret", query, id, workspaceID, sealed)
+}
+
+func (r *webhookRepository) DeleteEndpoint(ctx context.Context, workspaceID, id uuid.UUID, db DB) error {
+	query := `DELETE FROM webhook_endpoints WHERE id = $1 AND workspace_id = $2`
+	return execAffectingOne(ctx, db, "deleting webhook endpoint", query, id, workspaceID)
+}
+
+// execAffectingOne runs a statement that targets a single row and returns sql.ErrNoRows
+// when it matched nothing.
+func execAffectingOne(ctx context.Context, db DB, action, query string, args ...any) error {
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("error %s: %w", action, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error %s: %w", action, err)
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *webhookRepository) CreateDelivery(ctx context.Context, delivery *models.WebhookDelivery, db DB) error {
+	query := `
+		INSERT INTO webhook_deliveries (
+			id, endpoint_id, workspace_id, event_id, event_type, payload, status, next_attempt_at, redelivery_of
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING created_at
+	`
+
+	var redeliveryOf uuid.NullUUID
+	if delivery.RedeliveryOf != nil {
+		redeliveryOf = uuid.NullUUID{UUID: *delivery.RedeliveryOf, Valid: true}
+	}
+
+	err := db.QueryRowContext(ctx, query,
+		delivery.ID,
+		delivery.EndpointID,
+		delivery.WorkspaceID,
+		delivery.EventID,
+		delivery.EventType,
+		[]byte(delivery.Payload),
+		delivery.Status,
+		delivery.NextAttemptAt,
+		redeliveryOf,
+	).Scan(&delivery.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error creating webhook delivery: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookRepository) GetDelivery(ctx context.Context, workspaceID, id uuid.UUID, db DB) (*models.WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries d WHERE d.id = $1 AND d.workspace_id = $2`
+
+	delivery, err := scanWebhookDelivery(db.QueryRowContext(ctx, query, id, workspaceID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching webhook delivery: %w", err)
+	}
+	return delivery, nil
+}
+
+func (r *webhookRepository) ListDeliveries(ctx context.Context, workspaceID uuid.UUID, filter models.WebhookDeliveryFilter, db DB) ([]models.WebhookDelivery, error) {
+	conditions := []string{"d.workspace_id = $1"}
+	args := []any{workspaceID}
+	if filter.EndpointID != nil {
+		args = append(args, *filter.EndpointID)
+		conditions = append(conditions, fmt.Sprintf("d.endpoint_id = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("d.status = $%d", len(args)))
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultWebhookDeliveryLimit
+	}
+	args = append(args, limit)
+
+	query := `SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries d
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY d.created_at DESC
+		LIMIT $` + fmt.Sprint(len(args))
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := []models.WebhookDelivery{}
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, *delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+func (r *webhookRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int, db DB) ([]models.WebhookDispatch, error) {
+	query := `
+		WITH due AS (
+			SELECT d.id
+			FROM webhook_deliveries d
+			INNER JOIN webhook_endpoints e ON e.id = d.endpoint_id
+			WHERE d.status = 'pending' AND d.next_attempt_at <= $1 AND e.is_active
+			ORDER BY d.next_attempt_at
+			LIMIT $3
+			FOR UPDATE OF d SKIP LOCKED
+		)
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = $2
+		FROM due, webhook_endpoints e
+		WHERE d.id = due.id AND e.id = d.endpoint_id
+		RETURNING ` + webhookDeliveryColumns + `, e.url, e.secret
+	`
+
+	rows, err := db.QueryContext(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("error claiming webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	dispatches := []models.WebhookDispatch{}
+	for rows.Next() {
+		var (
+			url    string
+			secret []byte
+		)
+		delivery, err := scanWebhookDelivery(rows, &url, &secret)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning webhook delivery: %w", err)
+		}
+		plain, err := r.openSecret(secret)
+		if err != nil {
+			return nil, fmt.Errorf("error decrypting secret for webhook endpoint %s: %w", delivery.EndpointID, err)
+		}
+		dispatches = append(dispatches, models.WebhookDispatch{Delivery: *delivery, URL: url, Secret: plain})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating webhook deliveries: %w", err)
+	}
+	return dispatches, nil
+}
+
+func (r *webhookRepository) RecordAttempt(ctx context.Context, id uuid.UUID, attempt models.WebhookAttempt, db DB) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = attempts + 1, last_attempt_at = $3, next_attempt_at = $4,
+			response_status = $5, response_body = $6, error = $7
+		WHERE id = $1
+	`
+
+	var responseStatus sql.NullInt64
+	if attempt.ResponseStatus != nil {
+		responseStatus = sql.NullInt64{Int64: int64(*attempt.ResponseStatus), Valid: true}
+	}
+
+	_, err := db.ExecContext(ctx, query,
+		id,
+		attempt.Status,
+		attempt.AttemptedAt,
+		attempt.NextAttemptAt,
+		responseStatus,
+		sql.NullString{String: attempt.ResponseBody, Valid: attempt.ResponseBody != ""},
+		sql.NullString{String: attempt.Error, Valid: attempt.Error != ""},
+	)
+	if err != nil {
+		return fmt.Errorf("error recording webhook attempt: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookRepository) sealSecret(secret string) ([]byte, error) {
+	if r.cipher == nil {
+		return nil, errNoCredentialsKey
+	}
+	env, err := r.cipher.Seal([]byte(secret))
+	if err != nil {
+		return nil, fmt.Errorf("error encrypting webhook secret: %w", err)
+	}
+	return json.Marshal(env)
+}
+
+func (r *webhookRepository) openSecret(data []byte) (string, error) {
+	if r.cipher == nil {
+		return "", errNoCredentialsKey
+	}
+	var env envelope.Envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return "", err
+	}
+	plaintext, err := r.cipher.Open(&env)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
@@ -0,0 +1,235 @@
+package repositories
+
+//go:generate mockery --name=WidgetRepository --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/lib/pq"
+	"github.com/redis/go-redis/v9"
+)
+
+type WidgetRepository interface {
+	Create(ctx context.Context, widget *models.DisplayWidget, db DB) error
+	Get(ctx context.Context, workspaceID, id uuid.UUID, db DB) (*models.DisplayWidget, error)
+	GetByID(ctx context.Context, id uuid.UUID, db DB) (*models.DisplayWidget, error)
+	GetByWorkspace(ctx context.Context, workspaceID uuid.UUID, db DB) ([]models.DisplayWidget, error)
+	Update(ctx context.Context, widget *models.DisplayWidget, db DB) error
+	Delete(ctx context.Context, workspaceID, id uuid.UUID, db DB) error
+	// ListTestimonials returns the published testimonials a widget shows, up to limit.
+	ListTestimonials(ctx context.Context, workspaceID uuid.UUID, settings models.WidgetSettings, limit int, db DB) ([]models.EmbedTestimonial, error)
+}
+
+type widgetRepository struct {
+	*BaseRepository[models.DisplayWidget]
+}
+
+func NewWidgetRepository(redis *redis.Client) WidgetRepository {
+	return &widgetRepository{
+		BaseRepository: NewBaseRepository[models.DisplayWidget](redis, "display_widgets"),
+	}
+}
+
+const displayWidgetColumns = `id, workspace_id, name, type, settings, active, created_at, updated_at`
+
+func scanDisplayWidget(row rowScanner) (*models.DisplayWidget, error) {
+	var widget models.DisplayWidget
+	if err := row.Scan(
+		&widget.ID,
+		&widget.WorkspaceID,
+		&widget.Name,
+		&widget.Layout,
+		&widget.Settings,
+		&widget.Active,
+		&widget.CreatedAt,
+		&widget.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &widget, nil
+}
+
+func (r *widgetRepository) Create(ctx context.Context, widget *models.DisplayWidget, db DB) error {
+	query := `
+		INSERT INTO display_widgets (id, workspace_id, name, type, settings, active)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at, updated_at
+	`
+
+	err := db.QueryRowContext(ctx, query,
+		widget.ID,
+		widget.WorkspaceID,
+		widget.Name,
+		widget.Layout,
+		widget.Settings,
+		widget.Active,
+	).Scan(&widget.CreatedAt, &widget.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("error creating display widget: %w", err)
+	}
+	return nil
+}
+
+func (r *widgetRepository) Get(ctx context.Context, workspaceID, id uuid.UUID, db DB) (*models.DisplayWidget, error) {
+	query := `SELECT ` + displayWidgetColumns + ` FROM display_widgets WHERE id = $1 AND workspace_id = $2`
+
+	widget, err := scanDisplayWidget(db.QueryRowContext(ctx, query, id, workspaceID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching display widget: %w", err)
+	}
+	return widget, nil
+}
+
+func (r *widgetRepository) GetByID(ctx context.Context, id uuid.UUID, db DB) (*models.DisplayWidget, error) {
+	query := `SELECT ` + displayWidgetColumns + ` FROM display_widgets WHERE id = $1`
+
+	widget, err := scanDisplayWidget(db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching display widget: %w", err)
+	}
+	return widget, nil
+}
+
+func (r *widgetRepository) GetByWorkspace(ctx context.Context, workspaceID uuid.UUID, db DB) ([]models.DisplayWidget, error) {
+	query := `
+		SELECT ` + displayWidgetColumns + `
+		FROM display_widgets
+		WHERE workspace_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := db.QueryContext(ctx, query, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching display widgets: %w", err)
+	}
+	defer rows.Close()
+
+	widgets := []models.DisplayWidget{}
+	for rows.Next() {
+		widget, err := scanDisplayWidget(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning display widget: %w", err)
+		}
+		widgets = append(widgets, *widget)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating display widgets: %w", err)
+	}
+	return widgets, nil
+}
+
+func (r *widgetRepository) Update(ctx context.Context, widget *models.DisplayWidget, db DB) error {
+	query := `
+		UPDATE display_widgets SET
+			name = $3,
+			type = $4,
+			settings = $5,
+			active = $6
+		WHERE id = $1 AND workspace_id = $2
+		RETURNING updated_at
+	`
+
+	err := db.QueryRowContext(ctx, query,
+		widget.ID,
+		widget.WorkspaceID,
+		widget.Name,
+		widget.Layout,
+		widget.Settings,
+		widget.Active,
+	).Scan(&widget.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return sql.ErrNoRows
+	}
+	if err != nil {
+		return fmt.Errorf("error updating display widget: %w", err)
+	}
+	return nil
+}
+
+func (r *widgetRepository) Delete(ctx context.Context, workspaceID, id uuid.UUID, db DB) error {
+	result, err := db.ExecContext(ctx, `DELETE FROM display_widgets WHERE id = $1 AND workspace_id = $2`, id, workspaceID)
+	if err != nil {
+		return fmt.Errorf("error deleting display widget: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error deleting display widget: %w", err)
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// ListTestimonials returns published testimonials that are still approved or featured.
+// Pinned testimonials keep their order; otherwise featured ones come first, then the
+// most recently published.
+func (r *widgetRepository) ListTestimonials(ctx context.Context, workspaceID uuid.UUID, settings models.WidgetSettings, limit int, db DB) ([]models.EmbedTestimonial, error) {
+	query := `
+		SELECT
+			t.id, coalesce(t.title, ''), coalesce(t.content, ''), t.rating, t.format,
+			coalesce(t.media_url, ''), coalesce(t.thumbnail_url, ''), t.published_at,
+			coalesce(cp.name, ''), coalesce(cp.title, ''), coalesce(cp.company, ''), coalesce(cp.avatar_url, '')
+		FROM testimonials t
+		LEFT JOIN customer_profiles cp ON cp.id = t.customer_profile_id
+		WHERE t.workspace_id = $1
+		  AND t.published = TRUE
+		  AND t.status IN ('approved', 'featured')
+		  AND ($2::real IS NULL OR t.rating >= $2)
+		  AND (cardinality($3::text[]) = 0 OR t.tags && $3::text[])
+		  AND (cardinality($4::text[]) = 0 OR t.format::text = ANY($4::text[]))
+		  AND (cardinality($5::uuid[]) = 0 OR t.id = ANY($5::uuid[]))
+		ORDER BY array_position($5::uuid[], t.id), t.status = 'featured' DESC, t.published_at DESC NULLS LAST, t.id
+		LIMIT $6
+	`
+
+	formats := make(pq.StringArray, len(settings.Formats))
+	for i, f := range settings.Formats {
+		formats[i] = string(f)
+	}
+	pinned := make(pq.StringArray, len(settings.TestimonialIDs))
+	for i, id := range settings.TestimonialIDs {
+		pinned[i] = id.String()
+	}
+	tags := pq.StringArray(settings.Tags)
+	if tags == nil {
+		tags = pq.StringArray{}
+	}
+
+	rows, err := db.QueryContext(ctx, query, workspaceID, settings.MinRating, tags, formats, pinned, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching widget testimonials: %w", err)
+	}
+	defer rows.Close()
+
+	testimonials := []models.EmbedTestimonial{}
+	for rows.Next() {
+		var t models.EmbedTestimonial
+		if err := rows.Scan(
+			&t.ID, &t.Title, &t.Content, &t.Rating, &t.Format,
+			&t.MediaURL, &t.ThumbnailURL, &t.PublishedAt,
+			&t.AuthorName, &t.AuthorTitle, &t.AuthorCompany, &t.AvatarURL,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning widget testimonial: %w", err)
+		}
+		testimonials = append(testimonials, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating widget testimonials: %w", err)
+	}
+	return testimonials, nil
+}
@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/ifeanyidike/cenphi/internal/controllers"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+)
+
+func RegisterAnalyticsRoutes(
+	r chi.Router,
+	controller controllers.AnalyticsController,
+	authMiddleware *middleware.AuthMiddleware,
+	workspaceAccess *middleware.WorkspaceAccessMiddleware,
+	rateLimitMiddleware *middleware.RateLimitMiddleware,
+) {
+	r.Route("/workspaces/{workspaceID}/analytics", func(r chi.Router) {
+		r.Use(authMiddleware.VerifyToken)
+		r.Use(workspaceAccess.Require(models.PermTestimonialsRead))
+
+		r.Get("/", controller.GetWorkspaceAnalytics)
+		r.Get("/testimonials", controller.GetTestimonialPerformance)
+	})
+
+	// Beacons are sent by every visitor of the customer's site as they scroll
+	r.With(rateLimitMiddleware.PerIP("beacon", 300, time.Minute)).Post("/beacon", controller.RecordBeacon)
+}
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/ifeanyidike/cenphi/internal/controllers"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+)
+
+func RegisterAPIKeyRoutes(r chi.Router, controller controllers.APIKeyController, authMiddleware *middleware.AuthMiddleware, workspaceAccess *middleware.WorkspaceAccessMiddleware) {
+	r.Route("/workspaces/{workspaceID}/api-keys", func(r chi.Router) {
+		r.Use(authMiddleware.VerifyToken)
+		r.Use(workspaceAccess.Require(models.PermAPIKeysManage))
+
+		r.Get("/", controller.ListKeys)
+		r.Post("/", controller.IssueKey)
+		r.Delete("/{keyID}", controller.RevokeKey)
+		r.Post("/{keyID}/rotate", controller.RotateKey)
+	})
+}
@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/ifeanyidike/cenphi/internal/controllers"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+)
+
+func RegisterCollectionPortalRoutes(
+	r chi.Router,
+	controller controllers.CollectionPortalController,
+	authMiddleware *middleware.AuthMiddleware,
+	workspaceAccess *middleware.WorkspaceAccessMiddleware,
+	rateLimitMiddleware *middleware.RateLimitMiddleware,
+) {
+	r.Route("/workspaces/{workspaceID}/portals", func(r chi.Router) {
+		r.Use(authMiddleware.VerifyToken)
+		r.Use(workspaceAccess.Require(models.PermPortalsManage))
+
+		r.Get("/", controller.ListPortals)
+		r.Post("/", controller.CreatePortal)
+		r.Get("/{portalID}", controller.GetPortal)
+		r.Put("/{portalID}", controller.UpdatePortal)
+		r.Delete("/{portalID}", controller.DeletePortal)
+	})
+
+	// Hosted portals are public, so each visitor is rate limited by address
+	r.Route("/c/{slug}", func(r chi.Router) {
+		r.With(rateLimitMiddleware.PerIP("portal_view", 60, time.Minute)).Get("/", controller.GetPublicPortal)
+		r.With(rateLimitMiddleware.PerIP("portal_submit", 10, time.Hour)).Post("/", controller.SubmitToPortal)
+	})
+}
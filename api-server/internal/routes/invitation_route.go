@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/ifeanyidike/cenphi/internal/controllers"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+)
+
+func RegisterInvitationRoutes(r chi.Router, controller controllers.InvitationController, authMiddleware *middleware.AuthMiddleware, workspaceAccess *middleware.WorkspaceAccessMiddleware) {
+	r.Route("/workspaces/{workspaceID}/invitations", func(r chi.Router) {
+		r.Use(authMiddleware.VerifyToken)
+		r.Use(workspaceAccess.Require(models.PermMembersManage))
+
+		r.Post("/", controller.Invite)
+		r.Delete("/{invitationID}", controller.RevokeInvitation)
+		r.Post("/{invitationID}/resend", controller.ResendInvitation)
+	})
+
+	r.With(authMiddleware.VerifyToken, workspaceAccess.Require(models.PermWorkspaceRead)).
+		Get("/workspaces/{workspaceID}/team", controller.GetTeam)
+
+	// The person accepting is not a member yet, so only their identity is checked
+	r.With(authMiddleware.VerifyToken).Post("/invitations/accept", controller.AcceptInvitation)
+}
@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/ifeanyidike/cenphi/internal/controllers"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+)
+
+// RegisterMediaUploadRoutes registers the chunked upload endpoints. mediaHandler serves
+// stored media when the local storage backend is in use and is nil otherwise.
+func RegisterMediaUploadRoutes(r chi.Router, controller controllers.MediaUploadController, authMiddleware *middleware.AuthMiddleware, workspaceAccess *middleware.WorkspaceAccessMiddleware, mediaHandler http.Handler) {
+	r.Route("/workspaces/{workspaceID}/uploads", func(r chi.Router) {
+		r.Use(authMiddleware.VerifyToken)
+		r.Use(workspaceAccess.Require(models.PermTestimonialsWrite))
+
+		r.Post("/", controller.CreateUpload)
+		r.Get("/{uploadID}", controller.GetUpload)
+		r.Delete("/{uploadID}", controller.AbortUpload)
+		r.Put("/{uploadID}/parts/{partNumber}", controller.UploadPart)
+		r.Post("/{uploadID}/complete", controller.CompleteUpload)
+	})
+
+	if mediaHandler != nil {
+		r.Handle("/media/*", http.StripPrefix("/api/v1/media", mediaHandler))
+	}
+}
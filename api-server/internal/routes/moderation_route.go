@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/ifeanyidike/cenphi/internal/controllers"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+)
+
+func RegisterModerationRoutes(r chi.Router, controller controllers.ModerationController, authMiddleware *middleware.AuthMiddleware, workspaceAccess *middleware.WorkspaceAccessMiddleware) {
+	r.Route("/workspaces/{workspaceID}/testimonials/{testimonialID}/transitions", func(r chi.Router) {
+		r.Use(authMiddleware.VerifyToken)
+
+		// Which roles may make a transition depends on the statuses, so the service
+		// checks that once it knows the testimonial's current status
+		r.With(workspaceAccess.Require(models.PermTestimonialsRead)).Get("/", controller.ListTransitions)
+		r.With(workspaceAccess.Require(models.PermTestimonialsModerate)).Post("/", controller.TransitionTestimonial)
+	})
+}
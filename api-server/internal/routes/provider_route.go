@@ -4,16 +4,19 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/ifeanyidike/cenphi/internal/controllers"
 	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
 )
 
-func RegisterProviderRoutes(r chi.Router, controller controllers.ProviderController, authMiddleware *middleware.AuthMiddleware) {
+func RegisterProviderRoutes(r chi.Router, controller controllers.ProviderController, authMiddleware *middleware.AuthMiddleware, workspaceAccess *middleware.WorkspaceAccessMiddleware) {
 	r.Route("/providers", func(r chi.Router) {
 		// In your routes setup function
 		r.Group(func(r chi.Router) {
 			r.Use(authMiddleware.VerifyToken)
 			// In your routes setup
-			r.Post("/setup/{provider}/{workspaceID}", controller.SetupProvider)
-			r.Get("/status/{workspaceID}", controller.GetProviderStatus)
+			r.Get("/", controller.ListProviders)
+			r.With(workspaceAccess.Require(models.PermProvidersManage)).Post("/setup/{provider}/{workspaceID}", controller.SetupProvider)
+			r.With(workspaceAccess.Require(models.PermWorkspaceRead)).Get("/status/{workspaceID}", controller.GetProviderStatus)
+			r.With(workspaceAccess.Require(models.PermWorkspaceRead)).Get("/{workspaceID}/runs", controller.GetSyncRuns)
 		})
 	})
 }
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/ifeanyidike/cenphi/internal/controllers"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+)
+
+// RegisterPublicRoutes registers the endpoints called by customers' own systems. They
+// are authenticated with workspace API keys rather than user tokens.
+func RegisterPublicRoutes(
+	r chi.Router,
+	testimonialController controllers.TestimonialController,
+	apiKeyMiddleware *middleware.APIKeyMiddleware,
+	idempotencyMiddleware *middleware.IdempotencyMiddleware,
+) {
+	r.Route("/public/workspaces/{workspaceID}", func(r chi.Router) {
+		r.Use(apiKeyMiddleware.RequireAPIKey)
+
+		r.With(idempotencyMiddleware.Idempotent).Post("/testimonials", testimonialController.HandleAPISubmission)
+	})
+}
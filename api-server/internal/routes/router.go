@@ -1,6 +1,8 @@
 package routes
 
 import (
+	"net/http"
+
 	"github.com/go-chi/chi/v5"
 	"github.com/ifeanyidike/cenphi/internal/controllers"
 	"github.com/ifeanyidike/cenphi/internal/middleware"
@@ -9,6 +11,7 @@ import (
 func RegisterRoutes(
 	r chi.Router,
 	authMiddleware *middleware.AuthMiddleware,
+	workspaceAccess *middleware.WorkspaceAccessMiddleware,
 	healthController *controllers.HealthController,
 	userController controllers.UserController,
 	swaggerController *controllers.SwaggerController,
@@ -16,14 +19,42 @@ func RegisterRoutes(
 	teamMemberController *controllers.TeamMemberController,
 	onboardingController *controllers.OnboardingController,
 	testimonialController *controllers.TestimonialController,
+	providerController *controllers.ProviderController,
+	apiKeyController *controllers.APIKeyController,
+	apiKeyMiddleware *middleware.APIKeyMiddleware,
+	idempotencyMiddleware *middleware.IdempotencyMiddleware,
+	collectionPortalController *controllers.CollectionPortalController,
+	rateLimitMiddleware *middleware.RateLimitMiddleware,
+	mediaUploadController *controllers.MediaUploadController,
+	aiJobController *controllers.AIJobController,
+	moderationController *controllers.ModerationController,
+	invitationController *controllers.InvitationController,
+	usageController *controllers.UsageController,
+	webhookController *controllers.WebhookController,
+	slackController *controllers.SlackController,
+	widgetController *controllers.WidgetController,
+	analyticsController *controllers.AnalyticsController,
+	mediaHandler http.Handler,
 ) {
 	r.Route("/api/v1", func(r chi.Router) {
 		RegisterHealthRoutes(r, healthController)
 		RegisterUserRoutes(r, userController, authMiddleware)
 		RegisterSwaggerRoute(r, swaggerController)
-		RegisterWorkspaceRoutes(r, *workspaceController, authMiddleware)
-		RegisterTeamMemberRoutes(r, *teamMemberController, authMiddleware)
+		RegisterWorkspaceRoutes(r, *workspaceController, authMiddleware, workspaceAccess)
+		RegisterTeamMemberRoutes(r, *teamMemberController, authMiddleware, workspaceAccess)
 		RegisterOnboardingRoutes(r, *onboardingController, authMiddleware)
-		RegisterTestimonialRoutes(r, *testimonialController, authMiddleware)
+		RegisterTestimonialRoutes(r, *testimonialController, *aiJobController, authMiddleware, workspaceAccess)
+		RegisterProviderRoutes(r, *providerController, authMiddleware, workspaceAccess)
+		RegisterAPIKeyRoutes(r, *apiKeyController, authMiddleware, workspaceAccess)
+		RegisterModerationRoutes(r, *moderationController, authMiddleware, workspaceAccess)
+		RegisterInvitationRoutes(r, *invitationController, authMiddleware, workspaceAccess)
+		RegisterUsageRoutes(r, *usageController, authMiddleware, workspaceAccess)
+		RegisterWebhookRoutes(r, *webhookController, authMiddleware, workspaceAccess)
+		RegisterSlackRoutes(r, *slackController, authMiddleware, workspaceAccess)
+		RegisterPublicRoutes(r, *testimonialController, apiKeyMiddleware, idempotencyMiddleware)
+		RegisterCollectionPortalRoutes(r, *collectionPortalController, authMiddleware, workspaceAccess, rateLimitMiddleware)
+		RegisterMediaUploadRoutes(r, *mediaUploadController, authMiddleware, workspaceAccess, mediaHandler)
+		RegisterWidgetRoutes(r, *widgetController, authMiddleware, workspaceAccess, rateLimitMiddleware)
+		RegisterAnalyticsRoutes(r, *analyticsController, authMiddleware, workspaceAccess, rateLimitMiddleware)
 	})
 }
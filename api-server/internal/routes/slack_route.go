@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/ifeanyidike/cenphi/internal/controllers"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+)
+
+func RegisterSlackRoutes(r chi.Router, controller controllers.SlackController, authMiddleware *middleware.AuthMiddleware, workspaceAccess *middleware.WorkspaceAccessMiddleware) {
+	r.Route("/workspaces/{workspaceID}/slack", func(r chi.Router) {
+		r.Use(authMiddleware.VerifyToken)
+
+		r.With(workspaceAccess.Require(models.PermWorkspaceManage)).Get("/", controller.GetSettings)
+		r.With(workspaceAccess.Require(models.PermWorkspaceManage)).Put("/", controller.UpdateSettings)
+		r.With(workspaceAccess.Require(models.PermWorkspaceManage)).Post("/test", controller.SendTest)
+		r.With(workspaceAccess.Require(models.PermWorkspaceRead)).Post("/link", controller.LinkAccount)
+	})
+
+	// Slack signs these requests; there is no user token
+	r.Post("/slack/interactions", controller.HandleInteraction)
+}
@@ -4,20 +4,19 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/ifeanyidike/cenphi/internal/controllers"
 	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
 )
 
-func RegisterTeamMemberRoutes(r chi.Router, controller controllers.TeamMemberController, authMiddleware *middleware.AuthMiddleware) {
-	r.Route("/team-member", func(r chi.Router) {
-		r.Group(func(r chi.Router) {
-			r.Use(authMiddleware.VerifyToken)
-			r.Get("/{id}", controller.GetTeamMember)
-			r.Get("/user/{id}", controller.GetTeamMemberByUserID)
-			r.Get("/firebase_uid/{id}", controller.GetTeamMemberByFirebaseUID)
-
-			r.Post("/", controller.CreateTeamMember)
-			r.Delete("/{id}", controller.DeleteTeamMember)
-			r.Get("/", controller.GetTeamMembers)
-		})
+func RegisterTeamMemberRoutes(r chi.Router, controller controllers.TeamMemberController, authMiddleware *middleware.AuthMiddleware, workspaceAccess *middleware.WorkspaceAccessMiddleware) {
+	r.Route("/workspaces/{workspaceID}/members", func(r chi.Router) {
+		r.Use(authMiddleware.VerifyToken)
 
+		r.With(workspaceAccess.Require(models.PermWorkspaceRead)).Get("/", controller.GetTeamMembers)
+		r.With(workspaceAccess.Require(models.PermWorkspaceRead)).Get("/{memberID}", controller.GetTeamMember)
+		r.With(workspaceAccess.Require(models.PermMembersManage)).Delete("/{memberID}", controller.DeleteTeamMember)
 	})
+
+	// Only the signed in user's own membership can be looked up here. Members are
+	// added by accepting invitations, never directly.
+	r.With(authMiddleware.VerifyToken).Get("/team-member/firebase_uid/{id}", controller.GetTeamMemberByFirebaseUID)
 }
@@ -4,15 +4,16 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/ifeanyidike/cenphi/internal/controllers"
 	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
 )
 
-func RegisterTestimonialRoutes(r chi.Router, controller controllers.TestimonialController, authMiddleware *middleware.AuthMiddleware) {
+func RegisterTestimonialRoutes(r chi.Router, controller controllers.TestimonialController, aiJobController controllers.AIJobController, authMiddleware *middleware.AuthMiddleware, workspaceAccess *middleware.WorkspaceAccessMiddleware) {
 	r.Route("/testimonials", func(r chi.Router) {
-		// In your routes setup function
-		r.Post("/fetch/{provider}/{workspaceID}", controller.FetchFromProvider)
 		r.Group(func(r chi.Router) {
 			r.Use(authMiddleware.VerifyToken)
-			r.Get("/{workspaceID}", controller.GetByWorkspaceID)
+			r.With(workspaceAccess.Require(models.PermProvidersManage)).Post("/fetch/{provider}/{workspaceID}", controller.FetchFromProvider)
+			r.With(workspaceAccess.Require(models.PermTestimonialsRead)).Get("/{workspaceID}", controller.GetByWorkspaceID)
+			r.Get("/{testimonialID}/jobs", aiJobController.ListTestimonialJobs)
 		})
 	})
 }
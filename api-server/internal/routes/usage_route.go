@@ -0,0 +1,13 @@
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/ifeanyidike/cenphi/internal/controllers"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+)
+
+func RegisterUsageRoutes(r chi.Router, controller controllers.UsageController, authMiddleware *middleware.AuthMiddleware, workspaceAccess *middleware.WorkspaceAccessMiddleware) {
+	r.With(authMiddleware.VerifyToken, workspaceAccess.Require(models.PermWorkspaceRead)).
+		Get("/workspaces/{workspaceID}/usage", controller.GetUsage)
+}
@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/ifeanyidike/cenphi/internal/controllers"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+)
+
+func RegisterWebhookRoutes(r chi.Router, controller controllers.WebhookController, authMiddleware *middleware.AuthMiddleware, workspaceAccess *middleware.WorkspaceAccessMiddleware) {
+	r.Route("/workspaces/{workspaceID}/webhooks", func(r chi.Router) {
+		r.Use(authMiddleware.VerifyToken)
+		r.Use(workspaceAccess.Require(models.PermWebhooksManage))
+
+		r.Get("/", controller.ListEndpoints)
+		r.Post("/", controller.CreateEndpoint)
+		r.Get("/deliveries", controller.ListDeliveries)
+		r.Post("/deliveries/{deliveryID}/redeliver", controller.Redeliver)
+		r.Get("/{endpointID}", controller.GetEndpoint)
+		r.Put("/{endpointID}", controller.UpdateEndpoint)
+		r.Delete("/{endpointID}", controller.DeleteEndpoint)
+		r.Post("/{endpointID}/rotate-secret", controller.RotateSecret)
+	})
+}
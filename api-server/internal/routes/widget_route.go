@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/ifeanyidike/cenphi/internal/controllers"
+	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
+)
+
+func RegisterWidgetRoutes(
+	r chi.Router,
+	controller controllers.WidgetController,
+	authMiddleware *middleware.AuthMiddleware,
+	workspaceAccess *middleware.WorkspaceAccessMiddleware,
+	rateLimitMiddleware *middleware.RateLimitMiddleware,
+) {
+	r.Route("/workspaces/{workspaceID}/widgets", func(r chi.Router) {
+		r.Use(authMiddleware.VerifyToken)
+		r.Use(workspaceAccess.Require(models.PermPortalsManage))
+
+		r.Get("/", controller.ListWidgets)
+		r.Post("/", controller.CreateWidget)
+		r.Get("/{widgetID}", controller.GetWidget)
+		r.Put("/{widgetID}", controller.UpdateWidget)
+		r.Delete("/{widgetID}", controller.DeleteWidget)
+	})
+
+	// Embeds are loaded by every visitor of the customer's site
+	r.With(rateLimitMiddleware.PerIP("widget_embed", 120, time.Minute)).Get("/embed/{widgetID}", controller.GetEmbed)
+}
@@ -4,23 +4,28 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/ifeanyidike/cenphi/internal/controllers"
 	"github.com/ifeanyidike/cenphi/internal/middleware"
+	"github.com/ifeanyidike/cenphi/internal/models"
 )
 
-func RegisterWorkspaceRoutes(r chi.Router, controller controllers.WorkspaceController, authMiddleware *middleware.AuthMiddleware) {
+func RegisterWorkspaceRoutes(r chi.Router, controller controllers.WorkspaceController, authMiddleware *middleware.AuthMiddleware, workspaceAccess *middleware.WorkspaceAccessMiddleware) {
 	r.Route("/workspaces", func(r chi.Router) {
 		// Apply auth middleware to all workspace routes
 		r.Use(authMiddleware.VerifyToken)
 
 		// Workspace CRUD operations
-		r.Post("/", controller.CreateWorkspace)       // Create a new workspace
-		r.Get("/", controller.GetWorkspace)           // Get all workspaces for the user
-		r.Get("/{id}", controller.GetWorkspace)       // Get a specific workspace
-		r.Put("/{id}", controller.UpdateWorkspace)    // Update a workspace
-		r.Delete("/{id}", controller.DeleteWorkspace) // Delete a workspace
+		r.Post("/", controller.CreateWorkspace)                                                                          // Create a new workspace
+		r.Get("/", controller.GetWorkspace)                                                                              // Get all workspaces for the user
+		r.With(workspaceAccess.Require(models.PermWorkspaceRead)).Get("/{workspaceID}", controller.GetWorkspace)         // Get a specific workspace
+		r.With(workspaceAccess.Require(models.PermWorkspaceManage)).Put("/{workspaceID}", controller.UpdateWorkspace)    // Update a workspace
+		r.With(workspaceAccess.Require(models.PermWorkspaceDelete)).Delete("/{workspaceID}", controller.DeleteWorkspace) // Delete a workspace
 
 		// Testimonial operations for a workspace
 		r.Route("/{workspaceID}/testimonials", func(r chi.Router) {
+			r.Use(workspaceAccess.Require(models.PermTestimonialsRead))
+
 			r.Get("/", controller.GetTestimonialsByWorkspaceID)  // Get all testimonials for a workspace
+			r.Get("/search", controller.SearchTestimonials)      // Search the testimonials of a workspace
+			r.Get("/similar", controller.SimilarTestimonials)    // Find testimonials similar in meaning
 			r.Get("/{testimonialID}", controller.GetTestimonial) // Get a specific testimonial within a workspace
 		})
 	})
@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// intelligenceRPCTimeout bounds a single unary call to the intelligence service.
+const intelligenceRPCTimeout = 60 * time.Second
+
+// intelligenceJobHandlers runs AI jobs against the matching intelligence service RPC. The
+// job's input parameters carry the optional fields of each request.
+type intelligenceJobHandlers struct {
+	client          *pb.IntelligenceClient
+	testimonialRepo repositories.TestimonialRepository
+	db              *sql.DB
+}
+
+// RegisterIntelligenceJobHandlers registers a handler on the worker for every job type the
+// intelligence service supports. Verification jobs are handled by the AuthenticityService,
+// and segmentation jobs have no matching RPC and fail.
+func RegisterIntelligenceJobHandlers(
+	worker *AIJobWorker,
+	client *pb.IntelligenceClient,
+	testimonialRepo repositories.TestimonialRepository,
+	db *sql.DB,
+) {
+	h := &intelligenceJobHandlers{client: client, testimonialRepo: testimonialRepo, db: db}
+
+	worker.Handle(models.AIServiceCategoryAnalysis, AIJobHandlerFunc(h.analyzeSentiment))
+	worker.Handle(models.AIServiceCategoryEnhancement, AIJobHandlerFunc(h.enhance))
+	worker.Handle(models.AIServiceCategoryGeneration, AIJobHandlerFunc(h.generateStory))
+	worker.Handle(models.AIServiceCategoryOptimization, AIJobHandlerFunc(h.analyzeEmotionalResonance))
+	worker.Handle(models.AIServiceCategoryRecommendation, AIJobHandlerFunc(h.generateSalesConversation))
+}
+
+func (h *intelligenceJobHandlers) analyzeSentiment(ctx context.Context, job *models.AIJob) (models.JSONMap, *uuid.UUID, error) {
+	return h.call(ctx, job, func(ctx context.Context, client pb.IntelligenceClient, text string) (proto.Message, error) {
+		return client.AnalyzeSentiment(ctx, &pb.AnalyzeSentimentRequest{
+			Text:             text,
+			IndustryContext:  stringParam(job, "industry_context"),
+			AspectCategories: stringsParam(job, "aspect_categories"),
+		})
+	})
+}
+
+func (h *intelligenceJobHandlers) enhance(ctx context.Context, job *models.AIJob) (models.JSONMap, *uuid.UUID, error) {
+	return h.call(ctx, job, func(ctx context.Context, client pb.IntelligenceClient, text string) (proto.Message, error) {
+		preserve, _ := job.InputParameters["preserve_key_metrics"].(bool)
+		return client.EnhanceTestimonial(ctx, &pb.EnhanceTestimonialRequest{
+			Text:                 text,
+			TargetTone:           stringParam(job, "target_tone"),
+			PreserveKeyMetrics:   preserve,
+			KeyPointsToEmphasize: stringsParam(job, "key_points_to_emphasize"),
+		})
+	})
+}
+
+func (h *intelligenceJobHandlers) generateStory(ctx context.Context, job *models.AIJob) (models.JSONMap, *uuid.UUID, error) {
+	return h.call(ctx, job, func(ctx context.Context, client pb.IntelligenceClient, _ string) (proto.Message, error) {
+		ids := stringsParam(job, "testimonial_ids")
+		if len(ids) == 0 {
+			ids = []string{job.TestimonialID.String()}
+		}
+		return client.GenerateStoryFromTestimonials(ctx, &pb.GenerateStoryRequest{
+			TestimonialIds: ids,
+			NarrativeStyle: stringParam(job, "narrative_style"),
+			KeyThemes:      stringsParam(job, "key_themes"),
+		})
+	})
+}
+
+func (h *intelligenceJobHandlers) analyzeEmotionalResonance(ctx context.Context, job *models.AIJob) (models.JSONMap, *uuid.UUID, error) {
+	return h.call(ctx, job, func(ctx context.Context, client pb.IntelligenceClient, text string) (proto.Message, error) {
+		return client.AnalyzeEmotionalResonance(ctx, &pb.EmotionalResonanceRequest{
+			TestimonialContent: text,
+			TargetAudience:     stringParam(job, "target_audience"),
+			EmotionalGoals:     stringsParam(job, "emotional_goals"),
+		})
+	})
+}
+
+func (h *intelligenceJobHandlers) generateSalesConversation(ctx context.Context, job *models.AIJob) (models.JSONMap, *uuid.UUID, error) {
+	return h.call(ctx, job, func(ctx context.Context, client pb.IntelligenceClient, _ string) (proto.Message, error) {
+		return client.GenerateSalesConversation(ctx, &pb.SalesConversationRequest{
+			TestimonialId:    job.TestimonialID.String(),
+			ProspectIndustry: stringParam(job, "prospect_industry"),
+			Objections:       stringsParam(job, "objections"),
+		})
+	})
+}
+
+// call loads the testimonial's text, makes the request and returns the response as the
+// job output.
+func (h *intelligenceJobHandlers) call(
+	ctx context.Context,
+	job *models.AIJob,
+	rpc func(ctx context.Context, client pb.IntelligenceClient, text string) (proto.Message, error),
+) (models.JSONMap, *uuid.UUID, error) {
+	if h.client == nil || *h.client == nil {
+		return nil, nil, permanentJobFailure(errors.New("intelligence service is not configured"))
+	}
+
+	testimonial, err := h.testimonialRepo.FetchByID(ctx, job.TestimonialID, h.db)
+	if err != nil {
+		return nil, nil, err
+	}
+	text := testimonialText(testimonial)
+	if text == "" {
+		return nil, nil, permanentJobFailure(errors.New("testimonial has no text to process"))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, intelligenceRPCTimeout)
+	defer cancel()
+
+	resp, err := rpc(ctx, *h.client, text)
+	if err != nil {
+		return nil, nil, fmt.Errorf("intelligence service request failed: %w", err)
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(resp)
+	if err != nil {
+		return nil, nil, permanentJobFailure(fmt.Errorf("failed to encode response: %w", err))
+	}
+	output := models.JSONMap{}
+	if err := json.Unmarshal(data, &output); err != nil {
+		return nil, nil, permanentJobFailure(fmt.Errorf("failed to decode response: %w", err))
+	}
+	return output, nil, nil
+}
+
+// testimonialText returns the written content of a testimonial, falling back to the
+// transcript of audio and video testimonials.
+func testimonialText(t *models.Testimonial) string {
+	if text := strings.TrimSpace(t.Content); text != "" {
+		return text
+	}
+	if t.Transcript != nil {
+		return strings.TrimSpace(*t.Transcript)
+	}
+	return ""
+}
+
+func stringParam(job *models.AIJob, key string) string {
+	s, _ := job.InputParameters[key].(string)
+	return s
+}
+
+// stringsParam reads a list of strings. Parameters loaded from the database decode as
+// []interface{}.
+func stringsParam(job *models.AIJob, key string) []string {
+	switch v := job.InputParameters[key].(type) {
+	case []string:
+		return v
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package services
+
+//go:generate mockery --name=AIJobService --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+)
+
+const (
+	defaultAIJobPriority    = 1
+	defaultAIJobMaxAttempts = 5
+)
+
+type AIJobService interface {
+	// Enqueue stores a pending job for the workers to pick up. Priority, MaxAttempts and
+	// RunAt default to 1, 5 and now when left unset. It fails with
+	// apperrors.ErrQuotaExceeded once the workspace has used its plan's AI jobs.
+	Enqueue(ctx context.Context, job *models.AIJob) error
+	ListForTestimonial(ctx context.Context, testimonialID uuid.UUID, firebaseUID string) ([]models.AIJob, error)
+}
+
+type aiJobService struct {
+	repo            repositories.AIJobRepository
+	testimonialRepo repositories.TestimonialRepository
+	teamMemberRepo  repositories.TeamMemberRepository
+	entitlements    EntitlementService
+	db              *sql.DB
+	now             func() time.Time
+}
+
+// NewAIJobService returns an AI job service. The AI jobs quota is only enforced when
+// entitlements is set.
+func NewAIJobService(
+	repo repositories.AIJobRepository,
+	testimonialRepo repositories.TestimonialRepository,
+	teamMemberRepo repositories.TeamMemberRepository,
+	entitlements EntitlementService,
+	db *sql.DB,
+) AIJobService {
+	return &aiJobService{
+		repo:            repo,
+		testimonialRepo: testimonialRepo,
+		teamMemberRepo:  teamMemberRepo,
+		entitlements:    entitlements,
+		db:              db,
+		now:             time.Now,
+	}
+}
+
+func (s *aiJobService) Enqueue(ctx context.Context, job *models.AIJob) error {
+	if job.ID == uuid.Nil {
+		job.ID = uuid.New()
+	}
+	if job.Priority == 0 {
+		job.Priority = defaultAIJobPriority
+	}
+	if job.MaxAttempts == 0 {
+		job.MaxAttempts = defaultAIJobMaxAttempts
+	}
+	if job.RunAt.IsZero() {
+		job.RunAt = s.now()
+	}
+	if job.InputParameters == nil {
+		job.InputParameters = models.JSONMap{}
+	}
+	job.Status = models.AIJobStatusPending
+	job.Attempts = 0
+
+	// The quota check locks the workspace until the job is stored, so concurrent
+	// enqueues cannot all pass it
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+	defer tx.Rollback()
+
+	if err := s.checkQuota(ctx, job.TestimonialID, tx); err != nil {
+		return err
+	}
+	if err := s.repo.Create(ctx, job, tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+	return nil
+}
+
+// checkQuota fails when the workspace the testimonial belongs to has used every AI job
+// its plan allows this month.
+func (s *aiJobService) checkQuota(ctx context.Context, testimonialID uuid.UUID, db repositories.DB) error {
+	if s.entitlements == nil {
+		return nil
+	}
+	workspaceID, err := s.testimonialRepo.GetWorkspaceID(ctx, testimonialID, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperrors.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	return s.entitlements.Check(ctx, workspaceID, models.QuotaAIJobs, 1, db)
+}
+
+// ListForTestimonial returns the jobs of a testimonial, newest first, to any member of
+// its workspace.
+func (s *aiJobService) ListForTestimonial(ctx context.Context, testimonialID uuid.UUID, firebaseUID string) ([]models.AIJob, error) {
+	workspaceID, err := s.testimonialRepo.GetWorkspaceID(ctx, testimonialID, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = requireWorkspacePermission(ctx, s.teamMemberRepo, s.db, workspaceID, firebaseUID, models.PermTestimonialsRead)
+	if err != nil {
+		// Do not reveal that the testimonial exists
+		if errors.Is(err, apperrors.ErrWorkspaceAccessDenied) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return s.repo.ListByTestimonial(ctx, testimonialID, s.db)
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAIJobService(t *testing.T) {
+	ctx := context.Background()
+	db, sqlMock, _ := sqlmock.New()
+	workspaceID := uuid.New()
+	testimonialID := uuid.New()
+
+	t.Run("EnqueueAppliesDefaults", func(t *testing.T) {
+		repo := mocks.NewAIJobRepository(t)
+		repo.On("Create", mock.Anything, mock.AnythingOfType("*models.AIJob"), mock.Anything).Return(nil)
+		svc := NewAIJobService(repo, mocks.NewTestimonialRepository(t), mocks.NewTeamMemberRepository(t), nil, db)
+
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+		job := &models.AIJob{TestimonialID: testimonialID, JobType: models.AIServiceCategoryAnalysis, Priority: 3}
+		require.NoError(t, svc.Enqueue(ctx, job))
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+		assert.NotEqual(t, uuid.Nil, job.ID)
+		assert.Equal(t, models.AIJobStatusPending, job.Status)
+		assert.Equal(t, 3, job.Priority)
+		assert.Equal(t, defaultAIJobMaxAttempts, job.MaxAttempts)
+		assert.False(t, job.RunAt.IsZero())
+	})
+
+	t.Run("ListForTestimonialHidesOtherWorkspaces", func(t *testing.T) {
+		repo := mocks.NewAIJobRepository(t)
+		testimonials := mocks.NewTestimonialRepository(t)
+		members := mocks.NewTeamMemberRepository(t)
+		svc := NewAIJobService(repo, testimonials, members, nil, db)
+
+		testimonials.On("GetWorkspaceID", mock.Anything, testimonialID, db).Return(workspaceID, nil)
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "viewer-uid", db).Return(&models.TeamMember{Role: models.Viewer}, nil)
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "stranger-uid", db).Return(nil, sql.ErrNoRows)
+		repo.On("ListByTestimonial", mock.Anything, testimonialID, db).Return([]models.AIJob{{ID: uuid.New()}}, nil)
+
+		jobs, err := svc.ListForTestimonial(ctx, testimonialID, "viewer-uid")
+		require.NoError(t, err)
+		assert.Len(t, jobs, 1)
+
+		_, err = svc.ListForTestimonial(ctx, testimonialID, "stranger-uid")
+		assert.ErrorIs(t, err, apperrors.ErrNotFound)
+	})
+}
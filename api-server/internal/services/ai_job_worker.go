@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pkg/events"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultAIJobWorkers      = 4
+	defaultAIJobPollInterval = 2 * time.Second
+	// defaultAIJobTimeout is long enough to stream and transcribe a large video.
+	defaultAIJobTimeout     = 30 * time.Minute
+	defaultAIJobBaseBackoff = 10 * time.Second
+	defaultAIJobMaxBackoff  = 30 * time.Minute
+	// aiJobLeaseMargin is how long a job stays leased after its timeout, so a slow
+	// worker can still record the outcome before the job is handed to another.
+	aiJobLeaseMargin = time.Minute
+)
+
+// AIJobHandler runs one kind of AI job. The returned output is stored on the job and the
+// optional reference points at a record the handler created.
+type AIJobHandler interface {
+	Handle(ctx context.Context, job *models.AIJob) (models.JSONMap, *uuid.UUID, error)
+}
+
+type AIJobHandlerFunc func(ctx context.Context, job *models.AIJob) (models.JSONMap, *uuid.UUID, error)
+
+func (f AIJobHandlerFunc) Handle(ctx context.Context, job *models.AIJob) (models.JSONMap, *uuid.UUID, error) {
+	return f(ctx, job)
+}
+
+// permanentJobError marks a failure that retrying cannot fix.
+type permanentJobError struct {
+	err error
+}
+
+func (e permanentJobError) Error() string { return e.err.Error() }
+func (e permanentJobError) Unwrap() error { return e.err }
+
+func permanentJobFailure(err error) error {
+	return permanentJobError{err: err}
+}
+
+// isPermanentJobFailure reports whether a job should fail without further attempts.
+// Requests the intelligence service rejects outright are not retried.
+func isPermanentJobFailure(err error) bool {
+	var permanent permanentJobError
+	if errors.As(err, &permanent) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.PermissionDenied, codes.Unauthenticated,
+		codes.FailedPrecondition, codes.Unimplemented:
+		return true
+	}
+	return false
+}
+
+type AIJobWorkerConfig struct {
+	Workers      int
+	PollInterval time.Duration
+	JobTimeout   time.Duration
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+}
+
+// AIJobWorker runs queued AI jobs on a pool of workers. Jobs are claimed from Postgres
+// with SKIP LOCKED, so any number of API servers can run workers against the same
+// queue. A job is dispatched on the task in its input parameters when a handler is
+// registered for it, and otherwise on its job type.
+type AIJobWorker struct {
+	repo            repositories.AIJobRepository
+	testimonialRepo repositories.TestimonialRepository
+	events          events.Publisher
+	db              *sql.DB
+	cfg             AIJobWorkerConfig
+	handlers        map[models.AIServiceCategory]AIJobHandler
+	tasks           map[string]AIJobHandler
+	now             func() time.Time
+}
+
+// NewAIJobWorker returns a worker for the job queue. Completed jobs are broadcast as
+// analysis.completed events when publisher is set.
+func NewAIJobWorker(
+	repo repositories.AIJobRepository,
+	testimonialRepo repositories.TestimonialRepository,
+	publisher events.Publisher,
+	cfg AIJobWorkerConfig,
+	db *sql.DB,
+) *AIJobWorker {
+	if cfg.Workers <= 0 {
+		cfg.Workers = defaultAIJobWorkers
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaultAIJobPollInterval
+	}
+	if cfg.JobTimeout <= 0 {
+		cfg.JobTimeout = defaultAIJobTimeout
+	}
+	if cfg.BaseBackoff <= 0 {
+		cfg.BaseBackoff = defaultAIJobBaseBackoff
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = defaultAIJobMaxBackoff
+	}
+
+	return &AIJobWorker{
+		repo:            repo,
+		testimonialRepo: testimonialRepo,
+		events:          publisher,
+		db:              db,
+		cfg:             cfg,
+		handlers:        map[models.AIServiceCategory]AIJobHandler{},
+		tasks:           map[string]AIJobHandler{},
+		now:             time.Now,
+	}
+}
+
+// Handle registers the handler for jobs of a type. Register handlers before Run.
+func (w *AIJobWorker) Handle(jobType models.AIServiceCategory, handler AIJobHandler) {
+	w.handlers[jobType] = handler
+}
+
+// HandleTask registers the handler for jobs whose input parameters name the task.
+func (w *AIJobWorker) HandleTask(task string, handler AIJobHandler) {
+	w.tasks[task] = handler
+}
+
+// Run processes jobs until ctx is cancelled, then waits for running jobs to finish.
+func (w *AIJobWorker) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < w.cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.loop(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+func (w *AIJobWorker) loop(ctx context.Context) {
+	for {
+		found, err := w.RunOnce(ctx)
+		if err != nil {
+			slog.Error("ai job worker failed", "error", err)
+		}
+		if found && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.cfg.PollInterval):
+		}
+	}
+}
+
+// RunOnce claims and runs a single job. It reports whether a job was found.
+func (w *AIJobWorker) RunOnce(ctx context.Context) (bool, error) {
+	if ctx.Err() != nil {
+		return false, nil
+	}
+
+	job, err := w.repo.Claim(ctx, w.cfg.JobTimeout+aiJobLeaseMargin, w.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	output, ref, err := w.run(ctx, job)
+
+	// Record the outcome even when shutting down, so the job is not left leased
+	ctx = context.WithoutCancel(ctx)
+	if err == nil {
+		if err := w.repo.Complete(ctx, job.ID, output, ref, w.db); err != nil {
+			return true, err
+		}
+		w.broadcastCompleted(ctx, job, ref)
+		return true, nil
+	}
+
+	details := models.JSONMap{"error": err.Error(), "attempts": job.Attempts}
+	if isPermanentJobFailure(err) || job.Attempts >= job.MaxAttempts {
+		slog.Warn("ai job failed", "job", job.ID, "type", job.JobType, "attempts", job.Attempts, "error", err)
+		return true, w.repo.Fail(ctx, job.ID, details, w.db)
+	}
+
+	runAt := w.now().Add(w.backoff(job.Attempts))
+	slog.Info("ai job will be retried", "job", job.ID, "type", job.JobType, "attempts", job.Attempts, "run_at", runAt, "error", err)
+	return true, w.repo.Retry(ctx, job.ID, runAt, details, w.db)
+}
+
+func (w *AIJobWorker) run(ctx context.Context, job *models.AIJob) (output models.JSONMap, ref *uuid.UUID, err error) {
+	handler := w.handlerFor(job)
+	if handler == nil {
+		return nil, nil, permanentJobFailure(fmt.Errorf("no handler for %s jobs", job.JobType))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, w.cfg.JobTimeout)
+	defer cancel()
+
+	defer func() {
+		if p := recover(); p != nil {
+			err = permanentJobFailure(fmt.Errorf("job handler panicked: %v", p))
+		}
+	}()
+	return handler.Handle(ctx, job)
+}
+
+// broadcastCompleted announces a finished job on its testimonial's workspace.
+func (w *AIJobWorker) broadcastCompleted(ctx context.Context, job *models.AIJob, ref *uuid.UUID) {
+	if w.events == nil {
+		return
+	}
+	workspaceID, err := w.testimonialRepo.GetWorkspaceID(ctx, job.TestimonialID, w.db)
+	if err != nil {
+		slog.Warn("failed to resolve workspace of completed ai job", "job", job.ID, "error", err)
+		return
+	}
+
+	data := map[string]any{"job_id": job.ID, "job_type": job.JobType}
+	if task, _ := job.InputParameters[jobParamTask].(string); task != "" {
+		data["task"] = task
+	}
+	if ref != nil {
+		data["output_reference_id"] = *ref
+	}
+	broadcast(ctx, w.events, events.Event{
+		Type:        events.AnalysisCompleted,
+		WorkspaceID: workspaceID,
+		SubjectID:   job.TestimonialID,
+		OccurredAt:  w.now(),
+		Data:        data,
+	})
+}
+
+func (w *AIJobWorker) handlerFor(job *models.AIJob) AIJobHandler {
+	if task, _ := job.InputParameters[jobParamTask].(string); task != "" {
+		if handler, ok := w.tasks[task]; ok {
+			return handler
+		}
+	}
+	return w.handlers[job.JobType]
+}
+
+// backoff doubles the delay with every attempt, with jitter so that jobs which failed
+// together are not retried together.
+func (w *AIJobWorker) backoff(attempts int) time.Duration {
+	delay := w.cfg.BaseBackoff
+	for i := 1; i < attempts && delay < w.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	delay = min(delay, w.cfg.MaxBackoff)
+	return delay/2 + rand.N(delay/2+1)
+}
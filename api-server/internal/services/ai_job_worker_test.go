@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAIJobWorker(t *testing.T) {
+	ctx := context.Background()
+	db, _, _ := sqlmock.New()
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	newWorker := func(t *testing.T, job *models.AIJob) (*AIJobWorker, *mocks.AIJobRepository) {
+		repo := mocks.NewAIJobRepository(t)
+		if job != nil {
+			repo.On("Claim", mock.Anything, 31*time.Minute, db).Return(job, nil).Once()
+		}
+		worker := NewAIJobWorker(repo, nil, nil, AIJobWorkerConfig{BaseBackoff: 10 * time.Second, MaxBackoff: time.Minute}, db)
+		worker.now = func() time.Time { return now }
+		return worker, repo
+	}
+	claimedJob := func(jobType models.AIServiceCategory, attempts int) *models.AIJob {
+		return &models.AIJob{
+			ID:              uuid.New(),
+			TestimonialID:   uuid.New(),
+			JobType:         jobType,
+			Status:          models.AIJobStatusRunning,
+			InputParameters: models.JSONMap{},
+			Attempts:        attempts,
+			MaxAttempts:     5,
+		}
+	}
+
+	t.Run("ReturnsFalseWhenQueueIsEmpty", func(t *testing.T) {
+		worker, repo := newWorker(t, nil)
+		repo.On("Claim", mock.Anything, mock.Anything, db).Return(nil, sql.ErrNoRows)
+
+		found, err := worker.RunOnce(ctx)
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("CompletesJobWithHandlerOutput", func(t *testing.T) {
+		job := claimedJob(models.AIServiceCategoryEnhancement, 1)
+		worker, repo := newWorker(t, job)
+		ref := uuid.New()
+		worker.Handle(models.AIServiceCategoryEnhancement, AIJobHandlerFunc(func(ctx context.Context, got *models.AIJob) (models.JSONMap, *uuid.UUID, error) {
+			assert.Equal(t, job.ID, got.ID)
+			return models.JSONMap{"enhanced_text": "Better"}, &ref, nil
+		}))
+		repo.On("Complete", mock.Anything, job.ID, models.JSONMap{"enhanced_text": "Better"}, &ref, db).Return(nil)
+
+		found, err := worker.RunOnce(ctx)
+		require.NoError(t, err)
+		assert.True(t, found)
+	})
+
+	t.Run("DispatchesOnTaskBeforeJobType", func(t *testing.T) {
+		job := claimedJob(models.AIServiceCategoryAnalysis, 1)
+		job.InputParameters[jobParamTask] = models.AIJobTaskVideoTranscription
+		worker, repo := newWorker(t, job)
+		worker.Handle(models.AIServiceCategoryAnalysis, AIJobHandlerFunc(func(context.Context, *models.AIJob) (models.JSONMap, *uuid.UUID, error) {
+			t.Fatal("job type handler should not run")
+			return nil, nil, nil
+		}))
+		worker.HandleTask(models.AIJobTaskVideoTranscription, AIJobHandlerFunc(func(context.Context, *models.AIJob) (models.JSONMap, *uuid.UUID, error) {
+			return models.JSONMap{"task": "video"}, nil, nil
+		}))
+		repo.On("Complete", mock.Anything, job.ID, models.JSONMap{"task": "video"}, (*uuid.UUID)(nil), db).Return(nil)
+
+		_, err := worker.RunOnce(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("RetriesTransientFailuresWithBackoff", func(t *testing.T) {
+		job := claimedJob(models.AIServiceCategoryAnalysis, 2)
+		worker, repo := newWorker(t, job)
+		worker.Handle(models.AIServiceCategoryAnalysis, AIJobHandlerFunc(func(context.Context, *models.AIJob) (models.JSONMap, *uuid.UUID, error) {
+			return nil, nil, status.Error(codes.Unavailable, "connection refused")
+		}))
+		var runAt time.Time
+		repo.On("Retry", mock.Anything, job.ID, mock.AnythingOfType("time.Time"), mock.AnythingOfType("models.JSONMap"), db).
+			Run(func(args mock.Arguments) { runAt = args.Get(2).(time.Time) }).
+			Return(nil)
+
+		_, err := worker.RunOnce(ctx)
+		require.NoError(t, err)
+		// The second attempt waits between half and all of twice the base backoff
+		assert.GreaterOrEqual(t, runAt.Sub(now), 10*time.Second)
+		assert.LessOrEqual(t, runAt.Sub(now), 20*time.Second)
+	})
+
+	t.Run("FailsPermanentErrorsWithoutRetry", func(t *testing.T) {
+		job := claimedJob(models.AIServiceCategoryVerification, 1)
+		worker, repo := newWorker(t, job)
+		worker.Handle(models.AIServiceCategoryVerification, AIJobHandlerFunc(func(context.Context, *models.AIJob) (models.JSONMap, *uuid.UUID, error) {
+			return nil, nil, status.Error(codes.InvalidArgument, "text is empty")
+		}))
+		repo.On("Fail", mock.Anything, job.ID, mock.MatchedBy(func(details models.JSONMap) bool {
+			return assert.Contains(t, details["error"], "text is empty")
+		}), db).Return(nil)
+
+		_, err := worker.RunOnce(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("FailsAfterLastAttempt", func(t *testing.T) {
+		job := claimedJob(models.AIServiceCategoryAnalysis, 5)
+		worker, repo := newWorker(t, job)
+		worker.Handle(models.AIServiceCategoryAnalysis, AIJobHandlerFunc(func(context.Context, *models.AIJob) (models.JSONMap, *uuid.UUID, error) {
+			return nil, nil, errors.New("timeout")
+		}))
+		repo.On("Fail", mock.Anything, job.ID, models.JSONMap{"error": "timeout", "attempts": 5}, db).Return(nil)
+
+		_, err := worker.RunOnce(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("FailsJobsWithoutHandlerOrThatPanic", func(t *testing.T) {
+		job := claimedJob(models.AIServiceCategorySegmentation, 1)
+		worker, repo := newWorker(t, job)
+		repo.On("Fail", mock.Anything, job.ID, mock.Anything, db).Return(nil)
+		_, err := worker.RunOnce(ctx)
+		require.NoError(t, err)
+
+		job = claimedJob(models.AIServiceCategoryGeneration, 1)
+		worker, repo = newWorker(t, job)
+		worker.Handle(models.AIServiceCategoryGeneration, AIJobHandlerFunc(func(context.Context, *models.AIJob) (models.JSONMap, *uuid.UUID, error) {
+			panic("nil pointer")
+		}))
+		repo.On("Fail", mock.Anything, job.ID, mock.Anything, db).Return(nil)
+		_, err = worker.RunOnce(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("BackoffIsCapped", func(t *testing.T) {
+		worker, _ := newWorker(t, nil)
+		for i := 0; i < 20; i++ {
+			delay := worker.backoff(30)
+			assert.GreaterOrEqual(t, delay, 30*time.Second)
+			assert.LessOrEqual(t, delay, time.Minute)
+		}
+	})
+}
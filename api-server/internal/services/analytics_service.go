@@ -0,0 +1,197 @@
+package services
+
+//go:generate mockery --name=AnalyticsService --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	analyticsCachePrefix = "analytics:v1:"
+	// analyticsCacheTTL applies while a period still includes today, so new testimonials
+	// show up within minutes; periods in the past change rarely and are kept longer.
+	analyticsCacheTTL     = 5 * time.Minute
+	analyticsPastCacheTTL = time.Hour
+	defaultAnalyticsDays  = 30
+	maxAnalyticsDays      = 5 * 366
+)
+
+type AnalyticsService interface {
+	// WorkspaceAnalytics aggregates the testimonials a workspace collected over a period.
+	// Results are cached briefly, so they can lag a few minutes behind.
+	WorkspaceAnalytics(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, query models.WorkspaceAnalyticsQuery) (*models.WorkspaceAnalytics, error)
+}
+
+type analyticsService struct {
+	repo           repositories.AnalyticsRepository
+	teamMemberRepo repositories.TeamMemberRepository
+	cache          *redis.Client
+	db             *sql.DB
+	now            func() time.Time
+}
+
+func NewAnalyticsService(
+	repo repositories.AnalyticsRepository,
+	teamMemberRepo repositories.TeamMemberRepository,
+	cache *redis.Client,
+	db *sql.DB,
+) AnalyticsService {
+	return &analyticsService{
+		repo:           repo,
+		teamMemberRepo: teamMemberRepo,
+		cache:          cache,
+		db:             db,
+		now:            time.Now,
+	}
+}
+
+func (s *analyticsService) WorkspaceAnalytics(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, query models.WorkspaceAnalyticsQuery) (*models.WorkspaceAnalytics, error) {
+	if err := requireWorkspacePermission(ctx, s.teamMemberRepo, s.db, workspaceID, firebaseUID, models.PermTestimonialsRead); err != nil {
+		return nil, err
+	}
+
+	today := models.GranularityDay.Truncate(s.now())
+	query, err := normalizeAnalyticsQuery(query, today)
+	if err != nil {
+		return nil, err
+	}
+
+	key := analyticsCacheKey(workspaceID, query)
+	if cached := s.cached(ctx, key); cached != nil {
+		return cached, nil
+	}
+
+	analytics, err := s.repo.WorkspaceAnalytics(ctx, workspaceID, query, s.db)
+	if err != nil {
+		return nil, err
+	}
+	analytics.Timeline = fillTimeline(analytics.Timeline, query)
+	analytics.GeneratedAt = s.now().UTC()
+
+	ttl := analyticsCacheTTL
+	if query.To.Before(today) {
+		ttl = analyticsPastCacheTTL
+	}
+	s.store(ctx, key, analytics, ttl)
+	return analytics, nil
+}
+
+// normalizeAnalyticsQuery fills in the defaults: the last 30 days up to today, bucketed
+// by day for up to 90 days, by week for up to two years and by month beyond that.
+func normalizeAnalyticsQuery(query models.WorkspaceAnalyticsQuery, today time.Time) (models.WorkspaceAnalyticsQuery, error) {
+	if query.To.IsZero() {
+		query.To = today
+	}
+	query.To = models.GranularityDay.Truncate(query.To)
+	if query.From.IsZero() {
+		query.From = query.To.AddDate(0, 0, -(defaultAnalyticsDays - 1))
+	}
+	query.From = models.GranularityDay.Truncate(query.From)
+
+	if query.From.After(query.To) {
+		return query, fmt.Errorf("%w: from must not be after to", apperrors.ErrValidationFailed)
+	}
+	days := int(query.To.Sub(query.From).Hours()/24) + 1
+	if days > maxAnalyticsDays {
+		return query, fmt.Errorf("%w: a report can cover at most %d days", apperrors.ErrValidationFailed, maxAnalyticsDays)
+	}
+
+	switch {
+	case query.Granularity != "":
+		if !query.Granularity.IsValid() {
+			return query, fmt.Errorf("%w: granularity must be day, week or month", apperrors.ErrValidationFailed)
+		}
+	case days <= 90:
+		query.Granularity = models.GranularityDay
+	case days <= 2*366:
+		query.Granularity = models.GranularityWeek
+	default:
+		query.Granularity = models.GranularityMonth
+	}
+
+	if buckets := len(timelineStarts(query)); buckets > models.MaxAnalyticsBuckets {
+		return query, fmt.Errorf("%w: %d %s buckets is more than the %d allowed, use a coarser granularity",
+			apperrors.ErrValidationFailed, buckets, query.Granularity, models.MaxAnalyticsBuckets)
+	}
+	return query, nil
+}
+
+func timelineStarts(query models.WorkspaceAnalyticsQuery) []time.Time {
+	var starts []time.Time
+	for start := query.Granularity.Truncate(query.From); !start.After(query.To); start = query.Granularity.Next(start) {
+		starts = append(starts, start)
+		if len(starts) > models.MaxAnalyticsBuckets {
+			break
+		}
+	}
+	return starts
+}
+
+// fillTimeline adds empty buckets for the periods nothing was collected in, so charts
+// have a point for every period.
+func fillTimeline(buckets []models.AnalyticsBucket, query models.WorkspaceAnalyticsQuery) []models.AnalyticsBucket {
+	byStart := make(map[time.Time]models.AnalyticsBucket, len(buckets))
+	for _, b := range buckets {
+		byStart[b.Start.UTC()] = b
+	}
+
+	starts := timelineStarts(query)
+	timeline := make([]models.AnalyticsBucket, 0, len(starts))
+	for _, start := range starts {
+		b, ok := byStart[start]
+		if !ok {
+			b = models.AnalyticsBucket{Start: start, ByStatus: map[models.ContentStatus]int64{}}
+		}
+		timeline = append(timeline, b)
+	}
+	return timeline
+}
+
+func analyticsCacheKey(workspaceID uuid.UUID, query models.WorkspaceAnalyticsQuery) string {
+	return fmt.Sprintf("%s%s:%s:%s:%s", analyticsCachePrefix, workspaceID,
+		query.From.Format(time.DateOnly), query.To.Format(time.DateOnly), query.Granularity)
+}
+
+// cached returns cached analytics. Cache failures are treated as misses.
+func (s *analyticsService) cached(ctx context.Context, key string) *models.WorkspaceAnalytics {
+	if s.cache == nil {
+		return nil
+	}
+	data, err := s.cache.Get(ctx, key).Bytes()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			slog.Warn("failed to read analytics cache", "error", err)
+		}
+		return nil
+	}
+	var analytics models.WorkspaceAnalytics
+	if err := json.Unmarshal(data, &analytics); err != nil {
+		return nil
+	}
+	return &analytics
+}
+
+func (s *analyticsService) store(ctx context.Context, key string, analytics *models.WorkspaceAnalytics, ttl time.Duration) {
+	if s.cache == nil {
+		return
+	}
+	data, err := json.Marshal(analytics)
+	if err != nil {
+		return
+	}
+	if err := s.cache.Set(ctx, key, string(data), ttl).Err(); err != nil {
+		slog.Warn("failed to cache analytics", "error", err)
+	}
+}
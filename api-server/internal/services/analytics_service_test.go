@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsService(t *testing.T) {
+	ctx := context.Background()
+	db, _, _ := sqlmock.New()
+	// A Wednesday
+	now := time.Date(2024, 5, 15, 16, 0, 0, 0, time.UTC)
+	workspaceID := uuid.New()
+	day := func(month time.Month, d int) time.Time { return time.Date(2024, month, d, 0, 0, 0, 0, time.UTC) }
+
+	newService := func(t *testing.T) (*analyticsService, *mocks.AnalyticsRepository, redismock.ClientMock) {
+		repo := mocks.NewAnalyticsRepository(t)
+		members := mocks.NewTeamMemberRepository(t)
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "viewer-uid", db).Return(&models.TeamMember{Role: models.Viewer}, nil).Maybe()
+		cache, cacheMock := redismock.NewClientMock()
+		svc := NewAnalyticsService(repo, members, cache, db).(*analyticsService)
+		svc.now = func() time.Time { return now }
+		return svc, repo, cacheMock
+	}
+
+	t.Run("DefaultsToTheLast30DaysByDay", func(t *testing.T) {
+		svc, repo, cacheMock := newService(t)
+		query := models.WorkspaceAnalyticsQuery{From: day(4, 16), To: day(5, 15), Granularity: models.GranularityDay}
+		key := analyticsCacheKey(workspaceID, query)
+		cacheMock.ExpectGet(key).RedisNil()
+		repo.On("WorkspaceAnalytics", mock.Anything, workspaceID, query, db).Return(&models.WorkspaceAnalytics{
+			Total: 3,
+			Timeline: []models.AnalyticsBucket{{
+				Start:    day(5, 1),
+				Total:    3,
+				ByStatus: map[models.ContentStatus]int64{models.StatusApproved: 2, models.StatusPendingReview: 1},
+			}},
+		}, nil)
+		// Periods that include today are only kept for a few minutes
+		cacheMock.Regexp().ExpectSet(key, `"total":3`, analyticsCacheTTL).SetVal("OK")
+
+		analytics, err := svc.WorkspaceAnalytics(ctx, workspaceID, "viewer-uid", models.WorkspaceAnalyticsQuery{})
+		require.NoError(t, err)
+		// Every day gets a bucket, including those nothing was collected on
+		require.Len(t, analytics.Timeline, 30)
+		assert.Equal(t, day(4, 16), analytics.Timeline[0].Start)
+		assert.Equal(t, int64(3), analytics.Timeline[15].Total)
+		assert.Equal(t, int64(0), analytics.Timeline[16].Total)
+		assert.Equal(t, now, analytics.GeneratedAt)
+		assert.NoError(t, cacheMock.ExpectationsWereMet())
+	})
+
+	t.Run("WeeksStartOnMonday", func(t *testing.T) {
+		svc, repo, cacheMock := newService(t)
+		// From a Wednesday to a Wednesday
+		query := models.WorkspaceAnalyticsQuery{From: day(1, 3), To: day(1, 31), Granularity: models.GranularityWeek}
+		key := analyticsCacheKey(workspaceID, query)
+		cacheMock.ExpectGet(key).RedisNil()
+		repo.On("WorkspaceAnalytics", mock.Anything, workspaceID, query, db).Return(&models.WorkspaceAnalytics{}, nil)
+		// Past periods change rarely
+		cacheMock.Regexp().ExpectSet(key, `.+`, analyticsPastCacheTTL).SetVal("OK")
+
+		analytics, err := svc.WorkspaceAnalytics(ctx, workspaceID, "viewer-uid", query)
+		require.NoError(t, err)
+		var starts []time.Time
+		for _, b := range analytics.Timeline {
+			starts = append(starts, b.Start)
+		}
+		assert.Equal(t, []time.Time{day(1, 1), day(1, 8), day(1, 15), day(1, 22), day(1, 29)}, starts)
+		assert.NoError(t, cacheMock.ExpectationsWereMet())
+	})
+
+	t.Run("CachedResultsAreReused", func(t *testing.T) {
+		svc, _, cacheMock := newService(t)
+		query := models.WorkspaceAnalyticsQuery{From: day(5, 1), To: day(5, 7), Granularity: models.GranularityDay}
+		cached, err := json.Marshal(models.WorkspaceAnalytics{WorkspaceID: workspaceID, Total: 7})
+		require.NoError(t, err)
+		cacheMock.ExpectGet(analyticsCacheKey(workspaceID, query)).SetVal(string(cached))
+
+		analytics, err := svc.WorkspaceAnalytics(ctx, workspaceID, "viewer-uid", query)
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), analytics.Total)
+		assert.NoError(t, cacheMock.ExpectationsWereMet())
+	})
+
+	t.Run("QueriesAreValidated", func(t *testing.T) {
+		svc, _, _ := newService(t)
+
+		for _, query := range []models.WorkspaceAnalyticsQuery{
+			{From: day(5, 10), To: day(5, 1)},
+			{Granularity: "hour"},
+			{From: day(1, 1).AddDate(-6, 0, 0), To: day(1, 1), Granularity: models.GranularityMonth},
+			// Three years of days is too many buckets
+			{From: day(1, 1).AddDate(-3, 0, 0), To: day(1, 1), Granularity: models.GranularityDay},
+		} {
+			_, err := svc.WorkspaceAnalytics(ctx, workspaceID, "viewer-uid", query)
+			assert.ErrorIs(t, err, apperrors.ErrValidationFailed, query)
+		}
+	})
+
+	t.Run("GranularityFollowsThePeriod", func(t *testing.T) {
+		for days, want := range map[int]models.AnalyticsGranularity{
+			7:   models.GranularityDay,
+			90:  models.GranularityDay,
+			180: models.GranularityWeek,
+			900: models.GranularityMonth,
+		} {
+			query, err := normalizeAnalyticsQuery(models.WorkspaceAnalyticsQuery{From: day(5, 15).AddDate(0, 0, -(days - 1))}, day(5, 15))
+			require.NoError(t, err)
+			assert.Equal(t, want, query.Granularity, days)
+		}
+	})
+}
@@ -0,0 +1,192 @@
+package services
+
+//go:generate mockery --name=APIKeyService --output=./mocks --case=underscore
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+)
+
+const (
+	// apiKeyPrefix marks a string as a cenphi API key, which helps secret scanners.
+	apiKeyPrefix = "cph_"
+	// apiKeyDisplayLength is how much of a key is kept in clear so admins can tell keys apart.
+	apiKeyDisplayLength = 12
+	maxAPIKeyRateLimit  = 6000
+)
+
+type APIKeyService interface {
+	Issue(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, req models.APIKeyRequest) (*models.IssuedAPIKey, error)
+	List(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) ([]models.APIKey, error)
+	Revoke(ctx context.Context, workspaceID, keyID uuid.UUID, firebaseUID string) error
+	Rotate(ctx context.Context, workspaceID, keyID uuid.UUID, firebaseUID string) (*models.IssuedAPIKey, error)
+	Authenticate(ctx context.Context, rawKey string) (*models.APIKey, error)
+}
+
+type apiKeyService struct {
+	repo           repositories.APIKeyRepository
+	teamMemberRepo repositories.TeamMemberRepository
+	db             *sql.DB
+}
+
+func NewAPIKeyService(repo repositories.APIKeyRepository, teamMemberRepo repositories.TeamMemberRepository, db *sql.DB) APIKeyService {
+	return &apiKeyService{repo: repo, teamMemberRepo: teamMemberRepo, db: db}
+}
+
+// requireAdmin ensures the caller is an owner or admin of the workspace.
+func (s *apiKeyService) requireAdmin(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) error {
+	return requireWorkspacePermission(ctx, s.teamMemberRepo, s.db, workspaceID, firebaseUID, models.PermAPIKeysManage)
+}
+
+func (s *apiKeyService) Issue(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, req models.APIKeyRequest) (*models.IssuedAPIKey, error) {
+	if err := s.requireAdmin(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", apperrors.ErrValidationFailed)
+	}
+	if req.RateLimitPerMinute == 0 {
+		req.RateLimitPerMinute = models.DefaultAPIKeyRateLimit
+	}
+	if req.RateLimitPerMinute < 1 || req.RateLimitPerMinute > maxAPIKeyRateLimit {
+		return nil, fmt.Errorf("%w: rate_limit_per_minute must be between 1 and %d", apperrors.ErrValidationFailed, maxAPIKeyRateLimit)
+	}
+
+	return s.issue(ctx, workspaceID, firebaseUID, req, s.db)
+}
+
+func (s *apiKeyService) issue(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, req models.APIKeyRequest, db repositories.DB) (*models.IssuedAPIKey, error) {
+	secret, err := generateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+
+	key := models.APIKey{
+		ID:                 uuid.New(),
+		WorkspaceID:        workspaceID,
+		Name:               req.Name,
+		Prefix:             secret[:apiKeyDisplayLength],
+		KeyHash:            hashAPIKey(secret),
+		RateLimitPerMinute: req.RateLimitPerMinute,
+		CreatedBy:          firebaseUID,
+	}
+	if err := s.repo.Create(ctx, &key, db); err != nil {
+		return nil, err
+	}
+
+	return &models.IssuedAPIKey{APIKey: key, Key: secret}, nil
+}
+
+func (s *apiKeyService) List(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) ([]models.APIKey, error) {
+	if err := s.requireAdmin(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetByWorkspace(ctx, workspaceID, s.db)
+}
+
+func (s *apiKeyService) Revoke(ctx context.Context, workspaceID, keyID uuid.UUID, firebaseUID string) error {
+	if err := s.requireAdmin(ctx, workspaceID, firebaseUID); err != nil {
+		return err
+	}
+
+	err := s.repo.Revoke(ctx, workspaceID, keyID, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperrors.ErrNotFound
+	}
+	return err
+}
+
+// Rotate revokes a key and issues a replacement with the same name and limits in a
+// single transaction.
+func (s *apiKeyService) Rotate(ctx context.Context, workspaceID, keyID uuid.UUID, firebaseUID string) (*models.IssuedAPIKey, error) {
+	if err := s.requireAdmin(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+	defer tx.Rollback()
+
+	existing, err := s.repo.Get(ctx, workspaceID, keyID, tx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Revoke(ctx, workspaceID, keyID, tx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	issued, err := s.issue(ctx, workspaceID, firebaseUID, models.APIKeyRequest{
+		Name:               existing.Name,
+		RateLimitPerMinute: existing.RateLimitPerMinute,
+	}, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+	return issued, nil
+}
+
+// Authenticate resolves a plaintext key to the active key record it belongs to.
+func (s *apiKeyService) Authenticate(ctx context.Context, rawKey string) (*models.APIKey, error) {
+	if !strings.HasPrefix(rawKey, apiKeyPrefix) {
+		return nil, apperrors.ErrAPIKeyInvalid
+	}
+
+	key, err := s.repo.GetByHash(ctx, hashAPIKey(rawKey), s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrAPIKeyInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	if key.IsRevoked() {
+		return nil, apperrors.ErrAPIKeyRevoked
+	}
+
+	if err := s.repo.TouchLastUsed(ctx, key.ID, s.db); err != nil {
+		slog.Warn("failed to record api key usage", "key", key.ID, "error", err)
+	}
+	return key, nil
+}
+
+func generateAPIKey() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	return apiKeyPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashAPIKey returns the hex SHA-256 of a key. Keys carry 256 bits of entropy, so a
+// fast unsalted hash is enough to make a leaked table useless.
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
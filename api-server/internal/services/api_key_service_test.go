@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeyService(t *testing.T) {
+	db, _, _ := sqlmock.New()
+	workspaceID := uuid.New()
+
+	t.Run("IssueStoresOnlyTheHash", func(t *testing.T) {
+		repo := &mocks.APIKeyRepository{}
+		members := &mocks.TeamMemberRepository{}
+		svc := NewAPIKeyService(repo, members, db)
+
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "admin-uid", db).Return(&models.TeamMember{Role: models.Admin}, nil)
+		var stored *models.APIKey
+		repo.On("Create", mock.Anything, mock.AnythingOfType("*models.APIKey"), db).
+			Run(func(args mock.Arguments) { stored = args.Get(1).(*models.APIKey) }).
+			Return(nil)
+
+		issued, err := svc.Issue(context.Background(), workspaceID, "admin-uid", models.APIKeyRequest{Name: "Zapier"})
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(issued.Key, apiKeyPrefix))
+		assert.Equal(t, hashAPIKey(issued.Key), stored.KeyHash)
+		assert.NotContains(t, stored.KeyHash, issued.Key)
+		assert.Equal(t, issued.Key[:apiKeyDisplayLength], stored.Prefix)
+		assert.Equal(t, models.DefaultAPIKeyRateLimit, stored.RateLimitPerMinute)
+	})
+
+	t.Run("NonAdminsCannotManageKeys", func(t *testing.T) {
+		repo := &mocks.APIKeyRepository{}
+		members := &mocks.TeamMemberRepository{}
+		svc := NewAPIKeyService(repo, members, db)
+
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "viewer-uid", db).Return(&models.TeamMember{Role: models.Viewer}, nil)
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "stranger-uid", db).Return(nil, sql.ErrNoRows)
+
+		_, err := svc.Issue(context.Background(), workspaceID, "viewer-uid", models.APIKeyRequest{Name: "x"})
+		assert.ErrorIs(t, err, apperrors.ErrWorkspaceAccessDenied)
+
+		err = svc.Revoke(context.Background(), workspaceID, uuid.New(), "stranger-uid")
+		assert.ErrorIs(t, err, apperrors.ErrWorkspaceAccessDenied)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("MemberPermissionsOverrideRole", func(t *testing.T) {
+		repo := &mocks.APIKeyRepository{}
+		members := &mocks.TeamMemberRepository{}
+		svc := NewAPIKeyService(repo, members, db)
+
+		editor := &models.TeamMember{Role: models.Editor, Permissions: map[string]any{"api_keys:manage": true}}
+		admin := &models.TeamMember{Role: models.Admin, Permissions: map[string]any{"api_keys:manage": false}}
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "editor-uid", db).Return(editor, nil)
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "admin-uid", db).Return(admin, nil)
+		repo.On("Create", mock.Anything, mock.AnythingOfType("*models.APIKey"), db).Return(nil)
+
+		_, err := svc.Issue(context.Background(), workspaceID, "editor-uid", models.APIKeyRequest{Name: "CI"})
+		assert.NoError(t, err)
+
+		_, err = svc.Issue(context.Background(), workspaceID, "admin-uid", models.APIKeyRequest{Name: "CI"})
+		assert.ErrorIs(t, err, apperrors.ErrWorkspaceAccessDenied)
+		repo.AssertNumberOfCalls(t, "Create", 1)
+	})
+
+	t.Run("Authenticate", func(t *testing.T) {
+		repo := &mocks.APIKeyRepository{}
+		svc := NewAPIKeyService(repo, &mocks.TeamMemberRepository{}, db)
+
+		active := &models.APIKey{ID: uuid.New(), WorkspaceID: workspaceID}
+		revokedAt := time.Now()
+		revoked := &models.APIKey{ID: uuid.New(), WorkspaceID: workspaceID, RevokedAt: &revokedAt}
+
+		repo.On("GetByHash", mock.Anything, hashAPIKey("cph_active"), db).Return(active, nil)
+		repo.On("GetByHash", mock.Anything, hashAPIKey("cph_revoked"), db).Return(revoked, nil)
+		repo.On("GetByHash", mock.Anything, hashAPIKey("cph_unknown"), db).Return(nil, sql.ErrNoRows)
+		repo.On("TouchLastUsed", mock.Anything, active.ID, db).Return(nil)
+
+		key, err := svc.Authenticate(context.Background(), "cph_active")
+		require.NoError(t, err)
+		assert.Equal(t, active.ID, key.ID)
+
+		_, err = svc.Authenticate(context.Background(), "cph_revoked")
+		assert.ErrorIs(t, err, apperrors.ErrAPIKeyRevoked)
+
+		_, err = svc.Authenticate(context.Background(), "cph_unknown")
+		assert.ErrorIs(t, err, apperrors.ErrAPIKeyInvalid)
+
+		_, err = svc.Authenticate(context.Background(), "not-a-key")
+		assert.ErrorIs(t, err, apperrors.ErrAPIKeyInvalid)
+	})
+}
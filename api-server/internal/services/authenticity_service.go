@@ -0,0 +1,245 @@
+package services
+
+//go:generate mockery --name=AuthenticityService --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pb"
+)
+
+const (
+	// defaultAuthenticityThreshold applies to workspaces without an
+	// authenticity_threshold setting.
+	defaultAuthenticityThreshold = 0.5
+	// verificationJobPriority runs authenticity checks ahead of other AI jobs, so
+	// suspicious testimonials are held before anyone reviews them.
+	verificationJobPriority   = 2
+	authenticityBurstWindow   = time.Hour
+	authenticityBurstLimit    = 3
+	authenticityNewAccountAge = 30 * 24 * time.Hour
+	authenticityVersion       = "detect_fake_testimonial/v1"
+)
+
+// Heuristic risk factors and how much each lowers the authenticity score.
+const (
+	riskDuplicateText    = "duplicate_text"
+	riskBurstSubmissions = "burst_submissions"
+	riskNewAccount       = "new_reviewer_account"
+)
+
+var authenticityPenalties = map[string]float64{
+	riskDuplicateText:    0.4,
+	riskBurstSubmissions: 0.3,
+	riskNewAccount:       0.2,
+}
+
+type AuthenticityService interface {
+	// Schedule queues an authenticity check for each testimonial.
+	Schedule(ctx context.Context, testimonialIDs ...uuid.UUID) error
+	// Handle scores a testimonial with DetectFakeTestimonial and local heuristics, stores
+	// the result as an authenticity analysis and records a hold reason when it scores
+	// below the workspace threshold while still pending review. It never changes the
+	// status a moderator chose. It runs verification jobs for the AI job worker.
+	Handle(ctx context.Context, job *models.AIJob) (models.JSONMap, *uuid.UUID, error)
+}
+
+type authenticityService struct {
+	jobs            AIJobService
+	testimonialRepo repositories.TestimonialRepository
+	analysisRepo    repositories.AnalysisRepository
+	client          *pb.IntelligenceClient
+	db              *sql.DB
+	now             func() time.Time
+}
+
+func NewAuthenticityService(
+	jobs AIJobService,
+	testimonialRepo repositories.TestimonialRepository,
+	analysisRepo repositories.AnalysisRepository,
+	client *pb.IntelligenceClient,
+	db *sql.DB,
+) AuthenticityService {
+	return &authenticityService{
+		jobs:            jobs,
+		testimonialRepo: testimonialRepo,
+		analysisRepo:    analysisRepo,
+		client:          client,
+		db:              db,
+		now:             time.Now,
+	}
+}
+
+func (s *authenticityService) Schedule(ctx context.Context, testimonialIDs ...uuid.UUID) error {
+	var errs []error
+	for _, id := range testimonialIDs {
+		err := s.jobs.Enqueue(ctx, &models.AIJob{
+			TestimonialID: id,
+			JobType:       models.AIServiceCategoryVerification,
+			Priority:      verificationJobPriority,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("testimonial %s: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *authenticityService) Handle(ctx context.Context, job *models.AIJob) (models.JSONMap, *uuid.UUID, error) {
+	signals, err := s.testimonialRepo.GetAuthenticitySignals(ctx, job.TestimonialID, authenticityBurstWindow, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, permanentJobFailure(fmt.Errorf("%w: testimonial %s", apperrors.ErrNotFound, job.TestimonialID))
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	verdict, err := s.detect(ctx, signals)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Heuristics lower the score the model gave
+	risks := s.heuristicRisks(signals)
+	score := verdict.GetAuthenticityScore()
+	for _, risk := range risks {
+		score -= float32(authenticityPenalties[risk])
+	}
+	score = max(0, min(1, score))
+
+	threshold := defaultAuthenticityThreshold
+	if signals.Threshold != nil {
+		threshold = *signals.Threshold
+	}
+	riskFactors := append(risks, verdict.GetRiskFactors()...)
+	var holdReason string
+	if float64(score) < threshold {
+		holdReason = authenticityHoldReason(score, threshold, riskFactors)
+	}
+
+	analysis := &models.TestimonialAnalysis{
+		TestimonialID:     job.TestimonialID,
+		AnalysisType:      models.AnalysisTypeAuthenticity,
+		AuthenticityScore: &score,
+		AnalysisData: models.JSONMap{
+			"model_score":         verdict.GetAuthenticityScore(),
+			"feature_scores":      verdict.GetFeatureScores(),
+			"risk_factors":        riskFactors,
+			"duplicate_count":     signals.DuplicateCount,
+			"submissions_from_ip": signals.SubmissionsFromIP,
+			"threshold":           threshold,
+			"held":                holdReason != "",
+		},
+		ExtractedInsights: models.JSONArray{},
+		AnalysisVersion:   authenticityVersion,
+	}
+	for _, risk := range riskFactors {
+		analysis.ExtractedInsights = append(analysis.ExtractedInsights, risk)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+	defer tx.Rollback()
+
+	if err := s.analysisRepo.Upsert(ctx, analysis, tx); err != nil {
+		return nil, nil, err
+	}
+	if err := s.testimonialRepo.ApplyAuthenticity(ctx, job.TestimonialID, score, holdReason, tx); err != nil {
+		return nil, nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+
+	if holdReason != "" {
+		slog.Info("testimonial held for review", "testimonial", job.TestimonialID, "score", score, "reason", holdReason)
+	}
+
+	output := models.JSONMap{
+		"analysis_id":        analysis.ID.String(),
+		"authenticity_score": score,
+		"threshold":          threshold,
+		"held":               holdReason != "",
+	}
+	return output, &analysis.ID, nil
+}
+
+// detect asks the intelligence service for its verdict. Without a configured service, or
+// without text to judge, the heuristics decide alone.
+func (s *authenticityService) detect(ctx context.Context, signals *models.AuthenticitySignals) (*pb.DetectFakeTestimonialResponse, error) {
+	if s.client == nil || *s.client == nil || signals.Text == "" {
+		return &pb.DetectFakeTestimonialResponse{AuthenticityScore: 1}, nil
+	}
+
+	userData := &pb.UserMetadata{}
+	if signals.CustomerProfileID != nil {
+		userData.UserId = signals.CustomerProfileID.String()
+	}
+	if age, ok := s.accountAge(signals); ok {
+		userData.AccountAge = fmt.Sprintf("%d days", int(age.Hours()/24))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, intelligenceRPCTimeout)
+	defer cancel()
+
+	verdict, err := (*s.client).DetectFakeTestimonial(ctx, &pb.DetectFakeTestimonialRequest{
+		Text:                 signals.Text,
+		UserData:             userData,
+		PreviousTestimonials: signals.PreviousTestimonials,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fake testimonial detection failed: %w", err)
+	}
+	return verdict, nil
+}
+
+func (s *authenticityService) heuristicRisks(signals *models.AuthenticitySignals) []string {
+	var risks []string
+	if signals.DuplicateCount > 0 {
+		risks = append(risks, riskDuplicateText)
+	}
+	if signals.SubmissionsFromIP >= authenticityBurstLimit {
+		risks = append(risks, riskBurstSubmissions)
+	}
+	if age, ok := s.accountAge(signals); ok && age < authenticityNewAccountAge {
+		risks = append(risks, riskNewAccount)
+	}
+	return risks
+}
+
+// accountAge is the age of the reviewer's account on the platform the testimonial was
+// imported from, when the provider reports it.
+func (s *authenticityService) accountAge(signals *models.AuthenticitySignals) (time.Duration, bool) {
+	createdAt, _ := signals.SourceData[models.SourceDataAccountCreatedAt].(string)
+	if createdAt == "" {
+		return 0, false
+	}
+	t, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return 0, false
+	}
+	return s.now().Sub(t), true
+}
+
+func authenticityHoldReason(score float32, threshold float64, riskFactors []string) string {
+	reason := fmt.Sprintf("authenticity score %.2f is below the workspace threshold of %.2f", score, threshold)
+	if len(riskFactors) == 0 {
+		return reason
+	}
+	factors := append([]string(nil), riskFactors...)
+	sort.Strings(factors)
+	return reason + ": " + strings.Join(factors, ", ")
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/ifeanyidike/cenphi/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestAuthenticityService(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	testimonialID := uuid.New()
+	customerID := uuid.New()
+	job := &models.AIJob{ID: uuid.New(), TestimonialID: testimonialID, JobType: models.AIServiceCategoryVerification}
+
+	newService := func(t *testing.T, client pb.IntelligenceClient, signals *models.AuthenticitySignals) (*authenticityService, *mocks.TestimonialRepository, *mocks.AnalysisRepository, sqlmock.Sqlmock) {
+		db, sqlMock, err := sqlmock.New()
+		require.NoError(t, err)
+		testimonialRepo := mocks.NewTestimonialRepository(t)
+		analysisRepo := mocks.NewAnalysisRepository(t)
+		testimonialRepo.On("GetAuthenticitySignals", mock.Anything, testimonialID, authenticityBurstWindow, db).Return(signals, nil)
+
+		var clientRef *pb.IntelligenceClient
+		if client != nil {
+			clientRef = &client
+		}
+		svc := NewAuthenticityService(nil, testimonialRepo, analysisRepo, clientRef, db).(*authenticityService)
+		svc.now = func() time.Time { return now }
+		return svc, testimonialRepo, analysisRepo, sqlMock
+	}
+	captureAnalysis := func(analysisRepo *mocks.AnalysisRepository) **models.TestimonialAnalysis {
+		var saved *models.TestimonialAnalysis
+		analysisRepo.On("Upsert", mock.Anything, mock.AnythingOfType("*models.TestimonialAnalysis"), mock.Anything).
+			Run(func(args mock.Arguments) {
+				saved = args.Get(1).(*models.TestimonialAnalysis)
+				saved.ID = uuid.New()
+			}).
+			Return(nil)
+		return &saved
+	}
+
+	t.Run("HoldsTestimonialBelowThreshold", func(t *testing.T) {
+		threshold := 0.6
+		signals := &models.AuthenticitySignals{
+			Text:                 "Best product ever, buy it now!",
+			CustomerProfileID:    &customerID,
+			SourceData:           models.JSONMap{models.SourceDataAccountCreatedAt: now.Add(-72 * time.Hour).Format(time.RFC3339)},
+			Threshold:            &threshold,
+			DuplicateCount:       2,
+			PreviousTestimonials: []string{"Best product ever!"},
+		}
+		client := &fakeAuthenticityClient{response: &pb.DetectFakeTestimonialResponse{
+			AuthenticityScore: 0.9,
+			RiskFactors:       []string{"generic_praise"},
+		}}
+		svc, testimonialRepo, analysisRepo, sqlMock := newService(t, client, signals)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+		saved := captureAnalysis(analysisRepo)
+
+		var holdReason string
+		testimonialRepo.On("ApplyAuthenticity", mock.Anything, testimonialID, mock.AnythingOfType("float32"), mock.AnythingOfType("string"), mock.Anything).
+			Run(func(args mock.Arguments) { holdReason = args.String(3) }).
+			Return(nil)
+
+		output, ref, err := svc.Handle(ctx, job)
+		require.NoError(t, err)
+
+		// 0.9 from the model, less 0.4 for duplicate text and 0.2 for a three day old account
+		assert.InDelta(t, 0.3, *(*saved).AuthenticityScore, 0.0001)
+		assert.Equal(t, models.AnalysisTypeAuthenticity, (*saved).AnalysisType)
+		assert.Equal(t, models.JSONArray{riskDuplicateText, riskNewAccount, "generic_praise"}, (*saved).ExtractedInsights)
+		assert.Equal(t, "authenticity score 0.30 is below the workspace threshold of 0.60: duplicate_text, generic_praise, new_reviewer_account", holdReason)
+		assert.Equal(t, true, output["held"])
+		assert.Equal(t, &(*saved).ID, ref)
+
+		assert.Equal(t, customerID.String(), client.request.GetUserData().GetUserId())
+		assert.Equal(t, "3 days", client.request.GetUserData().GetAccountAge())
+		assert.Equal(t, signals.PreviousTestimonials, client.request.GetPreviousTestimonials())
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("PassesAboveDefaultThreshold", func(t *testing.T) {
+		signals := &models.AuthenticitySignals{Text: "Support fixed our billing issue the same day.", SubmissionsFromIP: 1}
+		client := &fakeAuthenticityClient{response: &pb.DetectFakeTestimonialResponse{AuthenticityScore: 0.85}}
+		svc, testimonialRepo, analysisRepo, sqlMock := newService(t, client, signals)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+		captureAnalysis(analysisRepo)
+		testimonialRepo.On("ApplyAuthenticity", mock.Anything, testimonialID, float32(0.85), "", mock.Anything).Return(nil)
+
+		output, _, err := svc.Handle(ctx, job)
+		require.NoError(t, err)
+		assert.Equal(t, false, output["held"])
+		assert.Equal(t, defaultAuthenticityThreshold, output["threshold"])
+	})
+
+	t.Run("UsesHeuristicsWithoutIntelligenceService", func(t *testing.T) {
+		signals := &models.AuthenticitySignals{Text: "Great!", SubmissionsFromIP: authenticityBurstLimit, DuplicateCount: 1}
+		svc, testimonialRepo, analysisRepo, sqlMock := newService(t, nil, signals)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+		captureAnalysis(analysisRepo)
+		testimonialRepo.On("ApplyAuthenticity", mock.Anything, testimonialID, mock.MatchedBy(func(score float32) bool {
+			return assert.InDelta(t, 0.3, score, 0.0001)
+		}), "authenticity score 0.30 is below the workspace threshold of 0.50: burst_submissions, duplicate_text", mock.Anything).Return(nil)
+
+		_, _, err := svc.Handle(ctx, job)
+		require.NoError(t, err)
+	})
+
+	t.Run("FailsPermanentlyForMissingTestimonial", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		require.NoError(t, err)
+		testimonialRepo := mocks.NewTestimonialRepository(t)
+		testimonialRepo.On("GetAuthenticitySignals", mock.Anything, testimonialID, authenticityBurstWindow, db).Return(nil, sql.ErrNoRows)
+
+		svc := NewAuthenticityService(nil, testimonialRepo, mocks.NewAnalysisRepository(t), nil, db)
+		_, _, err = svc.Handle(ctx, job)
+		assert.True(t, isPermanentJobFailure(err))
+	})
+
+	t.Run("ScheduleQueuesVerificationJobs", func(t *testing.T) {
+		db, sqlMock, err := sqlmock.New()
+		require.NoError(t, err)
+		for range 2 {
+			sqlMock.ExpectBegin()
+			sqlMock.ExpectCommit()
+		}
+
+		jobRepo := mocks.NewAIJobRepository(t)
+		var queued []*models.AIJob
+		jobRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.AIJob"), mock.Anything).
+			Run(func(args mock.Arguments) { queued = append(queued, args.Get(1).(*models.AIJob)) }).
+			Return(nil)
+		jobs := NewAIJobService(jobRepo, mocks.NewTestimonialRepository(t), mocks.NewTeamMemberRepository(t), nil, db)
+
+		svc := NewAuthenticityService(jobs, mocks.NewTestimonialRepository(t), mocks.NewAnalysisRepository(t), nil, db)
+		require.NoError(t, svc.Schedule(ctx, testimonialID, customerID))
+		require.Len(t, queued, 2)
+		assert.Equal(t, models.AIServiceCategoryVerification, queued[0].JobType)
+		assert.Equal(t, verificationJobPriority, queued[0].Priority)
+		assert.Equal(t, customerID, queued[1].TestimonialID)
+	})
+}
+
+type fakeAuthenticityClient struct {
+	pb.IntelligenceClient
+	request  *pb.DetectFakeTestimonialRequest
+	response *pb.DetectFakeTestimonialResponse
+	err      error
+}
+
+func (c *fakeAuthenticityClient) DetectFakeTestimonial(ctx context.Context, in *pb.DetectFakeTestimonialRequest, opts ...grpc.CallOption) (*pb.DetectFakeTestimonialResponse, error) {
+	c.request = in
+	return c.response, c.err
+}
@@ -0,0 +1,20 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ifeanyidike/cenphi/pkg/events"
+)
+
+// broadcast publishes an event about a change that has already been stored. The change
+// stands whether or not anyone hears about it, so a failure is only logged. A nil
+// publisher drops the event.
+func broadcast(ctx context.Context, publisher events.Publisher, event events.Event) {
+	if publisher == nil {
+		return
+	}
+	if err := publisher.Publish(ctx, event); err != nil {
+		slog.Warn("failed to broadcast event", "type", event.Type, "subject", event.SubjectID, "error", err)
+	}
+}
@@ -0,0 +1,303 @@
+package services
+
+//go:generate mockery --name=CollectionPortalService --output=./mocks --case=underscore
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pkg/formtoken"
+)
+
+const (
+	// portalMinFillTime is the shortest time a person could plausibly take to fill in a
+	// portal form. Faster submissions are treated as bots.
+	portalMinFillTime = 3 * time.Second
+	// portalFormTokenTTL is how long a served form can be submitted.
+	portalFormTokenTTL  = 24 * time.Hour
+	maxPortalSlugLength = 64
+)
+
+var (
+	portalSlugPattern   = regexp.MustCompile(`^[a-z0-9](?:[a-z0-9-]*[a-z0-9])?$`)
+	nonSlugCharsPattern = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// FormTokenGuard makes portal form tokens single-use. *formtoken.ReplayGuard implements it.
+type FormTokenGuard interface {
+	Use(ctx context.Context, token string, ttl time.Duration) error
+}
+
+type CollectionPortalService interface {
+	Create(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, req models.CollectionPortalRequest) (*models.CollectionPortal, error)
+	List(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) ([]models.CollectionPortal, error)
+	Get(ctx context.Context, workspaceID, portalID uuid.UUID, firebaseUID string) (*models.CollectionPortal, error)
+	Update(ctx context.Context, workspaceID, portalID uuid.UUID, firebaseUID string, req models.CollectionPortalRequest) (*models.CollectionPortal, error)
+	Delete(ctx context.Context, workspaceID, portalID uuid.UUID, firebaseUID string) error
+	GetPublic(ctx context.Context, slug string) (*models.PublicPortal, error)
+	SubmitPublic(ctx context.Context, slug string, submission models.PortalSubmission) (*models.Testimonial, error)
+}
+
+type collectionPortalService struct {
+	repo               repositories.CollectionPortalRepository
+	teamMemberRepo     repositories.TeamMemberRepository
+	testimonialService TestimonialService
+	signer             *formtoken.Signer
+	tokenGuard         FormTokenGuard
+	db                 *sql.DB
+}
+
+func NewCollectionPortalService(
+	repo repositories.CollectionPortalRepository,
+	teamMemberRepo repositories.TeamMemberRepository,
+	testimonialService TestimonialService,
+	signer *formtoken.Signer,
+	tokenGuard FormTokenGuard,
+	db *sql.DB,
+) CollectionPortalService {
+	return &collectionPortalService{
+		repo:               repo,
+		teamMemberRepo:     teamMemberRepo,
+		testimonialService: testimonialService,
+		signer:             signer,
+		tokenGuard:         tokenGuard,
+		db:                 db,
+	}
+}
+
+// requireEditor ensures the caller may manage the workspace's portals.
+func (s *collectionPortalService) requireEditor(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) error {
+	return requireWorkspacePermission(ctx, s.teamMemberRepo, s.db, workspaceID, firebaseUID, models.PermPortalsManage)
+}
+
+func (s *collectionPortalService) Create(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, req models.CollectionPortalRequest) (*models.CollectionPortal, error) {
+	if err := s.requireEditor(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+
+	portal := &models.CollectionPortal{
+		ID:          uuid.New(),
+		WorkspaceID: workspaceID,
+		Active:      true,
+	}
+	if err := applyPortalRequest(portal, req); err != nil {
+		return nil, err
+	}
+	if portal.Slug == "" {
+		slug, err := generatePortalSlug(portal.Name)
+		if err != nil {
+			return nil, err
+		}
+		portal.Slug = slug
+	}
+	if len(portal.CollectionMethods) == 0 {
+		portal.CollectionMethods = []models.CollectionMethod{models.CollectionMethodDirectLink}
+	}
+
+	if err := s.repo.Create(ctx, portal, s.db); err != nil {
+		if errors.Is(err, apperrors.ErrDuplicateEntry) {
+			return nil, apperrors.ErrPortalSlugTaken
+		}
+		return nil, err
+	}
+	return portal, nil
+}
+
+func (s *collectionPortalService) List(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) ([]models.CollectionPortal, error) {
+	if err := s.requireEditor(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetByWorkspace(ctx, workspaceID, s.db)
+}
+
+func (s *collectionPortalService) Get(ctx context.Context, workspaceID, portalID uuid.UUID, firebaseUID string) (*models.CollectionPortal, error) {
+	if err := s.requireEditor(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+
+	portal, err := s.repo.Get(ctx, workspaceID, portalID, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrNotFound
+	}
+	return portal, err
+}
+
+func (s *collectionPortalService) Update(ctx context.Context, workspaceID, portalID uuid.UUID, firebaseUID string, req models.CollectionPortalRequest) (*models.CollectionPortal, error) {
+	portal, err := s.Get(ctx, workspaceID, portalID, firebaseUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// An empty slug keeps the existing public link
+	if err := applyPortalRequest(portal, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, portal, s.db); err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, apperrors.ErrNotFound
+		case errors.Is(err, apperrors.ErrDuplicateEntry):
+			return nil, apperrors.ErrPortalSlugTaken
+		}
+		return nil, err
+	}
+	return portal, nil
+}
+
+func (s *collectionPortalService) Delete(ctx context.Context, workspaceID, portalID uuid.UUID, firebaseUID string) error {
+	if err := s.requireEditor(ctx, workspaceID, firebaseUID); err != nil {
+		return err
+	}
+
+	err := s.repo.Delete(ctx, workspaceID, portalID, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperrors.ErrNotFound
+	}
+	return err
+}
+
+// GetPublic returns the form definition of an active portal along with a fresh form
+// token.
+func (s *collectionPortalService) GetPublic(ctx context.Context, slug string) (*models.PublicPortal, error) {
+	portal, err := s.activePortal(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.PublicPortal{
+		Name:                portal.Name,
+		Slug:                portal.Slug,
+		BrandingSettings:    portal.BrandingSettings,
+		Form:                portal.FormSettings,
+		VerificationMethods: portal.VerificationMethods,
+		FormToken:           s.signer.Issue(portal.ID.String()),
+	}, nil
+}
+
+// SubmitPublic stores a testimonial submitted through a portal once it has passed the
+// spam checks and the portal's form rules.
+func (s *collectionPortalService) SubmitPublic(ctx context.Context, slug string, submission models.PortalSubmission) (*models.Testimonial, error) {
+	if submission.Website != "" {
+		return nil, apperrors.ErrSpamDetected
+	}
+
+	portal, err := s.activePortal(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.signer.Verify(submission.FormToken, portal.ID.String(), portalMinFillTime, portalFormTokenTTL); err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrSpamDetected, err)
+	}
+
+	form := portal.FormSettings
+	testimonial := submission.TestimonialSubmission
+	if testimonial.Format == "" {
+		testimonial.Format = models.ContentFormatText
+	}
+	if !form.AllowsFormat(testimonial.Format) {
+		return nil, fmt.Errorf("%w: %s testimonials are not accepted by this portal", apperrors.ErrValidationFailed, testimonial.Format)
+	}
+	if form.RequireEmail && (testimonial.Customer == nil || testimonial.Customer.Email == "") {
+		return nil, fmt.Errorf("%w: customer email is required", apperrors.ErrValidationFailed)
+	}
+	if err := form.ValidateAnswers(testimonial.CustomFields); err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrValidationFailed, err)
+	}
+
+	// Tags and categories are curated by the workspace, not by submitters
+	testimonial.Tags = nil
+	testimonial.Categories = nil
+
+	// Spend the token last, so that a submission rejected for invalid answers can be
+	// corrected and sent again from the same form
+	if err := s.tokenGuard.Use(ctx, submission.FormToken, portalFormTokenTTL); err != nil {
+		if errors.Is(err, formtoken.ErrReused) {
+			return nil, fmt.Errorf("%w: %v", apperrors.ErrSpamDetected, err)
+		}
+		return nil, err
+	}
+
+	return s.testimonialService.Submit(ctx, portal.WorkspaceID, models.CollectionMethodDirectLink, testimonial)
+}
+
+func (s *collectionPortalService) activePortal(ctx context.Context, slug string) (*models.CollectionPortal, error) {
+	portal, err := s.repo.GetBySlug(ctx, strings.ToLower(slug), s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !portal.Active {
+		return nil, apperrors.ErrNotFound
+	}
+	return portal, nil
+}
+
+// applyPortalRequest validates req and copies it onto portal.
+func applyPortalRequest(portal *models.CollectionPortal, req models.CollectionPortalRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return fmt.Errorf("%w: name is required", apperrors.ErrValidationFailed)
+	}
+	if req.Slug != "" {
+		slug := strings.ToLower(req.Slug)
+		if len(slug) > maxPortalSlugLength || !portalSlugPattern.MatchString(slug) {
+			return fmt.Errorf("%w: slug may only contain lowercase letters, digits and dashes", apperrors.ErrValidationFailed)
+		}
+		portal.Slug = slug
+	}
+	if err := req.FormSettings.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", apperrors.ErrValidationFailed, err)
+	}
+
+	portal.Name = name
+	portal.CollectionMethods = req.CollectionMethods
+	portal.VerificationMethods = req.VerificationMethods
+	portal.CustomFields = jsonMapOrEmpty(req.CustomFields)
+	portal.BrandingSettings = jsonMapOrEmpty(req.BrandingSettings)
+	portal.FormSettings = req.FormSettings
+	portal.NotificationSettings = jsonMapOrEmpty(req.NotificationSettings)
+	if req.Active != nil {
+		portal.Active = *req.Active
+	}
+	return nil
+}
+
+func jsonMapOrEmpty(m models.JSONMap) models.JSONMap {
+	if m == nil {
+		return models.JSONMap{}
+	}
+	return m
+}
+
+// generatePortalSlug derives a slug from the portal name with a random suffix so that
+// portals with the same name do not collide.
+func generatePortalSlug(name string) (string, error) {
+	base := strings.Trim(nonSlugCharsPattern.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if len(base) > maxPortalSlugLength-7 {
+		base = strings.TrimRight(base[:maxPortalSlugLength-7], "-")
+	}
+	if base == "" {
+		base = "portal"
+	}
+
+	suffix := make([]byte, 3)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("failed to generate portal slug: %w", err)
+	}
+	return base + "-" + hex.EncodeToString(suffix), nil
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/ifeanyidike/cenphi/pkg/formtoken"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTokenGuard remembers used tokens in memory, like formtoken.ReplayGuard does in Redis.
+type fakeTokenGuard struct {
+	used map[string]bool
+}
+
+func (g *fakeTokenGuard) Use(_ context.Context, token string, _ time.Duration) error {
+	if g.used[token] {
+		return formtoken.ErrReused
+	}
+	g.used[token] = true
+	return nil
+}
+
+func TestCollectionPortalService_SubmitPublic(t *testing.T) {
+	db, sqlMock, _ := sqlmock.New()
+	signer, err := formtoken.NewSigner([]byte("test-secret"))
+	require.NoError(t, err)
+
+	portal := &models.CollectionPortal{
+		ID:          uuid.New(),
+		WorkspaceID: uuid.New(),
+		Slug:        "acme-feedback",
+		Active:      true,
+		FormSettings: models.PortalFormSettings{
+			AllowedFormats: []models.ContentFormat{models.ContentFormatText},
+			Fields: []models.PortalField{
+				{Key: "plan", Type: models.PortalFieldSelect, Required: true, Options: []string{"starter", "pro"}},
+				{Key: "would_recommend", Type: models.PortalFieldCheckbox},
+			},
+		},
+	}
+
+	newService := func() (CollectionPortalService, *mocks.TestimonialRepository) {
+		portalRepo := &mocks.CollectionPortalRepository{}
+		portalRepo.On("GetBySlug", mock.Anything, "acme-feedback", db).Return(portal, nil)
+		testimonialRepo := &mocks.TestimonialRepository{}
+		testimonialService := NewTestimonialService(testimonialRepo, nil, nil, nil, nil, db)
+		return NewCollectionPortalService(portalRepo, &mocks.TeamMemberRepository{}, testimonialService, signer, &fakeTokenGuard{used: map[string]bool{}}, db), testimonialRepo
+	}
+	submission := func(fields models.JSONMap) models.PortalSubmission {
+		return models.PortalSubmission{
+			TestimonialSubmission: models.TestimonialSubmission{Content: "Great product", CustomFields: fields},
+			FormToken:             signer.IssueAt(portal.ID.String(), time.Now().Add(-time.Minute)),
+		}
+	}
+
+	t.Run("StoresValidSubmissionAsDirectLink", func(t *testing.T) {
+		svc, testimonialRepo := newService()
+		testimonialRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Testimonial"), mock.Anything).Return(nil)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		testimonial, err := svc.SubmitPublic(context.Background(), "acme-feedback", submission(models.JSONMap{"plan": "pro", "would_recommend": true}))
+		require.NoError(t, err)
+		assert.Equal(t, portal.WorkspaceID, testimonial.WorkspaceID)
+		assert.Equal(t, models.CollectionMethodDirectLink, testimonial.CollectionMethod)
+		assert.Equal(t, models.StatusPendingReview, testimonial.Status)
+		testimonialRepo.AssertExpectations(t)
+	})
+
+	t.Run("RejectsInvalidCustomFields", func(t *testing.T) {
+		svc, testimonialRepo := newService()
+
+		for _, fields := range []models.JSONMap{
+			{},
+			{"plan": "enterprise"},
+			{"plan": "pro", "would_recommend": "yes"},
+			{"plan": "pro", "nickname": "bob"},
+		} {
+			_, err := svc.SubmitPublic(context.Background(), "acme-feedback", submission(fields))
+			assert.ErrorIs(t, err, apperrors.ErrValidationFailed, "fields: %v", fields)
+		}
+		testimonialRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("RejectsSpam", func(t *testing.T) {
+		svc, testimonialRepo := newService()
+
+		honeypot := submission(models.JSONMap{"plan": "pro"})
+		honeypot.Website = "http://spam.example"
+		_, err := svc.SubmitPublic(context.Background(), "acme-feedback", honeypot)
+		assert.ErrorIs(t, err, apperrors.ErrSpamDetected)
+
+		tooFast := submission(models.JSONMap{"plan": "pro"})
+		tooFast.FormToken = signer.Issue(portal.ID.String())
+		_, err = svc.SubmitPublic(context.Background(), "acme-feedback", tooFast)
+		assert.ErrorIs(t, err, apperrors.ErrSpamDetected)
+
+		missingToken := submission(models.JSONMap{"plan": "pro"})
+		missingToken.FormToken = ""
+		_, err = svc.SubmitPublic(context.Background(), "acme-feedback", missingToken)
+		assert.ErrorIs(t, err, apperrors.ErrSpamDetected)
+
+		testimonialRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("RejectsReusedToken", func(t *testing.T) {
+		svc, testimonialRepo := newService()
+		testimonialRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Testimonial"), mock.Anything).Return(nil)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		// A submission with invalid answers does not spend the token
+		invalid := submission(models.JSONMap{"plan": "enterprise"})
+		_, err := svc.SubmitPublic(context.Background(), "acme-feedback", invalid)
+		assert.ErrorIs(t, err, apperrors.ErrValidationFailed)
+
+		corrected := invalid
+		corrected.CustomFields = models.JSONMap{"plan": "pro"}
+		_, err = svc.SubmitPublic(context.Background(), "acme-feedback", corrected)
+		require.NoError(t, err)
+
+		_, err = svc.SubmitPublic(context.Background(), "acme-feedback", corrected)
+		assert.ErrorIs(t, err, apperrors.ErrSpamDetected)
+		testimonialRepo.AssertNumberOfCalls(t, "Create", 1)
+	})
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/pb"
+)
+
+const (
+	// embedGRPCTimeout bounds a call to the intelligence service before falling back to
+	// OpenAI.
+	embedGRPCTimeout = 10 * time.Second
+	openAIEmbedURL   = "https://api.openai.com/v1/embeddings"
+	openAIEmbedModel = "text-embedding-3-small"
+)
+
+// embedder turns texts into vectors for the semantic index. It asks the intelligence
+// service and falls back to OpenAI when that is unavailable. Vectors from the two are
+// not comparable, so each result records the model that made it.
+type embedder struct {
+	client     *pb.IntelligenceClient
+	httpClient *http.Client
+	apiKey     string
+}
+
+func newEmbedder(client *pb.IntelligenceClient, apiKey string) *embedder {
+	return &embedder{
+		client:     client,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		apiKey:     apiKey,
+	}
+}
+
+// embed returns one vector per text, in order, and the model that produced them.
+func (e *embedder) embed(ctx context.Context, texts []string) (string, []models.Vector, error) {
+	model, vectors, err := e.embedWithGRPC(ctx, texts)
+	if err != nil {
+		if e.apiKey == "" {
+			return "", nil, err
+		}
+		slog.Warn("intelligence embedding failed, falling back to OpenAI", "error", err)
+
+		var openAIErr error
+		model, vectors, openAIErr = e.embedWithOpenAI(ctx, texts)
+		if openAIErr != nil {
+			return "", nil, errors.Join(err, openAIErr)
+		}
+	}
+
+	if len(vectors) != len(texts) {
+		return "", nil, fmt.Errorf("got %d embeddings for %d texts", len(vectors), len(texts))
+	}
+	for _, v := range vectors {
+		if len(v) != models.EmbeddingDimensions {
+			return "", nil, fmt.Errorf("%s returned %d dimensions, want %d", model, len(v), models.EmbeddingDimensions)
+		}
+	}
+	return model, vectors, nil
+}
+
+func (e *embedder) embedWithGRPC(ctx context.Context, texts []string) (string, []models.Vector, error) {
+	if e.client == nil || *e.client == nil {
+		return "", nil, errors.New("intelligence service is not configured")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, embedGRPCTimeout)
+	defer cancel()
+
+	resp, err := (*e.client).EmbedTexts(ctx, &pb.EmbedTextsRequest{Texts: texts})
+	if err != nil {
+		return "", nil, fmt.Errorf("GRPC embedding failed: %w", err)
+	}
+
+	vectors := make([]models.Vector, len(resp.GetEmbeddings()))
+	for i, embedding := range resp.GetEmbeddings() {
+		vectors[i] = embedding.GetValues()
+	}
+	return resp.GetModel(), vectors, nil
+}
+
+func (e *embedder) embedWithOpenAI(ctx context.Context, texts []string) (string, []models.Vector, error) {
+	requestBody, err := json.Marshal(map[string]any{
+		"model": openAIEmbedModel,
+		"input": texts,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to marshal OpenAI request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, openAIEmbedURL, bytes.NewReader(requestBody))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create OpenAI request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+e.apiKey)
+
+	resp, err := e.httpClient.Do(req)
+	if err != nil {
+		return "", nil, fmt.Errorf("OpenAI request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", nil, fmt.Errorf("OpenAI returned status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Data []struct {
+			Index     int       `json:"index"`
+			Embedding []float32 `json:"embedding"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", nil, fmt.Errorf("failed to decode OpenAI response: %w", err)
+	}
+
+	vectors := make([]models.Vector, len(texts))
+	for _, d := range result.Data {
+		if d.Index < 0 || d.Index >= len(vectors) {
+			return "", nil, fmt.Errorf("OpenAI returned an embedding for unknown input %d", d.Index)
+		}
+		vectors[d.Index] = d.Embedding
+	}
+	return openAIEmbedModel, vectors, nil
+}
+
+// embeddingText returns the text of a testimonial that is embedded: its title, summary
+// and content, or transcript when it has no written content.
+func embeddingText(t *models.Testimonial) string {
+	var parts []string
+	for _, part := range []string{t.Title, t.Summary, testimonialText(t)} {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+func embeddingContentHash(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,135 @@
+package services
+
+//go:generate mockery --name=EntitlementService --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+)
+
+type EntitlementService interface {
+	// Check fails with an *apperrors.QuotaExceededError when using n more of the quota
+	// would take the workspace over its plan's limit. When db is a transaction the
+	// workspace stays locked until it ends, so concurrent checks cannot both pass.
+	Check(ctx context.Context, workspaceID uuid.UUID, quota models.Quota, n int, db repositories.DB) error
+	// Limit returns the workspace's limit for the quota, and false when its plan does
+	// not cap it.
+	Limit(ctx context.Context, workspaceID uuid.UUID, quota models.Quota, db repositories.DB) (int, bool, error)
+	// Usage reports the workspace's usage of every quota against its plan's limits.
+	Usage(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) (*models.WorkspaceUsage, error)
+}
+
+type entitlementService struct {
+	repo           repositories.EntitlementRepository
+	teamMemberRepo repositories.TeamMemberRepository
+	db             *sql.DB
+	now            func() time.Time
+}
+
+func NewEntitlementService(
+	repo repositories.EntitlementRepository,
+	teamMemberRepo repositories.TeamMemberRepository,
+	db *sql.DB,
+) EntitlementService {
+	return &entitlementService{
+		repo:           repo,
+		teamMemberRepo: teamMemberRepo,
+		db:             db,
+		now:            time.Now,
+	}
+}
+
+func (s *entitlementService) Check(ctx context.Context, workspaceID uuid.UUID, quota models.Quota, n int, db repositories.DB) error {
+	plan, err := s.repo.LockPlan(ctx, workspaceID, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperrors.ErrWorkspaceNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	limit, limited := plan.Entitlements().Limit(quota)
+	if !limited {
+		return nil
+	}
+	start, _ := s.period()
+	used, err := s.repo.CountUsage(ctx, workspaceID, quota, start, db)
+	if err != nil {
+		return err
+	}
+	if used+n > limit {
+		return &apperrors.QuotaExceededError{
+			Quota: string(quota),
+			Plan:  string(plan),
+			Limit: limit,
+			Used:  used,
+		}
+	}
+	return nil
+}
+
+func (s *entitlementService) Limit(ctx context.Context, workspaceID uuid.UUID, quota models.Quota, db repositories.DB) (int, bool, error) {
+	plan, err := s.repo.GetPlan(ctx, workspaceID, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, apperrors.ErrWorkspaceNotFound
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	limit, limited := plan.Entitlements().Limit(quota)
+	return limit, limited, nil
+}
+
+func (s *entitlementService) Usage(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) (*models.WorkspaceUsage, error) {
+	if err := requireWorkspacePermission(ctx, s.teamMemberRepo, s.db, workspaceID, firebaseUID, models.PermWorkspaceRead); err != nil {
+		return nil, err
+	}
+
+	plan, err := s.repo.GetPlan(ctx, workspaceID, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrWorkspaceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := s.period()
+	usage := &models.WorkspaceUsage{
+		WorkspaceID: workspaceID,
+		Plan:        plan,
+		PeriodStart: start,
+		PeriodEnd:   end,
+		Quotas:      make([]models.QuotaUsage, 0, len(models.Quotas)),
+	}
+	entitlements := plan.Entitlements()
+	for _, quota := range models.Quotas {
+		used, err := s.repo.CountUsage(ctx, workspaceID, quota, start, s.db)
+		if err != nil {
+			return nil, err
+		}
+
+		q := models.QuotaUsage{Quota: quota, Used: used}
+		if limit, limited := entitlements.Limit(quota); limited {
+			remaining := max(limit-used, 0)
+			q.Limit = &limit
+			q.Remaining = &remaining
+		}
+		usage.Quotas = append(usage.Quotas, q)
+	}
+	return usage, nil
+}
+
+// period returns the calendar month, in UTC, that monthly quotas are counted over.
+func (s *entitlementService) period() (time.Time, time.Time) {
+	now := s.now().UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntitlementService(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := uuid.New()
+	now := time.Date(2024, time.March, 14, 15, 30, 0, 0, time.UTC)
+	monthStart := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	setup := func(t *testing.T) (*entitlementService, *mocks.EntitlementRepository, *mocks.TeamMemberRepository) {
+		db, _, err := sqlmock.New()
+		require.NoError(t, err)
+		repo := mocks.NewEntitlementRepository(t)
+		members := mocks.NewTeamMemberRepository(t)
+		svc := NewEntitlementService(repo, members, db).(*entitlementService)
+		svc.now = func() time.Time { return now }
+		return svc, repo, members
+	}
+
+	t.Run("CheckAllowsUsageUpToTheLimit", func(t *testing.T) {
+		svc, repo, _ := setup(t)
+		repo.On("LockPlan", mock.Anything, workspaceID, mock.Anything).Return(models.PlanEssential, nil)
+		repo.On("CountUsage", mock.Anything, workspaceID, models.QuotaVideoMinutes, monthStart, mock.Anything).Return(7, nil)
+
+		assert.NoError(t, svc.Check(ctx, workspaceID, models.QuotaVideoMinutes, 3, svc.db))
+
+		err := svc.Check(ctx, workspaceID, models.QuotaVideoMinutes, 4, svc.db)
+		assert.ErrorIs(t, err, apperrors.ErrQuotaExceeded)
+		var quotaErr *apperrors.QuotaExceededError
+		require.ErrorAs(t, err, &quotaErr)
+		assert.Equal(t, apperrors.QuotaExceededError{
+			Quota: string(models.QuotaVideoMinutes),
+			Plan:  string(models.PlanEssential),
+			Limit: 10,
+			Used:  7,
+		}, *quotaErr)
+	})
+
+	t.Run("CheckSkipsCountingUnlimitedQuotas", func(t *testing.T) {
+		svc, repo, _ := setup(t)
+		repo.On("LockPlan", mock.Anything, workspaceID, mock.Anything).Return(models.PlanTransform, nil)
+
+		assert.NoError(t, svc.Check(ctx, workspaceID, models.QuotaProviders, 1, svc.db))
+		repo.AssertNotCalled(t, "CountUsage", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("CheckReportsMissingWorkspaces", func(t *testing.T) {
+		svc, repo, _ := setup(t)
+		repo.On("LockPlan", mock.Anything, workspaceID, mock.Anything).Return(models.Plan(""), sql.ErrNoRows)
+
+		err := svc.Check(ctx, workspaceID, models.QuotaTestimonials, 1, svc.db)
+		assert.ErrorIs(t, err, apperrors.ErrWorkspaceNotFound)
+	})
+
+	t.Run("UsageReportsEveryQuotaForTheMonth", func(t *testing.T) {
+		svc, repo, members := setup(t)
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "viewer-uid", mock.Anything).Return(&models.TeamMember{Role: models.Viewer}, nil)
+		repo.On("GetPlan", mock.Anything, workspaceID, mock.Anything).Return(models.PlanTransform, nil)
+		repo.On("CountUsage", mock.Anything, workspaceID, models.QuotaTestimonials, monthStart, mock.Anything).Return(10500, nil)
+		repo.On("CountUsage", mock.Anything, workspaceID, mock.Anything, monthStart, mock.Anything).Return(4, nil)
+
+		usage, err := svc.Usage(ctx, workspaceID, "viewer-uid")
+		require.NoError(t, err)
+		assert.Equal(t, models.PlanTransform, usage.Plan)
+		assert.Equal(t, monthStart, usage.PeriodStart)
+		assert.Equal(t, time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC), usage.PeriodEnd)
+		require.Len(t, usage.Quotas, len(models.Quotas))
+
+		// Imports can overshoot the limit, which never leaves less than nothing
+		testimonials := usage.Quotas[0]
+		assert.Equal(t, models.QuotaTestimonials, testimonials.Quota)
+		assert.Equal(t, 10500, testimonials.Used)
+		assert.Equal(t, 10000, *testimonials.Limit)
+		assert.Equal(t, 0, *testimonials.Remaining)
+
+		providers := usage.Quotas[1]
+		assert.Equal(t, models.QuotaProviders, providers.Quota)
+		assert.Equal(t, 4, providers.Used)
+		assert.Nil(t, providers.Limit)
+		assert.Nil(t, providers.Remaining)
+	})
+
+	t.Run("UsageRequiresMembership", func(t *testing.T) {
+		svc, repo, members := setup(t)
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "stranger-uid", mock.Anything).Return(nil, sql.ErrNoRows)
+
+		_, err := svc.Usage(ctx, workspaceID, "stranger-uid")
+		assert.ErrorIs(t, err, apperrors.ErrWorkspaceAccessDenied)
+		repo.AssertNotCalled(t, "GetPlan", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,313 @@
+package services
+
+//go:generate mockery --name=InvitationService --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pkg/invitetoken"
+)
+
+// invitationTTL is how long an invitation can be accepted after it was last sent.
+const invitationTTL = 7 * 24 * time.Hour
+
+type InvitationService interface {
+	Invite(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, req models.InvitationRequest) (*models.TeamInvitation, error)
+	Resend(ctx context.Context, workspaceID, invitationID uuid.UUID, firebaseUID string) (*models.TeamInvitation, error)
+	Revoke(ctx context.Context, workspaceID, invitationID uuid.UUID, firebaseUID string) error
+	// Accept adds the signed-in user to the workspace the token invites them to.
+	Accept(ctx context.Context, firebaseUID, token string) (*models.TeamMember, error)
+	// Roster lists the workspace's members and pending invitations.
+	Roster(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) (*models.TeamRoster, error)
+}
+
+type invitationService struct {
+	repo           repositories.TeamInvitationRepository
+	teamMemberRepo repositories.TeamMemberRepository
+	userRepo       repositories.UserRepository
+	entitlements   EntitlementService
+	signer         *invitetoken.Signer
+	mailer         Mailer
+	appURL         string
+	db             *sql.DB
+	now            func() time.Time
+}
+
+func NewInvitationService(
+	repo repositories.TeamInvitationRepository,
+	teamMemberRepo repositories.TeamMemberRepository,
+	userRepo repositories.UserRepository,
+	entitlements EntitlementService,
+	signer *invitetoken.Signer,
+	mailer Mailer,
+	appURL string,
+	db *sql.DB,
+) InvitationService {
+	return &invitationService{
+		repo:           repo,
+		teamMemberRepo: teamMemberRepo,
+		userRepo:       userRepo,
+		entitlements:   entitlements,
+		signer:         signer,
+		mailer:         mailer,
+		appURL:         strings.TrimSuffix(appURL, "/"),
+		db:             db,
+		now:            time.Now,
+	}
+}
+
+// requireMemberManager returns the caller's membership if they may manage the team.
+func (s *invitationService) requireMemberManager(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) (*models.TeamMember, error) {
+	member, err := workspaceMember(ctx, s.teamMemberRepo, s.db, workspaceID, firebaseUID)
+	if err != nil {
+		return nil, err
+	}
+	if !member.Can(models.PermMembersManage) {
+		return nil, apperrors.ErrWorkspaceAccessDenied
+	}
+	return member, nil
+}
+
+func (s *invitationService) Invite(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, req models.InvitationRequest) (*models.TeamInvitation, error) {
+	inviter, err := s.requireMemberManager(ctx, workspaceID, firebaseUID)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := mail.ParseAddress(strings.TrimSpace(req.Email))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrInvalidEmailFormat, err)
+	}
+	email := strings.ToLower(address.Address)
+	switch req.Role {
+	case models.Admin, models.Editor, models.Viewer:
+	default:
+		return nil, fmt.Errorf("%w: role must be admin, editor or viewer", apperrors.ErrValidationFailed)
+	}
+	// Admins can manage the team too, so only owners may add more of them
+	if req.Role == models.Admin && inviter.Role != models.Owner {
+		return nil, apperrors.ErrWorkspaceAccessDenied
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+	defer tx.Rollback()
+
+	if err := s.entitlements.Check(ctx, workspaceID, models.QuotaTeamSeats, 1, tx); err != nil {
+		return nil, err
+	}
+	isMember, err := s.repo.HasMemberWithEmail(ctx, workspaceID, email, tx)
+	if err != nil {
+		return nil, err
+	}
+	if isMember {
+		return nil, fmt.Errorf("%w: %s is already a member of this workspace", apperrors.ErrConflict, email)
+	}
+
+	invitation := models.TeamInvitation{
+		ID:          uuid.New(),
+		WorkspaceID: workspaceID,
+		Email:       email,
+		Role:        req.Role,
+		Status:      models.InvitationPending,
+		InvitedBy:   &inviter.ID,
+		ExpiresAt:   s.expiry(),
+	}
+	err = s.repo.Create(ctx, &invitation, tx)
+	if errors.Is(err, apperrors.ErrDuplicateEntry) {
+		return nil, fmt.Errorf("%w: %s already has a pending invitation; resend it instead", apperrors.ErrConflict, email)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+
+	if err := s.send(ctx, &invitation); err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+// Resend emails a fresh token and restarts the invitation's expiry. Tokens sent earlier
+// stop working. An expired invitation has given up its seat and has to be sent again
+// with Invite.
+func (s *invitationService) Resend(ctx context.Context, workspaceID, invitationID uuid.UUID, firebaseUID string) (*models.TeamInvitation, error) {
+	if _, err := s.requireMemberManager(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.repo.Get(ctx, workspaceID, invitationID, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	invitation.ExpiresAt = s.expiry()
+	invitation.SentAt = s.now()
+	if err := s.repo.Renew(ctx, workspaceID, invitationID, invitation.ExpiresAt, s.db); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	if err := s.send(ctx, invitation); err != nil {
+		return nil, err
+	}
+	return invitation, nil
+}
+
+func (s *invitationService) Revoke(ctx context.Context, workspaceID, invitationID uuid.UUID, firebaseUID string) error {
+	if _, err := s.requireMemberManager(ctx, workspaceID, firebaseUID); err != nil {
+		return err
+	}
+
+	err := s.repo.Revoke(ctx, workspaceID, invitationID, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperrors.ErrNotFound
+	}
+	return err
+}
+
+func (s *invitationService) Accept(ctx context.Context, firebaseUID, token string) (*models.TeamMember, error) {
+	invitationID, expiresAt, err := s.signer.Verify(token)
+	if errors.Is(err, invitetoken.ErrExpired) {
+		return nil, apperrors.ErrInvitationExpired
+	}
+	if err != nil {
+		return nil, apperrors.ErrInvitationInvalid
+	}
+
+	user, err := s.userRepo.FindByUID(ctx, firebaseUID, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+	defer tx.Rollback()
+
+	invitation, err := s.repo.GetForUpdate(ctx, invitationID, tx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrInvitationInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	// A resent invitation has a new expiry, which retires the tokens sent before it
+	if invitation.Status != models.InvitationPending || invitation.ExpiresAt.Unix() != expiresAt.Unix() {
+		return nil, apperrors.ErrInvitationInvalid
+	}
+	if invitation.IsExpired(s.now()) {
+		return nil, apperrors.ErrInvitationExpired
+	}
+	if !strings.EqualFold(user.Email, invitation.Email) {
+		return nil, apperrors.ErrInvitationEmailMismatch
+	}
+
+	_, err = s.teamMemberRepo.GetByFirebaseUID(ctx, invitation.WorkspaceID, firebaseUID, tx)
+	if err == nil {
+		return nil, fmt.Errorf("%w: you are already a member of this workspace", apperrors.ErrConflict)
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	// The pending invitation already holds the seat the new member takes
+	member := models.TeamMember{
+		ID:          uuid.New(),
+		WorkspaceID: invitation.WorkspaceID,
+		UserID:      user.ID,
+		Role:        invitation.Role,
+	}
+	if err := s.teamMemberRepo.Create(ctx, &member, tx); err != nil {
+		return nil, err
+	}
+	if err := s.repo.MarkAccepted(ctx, invitation.ID, user.ID, tx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+	return &member, nil
+}
+
+func (s *invitationService) Roster(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) (*models.TeamRoster, error) {
+	if err := requireWorkspacePermission(ctx, s.teamMemberRepo, s.db, workspaceID, firebaseUID, models.PermWorkspaceRead); err != nil {
+		return nil, err
+	}
+
+	members, err := s.teamMemberRepo.ListSummaries(ctx, workspaceID, s.db)
+	if err != nil {
+		return nil, err
+	}
+	invitations, err := s.repo.ListPending(ctx, workspaceID, s.db)
+	if err != nil {
+		return nil, err
+	}
+	limit, limited, err := s.entitlements.Limit(ctx, workspaceID, models.QuotaTeamSeats, s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	roster := &models.TeamRoster{
+		Members:     members,
+		Invitations: invitations,
+		SeatsUsed:   len(members) + len(invitations),
+	}
+	if limited {
+		roster.SeatLimit = &limit
+	}
+	return roster, nil
+}
+
+// expiry returns the expiry for an invitation sent now. Tokens carry whole seconds, so
+// the stored expiry is truncated to match.
+func (s *invitationService) expiry() time.Time {
+	return s.now().Add(invitationTTL).Truncate(time.Second)
+}
+
+// send emails the invitation with a token for its current expiry.
+func (s *invitationService) send(ctx context.Context, invitation *models.TeamInvitation) error {
+	token := s.signer.Issue(invitation.ID, invitation.ExpiresAt)
+	link := s.appURL + "/invitations/accept?token=" + url.QueryEscape(token)
+
+	err := s.mailer.Send(ctx, Email{
+		To:      invitation.Email,
+		Subject: "You have been invited to a Cenphi workspace",
+		Body: fmt.Sprintf(
+			"You have been invited to join a workspace on Cenphi as %s.\n\n"+
+				"Accept the invitation by %s:\n%s\n",
+			invitation.Role, invitation.ExpiresAt.UTC().Format("January 2, 2006"), link,
+		),
+	})
+	if err != nil {
+		// The invitation is saved, so it can be resent once mail is working again
+		return fmt.Errorf("%w: invitation saved but the email could not be sent: %v", apperrors.ErrServiceUnavailable, err)
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/ifeanyidike/cenphi/pkg/invitetoken"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeMailer struct {
+	sent []Email
+}
+
+func (m *fakeMailer) Send(ctx context.Context, email Email) error {
+	m.sent = append(m.sent, email)
+	return nil
+}
+
+// token returns the invitation token from the link in the last email sent.
+func (m *fakeMailer) token(t *testing.T) string {
+	require.NotEmpty(t, m.sent)
+	body := m.sent[len(m.sent)-1].Body
+	i := strings.Index(body, "token=")
+	require.GreaterOrEqual(t, i, 0)
+	token, err := url.QueryUnescape(strings.TrimSpace(body[i+len("token="):]))
+	require.NoError(t, err)
+	return token
+}
+
+func TestInvitationService(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := uuid.New()
+	owner := &models.TeamMember{ID: uuid.New(), WorkspaceID: workspaceID, Role: models.Owner}
+	now := time.Now().Truncate(time.Second)
+
+	type fixture struct {
+		svc     *invitationService
+		repo    *mocks.TeamInvitationRepository
+		members *mocks.TeamMemberRepository
+		users   *mocks.UserRepository
+		plans   *mocks.EntitlementRepository
+		mailer  *fakeMailer
+		sqlMock sqlmock.Sqlmock
+	}
+	setup := func(t *testing.T) fixture {
+		db, sqlMock, err := sqlmock.New()
+		require.NoError(t, err)
+		signer, err := invitetoken.NewSigner([]byte("secret"))
+		require.NoError(t, err)
+
+		f := fixture{
+			repo:    mocks.NewTeamInvitationRepository(t),
+			members: mocks.NewTeamMemberRepository(t),
+			users:   mocks.NewUserRepository(t),
+			plans:   mocks.NewEntitlementRepository(t),
+			mailer:  &fakeMailer{},
+			sqlMock: sqlMock,
+		}
+		entitlements := NewEntitlementService(f.plans, f.members, db)
+		f.svc = NewInvitationService(f.repo, f.members, f.users, entitlements, signer, f.mailer, "https://app.cenphi.io/", db).(*invitationService)
+		f.svc.now = func() time.Time { return now }
+		return f
+	}
+
+	t.Run("InviteEmailsAnAcceptLink", func(t *testing.T) {
+		f := setup(t)
+		f.sqlMock.ExpectBegin()
+		f.sqlMock.ExpectCommit()
+
+		f.members.On("GetByFirebaseUID", mock.Anything, workspaceID, "owner-uid", mock.Anything).Return(owner, nil)
+		f.plans.On("LockPlan", mock.Anything, workspaceID, mock.Anything).Return(models.PlanGrowth, nil)
+		f.plans.On("CountUsage", mock.Anything, workspaceID, models.QuotaTeamSeats, mock.Anything, mock.Anything).Return(4, nil)
+		f.repo.On("HasMemberWithEmail", mock.Anything, workspaceID, "ada@example.com", mock.Anything).Return(false, nil)
+		f.repo.On("Create", mock.Anything, mock.AnythingOfType("*models.TeamInvitation"), mock.Anything).Return(nil)
+
+		invitation, err := f.svc.Invite(ctx, workspaceID, "owner-uid", models.InvitationRequest{Email: " Ada@Example.com ", Role: models.Editor})
+		require.NoError(t, err)
+		assert.Equal(t, "ada@example.com", invitation.Email)
+		assert.Equal(t, models.InvitationPending, invitation.Status)
+		assert.Equal(t, owner.ID, *invitation.InvitedBy)
+		assert.Equal(t, now.Add(invitationTTL), invitation.ExpiresAt)
+
+		require.Len(t, f.mailer.sent, 1)
+		assert.Equal(t, "ada@example.com", f.mailer.sent[0].To)
+		assert.Contains(t, f.mailer.sent[0].Body, "https://app.cenphi.io/invitations/accept?token=")
+		assert.NoError(t, f.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("InviteStopsAtSeatLimit", func(t *testing.T) {
+		f := setup(t)
+		f.sqlMock.ExpectBegin()
+		f.sqlMock.ExpectRollback()
+
+		f.members.On("GetByFirebaseUID", mock.Anything, workspaceID, "owner-uid", mock.Anything).Return(owner, nil)
+		f.plans.On("LockPlan", mock.Anything, workspaceID, mock.Anything).Return(models.PlanGrowth, nil)
+		f.plans.On("CountUsage", mock.Anything, workspaceID, models.QuotaTeamSeats, mock.Anything, mock.Anything).Return(5, nil)
+
+		_, err := f.svc.Invite(ctx, workspaceID, "owner-uid", models.InvitationRequest{Email: "ada@example.com", Role: models.Viewer})
+		assert.ErrorIs(t, err, apperrors.ErrQuotaExceeded)
+		var quotaErr *apperrors.QuotaExceededError
+		require.ErrorAs(t, err, &quotaErr)
+		assert.Equal(t, 5, quotaErr.Limit)
+		assert.Empty(t, f.mailer.sent)
+	})
+
+	t.Run("OnlyOwnersInviteAdmins", func(t *testing.T) {
+		f := setup(t)
+		admin := &models.TeamMember{ID: uuid.New(), WorkspaceID: workspaceID, Role: models.Admin}
+		viewer := &models.TeamMember{ID: uuid.New(), WorkspaceID: workspaceID, Role: models.Viewer}
+		f.members.On("GetByFirebaseUID", mock.Anything, workspaceID, "admin-uid", mock.Anything).Return(admin, nil)
+		f.members.On("GetByFirebaseUID", mock.Anything, workspaceID, "viewer-uid", mock.Anything).Return(viewer, nil)
+
+		_, err := f.svc.Invite(ctx, workspaceID, "admin-uid", models.InvitationRequest{Email: "ada@example.com", Role: models.Admin})
+		assert.ErrorIs(t, err, apperrors.ErrWorkspaceAccessDenied)
+		_, err = f.svc.Invite(ctx, workspaceID, "viewer-uid", models.InvitationRequest{Email: "ada@example.com", Role: models.Viewer})
+		assert.ErrorIs(t, err, apperrors.ErrWorkspaceAccessDenied)
+		_, err = f.svc.Invite(ctx, workspaceID, "admin-uid", models.InvitationRequest{Email: "ada@example.com", Role: models.Owner})
+		assert.ErrorIs(t, err, apperrors.ErrValidationFailed)
+	})
+
+	t.Run("AcceptAddsTheMember", func(t *testing.T) {
+		f := setup(t)
+		f.members.On("GetByFirebaseUID", mock.Anything, workspaceID, "owner-uid", mock.Anything).Return(owner, nil)
+		invitation := &models.TeamInvitation{ID: uuid.New(), WorkspaceID: workspaceID, Email: "ada@example.com", Role: models.Editor, Status: models.InvitationPending}
+		f.repo.On("Get", mock.Anything, workspaceID, invitation.ID, mock.Anything).Return(invitation, nil)
+		f.repo.On("Renew", mock.Anything, workspaceID, invitation.ID, now.Add(invitationTTL), mock.Anything).Return(nil)
+		_, err := f.svc.Resend(ctx, workspaceID, invitation.ID, "owner-uid")
+		require.NoError(t, err)
+		token := f.mailer.token(t)
+
+		f.sqlMock.ExpectBegin()
+		f.sqlMock.ExpectCommit()
+		user := &models.User{ID: uuid.New(), Email: "Ada@example.com"}
+		f.users.On("FindByUID", mock.Anything, "ada-uid", mock.Anything).Return(user, nil)
+		f.repo.On("GetForUpdate", mock.Anything, invitation.ID, mock.Anything).Return(invitation, nil)
+		f.members.On("GetByFirebaseUID", mock.Anything, workspaceID, "ada-uid", mock.Anything).Return(nil, sql.ErrNoRows)
+		f.members.On("Create", mock.Anything, mock.MatchedBy(func(m *models.TeamMember) bool {
+			return m.UserID == user.ID && m.WorkspaceID == workspaceID && m.Role == models.Editor
+		}), mock.Anything).Return(nil)
+		f.repo.On("MarkAccepted", mock.Anything, invitation.ID, user.ID, mock.Anything).Return(nil)
+
+		member, err := f.svc.Accept(ctx, "ada-uid", token)
+		require.NoError(t, err)
+		assert.Equal(t, models.Editor, member.Role)
+		assert.NoError(t, f.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("AcceptRejectsReplacedAndMisdirectedTokens", func(t *testing.T) {
+		f := setup(t)
+		invitation := &models.TeamInvitation{ID: uuid.New(), WorkspaceID: workspaceID, Email: "ada@example.com", Role: models.Viewer, Status: models.InvitationPending}
+		oldToken := f.svc.signer.Issue(invitation.ID, now.Add(time.Hour))
+		invitation.ExpiresAt = now.Add(invitationTTL)
+		currentToken := f.svc.signer.Issue(invitation.ID, invitation.ExpiresAt)
+
+		f.users.On("FindByUID", mock.Anything, "ada-uid", mock.Anything).Return(&models.User{ID: uuid.New(), Email: "ada@example.com"}, nil)
+		f.users.On("FindByUID", mock.Anything, "bob-uid", mock.Anything).Return(&models.User{ID: uuid.New(), Email: "bob@example.com"}, nil)
+		f.repo.On("GetForUpdate", mock.Anything, invitation.ID, mock.Anything).Return(invitation, nil)
+
+		f.sqlMock.ExpectBegin()
+		f.sqlMock.ExpectRollback()
+		_, err := f.svc.Accept(ctx, "ada-uid", oldToken)
+		assert.ErrorIs(t, err, apperrors.ErrInvitationInvalid)
+
+		f.sqlMock.ExpectBegin()
+		f.sqlMock.ExpectRollback()
+		_, err = f.svc.Accept(ctx, "bob-uid", currentToken)
+		assert.ErrorIs(t, err, apperrors.ErrInvitationEmailMismatch)
+
+		_, err = f.svc.Accept(ctx, "ada-uid", "not-a-token")
+		assert.ErrorIs(t, err, apperrors.ErrInvitationInvalid)
+		f.members.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/smtp"
+	"strings"
+)
+
+// Email is a plain text message to a single recipient.
+type Email struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer sends transactional email.
+type Mailer interface {
+	Send(ctx context.Context, email Email) error
+}
+
+type smtpMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewSMTPMailer sends email through an SMTP server. Authentication is skipped when no
+// username is given.
+func NewSMTPMailer(host, port, username, password, from string) Mailer {
+	if port == "" {
+		port = "587"
+	}
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &smtpMailer{addr: net.JoinHostPort(host, port), from: from, auth: auth}
+}
+
+func (m *smtpMailer) Send(ctx context.Context, email Email) error {
+	// Headers are built from our own templates, but the recipient comes from a request
+	if strings.ContainsAny(email.To, "\r\n") || strings.ContainsAny(email.Subject, "\r\n") {
+		return fmt.Errorf("invalid email header")
+	}
+
+	msg := "From: " + m.from + "\r\n" +
+		"To: " + email.To + "\r\n" +
+		"Subject: " + email.Subject + "\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=UTF-8\r\n" +
+		"\r\n" + email.Body
+
+	if err := smtp.SendMail(m.addr, m.auth, m.from, []string{email.To}, []byte(msg)); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	return nil
+}
+
+type logMailer struct{}
+
+// NewLogMailer returns a mailer that logs messages instead of sending them, for
+// development without an SMTP server.
+func NewLogMailer() Mailer {
+	return logMailer{}
+}
+
+func (logMailer) Send(ctx context.Context, email Email) error {
+	slog.Info("email not sent; no SMTP server configured", "to", email.To, "subject", email.Subject, "body", email.Body)
+	return nil
+}
@@ -0,0 +1,331 @@
+package services
+
+//go:generate mockery --name=MediaUploadService --output=./mocks --case=underscore
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pkg/mediastore"
+)
+
+const (
+	// mediaUploadChunkSize is S3's minimum part size, which keeps each request well
+	// under the server's read timeout.
+	mediaUploadChunkSize = 5 << 20
+	maxMediaUploadParts  = 10000
+	mediaUploadTTL       = 24 * time.Hour
+	// mediaSniffLength is how much of the first part is inspected to detect its type.
+	mediaSniffLength = 3072
+)
+
+var unsafeFilenameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+type MediaUploadService interface {
+	CreateUpload(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, req models.MediaUploadRequest) (*models.MediaUpload, error)
+	GetUpload(ctx context.Context, workspaceID, uploadID uuid.UUID, firebaseUID string) (*models.MediaUpload, error)
+	UploadPart(ctx context.Context, workspaceID, uploadID uuid.UUID, firebaseUID string, number int, body io.Reader, size int64) (*models.MediaUploadPart, error)
+	CompleteUpload(ctx context.Context, workspaceID, uploadID uuid.UUID, firebaseUID string) (*models.Testimonial, error)
+	AbortUpload(ctx context.Context, workspaceID, uploadID uuid.UUID, firebaseUID string) error
+}
+
+type mediaUploadService struct {
+	repo               repositories.MediaUploadRepository
+	teamMemberRepo     repositories.TeamMemberRepository
+	testimonialService TestimonialService
+	videoProcessor     VideoProcessingService
+	entitlements       EntitlementService
+	store              mediastore.Store
+	maxSize            int64
+	db                 *sql.DB
+	now                func() time.Time
+}
+
+// NewMediaUploadService returns a media upload service. The video minutes quota is only
+// enforced when entitlements is set.
+func NewMediaUploadService(
+	repo repositories.MediaUploadRepository,
+	teamMemberRepo repositories.TeamMemberRepository,
+	testimonialService TestimonialService,
+	videoProcessor VideoProcessingService,
+	entitlements EntitlementService,
+	store mediastore.Store,
+	maxSize int64,
+	db *sql.DB,
+) MediaUploadService {
+	return &mediaUploadService{
+		repo:               repo,
+		teamMemberRepo:     teamMemberRepo,
+		testimonialService: testimonialService,
+		videoProcessor:     videoProcessor,
+		entitlements:       entitlements,
+		store:              store,
+		maxSize:            maxSize,
+		db:                 db,
+		now:                time.Now,
+	}
+}
+
+func (s *mediaUploadService) requireEditor(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) error {
+	return requireWorkspacePermission(ctx, s.teamMemberRepo, s.db, workspaceID, firebaseUID, models.PermTestimonialsWrite)
+}
+
+// CreateUpload validates the declared file and starts a multipart upload for it.
+func (s *mediaUploadService) CreateUpload(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, req models.MediaUploadRequest) (*models.MediaUpload, error) {
+	if err := s.requireEditor(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+
+	format, ok := models.MediaFormatForContentType(req.ContentType)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q is not a supported video or audio type", apperrors.ErrFileTypeInvalid, req.ContentType)
+	}
+	if req.SizeBytes <= 0 {
+		return nil, fmt.Errorf("%w: size_bytes is required", apperrors.ErrValidationFailed)
+	}
+	if req.SizeBytes > s.maxSize {
+		return nil, fmt.Errorf("%w: maximum size is %d bytes", apperrors.ErrFileTooLarge, s.maxSize)
+	}
+	if req.DurationSeconds < 0 {
+		return nil, fmt.Errorf("%w: duration_seconds cannot be negative", apperrors.ErrValidationFailed)
+	}
+	// Uploads do not count towards the quota until they become testimonials, which is
+	// checked again, with the workspace locked, when the upload is completed. This only
+	// saves uploading a video that could never be stored.
+	if format == models.ContentFormatVideo && s.entitlements != nil {
+		if err := s.entitlements.Check(ctx, workspaceID, models.QuotaVideoMinutes, videoMinutes(req.DurationSeconds), s.db); err != nil {
+			return nil, err
+		}
+	}
+	filename := sanitizeFilename(req.Filename)
+
+	upload := &models.MediaUpload{
+		ID:          uuid.New(),
+		WorkspaceID: workspaceID,
+		CreatedBy:   firebaseUID,
+		Filename:    filename,
+		ContentType: req.ContentType,
+		Format:      format,
+		SizeBytes:   req.SizeBytes,
+		ChunkSize:   mediaChunkSize(req.SizeBytes),
+		Status:      models.MediaUploadInProgress,
+		Metadata:    req.MediaUploadMetadata,
+		ExpiresAt:   s.now().Add(mediaUploadTTL),
+	}
+	upload.StorageKey = fmt.Sprintf("workspaces/%s/media/%s/%s", workspaceID, upload.ID, filename)
+
+	storageUploadID, err := s.store.InitUpload(ctx, upload.StorageKey, req.ContentType)
+	if err != nil {
+		return nil, err
+	}
+	upload.StorageUploadID = storageUploadID
+
+	if err := s.repo.Create(ctx, upload, s.db); err != nil {
+		if abortErr := s.store.AbortUpload(ctx, upload.StorageKey, storageUploadID); abortErr != nil {
+			return nil, errors.Join(err, abortErr)
+		}
+		return nil, err
+	}
+	upload.ReceivedParts = []int{}
+	return upload, nil
+}
+
+// GetUpload returns an upload with the parts received so far, so clients can resume.
+func (s *mediaUploadService) GetUpload(ctx context.Context, workspaceID, uploadID uuid.UUID, firebaseUID string) (*models.MediaUpload, error) {
+	if err := s.requireEditor(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+
+	upload, err := s.repo.Get(ctx, workspaceID, uploadID, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrNotFound
+	}
+	return upload, err
+}
+
+// openUpload returns an upload that can still receive parts.
+func (s *mediaUploadService) openUpload(ctx context.Context, workspaceID, uploadID uuid.UUID, firebaseUID string) (*models.MediaUpload, error) {
+	upload, err := s.GetUpload(ctx, workspaceID, uploadID, firebaseUID)
+	if err != nil {
+		return nil, err
+	}
+	if upload.Status != models.MediaUploadInProgress || upload.IsExpired(s.now()) {
+		return nil, apperrors.ErrUploadClosed
+	}
+	return upload, nil
+}
+
+// UploadPart stores one chunk. Parts may be sent in any order and retried; the first
+// part is sniffed to make sure the content really is video or audio.
+func (s *mediaUploadService) UploadPart(ctx context.Context, workspaceID, uploadID uuid.UUID, firebaseUID string, number int, body io.Reader, size int64) (*models.MediaUploadPart, error) {
+	upload, err := s.openUpload(ctx, workspaceID, uploadID, firebaseUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if number < 1 || number > upload.TotalParts() {
+		return nil, fmt.Errorf("%w: part number must be between 1 and %d", apperrors.ErrValidationFailed, upload.TotalParts())
+	}
+	if expected := upload.PartSize(number); size != expected {
+		return nil, fmt.Errorf("%w: part %d must be %d bytes", apperrors.ErrValidationFailed, number, expected)
+	}
+
+	if number == 1 {
+		buffered := bufio.NewReaderSize(body, mediaSniffLength)
+		head, err := buffered.Peek(int(min(size, mediaSniffLength)))
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to read part: %v", apperrors.ErrValidationFailed, err)
+		}
+		if !isAcceptedMedia(mimetype.Detect(head)) {
+			return nil, fmt.Errorf("%w: content is not a supported video or audio file", apperrors.ErrFileTypeInvalid)
+		}
+		body = buffered
+	}
+
+	etag, err := s.store.UploadPart(ctx, upload.StorageKey, upload.StorageUploadID, number, body, size)
+	if err != nil {
+		return nil, err
+	}
+
+	part := models.MediaUploadPart{Number: number, ETag: etag, SizeBytes: size}
+	if err := s.repo.SavePart(ctx, upload.ID, part, s.db); err != nil {
+		return nil, err
+	}
+	return &part, nil
+}
+
+// CompleteUpload assembles the stored object and creates a pending testimonial that
+// points at it. Videos are then sent for transcription in the background.
+func (s *mediaUploadService) CompleteUpload(ctx context.Context, workspaceID, uploadID uuid.UUID, firebaseUID string) (*models.Testimonial, error) {
+	upload, err := s.openUpload(ctx, workspaceID, uploadID, firebaseUID)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := s.repo.GetParts(ctx, upload.ID, s.db)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) != upload.TotalParts() {
+		return nil, fmt.Errorf("%w: received %d of %d parts", apperrors.ErrUploadIncomplete, len(parts), upload.TotalParts())
+	}
+
+	storeParts := make([]mediastore.Part, len(parts))
+	for i, p := range parts {
+		storeParts[i] = mediastore.Part{Number: p.Number, ETag: p.ETag}
+	}
+	// Completing in the store also claims the upload: a concurrent completion fails
+	// here because the multipart upload no longer exists.
+	if err := s.store.CompleteUpload(ctx, upload.StorageKey, upload.StorageUploadID, storeParts); err != nil {
+		if errors.Is(err, mediastore.ErrUploadNotFound) {
+			return nil, apperrors.ErrUploadClosed
+		}
+		return nil, err
+	}
+
+	mediaURL := s.store.URL(upload.StorageKey)
+	submission := models.TestimonialSubmission{
+		Format:   upload.Format,
+		Title:    upload.Metadata.Title,
+		Summary:  upload.Metadata.Summary,
+		MediaURL: &mediaURL,
+		Customer: upload.Metadata.Customer,
+	}
+	if upload.Metadata.DurationSeconds > 0 {
+		submission.MediaDuration = &upload.Metadata.DurationSeconds
+	}
+	testimonial, err := s.testimonialService.Submit(ctx, workspaceID, models.CollectionMethodWebsite, submission)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateStatus(ctx, upload.ID, models.MediaUploadCompleted, &testimonial.ID, s.db); err != nil {
+		return nil, fmt.Errorf("failed to mark upload %s completed: %w", upload.ID, err)
+	}
+
+	// The testimonial exists either way, so a transcription that cannot be scheduled
+	// does not fail the upload
+	if upload.Format == models.ContentFormatVideo && s.videoProcessor != nil {
+		_, err := s.videoProcessor.Schedule(ctx, testimonial.ID, VideoSource{
+			StorageKey:  upload.StorageKey,
+			ContentType: upload.ContentType,
+			SubmittedBy: firebaseUID,
+		})
+		if err != nil {
+			slog.Error("failed to schedule video transcription", "upload", upload.ID, "testimonial", testimonial.ID, "error", err)
+		}
+	}
+	return testimonial, nil
+}
+
+func (s *mediaUploadService) AbortUpload(ctx context.Context, workspaceID, uploadID uuid.UUID, firebaseUID string) error {
+	upload, err := s.openUpload(ctx, workspaceID, uploadID, firebaseUID)
+	if err != nil {
+		return err
+	}
+
+	err = s.store.AbortUpload(ctx, upload.StorageKey, upload.StorageUploadID)
+	if err != nil && !errors.Is(err, mediastore.ErrUploadNotFound) {
+		return err
+	}
+
+	err = s.repo.UpdateStatus(ctx, upload.ID, models.MediaUploadAborted, nil, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperrors.ErrUploadClosed
+	}
+	return err
+}
+
+// mediaChunkSize grows the chunk size for very large files so they fit in the
+// maximum number of parts.
+func mediaChunkSize(size int64) int64 {
+	chunk := int64(mediaUploadChunkSize)
+	if minChunk := (size + maxMediaUploadParts - 1) / maxMediaUploadParts; minChunk > chunk {
+		chunk = minChunk
+	}
+	return chunk
+}
+
+// videoMinutes is how many minutes of the video quota a recording uses: whole minutes,
+// rounded up, and at least one when the length was not given.
+func videoMinutes(seconds int) int {
+	return max(1, (seconds+59)/60)
+}
+
+// isAcceptedMedia reports whether the detected type, or a type it specialises, is an
+// accepted media type.
+func isAcceptedMedia(detected *mimetype.MIME) bool {
+	for m := detected; m != nil; m = m.Parent() {
+		if _, ok := models.MediaFormatForContentType(m.String()); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// sanitizeFilename keeps a filename safe to use in an object key.
+func sanitizeFilename(name string) string {
+	name = unsafeFilenameChars.ReplaceAllString(filepath.Base(strings.ReplaceAll(name, `\`, "/")), "-")
+	name = strings.Trim(name, ".-")
+	if len(name) > 100 {
+		name = name[len(name)-100:]
+	}
+	if name == "" {
+		return "media"
+	}
+	return name
+}
@@ -0,0 +1,186 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/ifeanyidike/cenphi/pkg/mediastore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mp4Header is the start of an ISO base media file, enough for content sniffing.
+var mp4Header = []byte("\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00mp42isom")
+
+func TestMediaUploadService(t *testing.T) {
+	db, sqlMock, _ := sqlmock.New()
+	workspaceID := uuid.New()
+	ctx := context.Background()
+
+	newService := func(t *testing.T) (MediaUploadService, *mocks.MediaUploadRepository, *mocks.TestimonialRepository, *recordingVideoProcessor, string) {
+		root := t.TempDir()
+		store, err := mediastore.NewLocalStore(root, "http://localhost/api/v1/media")
+		require.NoError(t, err)
+
+		members := &mocks.TeamMemberRepository{}
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "editor-uid", db).Return(&models.TeamMember{Role: models.Editor}, nil)
+
+		repo := &mocks.MediaUploadRepository{}
+		testimonialRepo := &mocks.TestimonialRepository{}
+		testimonialService := NewTestimonialService(testimonialRepo, nil, nil, nil, nil, db)
+		videos := &recordingVideoProcessor{}
+		return NewMediaUploadService(repo, members, testimonialService, videos, nil, store, 50<<20, db), repo, testimonialRepo, videos, root
+	}
+
+	t.Run("RejectsUnsupportedOrOversizedFiles", func(t *testing.T) {
+		svc, repo, _, _, _ := newService(t)
+
+		_, err := svc.CreateUpload(ctx, workspaceID, "editor-uid", models.MediaUploadRequest{Filename: "a.png", ContentType: "image/png", SizeBytes: 10})
+		assert.ErrorIs(t, err, apperrors.ErrFileTypeInvalid)
+
+		_, err = svc.CreateUpload(ctx, workspaceID, "editor-uid", models.MediaUploadRequest{Filename: "a.mp4", ContentType: "video/mp4", SizeBytes: 51 << 20})
+		assert.ErrorIs(t, err, apperrors.ErrFileTooLarge)
+
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("CountsVideoLengthAgainstThePlan", func(t *testing.T) {
+		root := t.TempDir()
+		store, err := mediastore.NewLocalStore(root, "http://localhost/api/v1/media")
+		require.NoError(t, err)
+		members := mocks.NewTeamMemberRepository(t)
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "editor-uid", db).Return(&models.TeamMember{Role: models.Editor}, nil)
+		plans := mocks.NewEntitlementRepository(t)
+		plans.On("LockPlan", mock.Anything, workspaceID, db).Return(models.PlanEssential, nil)
+		plans.On("CountUsage", mock.Anything, workspaceID, models.QuotaVideoMinutes, mock.Anything, db).Return(8, nil)
+		repo := mocks.NewMediaUploadRepository(t)
+		svc := NewMediaUploadService(repo, members, nil, nil, NewEntitlementService(plans, members, db), store, 50<<20, db)
+
+		// 121 seconds is three minutes, one more than the essentials plan has left
+		_, err = svc.CreateUpload(ctx, workspaceID, "editor-uid", models.MediaUploadRequest{
+			Filename:            "story.mp4",
+			ContentType:         "video/mp4",
+			SizeBytes:           1024,
+			MediaUploadMetadata: models.MediaUploadMetadata{DurationSeconds: 121},
+		})
+		assert.ErrorIs(t, err, apperrors.ErrQuotaExceeded)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("UploadsInPartsAndCreatesPendingTestimonial", func(t *testing.T) {
+		svc, repo, testimonialRepo, videos, root := newService(t)
+
+		var upload *models.MediaUpload
+		var parts []models.MediaUploadPart
+		repo.On("Create", mock.Anything, mock.AnythingOfType("*models.MediaUpload"), db).
+			Run(func(args mock.Arguments) { upload = args.Get(1).(*models.MediaUpload) }).
+			Return(nil)
+		repo.On("Get", mock.Anything, workspaceID, mock.Anything, db).
+			Return(func(context.Context, uuid.UUID, uuid.UUID, repositories.DB) (*models.MediaUpload, error) {
+				copied := *upload
+				return &copied, nil
+			})
+		repo.On("SavePart", mock.Anything, mock.Anything, mock.AnythingOfType("models.MediaUploadPart"), db).
+			Run(func(args mock.Arguments) { parts = append(parts, args.Get(2).(models.MediaUploadPart)) }).
+			Return(nil)
+		repo.On("GetParts", mock.Anything, mock.Anything, db).
+			Return(func(context.Context, uuid.UUID, repositories.DB) ([]models.MediaUploadPart, error) {
+				return parts, nil
+			})
+		repo.On("UpdateStatus", mock.Anything, mock.Anything, models.MediaUploadCompleted, mock.Anything, db).Return(nil)
+		testimonialRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Testimonial"), mock.Anything).Return(nil)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		first := append(append([]byte{}, mp4Header...), bytes.Repeat([]byte{0}, mediaUploadChunkSize-len(mp4Header))...)
+		last := []byte("tail")
+
+		created, err := svc.CreateUpload(ctx, workspaceID, "editor-uid", models.MediaUploadRequest{
+			Filename:            "../Customer Story.mp4",
+			ContentType:         "video/mp4",
+			SizeBytes:           int64(len(first) + len(last)),
+			MediaUploadMetadata: models.MediaUploadMetadata{Title: "Customer story"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, created.TotalParts())
+		assert.Equal(t, "Customer-Story.mp4", created.Filename)
+
+		_, err = svc.UploadPart(ctx, workspaceID, created.ID, "editor-uid", 2, strings.NewReader("short"), 5)
+		assert.ErrorIs(t, err, apperrors.ErrValidationFailed)
+		_, err = svc.CompleteUpload(ctx, workspaceID, created.ID, "editor-uid")
+		assert.ErrorIs(t, err, apperrors.ErrUploadIncomplete)
+
+		_, err = svc.UploadPart(ctx, workspaceID, created.ID, "editor-uid", 2, bytes.NewReader(last), int64(len(last)))
+		require.NoError(t, err)
+		_, err = svc.UploadPart(ctx, workspaceID, created.ID, "editor-uid", 1, bytes.NewReader(first), int64(len(first)))
+		require.NoError(t, err)
+
+		testimonial, err := svc.CompleteUpload(ctx, workspaceID, created.ID, "editor-uid")
+		require.NoError(t, err)
+		assert.Equal(t, models.ContentFormatVideo, testimonial.Format)
+		assert.Equal(t, models.StatusPendingReview, testimonial.Status)
+		assert.Equal(t, "Customer story", testimonial.Title)
+		require.NotNil(t, testimonial.MediaURL)
+		assert.Equal(t, "http://localhost/api/v1/media/"+upload.StorageKey, *testimonial.MediaURL)
+
+		stored, err := os.ReadFile(filepath.Join(root, "objects", filepath.FromSlash(upload.StorageKey)))
+		require.NoError(t, err)
+		assert.Equal(t, len(first)+len(last), len(stored))
+		repo.AssertCalled(t, "UpdateStatus", mock.Anything, created.ID, models.MediaUploadCompleted, &testimonial.ID, db)
+
+		require.Len(t, videos.scheduled, 1)
+		assert.Equal(t, testimonial.ID, videos.scheduled[0].testimonialID)
+		assert.Equal(t, VideoSource{StorageKey: upload.StorageKey, ContentType: "video/mp4", SubmittedBy: "editor-uid"}, videos.scheduled[0].source)
+	})
+
+	t.Run("RejectsContentThatIsNotMedia", func(t *testing.T) {
+		svc, repo, _, _, _ := newService(t)
+
+		var upload *models.MediaUpload
+		repo.On("Create", mock.Anything, mock.AnythingOfType("*models.MediaUpload"), db).
+			Run(func(args mock.Arguments) { upload = args.Get(1).(*models.MediaUpload) }).
+			Return(nil)
+		repo.On("Get", mock.Anything, workspaceID, mock.Anything, db).
+			Return(func(context.Context, uuid.UUID, uuid.UUID, repositories.DB) (*models.MediaUpload, error) {
+				return upload, nil
+			})
+
+		body := []byte("#!/bin/sh\necho definitely not a video\n")
+		created, err := svc.CreateUpload(ctx, workspaceID, "editor-uid", models.MediaUploadRequest{Filename: "clip.mp4", ContentType: "video/mp4", SizeBytes: int64(len(body))})
+		require.NoError(t, err)
+
+		_, err = svc.UploadPart(ctx, workspaceID, created.ID, "editor-uid", 1, bytes.NewReader(body), int64(len(body)))
+		assert.ErrorIs(t, err, apperrors.ErrFileTypeInvalid)
+		repo.AssertNotCalled(t, "SavePart", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+type scheduledVideo struct {
+	testimonialID uuid.UUID
+	source        VideoSource
+}
+
+// recordingVideoProcessor records scheduled transcriptions without running them.
+type recordingVideoProcessor struct {
+	scheduled []scheduledVideo
+}
+
+func (p *recordingVideoProcessor) Schedule(ctx context.Context, testimonialID uuid.UUID, source VideoSource) (*models.AIJob, error) {
+	p.scheduled = append(p.scheduled, scheduledVideo{testimonialID, source})
+	return &models.AIJob{ID: uuid.New(), TestimonialID: testimonialID}, nil
+}
+
+func (p *recordingVideoProcessor) Handle(ctx context.Context, job *models.AIJob) (models.JSONMap, *uuid.UUID, error) {
+	return nil, nil, nil
+}
@@ -6,6 +6,7 @@ import (
 	context "context"
 
 	models "github.com/ifeanyidike/cenphi/internal/models"
+
 	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/google/uuid"
@@ -16,27 +17,9 @@ type TeamMemberService struct {
 	mock.Mock
 }
 
-// AddTeamMember provides a mock function with given fields: ctx, teamMember
-func (_m *TeamMemberService) AddTeamMember(ctx context.Context, teamMember *models.TeamMember) error {
-	ret := _m.Called(ctx, teamMember)
-
-	if len(ret) == 0 {
-		panic("no return value specified for AddTeamMember")
-	}
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.TeamMember) error); ok {
-		r0 = rf(ctx, teamMember)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// GetTeamMemberData provides a mock function with given fields: ctx, id
-func (_m *TeamMemberService) GetTeamMemberData(ctx context.Context, id uuid.UUID) (*models.TeamMemberGetParams, error) {
-	ret := _m.Called(ctx, id)
+// GetTeamMemberData provides a mock function with given fields: ctx, workspaceID, id
+func (_m *TeamMemberService) GetTeamMemberData(ctx context.Context, workspaceID uuid.UUID, id uuid.UUID) (*models.TeamMemberGetParams, error) {
+	ret := _m.Called(ctx, workspaceID, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTeamMemberData")
@@ -44,19 +27,19 @@ func (_m *TeamMemberService) GetTeamMemberData(ctx context.Context, id uuid.UUID
 
 	var r0 *models.TeamMemberGetParams
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.TeamMemberGetParams, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.TeamMemberGetParams, error)); ok {
+		return rf(ctx, workspaceID, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.TeamMemberGetParams); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.TeamMemberGetParams); ok {
+		r0 = rf(ctx, workspaceID, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.TeamMemberGetParams)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, workspaceID, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -124,17 +107,17 @@ func (_m *TeamMemberService) GetTeamMembers(ctx context.Context, workspaceID uui
 	return r0, r1
 }
 
-// RemoveTeamMember provides a mock function with given fields: ctx, id
-func (_m *TeamMemberService) RemoveTeamMember(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// RemoveTeamMember provides a mock function with given fields: ctx, workspaceID, firebaseUID, id
+func (_m *TeamMemberService) RemoveTeamMember(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, id uuid.UUID) error {
+	ret := _m.Called(ctx, workspaceID, firebaseUID, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RemoveTeamMember")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, uuid.UUID) error); ok {
+		r0 = rf(ctx, workspaceID, firebaseUID, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -142,6 +125,36 @@ func (_m *TeamMemberService) RemoveTeamMember(ctx context.Context, id uuid.UUID)
 	return r0
 }
 
+// WorkspaceMember provides a mock function with given fields: ctx, workspaceID, firebaseUID
+func (_m *TeamMemberService) WorkspaceMember(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) (*models.TeamMember, error) {
+	ret := _m.Called(ctx, workspaceID, firebaseUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkspaceMember")
+	}
+
+	var r0 *models.TeamMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*models.TeamMember, error)); ok {
+		return rf(ctx, workspaceID, firebaseUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *models.TeamMember); ok {
+		r0 = rf(ctx, workspaceID, firebaseUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TeamMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, workspaceID, firebaseUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewTeamMemberService creates a new instance of TeamMemberService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTeamMemberService(t interface {
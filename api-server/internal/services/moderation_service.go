@@ -0,0 +1,218 @@
+package services
+
+//go:generate mockery --name=ModerationService --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pkg/events"
+)
+
+const maxTransitionReasonLength = 1000
+
+type ModerationService interface {
+	// Transition moves a testimonial to another status along the moderation state machine
+	// and records the change in the audit log.
+	Transition(ctx context.Context, workspaceID, testimonialID uuid.UUID, firebaseUID string, req models.TestimonialTransitionRequest) (*models.TestimonialTransition, error)
+	// History lists the status changes of a testimonial, oldest first.
+	History(ctx context.Context, workspaceID, testimonialID uuid.UUID, firebaseUID string) ([]models.TestimonialTransition, error)
+}
+
+type moderationService struct {
+	testimonialRepo repositories.TestimonialRepository
+	auditRepo       repositories.AuditLogRepository
+	teamMemberRepo  repositories.TeamMemberRepository
+	events          events.Publisher
+	db              *sql.DB
+	now             func() time.Time
+}
+
+// NewModerationService returns a moderation service. Status changes are broadcast as
+// testimonial.status_changed events when publisher is set.
+func NewModerationService(
+	testimonialRepo repositories.TestimonialRepository,
+	auditRepo repositories.AuditLogRepository,
+	teamMemberRepo repositories.TeamMemberRepository,
+	publisher events.Publisher,
+	db *sql.DB,
+) ModerationService {
+	return &moderationService{
+		testimonialRepo: testimonialRepo,
+		auditRepo:       auditRepo,
+		teamMemberRepo:  teamMemberRepo,
+		events:          publisher,
+		db:              db,
+		now:             time.Now,
+	}
+}
+
+func (s *moderationService) Transition(ctx context.Context, workspaceID, testimonialID uuid.UUID, firebaseUID string, req models.TestimonialTransitionRequest) (*models.TestimonialTransition, error) {
+	req.Reason = strings.TrimSpace(req.Reason)
+	if !req.Status.IsValid() {
+		return nil, fmt.Errorf("%w: unknown status %q", apperrors.ErrValidationFailed, req.Status)
+	}
+	if req.Status == models.StatusRejected && req.Reason == "" {
+		return nil, fmt.Errorf("%w: a reason is required to reject a testimonial", apperrors.ErrValidationFailed)
+	}
+	if len(req.Reason) > maxTransitionReasonLength {
+		return nil, fmt.Errorf("%w: reason must be at most %d characters", apperrors.ErrValidationFailed, maxTransitionReasonLength)
+	}
+	publishAt, err := s.publishTime(req)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := workspaceMember(ctx, s.teamMemberRepo, s.db, workspaceID, firebaseUID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+	defer tx.Rollback()
+
+	from, err := s.testimonialRepo.GetStatus(ctx, workspaceID, testimonialID, tx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: testimonial %s", apperrors.ErrNotFound, testimonialID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	roles, ok := models.TransitionRoles(from, req.Status)
+	if !ok {
+		return nil, fmt.Errorf("%w: cannot move a testimonial from %s to %s", apperrors.ErrConflict, from, req.Status)
+	}
+	if !hasRole(member.Role, roles) {
+		return nil, apperrors.ErrWorkspaceAccessDenied
+	}
+
+	// Someone else may have moved the testimonial since it was read
+	err = s.testimonialRepo.TransitionStatus(ctx, testimonialID, from, req.Status, publishAt, tx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: testimonial status changed while it was being updated", apperrors.ErrConflict)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &models.AuditLogEntry{
+		WorkspaceID: &workspaceID,
+		ActorID:     &member.ID,
+		EventType:   models.AuditEventTestimonialTransition,
+		EntityType:  models.AuditEntityTestimonial,
+		EntityID:    testimonialID,
+		Details: models.JSONMap{
+			"from":   string(from),
+			"to":     string(req.Status),
+			"reason": req.Reason,
+		},
+	}
+	if publishAt != nil {
+		entry.Details["publish_at"] = publishAt.UTC().Format(time.RFC3339)
+		entry.Details["timezone"] = req.Timezone
+	}
+	if err := s.auditRepo.Create(ctx, entry, tx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+
+	transition := transitionFromAuditEntry(entry)
+	data := map[string]any{"from": transition.From, "to": transition.To, "reason": transition.Reason}
+	if transition.PublishAt != nil {
+		data["publish_at"] = transition.PublishAt
+	}
+	broadcast(ctx, s.events, events.Event{
+		Type:        events.TestimonialStatusChanged,
+		WorkspaceID: workspaceID,
+		SubjectID:   testimonialID,
+		OccurredAt:  s.now(),
+		Data:        data,
+	})
+	return transition, nil
+}
+
+func (s *moderationService) History(ctx context.Context, workspaceID, testimonialID uuid.UUID, firebaseUID string) ([]models.TestimonialTransition, error) {
+	err := requireWorkspacePermission(ctx, s.teamMemberRepo, s.db, workspaceID, firebaseUID, models.PermTestimonialsRead)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.testimonialRepo.GetStatus(ctx, workspaceID, testimonialID, s.db); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: testimonial %s", apperrors.ErrNotFound, testimonialID)
+		}
+		return nil, err
+	}
+
+	entries, err := s.auditRepo.ListForEntity(ctx, models.AuditEntityTestimonial, testimonialID, s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	transitions := []models.TestimonialTransition{}
+	for i := range entries {
+		if entries[i].EventType == models.AuditEventTestimonialTransition {
+			transitions = append(transitions, *transitionFromAuditEntry(&entries[i]))
+		}
+	}
+	return transitions, nil
+}
+
+// publishTime validates the publish time of a transition. Only a move to scheduled takes
+// one, and it must be in the future.
+func (s *moderationService) publishTime(req models.TestimonialTransitionRequest) (*time.Time, error) {
+	if req.Status != models.StatusScheduled {
+		if req.PublishAt != "" {
+			return nil, fmt.Errorf("%w: publish_at is only accepted when scheduling a testimonial", apperrors.ErrValidationFailed)
+		}
+		return nil, nil
+	}
+
+	publishAt, err := req.PublishTime()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrValidationFailed, err)
+	}
+	if !publishAt.After(s.now()) {
+		return nil, fmt.Errorf("%w: publish_at must be in the future", apperrors.ErrValidationFailed)
+	}
+	return &publishAt, nil
+}
+
+func transitionFromAuditEntry(entry *models.AuditLogEntry) *models.TestimonialTransition {
+	from, _ := entry.Details["from"].(string)
+	to, _ := entry.Details["to"].(string)
+	reason, _ := entry.Details["reason"].(string)
+
+	var publishAt *time.Time
+	if value, _ := entry.Details["publish_at"].(string); value != "" {
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			publishAt = &t
+		}
+	}
+
+	return &models.TestimonialTransition{
+		ID:            entry.ID,
+		TestimonialID: entry.EntityID,
+		From:          models.ContentStatus(from),
+		To:            models.ContentStatus(to),
+		Reason:        reason,
+		PublishAt:     publishAt,
+		ActorID:       entry.ActorID,
+		CreatedAt:     entry.CreatedAt,
+	}
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/ifeanyidike/cenphi/pkg/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModerationService(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := uuid.New()
+	testimonialID := uuid.New()
+	editor := &models.TeamMember{ID: uuid.New(), WorkspaceID: workspaceID, Role: models.Editor}
+
+	newService := func(t *testing.T) (ModerationService, *mocks.TestimonialRepository, *mocks.AuditLogRepository, *mocks.TeamMemberRepository, *sql.DB, sqlmock.Sqlmock) {
+		db, sqlMock, err := sqlmock.New()
+		require.NoError(t, err)
+		testimonials := mocks.NewTestimonialRepository(t)
+		audit := mocks.NewAuditLogRepository(t)
+		members := mocks.NewTeamMemberRepository(t)
+		return NewModerationService(testimonials, audit, members, nil, db), testimonials, audit, members, db, sqlMock
+	}
+
+	t.Run("ApprovesAndRecordsAuditEntry", func(t *testing.T) {
+		svc, testimonials, audit, members, db, sqlMock := newService(t)
+		recorder := &recordingEvents{}
+		svc.(*moderationService).events = recorder
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "editor-uid", db).Return(editor, nil)
+		testimonials.On("GetStatus", mock.Anything, workspaceID, testimonialID, mock.Anything).Return(models.StatusPendingReview, nil)
+		testimonials.On("TransitionStatus", mock.Anything, testimonialID, models.StatusPendingReview, models.StatusApproved, (*time.Time)(nil), mock.Anything).Return(nil)
+		var entry *models.AuditLogEntry
+		audit.On("Create", mock.Anything, mock.AnythingOfType("*models.AuditLogEntry"), mock.Anything).
+			Run(func(args mock.Arguments) { entry = args.Get(1).(*models.AuditLogEntry) }).
+			Return(nil)
+
+		transition, err := svc.Transition(ctx, workspaceID, testimonialID, "editor-uid", models.TestimonialTransitionRequest{
+			Status: models.StatusApproved,
+			Reason: " Verified customer ",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, models.StatusPendingReview, transition.From)
+		assert.Equal(t, models.StatusApproved, transition.To)
+		assert.Equal(t, "Verified customer", transition.Reason)
+		assert.Equal(t, &editor.ID, transition.ActorID)
+
+		assert.Equal(t, models.AuditEventTestimonialTransition, entry.EventType)
+		assert.Equal(t, testimonialID, entry.EntityID)
+		assert.Equal(t, &workspaceID, entry.WorkspaceID)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+
+		require.Len(t, recorder.published, 1)
+		assert.Equal(t, events.TestimonialStatusChanged, recorder.published[0].Type)
+		assert.Equal(t, testimonialID, recorder.published[0].SubjectID)
+		assert.Equal(t, models.StatusApproved, recorder.published[0].Data["to"])
+	})
+
+	t.Run("RejectsTransitionsOutsideStateMachine", func(t *testing.T) {
+		svc, testimonials, _, members, db, sqlMock := newService(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectRollback()
+
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "editor-uid", db).Return(editor, nil)
+		testimonials.On("GetStatus", mock.Anything, workspaceID, testimonialID, mock.Anything).Return(models.StatusPendingReview, nil)
+
+		_, err := svc.Transition(ctx, workspaceID, testimonialID, "editor-uid", models.TestimonialTransitionRequest{Status: models.StatusFeatured})
+		assert.ErrorIs(t, err, apperrors.ErrConflict)
+	})
+
+	t.Run("ChecksRolePerTransition", func(t *testing.T) {
+		svc, testimonials, _, members, db, sqlMock := newService(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectRollback()
+
+		// Editors moderate but only owners and admins archive
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "editor-uid", db).Return(editor, nil)
+		testimonials.On("GetStatus", mock.Anything, workspaceID, testimonialID, mock.Anything).Return(models.StatusApproved, nil)
+
+		_, err := svc.Transition(ctx, workspaceID, testimonialID, "editor-uid", models.TestimonialTransitionRequest{Status: models.StatusArchived})
+		assert.ErrorIs(t, err, apperrors.ErrWorkspaceAccessDenied)
+	})
+
+	t.Run("ReportsConcurrentChange", func(t *testing.T) {
+		svc, testimonials, _, members, db, sqlMock := newService(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectRollback()
+
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "editor-uid", db).Return(editor, nil)
+		testimonials.On("GetStatus", mock.Anything, workspaceID, testimonialID, mock.Anything).Return(models.StatusPendingReview, nil)
+		testimonials.On("TransitionStatus", mock.Anything, testimonialID, models.StatusPendingReview, models.StatusApproved, (*time.Time)(nil), mock.Anything).Return(sql.ErrNoRows)
+
+		_, err := svc.Transition(ctx, workspaceID, testimonialID, "editor-uid", models.TestimonialTransitionRequest{Status: models.StatusApproved})
+		assert.ErrorIs(t, err, apperrors.ErrConflict)
+	})
+
+	t.Run("ValidatesRequest", func(t *testing.T) {
+		svc, _, _, _, _, _ := newService(t)
+
+		_, err := svc.Transition(ctx, workspaceID, testimonialID, "editor-uid", models.TestimonialTransitionRequest{Status: "published"})
+		assert.ErrorIs(t, err, apperrors.ErrValidationFailed)
+
+		_, err = svc.Transition(ctx, workspaceID, testimonialID, "editor-uid", models.TestimonialTransitionRequest{Status: models.StatusRejected})
+		assert.ErrorIs(t, err, apperrors.ErrValidationFailed)
+	})
+
+	t.Run("SchedulesInWorkspaceTimezone", func(t *testing.T) {
+		svc, testimonials, audit, members, db, sqlMock := newService(t)
+		svc.(*moderationService).now = func() time.Time { return time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC) }
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		// Berlin is on summer time by May, two hours ahead of UTC
+		publishAt := time.Date(2024, 5, 1, 7, 0, 0, 0, time.UTC)
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "editor-uid", db).Return(editor, nil)
+		testimonials.On("GetStatus", mock.Anything, workspaceID, testimonialID, mock.Anything).Return(models.StatusApproved, nil)
+		testimonials.On("TransitionStatus", mock.Anything, testimonialID, models.StatusApproved, models.StatusScheduled,
+			mock.MatchedBy(func(at *time.Time) bool { return at != nil && at.Equal(publishAt) }), mock.Anything).Return(nil)
+		audit.On("Create", mock.Anything, mock.AnythingOfType("*models.AuditLogEntry"), mock.Anything).Return(nil)
+
+		transition, err := svc.Transition(ctx, workspaceID, testimonialID, "editor-uid", models.TestimonialTransitionRequest{
+			Status:    models.StatusScheduled,
+			PublishAt: "2024-05-01T09:00",
+			Timezone:  "Europe/Berlin",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, transition.PublishAt)
+		assert.True(t, transition.PublishAt.Equal(publishAt))
+	})
+
+	t.Run("ValidatesPublishTime", func(t *testing.T) {
+		svc, _, _, _, _, _ := newService(t)
+		svc.(*moderationService).now = func() time.Time { return time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC) }
+
+		for _, req := range []models.TestimonialTransitionRequest{
+			{Status: models.StatusScheduled},
+			{Status: models.StatusScheduled, PublishAt: "2024-05-01T09:00"},
+			{Status: models.StatusScheduled, PublishAt: "2024-05-01T09:00", Timezone: "Mars/Olympus"},
+			{Status: models.StatusScheduled, PublishAt: "2024-02-01T09:00:00Z"},
+			{Status: models.StatusApproved, PublishAt: "2024-05-01T09:00:00Z"},
+		} {
+			_, err := svc.Transition(ctx, workspaceID, testimonialID, "editor-uid", req)
+			assert.ErrorIs(t, err, apperrors.ErrValidationFailed, "%+v", req)
+		}
+	})
+
+	t.Run("HistoryListsTransitions", func(t *testing.T) {
+		svc, testimonials, audit, members, db, _ := newService(t)
+
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "viewer-uid", db).Return(&models.TeamMember{Role: models.Viewer}, nil)
+		testimonials.On("GetStatus", mock.Anything, workspaceID, testimonialID, db).Return(models.StatusApproved, nil)
+		audit.On("ListForEntity", mock.Anything, models.AuditEntityTestimonial, testimonialID, db).Return([]models.AuditLogEntry{
+			{EventType: models.AuditEventTestimonialTransition, EntityID: testimonialID, ActorID: &editor.ID, Details: models.JSONMap{"from": "pending_review", "to": "approved"}},
+			{EventType: "testimonial_exported", EntityID: testimonialID},
+		}, nil)
+
+		transitions, err := svc.History(ctx, workspaceID, testimonialID, "viewer-uid")
+		require.NoError(t, err)
+		require.Len(t, transitions, 1)
+		assert.Equal(t, models.StatusPendingReview, transitions[0].From)
+		assert.Equal(t, models.StatusApproved, transitions[0].To)
+	})
+}
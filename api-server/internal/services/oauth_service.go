@@ -41,6 +41,15 @@ func NewOAuthService(redisClient *redis.Client, callbackURL string, cfg *config.
 			Scopes:       []string{"https://www.googleapis.com/auth/business.manage"},
 			Endpoint:     google.Endpoint,
 		},
+
+		// Instagram business accounts are managed through Facebook Login
+		"instagram": {
+			ClientID:     cfg.Providers.Facebook.ClientID,
+			ClientSecret: cfg.Providers.Facebook.ClientSecret,
+			RedirectURL:  callbackURL + "/instagram",
+			Scopes:       []string{"instagram_basic", "instagram_manage_comments", "pages_show_list"},
+			Endpoint:     facebook.Endpoint,
+		},
 	}
 
 	return &oauthService{
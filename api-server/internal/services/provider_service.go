@@ -3,8 +3,12 @@ package services
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log/slog"
+	"os"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,45 +17,87 @@ import (
 	"github.com/ifeanyidike/cenphi/internal/models"
 	"github.com/ifeanyidike/cenphi/internal/providers"
 	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pkg/events"
+	"github.com/ifeanyidike/cenphi/pkg/lease"
 	"github.com/ifeanyidike/cenphi/pkg/ratelimit"
 	"github.com/robfig/cron/v3"
 )
 
+const (
+	// syncLeaseMargin is how much earlier than the next tick a sync lease expires. Replicas
+	// whose clocks disagree by less than this still run each tick once.
+	syncLeaseMargin = time.Minute
+	defaultRunLimit = 50
+
+	// scheduleReconcileInterval is how often each replica reloads the active configs, so
+	// that configs created, changed or re-activated on another replica get scheduled.
+	scheduleReconcileInterval = 5 * time.Minute
+
+	// fullScanInterval controls how often an incremental provider walks its whole
+	// history so deletions upstream can be detected.
+	fullScanInterval = 24 * time.Hour
+)
+
 type ProviderService struct {
 	providers   map[string]providers.Provider
+	registry    *providers.Registry
 	limiter     *ratelimit.RedisLimiter
+	lease       *lease.RedisLease
 	scheduler   *cron.Cron
-	cronEntries map[string]cron.EntryID
+	mu          sync.Mutex
+	cronEntries map[string]scheduledSync
+	reconciling bool
+	instanceID  string
 
 	providerRepo     repositories.ProviderConfigRepository
 	profileRepo      repositories.CustomerProfileRepository
 	testimonialRepo  repositories.TestimonialRepository
+	syncRunRepo      repositories.SyncRunRepository
+	cursorRepo       repositories.SyncCursorRepository
 	oauthService     contracts.OAuthService
 	sentimentService contracts.SentimentService
+	authenticity     AuthenticityService
+	entitlements     EntitlementService
+	events           events.Publisher
 	db               *sql.DB
 }
 
 func NewProviderService(
 	prs []providers.Provider,
+	registry *providers.Registry,
 	limiter *ratelimit.RedisLimiter,
+	syncLease *lease.RedisLease,
 	testimonialRepo repositories.TestimonialRepository,
 	profileRepo repositories.CustomerProfileRepository,
 	providerRepo repositories.ProviderConfigRepository,
+	syncRunRepo repositories.SyncRunRepository,
+	cursorRepo repositories.SyncCursorRepository,
 	oauthService contracts.OAuthService,
 	sentimentService contracts.SentimentService,
+	authenticity AuthenticityService,
+	entitlements EntitlementService,
+	publisher events.Publisher,
 	db *sql.DB,
 ) *ProviderService {
 	ps := &ProviderService{
+		registry:         registry,
 		limiter:          limiter,
+		lease:            syncLease,
 		testimonialRepo:  testimonialRepo,
 		profileRepo:      profileRepo,
 		providerRepo:     providerRepo,
+		syncRunRepo:      syncRunRepo,
+		cursorRepo:       cursorRepo,
 		oauthService:     oauthService,
 		sentimentService: sentimentService,
+		authenticity:     authenticity,
+		entitlements:     entitlements,
+		events:           publisher,
 		db:               db,
 		providers:        make(map[string]providers.Provider),
 		scheduler:        cron.New(),
-		cronEntries:      make(map[string]cron.EntryID),
+		cronEntries:      make(map[string]scheduledSync),
+		instanceID:       newInstanceID(),
 	}
 
 	for _, p := range prs {
@@ -59,66 +105,235 @@ func NewProviderService(
 			slog.Warn("provider not configured, skipping", "provider", p.Name())
 			continue
 		}
-
-		currentProvider := p
-
-		entryID, err := ps.scheduler.AddFunc(p.Schedule(), func() {
-			ctx := context.Background()
-			slog.Info("starting scheduled sync", "provider", currentProvider.Name())
-			if err := ps.SyncProvider(ctx, currentProvider.Name()); err != nil {
-				slog.Error("scheduled sync failed",
-					"provider", currentProvider.Name(),
-					"error", err)
-			}
-		})
-
-		if err != nil {
-			slog.Error("failed to schedule provider",
-				"provider", p.Name(),
-				"schedule", p.Schedule(),
-				"error", err)
-			continue
-		}
-
 		ps.providers[p.Name()] = p
-		ps.cronEntries[p.Name()] = entryID
 	}
 
 	ps.scheduler.Start()
 	return ps
 }
 
+var (
+	errConfigInactive  = errors.New("provider config is no longer active")
+	errScheduleChanged = errors.New("provider schedule changed; syncing from the next tick of the new schedule")
+)
+
+func newInstanceID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s-%s", hostname, uuid.NewString()[:8])
+}
+
+// SyncProvider runs a sync for every active workspace configured with the provider.
 func (ps *ProviderService) SyncProvider(ctx context.Context, name string) error {
-	provider, ok := ps.providers[name]
-	if !ok {
+	if _, ok := ps.providers[name]; !ok {
 		return apperrors.ErrProviderNotFound
 	}
 
-	allowed, err := ps.limiter.Allow(ctx, name, provider.RateLimit(), provider.RateWindow())
-	if err != nil || !allowed {
-		return apperrors.ErrRateLimited
+	configs, err := ps.providerRepo.GetByProvider(ctx, name, ps.db)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, config := range configs {
+		if !config.IsActive {
+			continue
+		}
+		if _, err := ps.runWorkspaceSync(ctx, config, models.SyncTriggerManual); err != nil {
+			errs = append(errs, fmt.Errorf("workspace %s: %w", config.WorkspaceID, err))
+		}
 	}
+	return errors.Join(errs...)
+}
 
-	testimonials, err := provider.Fetch(ctx, "", uuid.New())
+// runWorkspaceSync fetches and stores testimonials for a single workspace config and
+// records the outcome as a sync run.
+func (ps *ProviderService) runWorkspaceSync(ctx context.Context, config models.ProviderConfig, trigger models.SyncTrigger) (*models.SyncRun, error) {
+	run := ps.startRun(ctx, config.WorkspaceID, config.ProviderName, trigger)
+
+	provider, err := ps.workspaceProvider(config)
 	if err != nil {
-		return err
+		ps.finishRun(ctx, run, 0, err)
+		return run, err
+	}
+	if err := ps.checkTestimonialQuota(ctx, config.WorkspaceID); err != nil {
+		ps.finishRun(ctx, run, 0, err)
+		return run, err
 	}
 
-	if err := ps.testimonialRepo.BatchUpsert(ctx, testimonials, ps.db); err != nil {
-		return fmt.Errorf("batch upsert failed: %w", err)
+	allowed, err := ps.limiter.Allow(ctx, config.ProviderName, provider.RateLimit(), provider.RateWindow())
+	if err != nil || !allowed {
+		ps.finishRun(ctx, run, 0, apperrors.ErrRateLimited)
+		return run, apperrors.ErrRateLimited
 	}
 
-	slog.Info("successfully synced provider",
-		"provider", name,
+	var testimonials []models.Testimonial
+	if incremental, ok := provider.(providers.IncrementalProvider); ok {
+		testimonials, err = ps.syncIncremental(ctx, incremental, config)
+	} else {
+		testimonials, err = ps.syncFull(ctx, provider, config)
+	}
+	ps.finishRun(ctx, run, len(testimonials), err)
+	if err != nil {
+		return run, err
+	}
+
+	slog.Info("successfully synced workspace provider",
+		"provider", config.ProviderName,
+		"workspace", config.WorkspaceID,
 		"testimonials", len(testimonials))
-	return nil
+	return run, nil
+}
+
+func (ps *ProviderService) syncFull(ctx context.Context, provider providers.Provider, config models.ProviderConfig) ([]models.Testimonial, error) {
+	testimonials, err := provider.Fetch(ctx, config.UserID, config.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	inserted, err := ps.testimonialRepo.BatchUpsert(ctx, testimonials, ps.db)
+	if err != nil {
+		return testimonials, fmt.Errorf("batch upsert failed: %w", err)
+	}
+	ps.recordSentiment(ctx, testimonials)
+	ps.scheduleAuthenticity(ctx, inserted)
+	broadcastCreated(ctx, ps.events, testimonials, inserted)
+	return testimonials, nil
+}
+
+// syncIncremental resumes from the stored cursor, archives testimonials that were
+// removed upstream and only then advances the cursor.
+func (ps *ProviderService) syncIncremental(ctx context.Context, provider providers.IncrementalProvider, config models.ProviderConfig) ([]models.Testimonial, error) {
+	cursor, err := ps.cursorRepo.Get(ctx, config.WorkspaceID, config.ProviderName, ps.db)
+	if err != nil {
+		return nil, err
+	}
+
+	fullScan := cursor.LastFullScanAt == nil || time.Since(*cursor.LastFullScanAt) > fullScanInterval
+
+	result, err := provider.FetchSince(ctx, config.UserID, config.WorkspaceID, *cursor, fullScan)
+	if err != nil {
+		return nil, err
+	}
+
+	inserted, err := ps.testimonialRepo.BatchUpsert(ctx, result.Testimonials, ps.db)
+	if err != nil {
+		return result.Testimonials, fmt.Errorf("batch upsert failed: %w", err)
+	}
+	ps.recordSentiment(ctx, result.Testimonials)
+	ps.scheduleAuthenticity(ctx, inserted)
+	broadcastCreated(ctx, ps.events, result.Testimonials, inserted)
+
+	var archived int64
+	if len(result.DeletedIDs) > 0 {
+		n, err := ps.testimonialRepo.ArchiveBySourceIDs(ctx, config.WorkspaceID, config.ProviderName, result.DeletedIDs, ps.db)
+		if err != nil {
+			return result.Testimonials, err
+		}
+		archived += n
+	}
+
+	// An empty listing is more likely a token or API problem than every review being
+	// deleted, so it is never used to archive.
+	if fullScan && len(result.LiveIDs) > 0 {
+		n, err := ps.testimonialRepo.ArchiveMissingFromSource(ctx, config.WorkspaceID, config.ProviderName, result.LiveIDs, ps.db)
+		if err != nil {
+			return result.Testimonials, err
+		}
+		archived += n
+	}
+
+	next := result.Cursor
+	next.WorkspaceID = config.WorkspaceID
+	next.ProviderName = config.ProviderName
+	next.LastFullScanAt = nil
+	if fullScan && result.LiveIDs != nil {
+		now := time.Now()
+		next.LastFullScanAt = &now
+	}
+	if err := ps.cursorRepo.Save(ctx, &next, ps.db); err != nil {
+		return result.Testimonials, err
+	}
+
+	if archived > 0 {
+		slog.Info("archived testimonials deleted upstream",
+			"provider", config.ProviderName,
+			"workspace", config.WorkspaceID,
+			"archived", archived)
+	}
+	return result.Testimonials, nil
+}
+
+func (ps *ProviderService) startRun(ctx context.Context, workspaceID uuid.UUID, providerName string, trigger models.SyncTrigger) *models.SyncRun {
+	run := &models.SyncRun{
+		ID:           uuid.New(),
+		WorkspaceID:  workspaceID,
+		ProviderName: providerName,
+		Trigger:      trigger,
+		Status:       models.SyncRunRunning,
+		InstanceID:   ps.instanceID,
+		StartedAt:    time.Now(),
+	}
+
+	// A failure to record history must never block the sync itself.
+	if err := ps.syncRunRepo.Create(ctx, run, ps.db); err != nil {
+		slog.Error("failed to record sync run", "provider", providerName, "workspace", workspaceID, "error", err)
+	}
+	return run
+}
+
+func (ps *ProviderService) finishRun(ctx context.Context, run *models.SyncRun, fetched int, runErr error) {
+	finishedAt := time.Now()
+	run.FinishedAt = &finishedAt
+	run.TestimonialsFetched = fetched
+
+	switch {
+	case runErr == nil:
+		run.Status = models.SyncRunSucceeded
+	case errors.Is(runErr, apperrors.ErrRateLimited), errors.Is(runErr, errConfigInactive), errors.Is(runErr, errScheduleChanged):
+		run.Status = models.SyncRunSkipped
+		run.Error = runErr.Error()
+	default:
+		run.Status = models.SyncRunFailed
+		run.Error = runErr.Error()
+	}
+
+	if err := ps.syncRunRepo.Finish(ctx, run, ps.db); err != nil {
+		slog.Error("failed to finish sync run", "run", run.ID, "error", err)
+	}
+
+	if run.Status == models.SyncRunFailed {
+		broadcast(ctx, ps.events, events.Event{
+			Type:        events.SyncFailed,
+			WorkspaceID: run.WorkspaceID,
+			SubjectID:   run.ID,
+			OccurredAt:  finishedAt,
+			Data: map[string]any{
+				"provider": run.ProviderName,
+				"trigger":  run.Trigger,
+				"error":    run.Error,
+			},
+		})
+	}
+}
+
+func (ps *ProviderService) GetSyncRuns(ctx context.Context, workspaceID uuid.UUID, limit int) ([]models.SyncRun, error) {
+	if limit <= 0 {
+		limit = defaultRunLimit
+	}
+	return ps.syncRunRepo.GetByWorkspace(ctx, workspaceID, limit, ps.db)
 }
 
 func (ps *ProviderService) Stop() context.Context {
 	ctx := ps.scheduler.Stop()
 
-	for _, entryID := range ps.cronEntries {
-		ps.scheduler.Remove(entryID)
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	for jobID, entry := range ps.cronEntries {
+		ps.scheduler.Remove(entry.entryID)
+		delete(ps.cronEntries, jobID)
 	}
 	return ctx
 }
@@ -131,8 +346,8 @@ func (ps *ProviderService) GetProviders() []string {
 	return names
 }
 
-// Add this to your services/provider_service.go
-
+// FetchWithCredentials fetches and stores testimonials for a workspace using the
+// credentials it supplied, recording the fetch as a manual sync run.
 func (ps *ProviderService) FetchWithCredentials(
 	ctx context.Context,
 	providerName string,
@@ -140,50 +355,34 @@ func (ps *ProviderService) FetchWithCredentials(
 	workspaceID uuid.UUID,
 	credentials map[string]string,
 ) ([]models.Testimonial, error) {
-	// Find the provider
-	provider, ok := ps.providers[providerName]
-	if !ok {
-		return nil, apperrors.ErrProviderNotFound
+	run := ps.startRun(ctx, workspaceID, providerName, models.SyncTriggerManual)
+	testimonials, err := ps.fetchWithCredentials(ctx, providerName, userID, workspaceID, credentials)
+	ps.finishRun(ctx, run, len(testimonials), err)
+	return testimonials, err
+}
+
+func (ps *ProviderService) fetchWithCredentials(
+	ctx context.Context,
+	providerName string,
+	userID string,
+	workspaceID uuid.UUID,
+	credentials map[string]string,
+) ([]models.Testimonial, error) {
+	// Create a temporary provider with the user's credentials
+	tempProvider, err := ps.providerFromCredentials(providerName, credentials)
+	if err != nil {
+		return nil, err
+	}
+	if err := ps.checkTestimonialQuota(ctx, workspaceID); err != nil {
+		return nil, err
 	}
 
 	// Use rate limiting
-	allowed, err := ps.limiter.Allow(ctx, providerName, provider.RateLimit(), provider.RateWindow())
+	allowed, err := ps.limiter.Allow(ctx, providerName, tempProvider.RateLimit(), tempProvider.RateWindow())
 	if err != nil || !allowed {
 		return nil, apperrors.ErrRateLimited
 	}
 
-	// Create a temporary provider with the user's credentials
-	var tempProvider providers.Provider
-
-	switch providerName {
-	case "facebook":
-		clientID, ok := credentials["clientID"]
-		if !ok {
-			return nil, fmt.Errorf("missing client id")
-		}
-		clientSecret, ok := credentials["clientSecret"]
-		if !ok {
-			return nil, fmt.Errorf("missing client secret")
-		}
-
-		tempProvider = providers.NewFacebookProvider(
-			clientID,
-			clientSecret,
-			ps.oauthService,
-			ps.sentimentService,
-			ps.profileRepo,
-			ps.db,
-		)
-	// Similar cases for other providers
-	default:
-		return nil, fmt.Errorf("unsupported provider: %s", providerName)
-	}
-
-	// Check if provider is properly configured
-	if !tempProvider.IsConfigured() {
-		return nil, fmt.Errorf("provider not properly configured")
-	}
-
 	// Fetch testimonials using the temporary provider
 	testimonials, err := tempProvider.Fetch(ctx, userID, workspaceID)
 	if err != nil {
@@ -191,13 +390,104 @@ func (ps *ProviderService) FetchWithCredentials(
 	}
 
 	// Store in database
-	if err := ps.testimonialRepo.BatchUpsert(ctx, testimonials, ps.db); err != nil {
+	inserted, err := ps.testimonialRepo.BatchUpsert(ctx, testimonials, ps.db)
+	if err != nil {
 		return nil, fmt.Errorf("batch upsert failed: %w", err)
 	}
+	ps.recordSentiment(ctx, testimonials)
+	ps.scheduleAuthenticity(ctx, inserted)
+	broadcastCreated(ctx, ps.events, testimonials, inserted)
 
 	return testimonials, nil
 }
 
+// checkTestimonialQuota stops a sync before it fetches anything once the workspace has
+// stored every testimonial its plan allows this month. A sync that starts with room
+// left stores everything it fetches, since some of it may only update testimonials
+// that are already stored.
+func (ps *ProviderService) checkTestimonialQuota(ctx context.Context, workspaceID uuid.UUID) error {
+	if ps.entitlements == nil {
+		return nil
+	}
+	return ps.entitlements.Check(ctx, workspaceID, models.QuotaTestimonials, 1, ps.db)
+}
+
+// checkProviderQuota fails when connecting the provider would take the workspace past
+// the number of providers its plan allows. Reconfiguring a connected provider is
+// always allowed.
+func (ps *ProviderService) checkProviderQuota(ctx context.Context, config models.ProviderConfig) error {
+	if ps.entitlements == nil {
+		return nil
+	}
+	configs, err := ps.providerRepo.GetByWorkspace(ctx, config.WorkspaceID, ps.db)
+	if err != nil {
+		return err
+	}
+	for _, existing := range configs {
+		if existing.ProviderName == config.ProviderName {
+			return nil
+		}
+	}
+	return ps.entitlements.Check(ctx, config.WorkspaceID, models.QuotaProviders, 1, ps.db)
+}
+
+// recordSentiment stores the sentiment breakdown of imported testimonials. A failure
+// only loses the breakdown, so it does not fail the sync.
+func (ps *ProviderService) recordSentiment(ctx context.Context, testimonials []models.Testimonial) {
+	if ps.sentimentService == nil {
+		return
+	}
+	for _, t := range testimonials {
+		if t.ID == uuid.Nil || strings.TrimSpace(t.Content) == "" {
+			continue
+		}
+		if err := ps.sentimentService.RecordAnalysis(ctx, t.ID, t.Content); err != nil {
+			slog.Warn("failed to record testimonial sentiment", "testimonial", t.ID, "error", err)
+		}
+	}
+}
+
+// scheduleAuthenticity queues authenticity checks for testimonials imported for the first
+// time. Like the sentiment breakdown, a failure does not fail the sync.
+func (ps *ProviderService) scheduleAuthenticity(ctx context.Context, inserted []uuid.UUID) {
+	if ps.authenticity == nil || len(inserted) == 0 {
+		return
+	}
+	if err := ps.authenticity.Schedule(ctx, inserted...); err != nil {
+		slog.Warn("failed to schedule authenticity checks", "testimonials", len(inserted), "error", err)
+	}
+}
+
+// providerFromCredentials builds a provider for a single workspace from the
+// credentials it connected with, using the factory registered for it.
+func (ps *ProviderService) providerFromCredentials(providerName string, credentials map[string]string) (providers.Provider, error) {
+	return ps.registry.Build(providerName, credentials, providers.Dependencies{
+		OAuthService:        ps.oauthService,
+		SentimentService:    ps.sentimentService,
+		CustomerProfileRepo: ps.profileRepo,
+		DB:                  ps.db,
+	})
+}
+
+// AvailableProviders lists every provider a workspace can connect.
+func (ps *ProviderService) AvailableProviders() []providers.Definition {
+	return ps.registry.List()
+}
+
+// workspaceProvider returns the provider a workspace config should sync with: one built
+// from its own credentials when it has them, otherwise the globally configured one.
+func (ps *ProviderService) workspaceProvider(config models.ProviderConfig) (providers.Provider, error) {
+	if len(config.Credentials) > 0 {
+		return ps.providerFromCredentials(config.ProviderName, config.Credentials)
+	}
+
+	provider, ok := ps.providers[config.ProviderName]
+	if !ok {
+		return nil, apperrors.ErrProviderNotFound
+	}
+	return provider, nil
+}
+
 // Add these methods to your provider_service.go
 func (ps *ProviderService) ConfigureProvider(ctx context.Context, config models.ProviderConfig) error {
 	// Generate ID if not present
@@ -212,9 +502,18 @@ func (ps *ProviderService) ConfigureProvider(ctx context.Context, config models.
 	}
 	config.UpdatedAt = now
 
-	// Validate provider exists
-	if _, ok := ps.providers[config.ProviderName]; !ok {
-		return apperrors.ErrProviderNotFound
+	// Validate the provider can be built for this workspace
+	if _, err := ps.workspaceProvider(config); err != nil {
+		return err
+	}
+	if err := ps.checkProviderQuota(ctx, config); err != nil {
+		return err
+	}
+
+	if config.Schedule == "" {
+		if def, ok := ps.registry.Get(config.ProviderName); ok {
+			config.Schedule = def.DefaultSchedule
+		}
 	}
 
 	// Save configuration to database
@@ -222,75 +521,225 @@ func (ps *ProviderService) ConfigureProvider(ctx context.Context, config models.
 		return err
 	}
 
-	// If active, schedule it
+	// If active, schedule it, otherwise stop any sync scheduled before
 	if config.IsActive {
 		return ps.scheduleWorkspaceProvider(ctx, config)
 	}
+	ps.unscheduleWorkspaceProvider(config)
 
 	return nil
 }
 
+// scheduledSync is the cron entry of a workspace config and the schedule it was added with.
+type scheduledSync struct {
+	entryID  cron.EntryID
+	schedule string
+}
+
+func workspaceProviderJobID(config models.ProviderConfig) string {
+	return fmt.Sprintf("%s-%s", config.ProviderName, config.WorkspaceID.String())
+}
+
 func (ps *ProviderService) scheduleWorkspaceProvider(ctx context.Context, config models.ProviderConfig) error {
 	// Create a unique ID for this scheduled job
-	jobID := fmt.Sprintf("%s-%s", config.ProviderName, config.WorkspaceID.String())
+	jobID := workspaceProviderJobID(config)
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
 
 	// Remove existing cron entry if it exists
-	if entryID, exists := ps.cronEntries[jobID]; exists {
-		ps.scheduler.Remove(entryID)
+	if entry, exists := ps.cronEntries[jobID]; exists {
+		ps.scheduler.Remove(entry.entryID)
+		delete(ps.cronEntries, jobID)
 	}
 
-	// Add new cron job
 	entryID, err := ps.scheduler.AddFunc(config.Schedule, func() {
-		// Create context for the job
-		jobCtx := context.Background()
+		ps.runScheduledSync(jobID, config)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to schedule workspace provider: %w", err)
+	}
+
+	ps.cronEntries[jobID] = scheduledSync{entryID: entryID, schedule: config.Schedule}
+	return nil
+}
 
-		// Use the userID from the config
-		userID := config.UserID // This would need to be added to your ProviderConfig model
+// unscheduleWorkspaceProvider stops the scheduled sync of a workspace config, if any.
+func (ps *ProviderService) unscheduleWorkspaceProvider(config models.ProviderConfig) {
+	jobID := workspaceProviderJobID(config)
 
-		// Get the base provider
-		baseProvider, ok := ps.providers[config.ProviderName]
-		if !ok {
-			slog.Error("provider not found in scheduled job", "provider", config.ProviderName)
-			return
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	if entry, exists := ps.cronEntries[jobID]; exists {
+		ps.scheduler.Remove(entry.entryID)
+		delete(ps.cronEntries, jobID)
+	}
+}
+
+// currentConfig reloads a scheduled config from the database, so that syncs use the
+// latest credentials and stop on replicas other than the one that deactivated it. It
+// returns nil when the config is gone or inactive.
+func (ps *ProviderService) currentConfig(ctx context.Context, config models.ProviderConfig) (*models.ProviderConfig, error) {
+	configs, err := ps.providerRepo.GetByWorkspace(ctx, config.WorkspaceID, ps.db)
+	if err != nil {
+		return nil, err
+	}
+	for _, current := range configs {
+		if current.ProviderName == config.ProviderName && current.IsActive {
+			return &current, nil
 		}
+	}
+	return nil, nil
+}
+
+// runScheduledSync is invoked by cron on every replica. Each tick is claimed through
+// a Redis lease so that only one replica performs the sync. The lease is keyed on the
+// job alone and held until just before the next tick, so replicas whose clocks put the
+// tick in different minutes still see each other's claim.
+func (ps *ProviderService) runScheduledSync(jobID string, config models.ProviderConfig) {
+	ctx := context.Background()
 
-		// Check rate limits
-		allowed, err := ps.limiter.Allow(jobCtx, config.ProviderName, baseProvider.RateLimit(), baseProvider.RateWindow())
-		if err != nil || !allowed {
-			slog.Error("rate limited in scheduled job", "provider", config.ProviderName)
-			return
+	current, err := ps.currentConfig(ctx, config)
+	if err != nil {
+		slog.Error("failed to reload provider config", "job", jobID, "error", err)
+		ps.recordUnrunSync(ctx, config, fmt.Errorf("failed to reload provider config: %w", err))
+		return
+	}
+	if current == nil {
+		slog.Info("provider config no longer active, unscheduling", "job", jobID)
+		ps.unscheduleWorkspaceProvider(config)
+		ps.recordUnrunSync(ctx, config, errConfigInactive)
+		return
+	}
+	if current.Schedule != config.Schedule {
+		// The schedule was changed on another replica; follow it from the next tick
+		slog.Info("provider schedule changed, rescheduling", "job", jobID, "schedule", current.Schedule)
+		if err := ps.scheduleWorkspaceProvider(ctx, *current); err != nil {
+			slog.Error("failed to reschedule provider", "job", jobID, "error", err)
 		}
+		ps.recordUnrunSync(ctx, config, errScheduleChanged)
+		return
+	}
+	config = *current
 
-		// Fetch testimonials directly using the provider with the workspace context
-		testimonials, err := baseProvider.Fetch(jobCtx, userID, config.WorkspaceID)
-		if err != nil {
-			slog.Error("failed to fetch testimonials in scheduled job",
+	leaseKey := "sync:lease:" + jobID
+
+	acquired, err := ps.lease.Acquire(ctx, leaseKey, ps.instanceID, syncLeaseTTL(config.Schedule, time.Now()))
+	if err != nil {
+		slog.Error("failed to acquire sync lease", "job", jobID, "error", err)
+		ps.recordUnrunSync(ctx, config, fmt.Errorf("failed to acquire sync lease: %w", err))
+		return
+	}
+	if !acquired {
+		slog.Debug("sync tick claimed by another instance", "job", jobID)
+		return
+	}
+
+	if _, err := ps.runWorkspaceSync(ctx, config, models.SyncTriggerScheduled); err != nil {
+		slog.Error("scheduled sync failed",
+			"provider", config.ProviderName,
+			"workspace", config.WorkspaceID,
+			"error", err)
+	}
+}
+
+// recordUnrunSync records a scheduled sync that did not run, so the run history shows
+// why. A tick lost to another replica is not recorded, since that replica records it.
+func (ps *ProviderService) recordUnrunSync(ctx context.Context, config models.ProviderConfig, reason error) {
+	ps.finishRun(ctx, ps.startRun(ctx, config.WorkspaceID, config.ProviderName, models.SyncTriggerScheduled), 0, reason)
+}
+
+// syncLeaseTTL returns how long a sync started at now stays claimed: until
+// syncLeaseMargin before the schedule's next tick, or half the interval for schedules
+// that run more often than that allows.
+func syncLeaseTTL(schedule string, now time.Time) time.Duration {
+	sched, err := cron.ParseStandard(schedule)
+	if err != nil {
+		// The schedule was accepted by the scheduler, so this does not happen in practice
+		return syncLeaseMargin
+	}
+	interval := sched.Next(now).Sub(now)
+	if interval <= 2*syncLeaseMargin {
+		return interval / 2
+	}
+	return interval - syncLeaseMargin
+}
+
+// RestoreSchedules schedules every active workspace configuration from the database,
+// so configured syncs survive a restart, and keeps reconciling them every
+// scheduleReconcileInterval. Configs are saved on one replica but scheduled on all of
+// them, so this is how the others pick up new, changed and re-activated configs.
+func (ps *ProviderService) RestoreSchedules(ctx context.Context) error {
+	if err := ps.reconcileSchedules(ctx); err != nil {
+		return err
+	}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if !ps.reconciling {
+		ps.scheduler.Schedule(cron.Every(scheduleReconcileInterval), cron.FuncJob(func() {
+			if err := ps.reconcileSchedules(context.Background()); err != nil {
+				slog.Error("failed to reconcile provider schedules", "error", err)
+			}
+		}))
+		ps.reconciling = true
+	}
+	return nil
+}
+
+// reconcileSchedules makes the scheduled syncs of this replica match the active
+// configs: it schedules new ones, reschedules changed ones and drops the rest.
+func (ps *ProviderService) reconcileSchedules(ctx context.Context) error {
+	configs, err := ps.providerRepo.GetActive(ctx, ps.db)
+	if err != nil {
+		return fmt.Errorf("failed to load active provider configs: %w", err)
+	}
+
+	active := make(map[string]bool, len(configs))
+	scheduled := 0
+	for _, config := range configs {
+		if _, err := ps.workspaceProvider(config); err != nil {
+			slog.Warn("skipping config for unavailable provider",
 				"provider", config.ProviderName,
 				"workspace", config.WorkspaceID,
 				"error", err)
-			return
+			continue
 		}
+		jobID := workspaceProviderJobID(config)
+		active[jobID] = true
 
-		// Save testimonials
-		if err := ps.testimonialRepo.BatchUpsert(jobCtx, testimonials, ps.db); err != nil {
-			slog.Error("failed to save testimonials in scheduled job",
+		ps.mu.Lock()
+		entry, exists := ps.cronEntries[jobID]
+		ps.mu.Unlock()
+		if exists && entry.schedule == config.Schedule {
+			continue
+		}
+
+		if err := ps.scheduleWorkspaceProvider(ctx, config); err != nil {
+			slog.Error("failed to schedule provider",
 				"provider", config.ProviderName,
 				"workspace", config.WorkspaceID,
 				"error", err)
-			return
+			continue
 		}
+		scheduled++
+	}
 
-		slog.Info("successfully synced workspace provider",
-			"provider", config.ProviderName,
-			"workspace", config.WorkspaceID,
-			"testimonials", len(testimonials))
-	})
-
-	if err != nil {
-		return fmt.Errorf("failed to schedule workspace provider: %w", err)
+	ps.mu.Lock()
+	removed := 0
+	for jobID, entry := range ps.cronEntries {
+		if !active[jobID] {
+			ps.scheduler.Remove(entry.entryID)
+			delete(ps.cronEntries, jobID)
+			removed++
+		}
 	}
+	ps.mu.Unlock()
 
-	ps.cronEntries[jobID] = entryID
+	if scheduled > 0 || removed > 0 {
+		slog.Info("reconciled workspace provider schedules", "scheduled", scheduled, "removed", removed)
+	}
 	return nil
 }
 
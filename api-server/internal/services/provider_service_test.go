@@ -0,0 +1,274 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/providers"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/ifeanyidike/cenphi/pkg/lease"
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubIncrementalProvider records the cursor it was called with and returns a canned result.
+type stubIncrementalProvider struct {
+	result       *providers.FetchResult
+	lastCursor   models.SyncCursor
+	lastFullScan bool
+}
+
+func (p *stubIncrementalProvider) Name() string              { return "facebook" }
+func (p *stubIncrementalProvider) RateLimit() int            { return 100 }
+func (p *stubIncrementalProvider) RateWindow() time.Duration { return time.Hour }
+func (p *stubIncrementalProvider) Schedule() string          { return "@hourly" }
+func (p *stubIncrementalProvider) IsConfigured() bool        { return true }
+func (p *stubIncrementalProvider) Fetch(ctx context.Context, userID string, workspaceID uuid.UUID) ([]models.Testimonial, error) {
+	return p.result.Testimonials, nil
+}
+
+func (p *stubIncrementalProvider) FetchSince(ctx context.Context, userID string, workspaceID uuid.UUID, cursor models.SyncCursor, fullScan bool) (*providers.FetchResult, error) {
+	p.lastCursor = cursor
+	p.lastFullScan = fullScan
+	return p.result, nil
+}
+
+func TestProviderServiceSyncIncremental(t *testing.T) {
+	db, _, _ := sqlmock.New()
+	workspaceID := uuid.New()
+	config := models.ProviderConfig{WorkspaceID: workspaceID, ProviderName: "facebook", UserID: "user-1"}
+	highWater := time.Now().Add(-time.Minute).Truncate(time.Second)
+
+	t.Run("FullScanArchivesMissingReviews", func(t *testing.T) {
+		testimonialRepo := &mocks.TestimonialRepository{}
+		cursorRepo := &mocks.SyncCursorRepository{}
+		authenticity := &recordingAuthenticity{}
+		ps := &ProviderService{testimonialRepo: testimonialRepo, cursorRepo: cursorRepo, authenticity: authenticity, db: db}
+
+		provider := &stubIncrementalProvider{result: &providers.FetchResult{
+			Testimonials: []models.Testimonial{{WorkspaceID: workspaceID}},
+			Cursor:       models.SyncCursor{Since: highWater},
+			LiveIDs:      []string{"r1", "r2"},
+		}}
+
+		cursorRepo.On("Get", mock.Anything, workspaceID, "facebook", db).
+			Return(&models.SyncCursor{WorkspaceID: workspaceID, ProviderName: "facebook"}, nil)
+		insertedID := uuid.New()
+		testimonialRepo.On("BatchUpsert", mock.Anything, provider.result.Testimonials, db).Return([]uuid.UUID{insertedID}, nil)
+		testimonialRepo.On("ArchiveMissingFromSource", mock.Anything, workspaceID, "facebook", []string{"r1", "r2"}, db).
+			Return(int64(1), nil)
+		cursorRepo.On("Save", mock.Anything, mock.MatchedBy(func(c *models.SyncCursor) bool {
+			return c.Since.Equal(highWater) && c.LastFullScanAt != nil && c.WorkspaceID == workspaceID
+		}), db).Return(nil)
+
+		testimonials, err := ps.syncIncremental(context.Background(), provider, config)
+		require.NoError(t, err)
+		assert.Len(t, testimonials, 1)
+		assert.True(t, provider.lastFullScan)
+		assert.Equal(t, []uuid.UUID{insertedID}, authenticity.scheduled)
+		testimonialRepo.AssertExpectations(t)
+		cursorRepo.AssertExpectations(t)
+	})
+
+	t.Run("IncrementalResumesFromCursor", func(t *testing.T) {
+		testimonialRepo := &mocks.TestimonialRepository{}
+		cursorRepo := &mocks.SyncCursorRepository{}
+		ps := &ProviderService{testimonialRepo: testimonialRepo, cursorRepo: cursorRepo, db: db}
+
+		lastFullScan := time.Now().Add(-time.Hour)
+		stored := &models.SyncCursor{
+			WorkspaceID:    workspaceID,
+			ProviderName:   "facebook",
+			Since:          highWater.Add(-time.Hour),
+			LastFullScanAt: &lastFullScan,
+		}
+		provider := &stubIncrementalProvider{result: &providers.FetchResult{
+			Cursor:     models.SyncCursor{Since: highWater},
+			DeletedIDs: []string{"r3"},
+		}}
+
+		cursorRepo.On("Get", mock.Anything, workspaceID, "facebook", db).Return(stored, nil)
+		testimonialRepo.On("BatchUpsert", mock.Anything, []models.Testimonial(nil), db).Return([]uuid.UUID{}, nil)
+		testimonialRepo.On("ArchiveBySourceIDs", mock.Anything, workspaceID, "facebook", []string{"r3"}, db).
+			Return(int64(1), nil)
+		cursorRepo.On("Save", mock.Anything, mock.MatchedBy(func(c *models.SyncCursor) bool {
+			return c.Since.Equal(highWater) && c.LastFullScanAt == nil
+		}), db).Return(nil)
+
+		_, err := ps.syncIncremental(context.Background(), provider, config)
+		require.NoError(t, err)
+		assert.False(t, provider.lastFullScan)
+		assert.Equal(t, stored.Since, provider.lastCursor.Since)
+		testimonialRepo.AssertNotCalled(t, "ArchiveMissingFromSource", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		cursorRepo.AssertExpectations(t)
+	})
+}
+
+func TestProviderServiceSchedules(t *testing.T) {
+	db, _, _ := sqlmock.New()
+	config := models.ProviderConfig{WorkspaceID: uuid.New(), ProviderName: "facebook", Schedule: "@hourly", IsActive: true}
+	jobID := workspaceProviderJobID(config)
+
+	type fixture struct {
+		ps           *ProviderService
+		providerRepo *mocks.ProviderConfigRepository
+		syncRunRepo  *mocks.SyncRunRepository
+		redisMock    redismock.ClientMock
+	}
+	newService := func(t *testing.T) fixture {
+		f := fixture{
+			providerRepo: mocks.NewProviderConfigRepository(t),
+			syncRunRepo:  mocks.NewSyncRunRepository(t),
+		}
+		redisClient, redisMock := redismock.NewClientMock()
+		f.redisMock = redisMock
+		f.ps = &ProviderService{
+			providers:    map[string]providers.Provider{"facebook": &stubIncrementalProvider{}},
+			providerRepo: f.providerRepo,
+			syncRunRepo:  f.syncRunRepo,
+			lease:        lease.NewRedisLease(redisClient),
+			scheduler:    cron.New(),
+			cronEntries:  make(map[string]scheduledSync),
+			db:           db,
+			instanceID:   "replica-a",
+		}
+		return f
+	}
+	// expectRun expects a scheduled run to be recorded with the status and error.
+	expectRun := func(f fixture, status models.SyncRunStatus, reason string) {
+		f.syncRunRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.SyncRun"), db).Return(nil).Once()
+		f.syncRunRepo.On("Finish", mock.Anything, mock.MatchedBy(func(run *models.SyncRun) bool {
+			return run.Trigger == models.SyncTriggerScheduled && run.Status == status && strings.Contains(run.Error, reason)
+		}), db).Return(nil).Once()
+	}
+
+	t.Run("DeactivatingUnschedules", func(t *testing.T) {
+		f := newService(t)
+		ps, providerRepo := f.ps, f.providerRepo
+		providerRepo.On("Save", mock.Anything, mock.Anything, db).Return(nil)
+
+		require.NoError(t, ps.ConfigureProvider(context.Background(), config))
+		assert.Contains(t, ps.cronEntries, jobID)
+		assert.Len(t, ps.scheduler.Entries(), 1)
+
+		inactive := config
+		inactive.IsActive = false
+		require.NoError(t, ps.ConfigureProvider(context.Background(), inactive))
+		assert.NotContains(t, ps.cronEntries, jobID)
+		assert.Empty(t, ps.scheduler.Entries())
+	})
+
+	t.Run("RunsStopOnceDeactivatedElsewhere", func(t *testing.T) {
+		f := newService(t)
+		ps, providerRepo := f.ps, f.providerRepo
+		require.NoError(t, ps.scheduleWorkspaceProvider(context.Background(), config))
+		// Another replica deactivated the config, so this one still has it scheduled
+		inactive := config
+		inactive.IsActive = false
+		providerRepo.On("GetByWorkspace", mock.Anything, config.WorkspaceID, db).Return([]models.ProviderConfig{inactive}, nil)
+		expectRun(f, models.SyncRunSkipped, errConfigInactive.Error())
+
+		// Without a lease, reaching the sync would panic
+		ps.runScheduledSync(jobID, config)
+		assert.NotContains(t, ps.cronEntries, jobID)
+		assert.Empty(t, ps.scheduler.Entries())
+	})
+
+	t.Run("FollowsScheduleChangedElsewhere", func(t *testing.T) {
+		f := newService(t)
+		ps, providerRepo := f.ps, f.providerRepo
+		require.NoError(t, ps.scheduleWorkspaceProvider(context.Background(), config))
+		changed := config
+		changed.Schedule = "@daily"
+		providerRepo.On("GetByWorkspace", mock.Anything, config.WorkspaceID, db).Return([]models.ProviderConfig{changed}, nil)
+		expectRun(f, models.SyncRunSkipped, errScheduleChanged.Error())
+
+		// Rescheduling instead of syncing, so no lease is taken
+		ps.runScheduledSync(jobID, config)
+		assert.Equal(t, "@daily", ps.cronEntries[jobID].schedule)
+		assert.Len(t, ps.scheduler.Entries(), 1)
+	})
+
+	t.Run("ReconcilesWithActiveConfigs", func(t *testing.T) {
+		f := newService(t)
+		ps, providerRepo := f.ps, f.providerRepo
+		stale := models.ProviderConfig{WorkspaceID: uuid.New(), ProviderName: "facebook", Schedule: "@hourly", IsActive: true}
+		require.NoError(t, ps.scheduleWorkspaceProvider(context.Background(), stale))
+		require.NoError(t, ps.scheduleWorkspaceProvider(context.Background(), config))
+		unchanged := ps.cronEntries[jobID].entryID
+
+		created := models.ProviderConfig{WorkspaceID: uuid.New(), ProviderName: "facebook", Schedule: "@daily", IsActive: true}
+		providerRepo.On("GetActive", mock.Anything, db).Return([]models.ProviderConfig{config, created}, nil)
+
+		require.NoError(t, ps.reconcileSchedules(context.Background()))
+		assert.Equal(t, unchanged, ps.cronEntries[jobID].entryID)
+		assert.Contains(t, ps.cronEntries, workspaceProviderJobID(created))
+		assert.NotContains(t, ps.cronEntries, workspaceProviderJobID(stale))
+		assert.Len(t, ps.scheduler.Entries(), 2)
+	})
+
+	t.Run("SkipsTicksClaimedByAnotherReplica", func(t *testing.T) {
+		f := newService(t)
+		ps, providerRepo, redisMock := f.ps, f.providerRepo, f.redisMock
+		providerRepo.On("GetByWorkspace", mock.Anything, config.WorkspaceID, db).Return([]models.ProviderConfig{config}, nil)
+		// The key carries no timestamp, so a replica whose clock is a minute off still
+		// sees the claim
+		redisMock.CustomMatch(func(expected, actual []interface{}) error {
+			if actual[1] != "sync:lease:"+jobID || actual[2] != "replica-a" {
+				return fmt.Errorf("unexpected lease %v", actual)
+			}
+			return nil
+		}).ExpectSetNX("sync:lease:"+jobID, "replica-a", time.Hour).SetVal(false)
+
+		// Running the sync would panic without a rate limiter
+		ps.runScheduledSync(jobID, config)
+		assert.NoError(t, redisMock.ExpectationsWereMet())
+	})
+
+	t.Run("RecordsRunsThatCouldNotStart", func(t *testing.T) {
+		f := newService(t)
+		f.providerRepo.On("GetByWorkspace", mock.Anything, config.WorkspaceID, db).Return(nil, errors.New("connection refused")).Once()
+		expectRun(f, models.SyncRunFailed, "failed to reload provider config: connection refused")
+		f.ps.runScheduledSync(jobID, config)
+
+		f.providerRepo.On("GetByWorkspace", mock.Anything, config.WorkspaceID, db).Return([]models.ProviderConfig{config}, nil).Once()
+		f.redisMock.CustomMatch(func(expected, actual []interface{}) error { return nil }).
+			ExpectSetNX("sync:lease:"+jobID, "replica-a", time.Hour).SetErr(errors.New("redis unavailable"))
+		expectRun(f, models.SyncRunFailed, "failed to acquire sync lease: redis unavailable")
+		f.ps.runScheduledSync(jobID, config)
+		assert.NoError(t, f.redisMock.ExpectationsWereMet())
+	})
+}
+
+func TestSyncLeaseTTL(t *testing.T) {
+	now := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	// Expires shortly before the next tick
+	assert.Equal(t, 59*time.Minute, syncLeaseTTL("@hourly", now))
+	assert.Equal(t, 23*time.Hour+59*time.Minute, syncLeaseTTL("0 10 * * *", now))
+	assert.Equal(t, 14*time.Minute, syncLeaseTTL("*/15 * * * *", now))
+	// A replica running late holds the lease for what is left of the interval
+	assert.Equal(t, 58*time.Minute, syncLeaseTTL("@hourly", now.Add(time.Minute)))
+	// Schedules too frequent for the margin keep half the interval
+	assert.Equal(t, 30*time.Second, syncLeaseTTL("* * * * *", now))
+}
+
+type recordingAuthenticity struct {
+	AuthenticityService
+	scheduled []uuid.UUID
+}
+
+func (a *recordingAuthenticity) Schedule(ctx context.Context, testimonialIDs ...uuid.UUID) error {
+	a.scheduled = append(a.scheduled, testimonialIDs...)
+	return nil
+}
@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pkg/events"
+)
+
+const (
+	scheduledPublishInterval  = 30 * time.Second
+	scheduledPublishBatchSize = 100
+	scheduledPublishReason    = "published on schedule"
+)
+
+// ScheduledPublisher publishes testimonials when their scheduled time arrives. Due
+// testimonials are claimed with SKIP LOCKED, so every API server can run a publisher.
+// Each publication is written to the audit log without an actor and broadcast as a
+// testimonial.published event so that widgets can drop their cached testimonials.
+type ScheduledPublisher struct {
+	testimonialRepo repositories.TestimonialRepository
+	auditRepo       repositories.AuditLogRepository
+	events          events.Publisher
+	db              *sql.DB
+	interval        time.Duration
+	batchSize       int
+	now             func() time.Time
+}
+
+func NewScheduledPublisher(
+	testimonialRepo repositories.TestimonialRepository,
+	auditRepo repositories.AuditLogRepository,
+	publisher events.Publisher,
+	db *sql.DB,
+) *ScheduledPublisher {
+	return &ScheduledPublisher{
+		testimonialRepo: testimonialRepo,
+		auditRepo:       auditRepo,
+		events:          publisher,
+		db:              db,
+		interval:        scheduledPublishInterval,
+		batchSize:       scheduledPublishBatchSize,
+		now:             time.Now,
+	}
+}
+
+// Run publishes due testimonials until ctx is cancelled.
+func (p *ScheduledPublisher) Run(ctx context.Context) {
+	for {
+		// Keep going while batches come back full, so a backlog is not spread over polls
+		n, err := p.PublishDue(ctx)
+		if err != nil {
+			slog.Error("scheduled publisher failed", "error", err)
+		}
+		if err == nil && n == p.batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(p.interval):
+		}
+	}
+}
+
+// PublishDue publishes one batch of due testimonials and returns how many it published.
+func (p *ScheduledPublisher) PublishDue(ctx context.Context) (int, error) {
+	if ctx.Err() != nil {
+		return 0, nil
+	}
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+	defer tx.Rollback()
+
+	published, err := p.testimonialRepo.PublishDue(ctx, p.now(), p.batchSize, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range published {
+		t := &published[i]
+		entry := &models.AuditLogEntry{
+			WorkspaceID: &t.WorkspaceID,
+			EventType:   models.AuditEventTestimonialTransition,
+			EntityType:  models.AuditEntityTestimonial,
+			EntityID:    t.ID,
+			Details: models.JSONMap{
+				"from":       string(models.StatusScheduled),
+				"to":         string(models.StatusApproved),
+				"reason":     scheduledPublishReason,
+				"publish_at": t.ScheduledPublishAt.UTC().Format(time.RFC3339),
+			},
+		}
+		if err := p.auditRepo.Create(ctx, entry, tx); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+
+	// The testimonials are live whether or not anyone hears about it, so a failed
+	// broadcast is only logged
+	for _, t := range published {
+		event := events.Event{
+			Type:        events.TestimonialPublished,
+			WorkspaceID: t.WorkspaceID,
+			SubjectID:   t.ID,
+			OccurredAt:  *t.PublishedAt,
+			Data:        map[string]any{"scheduled_publish_at": t.ScheduledPublishAt},
+		}
+		if err := p.events.Publish(ctx, event); err != nil {
+			slog.Warn("failed to broadcast published testimonial", "testimonial", t.ID, "error", err)
+		}
+	}
+	return len(published), nil
+}
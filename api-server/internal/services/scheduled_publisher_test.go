@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/ifeanyidike/cenphi/pkg/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingEvents struct {
+	published []events.Event
+	err       error
+}
+
+func (r *recordingEvents) Publish(_ context.Context, event events.Event) error {
+	r.published = append(r.published, event)
+	return r.err
+}
+
+func TestScheduledPublisher(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 7, 0, 0, 0, time.UTC)
+	scheduledAt := now.Add(-time.Minute)
+	testimonial := models.Testimonial{
+		ID:                 uuid.New(),
+		WorkspaceID:        uuid.New(),
+		Status:             models.StatusApproved,
+		Published:          true,
+		PublishedAt:        &now,
+		ScheduledPublishAt: &scheduledAt,
+	}
+
+	newPublisher := func(t *testing.T) (*ScheduledPublisher, *mocks.TestimonialRepository, *mocks.AuditLogRepository, *recordingEvents, sqlmock.Sqlmock) {
+		db, sqlMock, err := sqlmock.New()
+		require.NoError(t, err)
+		testimonials := mocks.NewTestimonialRepository(t)
+		audit := mocks.NewAuditLogRepository(t)
+		bus := &recordingEvents{}
+		p := NewScheduledPublisher(testimonials, audit, bus, db)
+		p.now = func() time.Time { return now }
+		return p, testimonials, audit, bus, sqlMock
+	}
+
+	t.Run("PublishesDueTestimonials", func(t *testing.T) {
+		p, testimonials, audit, bus, sqlMock := newPublisher(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		testimonials.On("PublishDue", mock.Anything, now, scheduledPublishBatchSize, mock.Anything).
+			Return([]models.Testimonial{testimonial}, nil)
+		var entry *models.AuditLogEntry
+		audit.On("Create", mock.Anything, mock.AnythingOfType("*models.AuditLogEntry"), mock.Anything).
+			Run(func(args mock.Arguments) { entry = args.Get(1).(*models.AuditLogEntry) }).
+			Return(nil)
+
+		n, err := p.PublishDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		assert.Nil(t, entry.ActorID)
+		assert.Equal(t, "scheduled", entry.Details["from"])
+		assert.Equal(t, "approved", entry.Details["to"])
+
+		require.Len(t, bus.published, 1)
+		assert.Equal(t, events.TestimonialPublished, bus.published[0].Type)
+		assert.Equal(t, testimonial.ID, bus.published[0].SubjectID)
+		assert.Equal(t, testimonial.WorkspaceID, bus.published[0].WorkspaceID)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("BroadcastFailureDoesNotUndoPublication", func(t *testing.T) {
+		p, testimonials, audit, bus, sqlMock := newPublisher(t)
+		bus.err = errors.New("redis unavailable")
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		testimonials.On("PublishDue", mock.Anything, now, scheduledPublishBatchSize, mock.Anything).
+			Return([]models.Testimonial{testimonial}, nil)
+		audit.On("Create", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		n, err := p.PublishDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("RollsBackWhenAuditFails", func(t *testing.T) {
+		p, testimonials, audit, bus, sqlMock := newPublisher(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectRollback()
+
+		testimonials.On("PublishDue", mock.Anything, now, scheduledPublishBatchSize, mock.Anything).
+			Return([]models.Testimonial{testimonial}, nil)
+		audit.On("Create", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("insert failed"))
+
+		_, err := p.PublishDue(ctx)
+		assert.Error(t, err)
+		assert.Empty(t, bus.published)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pb"
+)
+
+const (
+	semanticIndexInterval = time.Minute
+	// semanticIndexBatchSize is the most texts the intelligence service embeds at once.
+	semanticIndexBatchSize = 64
+)
+
+// SemanticIndexer keeps testimonial embeddings up to date for similarity search. It
+// embeds testimonials that are new or have changed since they were embedded, so imports,
+// edits and transcripts that arrive later are all picked up without being scheduled.
+// Changes that leave the embedded text alone, such as a status change, only move the
+// index's timestamp forward.
+type SemanticIndexer struct {
+	indexRepo repositories.SemanticIndexRepository
+	embedder  *embedder
+	db        *sql.DB
+	interval  time.Duration
+	batchSize int
+}
+
+func NewSemanticIndexer(
+	indexRepo repositories.SemanticIndexRepository,
+	client *pb.IntelligenceClient,
+	openAIKey string,
+	db *sql.DB,
+) *SemanticIndexer {
+	return &SemanticIndexer{
+		indexRepo: indexRepo,
+		embedder:  newEmbedder(client, openAIKey),
+		db:        db,
+		interval:  semanticIndexInterval,
+		batchSize: semanticIndexBatchSize,
+	}
+}
+
+// Run indexes stale testimonials until ctx is cancelled.
+func (x *SemanticIndexer) Run(ctx context.Context) {
+	for {
+		n, err := x.IndexStale(ctx)
+		if err != nil {
+			slog.Error("semantic indexer failed", "error", err)
+		}
+		if err == nil && n == x.batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(x.interval):
+		}
+	}
+}
+
+// IndexStale embeds one batch of stale testimonials and returns how many it indexed.
+func (x *SemanticIndexer) IndexStale(ctx context.Context) (int, error) {
+	if ctx.Err() != nil {
+		return 0, nil
+	}
+
+	candidates, err := x.indexRepo.FetchStale(ctx, x.batchSize, x.db)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		pending []*models.SemanticIndex
+		texts   []string
+	)
+	for _, c := range candidates {
+		text := embeddingText(&c.Testimonial)
+		hash := embeddingContentHash(text)
+		if c.ContentHash != nil && *c.ContentHash == hash {
+			if err := x.indexRepo.MarkCurrent(ctx, c.Testimonial.ID, c.Testimonial.UpdatedAt, x.db); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		pending = append(pending, &models.SemanticIndex{
+			TestimonialID:   c.Testimonial.ID,
+			WorkspaceID:     c.Testimonial.WorkspaceID,
+			ContentHash:     hash,
+			SourceUpdatedAt: c.Testimonial.UpdatedAt,
+		})
+		texts = append(texts, text)
+	}
+
+	if len(pending) > 0 {
+		model, vectors, err := x.embedder.embed(ctx, texts)
+		if err != nil {
+			return 0, err
+		}
+		for i, index := range pending {
+			index.Model, index.Embedding = model, vectors[i]
+			if err := x.indexRepo.Upsert(ctx, index, x.db); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(candidates), nil
+}
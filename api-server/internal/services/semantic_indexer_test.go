@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/ifeanyidike/cenphi/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeEmbeddingClient struct {
+	pb.IntelligenceClient
+	requests []*pb.EmbedTextsRequest
+	err      error
+}
+
+// EmbedTexts returns a vector for each text with its first value set to the text's
+// position in the request.
+func (c *fakeEmbeddingClient) EmbedTexts(ctx context.Context, in *pb.EmbedTextsRequest, opts ...grpc.CallOption) (*pb.EmbedTextsResponse, error) {
+	c.requests = append(c.requests, in)
+	if c.err != nil {
+		return nil, c.err
+	}
+	resp := &pb.EmbedTextsResponse{Model: "intelligence-embed-v1"}
+	for i := range in.GetTexts() {
+		values := make([]float32, models.EmbeddingDimensions)
+		values[0] = float32(i)
+		resp.Embeddings = append(resp.Embeddings, &pb.Embedding{Values: values})
+	}
+	return resp, nil
+}
+
+func TestSemanticIndexer(t *testing.T) {
+	ctx := context.Background()
+	updatedAt := time.Date(2024, 5, 1, 7, 0, 0, 0, time.UTC)
+
+	newIndexer := func(t *testing.T, client pb.IntelligenceClient) (*SemanticIndexer, *mocks.SemanticIndexRepository) {
+		db, _, err := sqlmock.New()
+		require.NoError(t, err)
+		indexRepo := mocks.NewSemanticIndexRepository(t)
+		return NewSemanticIndexer(indexRepo, &client, "openai-key", db), indexRepo
+	}
+
+	t.Run("EmbedsNewAndChangedTestimonials", func(t *testing.T) {
+		client := &fakeEmbeddingClient{}
+		indexer, indexRepo := newIndexer(t, client)
+
+		oldHash := embeddingContentHash("an older version")
+		unchanged := models.Testimonial{ID: uuid.New(), WorkspaceID: uuid.New(), Content: "Same as before", UpdatedAt: updatedAt}
+		unchangedHash := embeddingContentHash(embeddingText(&unchanged))
+		candidates := []models.SemanticIndexCandidate{
+			{Testimonial: models.Testimonial{ID: uuid.New(), WorkspaceID: uuid.New(), Title: "Fast setup", Content: "Live in a day", UpdatedAt: updatedAt}},
+			{Testimonial: models.Testimonial{ID: uuid.New(), WorkspaceID: uuid.New(), Content: "Edited text", UpdatedAt: updatedAt}, ContentHash: &oldHash},
+			{Testimonial: unchanged, ContentHash: &unchangedHash},
+		}
+		indexRepo.On("FetchStale", mock.Anything, semanticIndexBatchSize, mock.Anything).Return(candidates, nil)
+		indexRepo.On("MarkCurrent", mock.Anything, unchanged.ID, updatedAt, mock.Anything).Return(nil)
+		var stored []*models.SemanticIndex
+		indexRepo.On("Upsert", mock.Anything, mock.AnythingOfType("*models.SemanticIndex"), mock.Anything).
+			Run(func(args mock.Arguments) { stored = append(stored, args.Get(1).(*models.SemanticIndex)) }).
+			Return(nil)
+
+		n, err := indexer.IndexStale(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+
+		// Both changed testimonials go in one request
+		require.Len(t, client.requests, 1)
+		assert.Equal(t, []string{"Fast setup\n\nLive in a day", "Edited text"}, client.requests[0].GetTexts())
+		require.Len(t, stored, 2)
+		for i, index := range stored {
+			assert.Equal(t, candidates[i].Testimonial.ID, index.TestimonialID)
+			assert.Equal(t, "intelligence-embed-v1", index.Model)
+			assert.Equal(t, float32(i), index.Embedding[0])
+			assert.Equal(t, embeddingContentHash(client.requests[0].GetTexts()[i]), index.ContentHash)
+			assert.Equal(t, updatedAt, index.SourceUpdatedAt)
+		}
+	})
+
+	t.Run("FallsBackToOpenAI", func(t *testing.T) {
+		client := &fakeEmbeddingClient{err: status.Error(codes.Unimplemented, "unknown method EmbedTexts")}
+		indexer, indexRepo := newIndexer(t, client)
+		indexer.embedder.httpClient = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			assert.Equal(t, openAIEmbedURL, r.URL.String())
+			assert.Equal(t, "Bearer openai-key", r.Header.Get("Authorization"))
+			values := strings.TrimSuffix(strings.Repeat("0.1,", models.EmbeddingDimensions), ",")
+			body := fmt.Sprintf(`{"data":[{"index":0,"embedding":[%s]}]}`, values)
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: http.Header{}}, nil
+		})}
+
+		testimonial := models.Testimonial{ID: uuid.New(), WorkspaceID: uuid.New(), Content: "Great support", UpdatedAt: updatedAt}
+		indexRepo.On("FetchStale", mock.Anything, semanticIndexBatchSize, mock.Anything).
+			Return([]models.SemanticIndexCandidate{{Testimonial: testimonial}}, nil)
+		indexRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(index *models.SemanticIndex) bool {
+			return index.Model == openAIEmbedModel && len(index.Embedding) == models.EmbeddingDimensions
+		}), mock.Anything).Return(nil)
+
+		_, err := indexer.IndexStale(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("RejectsVectorsOfTheWrongSize", func(t *testing.T) {
+		indexer, indexRepo := newIndexer(t, nil)
+		indexer.embedder.httpClient = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			body := `{"data":[{"index":0,"embedding":[0.1,0.2]}]}`
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: http.Header{}}, nil
+		})}
+
+		indexRepo.On("FetchStale", mock.Anything, semanticIndexBatchSize, mock.Anything).
+			Return([]models.SemanticIndexCandidate{{Testimonial: models.Testimonial{ID: uuid.New(), Content: "Great support"}}}, nil)
+
+		_, err := indexer.IndexStale(ctx)
+		assert.ErrorContains(t, err, "dimensions")
+	})
+}
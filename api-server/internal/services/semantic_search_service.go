@@ -0,0 +1,115 @@
+package services
+
+//go:generate mockery --name=SemanticSearchService --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pb"
+)
+
+type SemanticSearchService interface {
+	// Similar returns the workspace's testimonials closest in meaning to another
+	// testimonial or to a free text query. Only testimonials embedded by the same model as
+	// the testimonial or query are compared.
+	Similar(ctx context.Context, workspaceID uuid.UUID, req models.SimilarTestimonialRequest) ([]models.SimilarTestimonial, error)
+}
+
+type semanticSearchService struct {
+	indexRepo repositories.SemanticIndexRepository
+	embedder  *embedder
+	db        *sql.DB
+}
+
+func NewSemanticSearchService(
+	indexRepo repositories.SemanticIndexRepository,
+	client *pb.IntelligenceClient,
+	openAIKey string,
+	db *sql.DB,
+) SemanticSearchService {
+	return &semanticSearchService{
+		indexRepo: indexRepo,
+		embedder:  newEmbedder(client, openAIKey),
+		db:        db,
+	}
+}
+
+func (s *semanticSearchService) Similar(ctx context.Context, workspaceID uuid.UUID, req models.SimilarTestimonialRequest) ([]models.SimilarTestimonial, error) {
+	var (
+		model     string
+		embedding models.Vector
+		exclude   uuid.UUID
+	)
+	if req.To != nil {
+		index, err := s.testimonialIndex(ctx, workspaceID, *req.To)
+		if err != nil {
+			return nil, err
+		}
+		model, embedding, exclude = index.Model, index.Embedding, index.TestimonialID
+	} else {
+		var vectors []models.Vector
+		var err error
+		model, vectors, err = s.embedder.embed(ctx, []string{req.Query})
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", apperrors.ErrServiceUnavailable, err)
+		}
+		embedding = vectors[0]
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+	defer tx.Rollback()
+
+	return s.indexRepo.FindSimilar(ctx, workspaceID, model, embedding, exclude, req.Limit, tx)
+}
+
+// testimonialIndex returns the embedding of a testimonial. Testimonials the indexer has
+// not reached yet are embedded on the spot.
+func (s *semanticSearchService) testimonialIndex(ctx context.Context, workspaceID, testimonialID uuid.UUID) (*models.SemanticIndex, error) {
+	index, err := s.indexRepo.Get(ctx, workspaceID, testimonialID, s.db)
+	if err == nil {
+		return index, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	candidate, err := s.indexRepo.GetCandidate(ctx, workspaceID, testimonialID, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: testimonial %s", apperrors.ErrNotFound, testimonialID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	text := embeddingText(&candidate.Testimonial)
+	if text == "" {
+		return nil, fmt.Errorf("%w: testimonial has no text to compare", apperrors.ErrValidationFailed)
+	}
+	model, vectors, err := s.embedder.embed(ctx, []string{text})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrServiceUnavailable, err)
+	}
+
+	index = &models.SemanticIndex{
+		TestimonialID:   testimonialID,
+		WorkspaceID:     workspaceID,
+		Model:           model,
+		Embedding:       vectors[0],
+		ContentHash:     embeddingContentHash(text),
+		SourceUpdatedAt: candidate.Testimonial.UpdatedAt,
+	}
+	if err := s.indexRepo.Upsert(ctx, index, s.db); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
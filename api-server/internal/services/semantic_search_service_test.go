@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/ifeanyidike/cenphi/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSemanticSearchService(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := uuid.New()
+	testimonialID := uuid.New()
+	results := []models.SimilarTestimonial{{Testimonial: models.Testimonial{ID: uuid.New()}, Similarity: 0.9}}
+
+	newService := func(t *testing.T, client pb.IntelligenceClient, apiKey string) (SemanticSearchService, *mocks.SemanticIndexRepository, sqlmock.Sqlmock) {
+		db, sqlMock, err := sqlmock.New()
+		require.NoError(t, err)
+		indexRepo := mocks.NewSemanticIndexRepository(t)
+		return NewSemanticSearchService(indexRepo, &client, apiKey, db), indexRepo, sqlMock
+	}
+
+	t.Run("ComparesWithStoredEmbedding", func(t *testing.T) {
+		client := &fakeEmbeddingClient{}
+		svc, indexRepo, sqlMock := newService(t, client, "")
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectRollback()
+
+		embedding := models.Vector{0.1, 0.2}
+		indexRepo.On("Get", mock.Anything, workspaceID, testimonialID, mock.Anything).
+			Return(&models.SemanticIndex{TestimonialID: testimonialID, Model: "intelligence-embed-v1", Embedding: embedding}, nil)
+		indexRepo.On("FindSimilar", mock.Anything, workspaceID, "intelligence-embed-v1", embedding, testimonialID, 5, mock.Anything).
+			Return(results, nil)
+
+		got, err := svc.Similar(ctx, workspaceID, models.SimilarTestimonialRequest{To: &testimonialID, Limit: 5})
+		require.NoError(t, err)
+		assert.Equal(t, results, got)
+		assert.Empty(t, client.requests)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("EmbedsTestimonialNotYetIndexed", func(t *testing.T) {
+		client := &fakeEmbeddingClient{}
+		svc, indexRepo, sqlMock := newService(t, client, "")
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectRollback()
+
+		updatedAt := time.Date(2024, 5, 1, 7, 0, 0, 0, time.UTC)
+		indexRepo.On("Get", mock.Anything, workspaceID, testimonialID, mock.Anything).Return(nil, sql.ErrNoRows)
+		indexRepo.On("GetCandidate", mock.Anything, workspaceID, testimonialID, mock.Anything).
+			Return(&models.SemanticIndexCandidate{Testimonial: models.Testimonial{ID: testimonialID, Content: "Great support", UpdatedAt: updatedAt}}, nil)
+		indexRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(index *models.SemanticIndex) bool {
+			return index.TestimonialID == testimonialID && index.WorkspaceID == workspaceID && index.SourceUpdatedAt.Equal(updatedAt)
+		}), mock.Anything).Return(nil)
+		indexRepo.On("FindSimilar", mock.Anything, workspaceID, "intelligence-embed-v1", mock.Anything, testimonialID, 5, mock.Anything).
+			Return(results, nil)
+
+		_, err := svc.Similar(ctx, workspaceID, models.SimilarTestimonialRequest{To: &testimonialID, Limit: 5})
+		require.NoError(t, err)
+		require.Len(t, client.requests, 1)
+		assert.Equal(t, []string{"Great support"}, client.requests[0].GetTexts())
+	})
+
+	t.Run("ReportsUnknownTestimonial", func(t *testing.T) {
+		svc, indexRepo, _ := newService(t, &fakeEmbeddingClient{}, "")
+
+		indexRepo.On("Get", mock.Anything, workspaceID, testimonialID, mock.Anything).Return(nil, sql.ErrNoRows)
+		indexRepo.On("GetCandidate", mock.Anything, workspaceID, testimonialID, mock.Anything).Return(nil, sql.ErrNoRows)
+
+		_, err := svc.Similar(ctx, workspaceID, models.SimilarTestimonialRequest{To: &testimonialID, Limit: 5})
+		assert.ErrorIs(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("EmbedsQuery", func(t *testing.T) {
+		client := &fakeEmbeddingClient{}
+		svc, indexRepo, sqlMock := newService(t, client, "")
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectRollback()
+
+		indexRepo.On("FindSimilar", mock.Anything, workspaceID, "intelligence-embed-v1", mock.Anything, uuid.Nil, 10, mock.Anything).
+			Return(results, nil)
+
+		got, err := svc.Similar(ctx, workspaceID, models.SimilarTestimonialRequest{Query: "quick onboarding", Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, results, got)
+		assert.Equal(t, []string{"quick onboarding"}, client.requests[0].GetTexts())
+	})
+
+	t.Run("ReportsUnavailableEmbedding", func(t *testing.T) {
+		client := &fakeEmbeddingClient{err: status.Error(codes.Unavailable, "connection refused")}
+		svc, _, _ := newService(t, client, "")
+
+		_, err := svc.Similar(ctx, workspaceID, models.SimilarTestimonialRequest{Query: "quick onboarding", Limit: 10})
+		assert.ErrorIs(t, err, apperrors.ErrServiceUnavailable)
+	})
+}
@@ -3,66 +3,252 @@ package services
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/ifeanyidike/cenphi/internal/contracts"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
 	"github.com/ifeanyidike/cenphi/pb"
-	// "github.com/ifeanyidike/cenphi/internal/pb"
+	"github.com/redis/go-redis/v9"
 )
 
+const (
+	// sentimentGRPCTimeout bounds a call to the intelligence service; imports fall back
+	// to OpenAI rather than wait longer.
+	sentimentGRPCTimeout = 5 * time.Second
+	sentimentCachePrefix = "sentiment:v1:"
+	sentimentCacheTTL    = 7 * 24 * time.Hour
+	// sentimentFallbackCacheTTL is shorter so the full breakdown is fetched once the
+	// intelligence service is back.
+	sentimentFallbackCacheTTL = time.Hour
+)
+
+const (
+	sentimentSourceGRPC   = "intelligence"
+	sentimentSourceOpenAI = "openai"
+)
+
+// sentimentResult is a sentiment analysis as cached in Redis. Only results from the
+// intelligence service have a breakdown.
+type sentimentResult struct {
+	Score      float64            `json:"score"`
+	Source     string             `json:"source"`
+	Emotions   []sentimentEmotion `json:"emotions,omitempty"`
+	Aspects    []sentimentAspect  `json:"aspects,omitempty"`
+	KeyPhrases []string           `json:"key_phrases,omitempty"`
+}
+
+type sentimentEmotion struct {
+	Emotion   string   `json:"emotion"`
+	Intensity float64  `json:"intensity"`
+	Triggers  []string `json:"triggers,omitempty"`
+}
+
+type sentimentAspect struct {
+	Aspect     string   `json:"aspect"`
+	Score      float64  `json:"sentiment_score"`
+	KeyPhrases []string `json:"key_phrases,omitempty"`
+}
+
 // sentimentService provides sentiment analysis for text
 type sentimentService struct {
-	grpcClient  *pb.IntelligenceClient
-	httpClient  *http.Client
-	apiKey      string
-	enableCache bool
+	grpcClient   *pb.IntelligenceClient
+	httpClient   *http.Client
+	apiKey       string
+	enableCache  bool
+	cache        *redis.Client
+	analysisRepo repositories.AnalysisRepository
+	db           *sql.DB
 }
 
-func NewSentimentService(grpcClient *pb.IntelligenceClient, apiKey string, enableCache bool) contracts.SentimentService {
+func NewSentimentService(
+	grpcClient *pb.IntelligenceClient,
+	cache *redis.Client,
+	analysisRepo repositories.AnalysisRepository,
+	apiKey string,
+	enableCache bool,
+	db *sql.DB,
+) contracts.SentimentService {
 	return &sentimentService{
-		grpcClient:  grpcClient,
-		httpClient:  &http.Client{Timeout: 10 * time.Second},
-		apiKey:      apiKey,
-		enableCache: enableCache,
+		grpcClient:   grpcClient,
+		httpClient:   &http.Client{Timeout: 10 * time.Second},
+		apiKey:       apiKey,
+		enableCache:  enableCache,
+		cache:        cache,
+		analysisRepo: analysisRepo,
+		db:           db,
 	}
 }
 
 // AnalyzeText returns a sentiment score between -1 (very negative) and 1 (very positive)
 func (s *sentimentService) AnalyzeText(text string) (float64, error) {
+	result, err := s.analyze(context.Background(), text)
+	if err != nil {
+		return 0, err
+	}
+	return result.Score, nil
+}
+
+// analyze asks the intelligence service and falls back to OpenAI when it is unavailable.
+// Results are cached by content hash.
+func (s *sentimentService) analyze(ctx context.Context, text string) (*sentimentResult, error) {
 	// Skip empty text
 	if strings.TrimSpace(text) == "" {
-		return 0, fmt.Errorf("empty text")
+		return nil, fmt.Errorf("empty text")
 	}
 
-	// // Use GRPC client if available
-	// if s.grpcClient != nil {
-	// 	return s.analyzeWithGRPC(text)
-	// }
+	key := sentimentCacheKey(text)
+	if cached := s.cached(ctx, key); cached != nil {
+		return cached, nil
+	}
+
+	result, err := s.analyzeWithGRPC(ctx, text)
+	if err != nil {
+		if s.apiKey == "" {
+			return nil, err
+		}
+		slog.Warn("intelligence sentiment analysis failed, falling back to OpenAI", "error", err)
 
-	// // Otherwise use OpenAI API
-	// return s.analyzeWithOpenAI(text)
-	return 0, nil
+		score, openAIErr := s.AnalyzeWithOpenAI(text)
+		if openAIErr != nil {
+			return nil, errors.Join(err, openAIErr)
+		}
+		result = &sentimentResult{Score: score, Source: sentimentSourceOpenAI}
+	}
+
+	s.store(ctx, key, result)
+	return result, nil
 }
 
 func (s *sentimentService) AnalyzeWithGRPC(text string) (float64, error) {
-	// ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	// defer cancel()
+	result, err := s.analyzeWithGRPC(context.Background(), text)
+	if err != nil {
+		return 0, err
+	}
+	return result.Score, nil
+}
+
+func (s *sentimentService) analyzeWithGRPC(ctx context.Context, text string) (*sentimentResult, error) {
+	if s.grpcClient == nil || *s.grpcClient == nil {
+		return nil, errors.New("intelligence service is not configured")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sentimentGRPCTimeout)
+	defer cancel()
+
+	response, err := (*s.grpcClient).AnalyzeSentiment(ctx, &pb.AnalyzeSentimentRequest{Text: text})
+	if err != nil {
+		return nil, fmt.Errorf("GRPC sentiment analysis failed: %w", err)
+	}
+
+	result := &sentimentResult{
+		Score:      max(-1, min(1, float64(response.GetSentimentScore()))),
+		Source:     sentimentSourceGRPC,
+		KeyPhrases: response.GetKeyPhrases(),
+	}
+	for _, e := range response.GetEmotions() {
+		result.Emotions = append(result.Emotions, sentimentEmotion{
+			Emotion:   e.GetEmotion(),
+			Intensity: float64(e.GetIntensity()),
+			Triggers:  e.GetTriggers(),
+		})
+	}
+	for _, a := range response.GetAspects() {
+		result.Aspects = append(result.Aspects, sentimentAspect{
+			Aspect:     a.GetAspect(),
+			Score:      float64(a.GetSentimentScore()),
+			KeyPhrases: a.GetKeyPhrases(),
+		})
+	}
+	return result, nil
+}
+
+// RecordAnalysis stores the sentiment of a testimonial in testimonial_analyses. Imported
+// testimonials were analyzed before they were saved, so this is normally a cache hit.
+func (s *sentimentService) RecordAnalysis(ctx context.Context, testimonialID uuid.UUID, text string) error {
+	result, err := s.analyze(ctx, text)
+	if err != nil {
+		return err
+	}
 
-	// request := &pb.SentimentRequest{
-	// 	Text: text,
-	// }
+	score := float32(result.Score)
+	analysis := &models.TestimonialAnalysis{
+		TestimonialID:  testimonialID,
+		AnalysisType:   models.AnalysisTypeSentiment,
+		SentimentScore: &score,
+		AnalysisData: models.JSONMap{
+			"source":      result.Source,
+			"emotions":    result.Emotions,
+			"aspects":     result.Aspects,
+			"key_phrases": result.KeyPhrases,
+		},
+		ExtractedInsights: models.JSONArray{},
+		AnalysisVersion:   result.Source + "/v1",
+	}
+	for _, phrase := range result.KeyPhrases {
+		analysis.ExtractedInsights = append(analysis.ExtractedInsights, phrase)
+	}
+	if len(result.Emotions) > 0 {
+		var strongest float32
+		for _, e := range result.Emotions {
+			strongest = max(strongest, float32(e.Intensity))
+		}
+		analysis.EmotionalScore = &strongest
+	}
 
-	// response, err := (*s.grpcClient).AnalyzeSentiment(ctx, request)
-	// if err != nil {
-	// 	return 0, fmt.Errorf("GRPC sentiment analysis failed: %w", err)
-	// }
+	return s.analysisRepo.Upsert(ctx, analysis, s.db)
+}
 
-	// return response.Score, nil
-	return 0, nil
+func sentimentCacheKey(text string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(text)))
+	return sentimentCachePrefix + hex.EncodeToString(sum[:])
+}
+
+// cached returns the cached analysis of a text. Cache failures are treated as misses.
+func (s *sentimentService) cached(ctx context.Context, key string) *sentimentResult {
+	if !s.enableCache || s.cache == nil {
+		return nil
+	}
+	data, err := s.cache.Get(ctx, key).Bytes()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			slog.Warn("failed to read sentiment cache", "error", err)
+		}
+		return nil
+	}
+	var result sentimentResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil
+	}
+	return &result
+}
+
+func (s *sentimentService) store(ctx context.Context, key string, result *sentimentResult) {
+	if !s.enableCache || s.cache == nil {
+		return
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return
+	}
+	ttl := sentimentCacheTTL
+	if result.Source != sentimentSourceGRPC {
+		ttl = sentimentFallbackCacheTTL
+	}
+	if err := s.cache.Set(ctx, key, string(data), ttl).Err(); err != nil {
+		slog.Warn("failed to cache sentiment", "error", err)
+	}
 }
 
 func (s *sentimentService) AnalyzeWithOpenAI(text string) (float64, error) {
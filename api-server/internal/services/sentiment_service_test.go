@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/ifeanyidike/cenphi/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSentimentService(t *testing.T) {
+	ctx := context.Background()
+	text := "Setup took a day and support answered within minutes."
+	response := &pb.AnalyzeSentimentResponse{
+		SentimentScore: 0.8,
+		Emotions:       []*pb.EmotionAnalysis{{Emotion: "joy", Intensity: 0.7}, {Emotion: "relief", Intensity: 0.4}},
+		Aspects:        []*pb.AspectAnalysis{{Aspect: "support", SentimentScore: 0.9, KeyPhrases: []string{"answered within minutes"}}},
+		KeyPhrases:     []string{"setup took a day", "answered within minutes"},
+	}
+
+	newService := func(client pb.IntelligenceClient, cache bool) (*sentimentService, redismock.ClientMock, *mocks.AnalysisRepository) {
+		redisClient, redisMock := redismock.NewClientMock()
+		analysisRepo := mocks.NewAnalysisRepository(t)
+		db, _, _ := sqlmock.New()
+		svc := NewSentimentService(&client, redisClient, analysisRepo, "openai-key", cache, db).(*sentimentService)
+		return svc, redisMock, analysisRepo
+	}
+
+	t.Run("UsesIntelligenceServiceAndCachesResult", func(t *testing.T) {
+		client := &fakeSentimentClient{response: response}
+		svc, redisMock, _ := newService(client, true)
+
+		key := sentimentCacheKey(text)
+		redisMock.ExpectGet(key).RedisNil()
+		redisMock.Regexp().ExpectSet(key, `"source":"intelligence"`, sentimentCacheTTL).SetVal("OK")
+
+		score, err := svc.AnalyzeText(text)
+		require.NoError(t, err)
+		assert.InDelta(t, 0.8, score, 0.0001)
+		assert.Equal(t, text, client.request.GetText())
+		assert.NoError(t, redisMock.ExpectationsWereMet())
+	})
+
+	t.Run("CacheHitSkipsAnalysis", func(t *testing.T) {
+		client := &fakeSentimentClient{err: status.Error(codes.Internal, "should not be called")}
+		svc, redisMock, _ := newService(client, true)
+
+		cached, err := json.Marshal(sentimentResult{Score: -0.4, Source: sentimentSourceGRPC})
+		require.NoError(t, err)
+		redisMock.ExpectGet(sentimentCacheKey(text)).SetVal(string(cached))
+
+		score, err := svc.AnalyzeText(text)
+		require.NoError(t, err)
+		assert.InDelta(t, -0.4, score, 0.0001)
+		assert.Nil(t, client.request)
+	})
+
+	t.Run("FallsBackToOpenAIWhenIntelligenceFails", func(t *testing.T) {
+		client := &fakeSentimentClient{err: status.Error(codes.Unavailable, "connection refused")}
+		svc, _, _ := newService(client, false)
+		svc.httpClient = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			assert.Equal(t, "Bearer openai-key", r.Header.Get("Authorization"))
+			body := `{"choices":[{"message":{"content":"0.6"}}]}`
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: http.Header{}}, nil
+		})}
+
+		result, err := svc.analyze(ctx, text)
+		require.NoError(t, err)
+		assert.InDelta(t, 0.6, result.Score, 0.0001)
+		assert.Equal(t, sentimentSourceOpenAI, result.Source)
+	})
+
+	t.Run("RecordAnalysisStoresBreakdown", func(t *testing.T) {
+		svc, _, analysisRepo := newService(&fakeSentimentClient{response: response}, false)
+		testimonialID := uuid.New()
+
+		var saved *models.TestimonialAnalysis
+		analysisRepo.On("Upsert", mock.Anything, mock.AnythingOfType("*models.TestimonialAnalysis"), svc.db).
+			Run(func(args mock.Arguments) { saved = args.Get(1).(*models.TestimonialAnalysis) }).
+			Return(nil)
+
+		require.NoError(t, svc.RecordAnalysis(ctx, testimonialID, text))
+		assert.Equal(t, testimonialID, saved.TestimonialID)
+		assert.Equal(t, models.AnalysisTypeSentiment, saved.AnalysisType)
+		assert.InDelta(t, 0.8, *saved.SentimentScore, 0.0001)
+		assert.InDelta(t, 0.7, *saved.EmotionalScore, 0.0001)
+		assert.Len(t, saved.AnalysisData["emotions"], 2)
+		assert.Len(t, saved.AnalysisData["aspects"], 1)
+		assert.Equal(t, models.JSONArray{"setup took a day", "answered within minutes"}, saved.ExtractedInsights)
+	})
+
+	t.Run("RejectsEmptyText", func(t *testing.T) {
+		svc, _, _ := newService(&fakeSentimentClient{response: response}, false)
+		_, err := svc.AnalyzeText("  ")
+		assert.Error(t, err)
+	})
+}
+
+type fakeSentimentClient struct {
+	pb.IntelligenceClient
+	request  *pb.AnalyzeSentimentRequest
+	response *pb.AnalyzeSentimentResponse
+	err      error
+}
+
+func (c *fakeSentimentClient) AnalyzeSentiment(ctx context.Context, in *pb.AnalyzeSentimentRequest, opts ...grpc.CallOption) (*pb.AnalyzeSentimentResponse, error) {
+	c.request = in
+	return c.response, c.err
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pkg/slack"
+)
+
+const (
+	slackDigestInterval  = time.Minute
+	slackDigestBatchSize = 20
+	// slackDigestLease keeps a claimed digest from being sent by another server while
+	// this one is still sending it.
+	slackDigestLease = 10 * time.Minute
+	// slackDigestPeriod is how far back a digest looks.
+	slackDigestPeriod = 24 * time.Hour
+)
+
+// SlackDigester posts each workspace's daily digest to its Slack channel. Due digests
+// are claimed with SKIP LOCKED, so every API server can run a digester. Days without
+// new testimonials or a review queue are skipped.
+type SlackDigester struct {
+	repo      repositories.SlackRepository
+	client    *slack.Client
+	appURL    string
+	db        *sql.DB
+	interval  time.Duration
+	batchSize int
+	now       func() time.Time
+}
+
+func NewSlackDigester(repo repositories.SlackRepository, client *slack.Client, appURL string, db *sql.DB) *SlackDigester {
+	return &SlackDigester{
+		repo:      repo,
+		client:    client,
+		appURL:    strings.TrimSuffix(appURL, "/"),
+		db:        db,
+		interval:  slackDigestInterval,
+		batchSize: slackDigestBatchSize,
+		now:       time.Now,
+	}
+}
+
+// Run posts due digests until ctx is cancelled.
+func (d *SlackDigester) Run(ctx context.Context) {
+	for {
+		// Keep going while batches come back full, so a backlog is not spread over polls
+		n, err := d.SendDue(ctx)
+		if err != nil {
+			slog.Error("slack digester failed", "error", err)
+		}
+		if err == nil && n == d.batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.interval):
+		}
+	}
+}
+
+// SendDue handles one batch of due digests and returns how many it claimed. A digest
+// that fails to send is not retried; the workspace gets the next day's instead.
+func (d *SlackDigester) SendDue(ctx context.Context) (int, error) {
+	if ctx.Err() != nil {
+		return 0, nil
+	}
+
+	now := d.now()
+	due, err := d.repo.ClaimDueDigests(ctx, now, slackDigestLease, d.batchSize, d.db)
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	for i := range due {
+		settings := &due[i]
+		if err := d.send(ctx, settings, now); err != nil {
+			errs = append(errs, fmt.Errorf("workspace %s: %w", settings.WorkspaceID, err))
+		}
+		if err := d.repo.ScheduleDigest(ctx, settings.WorkspaceID, settings.NextDigest(now), d.db); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return len(due), errors.Join(errs...)
+}
+
+func (d *SlackDigester) send(ctx context.Context, settings *models.SlackSettings, now time.Time) error {
+	if slack.ValidateWebhookURL(settings.WebhookURL) != nil {
+		return nil
+	}
+	digest, err := d.repo.GetDigest(ctx, settings.WorkspaceID, now.Add(-slackDigestPeriod), now, settings.AlertRating, d.db)
+	if err != nil {
+		return err
+	}
+	if digest.Total == 0 && digest.PendingReview == 0 {
+		return nil
+	}
+	return d.client.Post(ctx, settings.WebhookURL, digestMessage(digest, d.appURL))
+}
+
+func digestMessage(digest *models.SlackDigest, appURL string) slack.Message {
+	title := "Daily testimonial digest"
+	if digest.WorkspaceName != "" {
+		title += " for " + digest.WorkspaceName
+	}
+
+	average := "–"
+	if digest.AverageRating != nil {
+		average = fmt.Sprintf("%.1f/5", *digest.AverageRating)
+	}
+	blocks := []slack.Block{
+		slack.Header(title),
+		slack.Section("Here is what happened in the last 24 hours.",
+			fmt.Sprintf("*New testimonials*\n%d", digest.Total),
+			"*Average rating*\n"+average,
+			fmt.Sprintf("*Negative*\n%d", digest.Negative),
+			fmt.Sprintf("*Awaiting review*\n%d", digest.PendingReview),
+		),
+	}
+	if top := digest.Top; top != nil && top.Content != "" {
+		quote := slackQuote(top.Content)
+		if top.Rating != nil {
+			quote = "*Top testimonial* " + ratingStars(*top.Rating) + "\n" + quote
+		}
+		blocks = append(blocks, slack.Divider(), slack.Section(quote))
+	}
+	if appURL != "" && digest.PendingReview > 0 {
+		review := slack.Button("Review testimonials", "open", "", "primary")
+		review.URL = appURL + "/testimonials?status=" + string(models.StatusPendingReview)
+		blocks = append(blocks, slack.Actions("digest", review))
+	}
+
+	return slack.Message{
+		Text:   fmt.Sprintf("%s: %d new, %d awaiting review", title, digest.Total, digest.PendingReview),
+		Blocks: blocks,
+	}
+}
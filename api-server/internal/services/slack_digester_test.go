@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlackDigester(t *testing.T) {
+	ctx := context.Background()
+	db, _, _ := sqlmock.New()
+	now := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	channel := "https://hooks.slack.com/services/T0/B0/main"
+
+	due := func() models.SlackSettings {
+		settings := models.DefaultSlackSettings(uuid.New())
+		settings.Enabled = true
+		settings.DigestEnabled = true
+		settings.WebhookURL = channel
+		return *settings
+	}
+
+	repo := mocks.NewSlackRepository(t)
+	client, transport := newFakeSlack()
+	digester := NewSlackDigester(repo, client, "https://app.cenphi.io", db)
+	digester.now = func() time.Time { return now }
+
+	busy, quiet := due(), due()
+	repo.On("ClaimDueDigests", mock.Anything, now, slackDigestLease, slackDigestBatchSize, db).
+		Return([]models.SlackSettings{busy, quiet}, nil)
+	average := float32(4.5)
+	repo.On("GetDigest", mock.Anything, busy.WorkspaceID, now.Add(-24*time.Hour), now, busy.AlertRating, db).
+		Return(&models.SlackDigest{WorkspaceName: "Acme", Total: 4, AverageRating: &average, Negative: 1, PendingReview: 2}, nil)
+	repo.On("GetDigest", mock.Anything, quiet.WorkspaceID, mock.Anything, mock.Anything, mock.Anything, db).
+		Return(&models.SlackDigest{}, nil)
+	// Both move on to tomorrow, whether or not anything was posted
+	tomorrow := now.Add(24 * time.Hour)
+	repo.On("ScheduleDigest", mock.Anything, busy.WorkspaceID, tomorrow, db).Return(nil)
+	repo.On("ScheduleDigest", mock.Anything, quiet.WorkspaceID, tomorrow, db).Return(nil)
+
+	n, err := digester.SendDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	require.Len(t, transport.posts, 1)
+	msg := transport.posts[0].Message
+	assert.Equal(t, channel, transport.posts[0].URL)
+	assert.Equal(t, "Daily testimonial digest for Acme: 4 new, 2 awaiting review", msg.Text)
+	assert.Contains(t, msg.Blocks[1].Fields[1].Text, "4.5/5")
+}
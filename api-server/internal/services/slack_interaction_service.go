@@ -0,0 +1,236 @@
+package services
+
+//go:generate mockery --name=SlackInteractionService --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pkg/slack"
+)
+
+const (
+	// slackRequestTolerance is how old a request from Slack may be.
+	slackRequestTolerance = 5 * time.Minute
+	// slackLinkTTL is how long a Slack user has to follow their link.
+	slackLinkTTL = time.Hour
+	// slackRejectReason is recorded when a testimonial is rejected from Slack, where
+	// there is nowhere to ask for a reason.
+	slackRejectReason = "Rejected from Slack"
+)
+
+// SlackInteractionService handles the approve and reject buttons of Slack messages.
+// Clicks act as the team member the Slack user has linked their account to, with that
+// member's role. Slack users who have not linked an account are sent a link that
+// connects the two once they open it signed in to the workspace.
+type SlackInteractionService interface {
+	// HandleInteraction verifies and acts on a request Slack sent for a button click. It
+	// returns apperrors.ErrUnauthorized when the request was not signed by Slack.
+	HandleInteraction(ctx context.Context, header http.Header, body []byte) error
+	// LinkUser connects the Slack user named in a link token to the caller's membership.
+	LinkUser(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, req models.SlackLinkRequest) (*models.SlackUserLink, error)
+}
+
+type slackInteractionService struct {
+	repo            repositories.SlackRepository
+	testimonialRepo repositories.TestimonialRepository
+	teamMemberRepo  repositories.TeamMemberRepository
+	moderation      ModerationService
+	client          *slack.Client
+	signingSecret   string
+	linkSecret      string
+	appURL          string
+	db              *sql.DB
+	now             func() time.Time
+}
+
+func NewSlackInteractionService(
+	repo repositories.SlackRepository,
+	testimonialRepo repositories.TestimonialRepository,
+	teamMemberRepo repositories.TeamMemberRepository,
+	moderation ModerationService,
+	client *slack.Client,
+	signingSecret string,
+	linkSecret string,
+	appURL string,
+	db *sql.DB,
+) SlackInteractionService {
+	return &slackInteractionService{
+		repo:            repo,
+		testimonialRepo: testimonialRepo,
+		teamMemberRepo:  teamMemberRepo,
+		moderation:      moderation,
+		client:          client,
+		signingSecret:   signingSecret,
+		linkSecret:      linkSecret,
+		appURL:          strings.TrimSuffix(appURL, "/"),
+		db:              db,
+		now:             time.Now,
+	}
+}
+
+func (s *slackInteractionService) HandleInteraction(ctx context.Context, header http.Header, body []byte) error {
+	// Without a signing secret nobody can prove a request came from Slack
+	if s.signingSecret == "" {
+		return apperrors.ErrUnauthorized
+	}
+	if err := slack.VerifyRequest(s.signingSecret, header, body, slackRequestTolerance, s.now()); err != nil {
+		return fmt.Errorf("%w: %w", apperrors.ErrUnauthorized, err)
+	}
+	interaction, err := slack.ParseInteraction(body)
+	if err != nil {
+		return fmt.Errorf("%w: %w", apperrors.ErrValidationFailed, err)
+	}
+	if interaction.Type != "block_actions" || slack.ValidateWebhookURL(interaction.ResponseURL) != nil {
+		return nil
+	}
+
+	for _, action := range interaction.Actions {
+		var status models.ContentStatus
+		switch action.ActionID {
+		case slackActionApprove:
+			status = models.StatusApproved
+		case slackActionReject:
+			status = models.StatusRejected
+		default:
+			continue
+		}
+		workspaceID, testimonialID, ok := parseSlackActionValue(action.Value)
+		if !ok {
+			continue
+		}
+
+		reply := s.moderate(ctx, interaction, workspaceID, testimonialID, status)
+		if err := s.client.Post(ctx, interaction.ResponseURL, reply); err != nil {
+			slog.Warn("failed to reply to slack interaction", "testimonial", testimonialID, "error", err)
+		}
+	}
+	return nil
+}
+
+// moderate moves a testimonial to status on behalf of the Slack user who clicked and
+// returns the reply for them: the updated message, or an explanation only they see.
+func (s *slackInteractionService) moderate(ctx context.Context, interaction *slack.Interaction, workspaceID, testimonialID uuid.UUID, status models.ContentStatus) slack.Message {
+	firebaseUID, err := s.repo.GetLinkedFirebaseUID(ctx, workspaceID, interaction.Team.ID, interaction.User.ID, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return s.linkPrompt(workspaceID, interaction)
+	}
+	if err != nil {
+		slog.Error("failed to look up slack user link", "error", err)
+		return slackEphemeral("Something went wrong. Please try again from Cenphi.")
+	}
+
+	req := models.TestimonialTransitionRequest{Status: status}
+	if status == models.StatusRejected {
+		req.Reason = slackRejectReason
+	}
+	_, err = s.moderation.Transition(ctx, workspaceID, testimonialID, firebaseUID, req)
+	switch {
+	case err == nil:
+	case errors.Is(err, apperrors.ErrWorkspaceAccessDenied):
+		return slackEphemeral("You do not have permission to moderate testimonials in this workspace.")
+	case errors.Is(err, apperrors.ErrNotFound):
+		return slackEphemeral("This testimonial no longer exists.")
+	case errors.Is(err, apperrors.ErrConflict):
+		return slackEphemeral("This testimonial has already been moderated.")
+	default:
+		slog.Error("failed to moderate testimonial from slack", "testimonial", testimonialID, "error", err)
+		return slackEphemeral("Something went wrong. Please try again from Cenphi.")
+	}
+
+	verb := "Approved"
+	if status == models.StatusRejected {
+		verb = "Rejected"
+	}
+	note := fmt.Sprintf("%s by <@%s>", verb, interaction.User.ID)
+
+	testimonial, err := s.testimonialRepo.FetchByID(ctx, testimonialID, s.db)
+	if err != nil {
+		return slack.Message{Text: note, Blocks: []slack.Block{slack.Section(note)}, ReplaceOriginal: true}
+	}
+	kind := models.SlackNotificationNew
+	if settings, err := s.repo.GetSettings(ctx, workspaceID, s.db); err == nil && isSlackAlert(settings, testimonial) {
+		kind = models.SlackNotificationAlert
+	}
+	msg := testimonialMessage(testimonial, kind, s.appURL)
+	msg.Blocks = append(msg.Blocks, slack.Context(note))
+	msg.ReplaceOriginal = true
+	return msg
+}
+
+// linkPrompt asks a Slack user to link their account before their clicks count.
+func (s *slackInteractionService) linkPrompt(workspaceID uuid.UUID, interaction *slack.Interaction) slack.Message {
+	if s.linkSecret == "" || s.appURL == "" {
+		return slackEphemeral("Your Slack account is not linked to a Cenphi account, so you cannot moderate from Slack.")
+	}
+	token := slack.IssueLinkToken(s.linkSecret, slack.LinkClaim{
+		WorkspaceID: workspaceID,
+		TeamID:      interaction.Team.ID,
+		UserID:      interaction.User.ID,
+	}, s.now().Add(slackLinkTTL))
+	link := fmt.Sprintf("%s/workspaces/%s/slack/link?token=%s", s.appURL, workspaceID, url.QueryEscape(token))
+	return slackEphemeral(fmt.Sprintf("Link your Slack account to Cenphi to moderate from Slack: <%s|link account>. The link expires in an hour.", link))
+}
+
+func (s *slackInteractionService) LinkUser(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, req models.SlackLinkRequest) (*models.SlackUserLink, error) {
+	member, err := workspaceMember(ctx, s.teamMemberRepo, s.db, workspaceID, firebaseUID)
+	if err != nil {
+		return nil, err
+	}
+	if s.linkSecret == "" {
+		return nil, fmt.Errorf("%w: slack accounts cannot be linked", apperrors.ErrValidationFailed)
+	}
+
+	claim, err := slack.VerifyLinkToken(s.linkSecret, strings.TrimSpace(req.Token), s.now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", apperrors.ErrValidationFailed, err)
+	}
+	if claim.WorkspaceID != workspaceID {
+		return nil, fmt.Errorf("%w: the link is for another workspace", apperrors.ErrValidationFailed)
+	}
+
+	link := &models.SlackUserLink{
+		WorkspaceID:  workspaceID,
+		TeamID:       claim.TeamID,
+		UserID:       claim.UserID,
+		TeamMemberID: member.ID,
+	}
+	if err := s.repo.SaveUserLink(ctx, link, s.db); err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+// parseSlackActionValue splits the workspace and testimonial IDs a button carries.
+func parseSlackActionValue(value string) (uuid.UUID, uuid.UUID, bool) {
+	ws, t, ok := strings.Cut(value, ":")
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+	workspaceID, err := uuid.Parse(ws)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, false
+	}
+	testimonialID, err := uuid.Parse(t)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, false
+	}
+	return workspaceID, testimonialID, true
+}
+
+// slackEphemeral is a reply only the user who clicked sees. The original message is
+// left as it is.
+func slackEphemeral(text string) slack.Message {
+	return slack.Message{Text: text, ResponseType: "ephemeral"}
+}
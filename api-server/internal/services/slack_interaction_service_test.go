@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/ifeanyidike/cenphi/pkg/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeModeration records the transitions asked of it.
+type fakeModeration struct {
+	ModerationService
+	calls []fakeTransition
+	err   error
+}
+
+type fakeTransition struct {
+	WorkspaceID, TestimonialID uuid.UUID
+	FirebaseUID                string
+	Request                    models.TestimonialTransitionRequest
+}
+
+func (f *fakeModeration) Transition(_ context.Context, workspaceID, testimonialID uuid.UUID, firebaseUID string, req models.TestimonialTransitionRequest) (*models.TestimonialTransition, error) {
+	f.calls = append(f.calls, fakeTransition{workspaceID, testimonialID, firebaseUID, req})
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.TestimonialTransition{}, nil
+}
+
+func TestSlackInteractionService(t *testing.T) {
+	ctx := context.Background()
+	db, _, _ := sqlmock.New()
+	workspaceID := uuid.New()
+	testimonialID := uuid.New()
+	now := time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC)
+	signingSecret, linkSecret := "signing-secret", "link-secret"
+	responseURL := "https://hooks.slack.com/actions/T9/1/abc"
+
+	newService := func(t *testing.T) (*slackInteractionService, *mocks.SlackRepository, *mocks.TestimonialRepository, *fakeModeration, *slackTransport) {
+		repo := mocks.NewSlackRepository(t)
+		testimonials := mocks.NewTestimonialRepository(t)
+		moderation := &fakeModeration{}
+		client, transport := newFakeSlack()
+		svc := NewSlackInteractionService(repo, testimonials, mocks.NewTeamMemberRepository(t), moderation, client,
+			signingSecret, linkSecret, "https://app.cenphi.io", db).(*slackInteractionService)
+		svc.now = func() time.Time { return now }
+		return svc, repo, testimonials, moderation, transport
+	}
+	click := func(actionID string) (http.Header, []byte) {
+		payload := `{"type":"block_actions","user":{"id":"U123"},"team":{"id":"T9"},"response_url":"` + responseURL + `",` +
+			`"actions":[{"action_id":"` + actionID + `","value":"` + workspaceID.String() + ":" + testimonialID.String() + `"}]}`
+		body := []byte(url.Values{"payload": {payload}}.Encode())
+		timestamp, signature := slack.SignRequest(signingSecret, now, body)
+		header := http.Header{}
+		header.Set(slack.TimestampHeader, timestamp)
+		header.Set(slack.SignatureHeader, signature)
+		return header, body
+	}
+
+	t.Run("RejectsRequestsNotSignedBySlack", func(t *testing.T) {
+		svc, _, _, _, transport := newService(t)
+		header, body := click(slackActionApprove)
+		header.Set(slack.SignatureHeader, "v0=00")
+
+		err := svc.HandleInteraction(ctx, header, body)
+		assert.ErrorIs(t, err, apperrors.ErrUnauthorized)
+		assert.Empty(t, transport.posts)
+	})
+
+	t.Run("AsksUnlinkedUsersToLinkTheirAccount", func(t *testing.T) {
+		svc, repo, _, _, transport := newService(t)
+		repo.On("GetLinkedFirebaseUID", mock.Anything, workspaceID, "T9", "U123", db).Return("", sql.ErrNoRows)
+
+		header, body := click(slackActionApprove)
+		require.NoError(t, svc.HandleInteraction(ctx, header, body))
+		require.Len(t, transport.posts, 1)
+		reply := transport.posts[0]
+		assert.Equal(t, responseURL, reply.URL)
+		assert.Equal(t, "ephemeral", reply.Message.ResponseType)
+		assert.Contains(t, reply.Message.Text, "https://app.cenphi.io/workspaces/"+workspaceID.String()+"/slack/link?token=")
+	})
+
+	t.Run("ModeratesAsTheLinkedMember", func(t *testing.T) {
+		svc, repo, testimonials, moderation, transport := newService(t)
+		repo.On("GetLinkedFirebaseUID", mock.Anything, workspaceID, "T9", "U123", db).Return("member-uid", nil)
+		testimonials.On("FetchByID", mock.Anything, testimonialID, db).Return(&models.Testimonial{
+			ID:          testimonialID,
+			WorkspaceID: workspaceID,
+			Content:     "Meh",
+			Status:      models.StatusRejected,
+		}, nil)
+		repo.On("GetSettings", mock.Anything, workspaceID, db).Return(models.DefaultSlackSettings(workspaceID), nil)
+
+		header, body := click(slackActionReject)
+		require.NoError(t, svc.HandleInteraction(ctx, header, body))
+		assert.Equal(t, []fakeTransition{{workspaceID, testimonialID, "member-uid", models.TestimonialTransitionRequest{
+			Status: models.StatusRejected,
+			Reason: slackRejectReason,
+		}}}, moderation.calls)
+		require.Len(t, transport.posts, 1)
+		reply := transport.posts[0].Message
+		assert.True(t, reply.ReplaceOriginal)
+		assert.NotContains(t, buttonActions(reply), slackActionApprove)
+		last := reply.Blocks[len(reply.Blocks)-1]
+		assert.Equal(t, "context", last.Type)
+	})
+
+	t.Run("ExplainsWhenTheMemberMayNotModerate", func(t *testing.T) {
+		svc, repo, _, moderation, transport := newService(t)
+		repo.On("GetLinkedFirebaseUID", mock.Anything, workspaceID, "T9", "U123", db).Return("viewer-uid", nil)
+		moderation.err = apperrors.ErrWorkspaceAccessDenied
+
+		header, body := click(slackActionApprove)
+		require.NoError(t, svc.HandleInteraction(ctx, header, body))
+		require.Len(t, transport.posts, 1)
+		assert.Equal(t, "ephemeral", transport.posts[0].Message.ResponseType)
+		assert.Contains(t, transport.posts[0].Message.Text, "permission")
+	})
+
+	t.Run("LinkUserRedeemsTokensForTheWorkspace", func(t *testing.T) {
+		svc, repo, _, _, _ := newService(t)
+		members := mocks.NewTeamMemberRepository(t)
+		svc.teamMemberRepo = members
+		member := &models.TeamMember{ID: uuid.New(), WorkspaceID: workspaceID, Role: models.Editor}
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "member-uid", db).Return(member, nil)
+		repo.On("SaveUserLink", mock.Anything, mock.AnythingOfType("*models.SlackUserLink"), db).Return(nil)
+
+		claim := slack.LinkClaim{WorkspaceID: workspaceID, TeamID: "T9", UserID: "U123"}
+		token := slack.IssueLinkToken(linkSecret, claim, now.Add(time.Hour))
+		link, err := svc.LinkUser(ctx, workspaceID, "member-uid", models.SlackLinkRequest{Token: token})
+		require.NoError(t, err)
+		assert.Equal(t, member.ID, link.TeamMemberID)
+		assert.Equal(t, "U123", link.UserID)
+
+		other := slack.IssueLinkToken(linkSecret, slack.LinkClaim{WorkspaceID: uuid.New(), TeamID: "T9", UserID: "U123"}, now.Add(time.Hour))
+		_, err = svc.LinkUser(ctx, workspaceID, "member-uid", models.SlackLinkRequest{Token: other})
+		assert.ErrorIs(t, err, apperrors.ErrValidationFailed)
+	})
+}
@@ -0,0 +1,442 @@
+package services
+
+//go:generate mockery --name=SlackService --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pkg/events"
+	"github.com/ifeanyidike/cenphi/pkg/slack"
+)
+
+const (
+	maxSlackRules          = 20
+	maxSlackRuleNameLength = 100
+	// maxSlackQuoteLength is how much of a testimonial is quoted in a message.
+	maxSlackQuoteLength = 600
+	// slackNotifyTimeout bounds the work done for one event, which runs after the
+	// request that caused it has returned.
+	slackNotifyTimeout = 30 * time.Second
+
+	slackActionApprove = "approve"
+	slackActionReject  = "reject"
+	slackModerateBlock = "moderate"
+)
+
+// SlackService manages a workspace's Slack settings and posts its testimonials to
+// Slack. It is an events.Publisher: new testimonials are posted when they are created,
+// and posted again as an alert when they, or a later analysis of them, turn out to be
+// negative. Messages are sent in the background so they never hold up the change that
+// caused them.
+type SlackService interface {
+	GetSettings(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) (*models.SlackSettings, error)
+	UpdateSettings(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, req models.SlackSettingsRequest) (*models.SlackSettings, error)
+	// SendTest posts a test message to the workspace's channel and to every channel its
+	// rules route to.
+	SendTest(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) error
+	Publish(ctx context.Context, event events.Event) error
+}
+
+type slackService struct {
+	repo            repositories.SlackRepository
+	testimonialRepo repositories.TestimonialRepository
+	teamMemberRepo  repositories.TeamMemberRepository
+	client          *slack.Client
+	appURL          string
+	db              *sql.DB
+	now             func() time.Time
+	// spawn runs the work for an event; tests run it inline.
+	spawn func(func())
+}
+
+func NewSlackService(
+	repo repositories.SlackRepository,
+	testimonialRepo repositories.TestimonialRepository,
+	teamMemberRepo repositories.TeamMemberRepository,
+	client *slack.Client,
+	appURL string,
+	db *sql.DB,
+) SlackService {
+	return &slackService{
+		repo:            repo,
+		testimonialRepo: testimonialRepo,
+		teamMemberRepo:  teamMemberRepo,
+		client:          client,
+		appURL:          strings.TrimSuffix(appURL, "/"),
+		db:              db,
+		now:             time.Now,
+		spawn:           func(f func()) { go f() },
+	}
+}
+
+// requireManager returns the caller's membership if they may manage the workspace.
+func (s *slackService) requireManager(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) (*models.TeamMember, error) {
+	member, err := workspaceMember(ctx, s.teamMemberRepo, s.db, workspaceID, firebaseUID)
+	if err != nil {
+		return nil, err
+	}
+	if !member.Can(models.PermWorkspaceManage) {
+		return nil, apperrors.ErrWorkspaceAccessDenied
+	}
+	return member, nil
+}
+
+func (s *slackService) GetSettings(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) (*models.SlackSettings, error) {
+	if _, err := s.requireManager(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+	return s.getSettings(ctx, workspaceID)
+}
+
+func (s *slackService) getSettings(ctx context.Context, workspaceID uuid.UUID) (*models.SlackSettings, error) {
+	settings, err := s.repo.GetSettings(ctx, workspaceID, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrWorkspaceNotFound
+	}
+	return settings, err
+}
+
+func (s *slackService) UpdateSettings(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, req models.SlackSettingsRequest) (*models.SlackSettings, error) {
+	if _, err := s.requireManager(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+	if err := validateSlackSettings(&req); err != nil {
+		return nil, err
+	}
+	settings, err := s.getSettings(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings.Enabled = req.Enabled
+	settings.NotifyNew = req.NotifyNew
+	settings.AlertRating = req.AlertRating
+	settings.AlertSentiments = req.AlertSentiments
+	settings.Rules = req.Rules
+	settings.DigestEnabled = req.DigestEnabled
+	settings.DigestHour = req.DigestHour
+	settings.Timezone = req.Timezone
+	if settings.Enabled && len(slackDestinations(settings, nil)) == 0 {
+		return nil, fmt.Errorf("%w: add a Slack webhook URL to the workspace's integration settings or to a routing rule first", apperrors.ErrValidationFailed)
+	}
+
+	settings.NextDigestAt = nil
+	if settings.DigestEnabled {
+		next := settings.NextDigest(s.now())
+		settings.NextDigestAt = &next
+	}
+	if err := s.repo.SaveSettings(ctx, settings, s.db); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func validateSlackSettings(req *models.SlackSettingsRequest) error {
+	if req.AlertRating < 0 || req.AlertRating > 5 {
+		return fmt.Errorf("%w: alert_rating must be between 0 and 5", apperrors.ErrValidationFailed)
+	}
+	if req.AlertSentiments == nil {
+		req.AlertSentiments = []models.Sentiment{}
+	}
+	for _, sentiment := range req.AlertSentiments {
+		if !sentiment.IsValid() {
+			return fmt.Errorf("%w: unknown sentiment %q", apperrors.ErrValidationFailed, sentiment)
+		}
+	}
+	if req.DigestHour < 0 || req.DigestHour > 23 {
+		return fmt.Errorf("%w: digest_hour must be between 0 and 23", apperrors.ErrValidationFailed)
+	}
+	req.Timezone = strings.TrimSpace(req.Timezone)
+	if req.Timezone == "" {
+		req.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(req.Timezone); err != nil {
+		return fmt.Errorf("%w: unknown timezone %q", apperrors.ErrValidationFailed, req.Timezone)
+	}
+
+	if req.Rules == nil {
+		req.Rules = []models.SlackRoutingRule{}
+	}
+	if len(req.Rules) > maxSlackRules {
+		return fmt.Errorf("%w: at most %d routing rules are allowed", apperrors.ErrValidationFailed, maxSlackRules)
+	}
+	for i := range req.Rules {
+		rule := &req.Rules[i]
+		rule.Name = strings.TrimSpace(rule.Name)
+		if rule.Name == "" || len(rule.Name) > maxSlackRuleNameLength {
+			return fmt.Errorf("%w: rule names must be 1 to %d characters", apperrors.ErrValidationFailed, maxSlackRuleNameLength)
+		}
+		if rule.MinRating != nil && rule.MaxRating != nil && *rule.MinRating > *rule.MaxRating {
+			return fmt.Errorf("%w: rule %q has min_rating above max_rating", apperrors.ErrValidationFailed, rule.Name)
+		}
+		rule.WebhookURL = strings.TrimSpace(rule.WebhookURL)
+		if rule.WebhookURL != "" {
+			if err := slack.ValidateWebhookURL(rule.WebhookURL); err != nil {
+				return fmt.Errorf("%w: rule %q: %w", apperrors.ErrValidationFailed, rule.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *slackService) SendTest(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) error {
+	if _, err := s.requireManager(ctx, workspaceID, firebaseUID); err != nil {
+		return err
+	}
+	settings, err := s.getSettings(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	destinations := slackDestinations(settings, nil)
+	if len(destinations) == 0 {
+		return fmt.Errorf("%w: the workspace has no Slack webhook URL", apperrors.ErrValidationFailed)
+	}
+	msg := slack.Message{
+		Text:   "Cenphi is connected",
+		Blocks: []slack.Block{slack.Section(":wave: Cenphi will post testimonials to this channel.")},
+	}
+	for _, u := range destinations {
+		if err := s.client.Post(ctx, u, msg); err != nil {
+			return fmt.Errorf("%w: slack: %w", apperrors.ErrServiceUnavailable, err)
+		}
+	}
+	return nil
+}
+
+func (s *slackService) Publish(ctx context.Context, event events.Event) error {
+	if event.Type != events.TestimonialCreated && event.Type != events.AnalysisCompleted {
+		return nil
+	}
+	ctx = context.WithoutCancel(ctx)
+	s.spawn(func() {
+		ctx, cancel := context.WithTimeout(ctx, slackNotifyTimeout)
+		defer cancel()
+		if err := s.notify(ctx, event); err != nil {
+			slog.Warn("failed to post testimonial to slack", "type", event.Type, "testimonial", event.SubjectID, "error", err)
+		}
+	})
+	return nil
+}
+
+// notify posts the testimonial an event is about, if the workspace wants it and it has
+// not been posted as the same kind of message before.
+func (s *slackService) notify(ctx context.Context, event events.Event) error {
+	settings, err := s.repo.GetSettings(ctx, event.WorkspaceID, s.db)
+	if err != nil {
+		return err
+	}
+	if !settings.Enabled {
+		return nil
+	}
+
+	testimonial, err := s.testimonialRepo.FetchByID(ctx, event.SubjectID, s.db)
+	if err != nil {
+		return err
+	}
+
+	kind := models.SlackNotificationNew
+	if isSlackAlert(settings, testimonial) {
+		kind = models.SlackNotificationAlert
+	} else if !settings.NotifyNew || event.Type != events.TestimonialCreated {
+		return nil
+	}
+
+	destinations := slackDestinations(settings, testimonial)
+	if len(destinations) == 0 {
+		return nil
+	}
+	claimed, err := s.repo.ClaimNotification(ctx, settings.WorkspaceID, testimonial.ID, kind, s.db)
+	if err != nil || !claimed {
+		return err
+	}
+
+	msg := testimonialMessage(testimonial, kind, s.appURL)
+	var errs []error
+	for _, u := range destinations {
+		if err := s.client.Post(ctx, u, msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == len(destinations) {
+		// Nothing went out, so let a later event try again
+		if err := s.repo.ReleaseNotification(ctx, testimonial.ID, kind, s.db); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// isSlackAlert reports whether a testimonial is rated or analysed as negative enough
+// for the workspace to be alerted about it.
+func isSlackAlert(settings *models.SlackSettings, t *models.Testimonial) bool {
+	if t.Rating != nil && *t.Rating <= settings.AlertRating {
+		return true
+	}
+	sentiment, ok := testimonialSentiment(t)
+	if !ok {
+		return false
+	}
+	for _, s := range settings.AlertSentiments {
+		if s == sentiment {
+			return true
+		}
+	}
+	return false
+}
+
+// testimonialSentiment returns the sentiment of a testimonial's latest sentiment analysis.
+func testimonialSentiment(t *models.Testimonial) (models.Sentiment, bool) {
+	var latest *models.TestimonialAnalysis
+	for i := range t.Analyses {
+		a := &t.Analyses[i]
+		if a.AnalysisType != models.AnalysisTypeSentiment || a.SentimentScore == nil {
+			continue
+		}
+		if latest == nil || a.CreatedAt.After(latest.CreatedAt) {
+			latest = a
+		}
+	}
+	if latest == nil {
+		return "", false
+	}
+	return models.SentimentForScore(*latest.SentimentScore), true
+}
+
+// testimonialProvider is where a testimonial came from: the platform it was imported
+// from, or how it was collected.
+func testimonialProvider(t *models.Testimonial) string {
+	if platform, ok := t.SourceData["platform"].(string); ok && platform != "" {
+		return platform
+	}
+	return string(t.CollectionMethod)
+}
+
+// slackDestinations returns the incoming webhooks a testimonial is posted to. Without
+// rules that is the workspace's channel; with rules it is the channel of every rule the
+// testimonial matches. A nil testimonial matches every rule. URLs that are not Slack
+// incoming webhooks are skipped.
+func slackDestinations(settings *models.SlackSettings, t *models.Testimonial) []string {
+	candidates := []string{settings.WebhookURL}
+	if len(settings.Rules) > 0 {
+		candidates = candidates[:0]
+		for _, rule := range settings.Rules {
+			if t != nil && !rule.Matches(testimonialProvider(t), t.Rating, t.Tags, isSlackAlert(settings, t)) {
+				continue
+			}
+			u := rule.WebhookURL
+			if u == "" {
+				u = settings.WebhookURL
+			}
+			candidates = append(candidates, u)
+		}
+	}
+
+	destinations := []string{}
+	seen := map[string]bool{}
+	for _, u := range candidates {
+		if seen[u] || slack.ValidateWebhookURL(u) != nil {
+			continue
+		}
+		seen[u] = true
+		destinations = append(destinations, u)
+	}
+	return destinations
+}
+
+// testimonialMessage renders a testimonial. Testimonials awaiting review get approve
+// and reject buttons.
+func testimonialMessage(t *models.Testimonial, kind models.SlackNotificationKind, appURL string) slack.Message {
+	title := "New testimonial"
+	if kind == models.SlackNotificationAlert {
+		title = ":rotating_light: Negative testimonial"
+	}
+
+	provider := testimonialProvider(t)
+	fields := []string{"*Source*\n" + slackEscape(slackDisplayName(provider)), "*Status*\n" + slackEscape(string(t.Status))}
+	if t.Rating != nil {
+		fields = append([]string{"*Rating*\n" + ratingStars(*t.Rating)}, fields...)
+	}
+	if t.CustomerProfile != nil && t.CustomerProfile.Name != "" {
+		fields = append(fields, "*Customer*\n"+slackEscape(t.CustomerProfile.Name))
+	}
+	if sentiment, ok := testimonialSentiment(t); ok {
+		fields = append(fields, "*Sentiment*\n"+strings.ReplaceAll(string(sentiment), "_", " "))
+	}
+
+	quote := t.Content
+	if quote == "" {
+		quote = t.Summary
+	}
+	if quote == "" {
+		quote = "_No text_"
+	} else {
+		quote = slackQuote(quote)
+	}
+
+	blocks := []slack.Block{slack.Header(title), slack.Section(quote, fields...)}
+	if len(t.Tags) > 0 {
+		blocks = append(blocks, slack.Context("Tags: "+slackEscape(strings.Join(t.Tags, ", "))))
+	}
+
+	var buttons []slack.Element
+	value := t.WorkspaceID.String() + ":" + t.ID.String()
+	if _, ok := models.TransitionRoles(t.Status, models.StatusApproved); ok {
+		buttons = append(buttons, slack.Button("Approve", slackActionApprove, value, "primary"))
+	}
+	if _, ok := models.TransitionRoles(t.Status, models.StatusRejected); ok {
+		buttons = append(buttons, slack.Button("Reject", slackActionReject, value, "danger"))
+	}
+	if appURL != "" {
+		view := slack.Button("Open in Cenphi", "open", "", "")
+		view.URL = appURL + "/testimonials/" + t.ID.String()
+		buttons = append(buttons, view)
+	}
+	if len(buttons) > 0 {
+		blocks = append(blocks, slack.Actions(slackModerateBlock, buttons...))
+	}
+
+	return slack.Message{Text: title + " from " + slackDisplayName(provider), Blocks: blocks}
+}
+
+func ratingStars(rating float32) string {
+	full := int(math.Round(float64(max(0, min(5, rating)))))
+	return strings.Repeat("★", full) + strings.Repeat("☆", 5-full) + fmt.Sprintf(" %g/5", rating)
+}
+
+func slackDisplayName(s string) string {
+	s = strings.ReplaceAll(s, "_", " ")
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func slackTruncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "…"
+}
+
+// slackQuote renders the start of a testimonial as a block quote.
+func slackQuote(text string) string {
+	return "> " + strings.ReplaceAll(slackEscape(slackTruncate(text, maxSlackQuoteLength)), "\n", "\n> ")
+}
+
+// slackEscape escapes the characters Slack treats as markup in mrkdwn text.
+func slackEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
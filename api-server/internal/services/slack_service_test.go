@@ -0,0 +1,237 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/ifeanyidike/cenphi/pkg/events"
+	"github.com/ifeanyidike/cenphi/pkg/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// slackPost is a message the fake Slack received.
+type slackPost struct {
+	URL     string
+	Message slack.Message
+}
+
+type slackTransport struct {
+	posts  []slackPost
+	status int
+}
+
+func (s *slackTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	var msg slack.Message
+	body, _ := io.ReadAll(r.Body)
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil, err
+	}
+	s.posts = append(s.posts, slackPost{URL: r.URL.String(), Message: msg})
+
+	status := s.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader("ok")), Request: r}, nil
+}
+
+// newFakeSlack returns a client whose messages are recorded instead of sent.
+func newFakeSlack() (*slack.Client, *slackTransport) {
+	transport := &slackTransport{}
+	return slack.NewClient(&http.Client{Transport: transport}), transport
+}
+
+func buttonActions(msg slack.Message) []string {
+	var actions []string
+	for _, block := range msg.Blocks {
+		if block.Type != "actions" {
+			continue
+		}
+		for _, e := range block.Elements {
+			// Decoded from JSON, elements are maps
+			actions = append(actions, e.(map[string]any)["action_id"].(string))
+		}
+	}
+	return actions
+}
+
+func TestSlackService(t *testing.T) {
+	ctx := context.Background()
+	db, _, _ := sqlmock.New()
+	workspaceID := uuid.New()
+	admin := &models.TeamMember{ID: uuid.New(), WorkspaceID: workspaceID, Role: models.Admin}
+	now := time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC)
+	channel := "https://hooks.slack.com/services/T0/B0/main"
+	alerts := "https://hooks.slack.com/services/T0/B1/alerts"
+
+	newService := func(t *testing.T) (*slackService, *mocks.SlackRepository, *mocks.TestimonialRepository, *mocks.TeamMemberRepository, *slackTransport) {
+		repo := mocks.NewSlackRepository(t)
+		testimonials := mocks.NewTestimonialRepository(t)
+		members := mocks.NewTeamMemberRepository(t)
+		client, transport := newFakeSlack()
+		svc := NewSlackService(repo, testimonials, members, client, "https://app.cenphi.io/", db).(*slackService)
+		svc.now = func() time.Time { return now }
+		svc.spawn = func(f func()) { f() }
+		return svc, repo, testimonials, members, transport
+	}
+	enabled := func() *models.SlackSettings {
+		settings := models.DefaultSlackSettings(workspaceID)
+		settings.Enabled = true
+		settings.WebhookURL = channel
+		return settings
+	}
+	rating := func(r float32) *float32 { return &r }
+	testimonial := func(r float32) *models.Testimonial {
+		return &models.Testimonial{
+			ID:               uuid.New(),
+			WorkspaceID:      workspaceID,
+			Content:          "Great <b>service</b>",
+			Rating:           rating(r),
+			Status:           models.StatusPendingReview,
+			CollectionMethod: models.CollectionMethod("direct_link"),
+			SourceData:       models.JSONMap{"platform": "google"},
+		}
+	}
+	created := func(t *models.Testimonial) events.Event {
+		return events.Event{Type: events.TestimonialCreated, WorkspaceID: workspaceID, SubjectID: t.ID}
+	}
+
+	t.Run("UpdateSettingsValidates", func(t *testing.T) {
+		svc, repo, _, members, _ := newService(t)
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "admin-uid", db).Return(admin, nil)
+		repo.On("GetSettings", mock.Anything, workspaceID, db).Return(models.DefaultSlackSettings(workspaceID), nil).Maybe()
+
+		for _, req := range []models.SlackSettingsRequest{
+			{AlertRating: 6},
+			{AlertSentiments: []models.Sentiment{"furious"}},
+			{DigestHour: 24},
+			{Timezone: "Mars/Olympus"},
+			{Rules: []models.SlackRoutingRule{{Name: "Bad", WebhookURL: "https://example.com/hook"}}},
+			{Rules: []models.SlackRoutingRule{{Name: "Upside down", MinRating: rating(4), MaxRating: rating(2)}}},
+			// Enabled without anywhere to post
+			{Enabled: true},
+		} {
+			_, err := svc.UpdateSettings(ctx, workspaceID, "admin-uid", req)
+			assert.ErrorIs(t, err, apperrors.ErrValidationFailed, req)
+		}
+	})
+
+	t.Run("UpdateSettingsSchedulesTheDigestInTheWorkspaceTimezone", func(t *testing.T) {
+		svc, repo, _, members, _ := newService(t)
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "admin-uid", db).Return(admin, nil)
+		repo.On("GetSettings", mock.Anything, workspaceID, db).Return(enabled(), nil)
+		repo.On("SaveSettings", mock.Anything, mock.AnythingOfType("*models.SlackSettings"), db).Return(nil)
+
+		settings, err := svc.UpdateSettings(ctx, workspaceID, "admin-uid", models.SlackSettingsRequest{
+			Enabled:       true,
+			DigestEnabled: true,
+			DigestHour:    9,
+			Timezone:      "America/New_York",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, settings.NextDigestAt)
+		// 09:30 UTC is 05:30 in New York, so the digest goes out at 09:00 there today
+		assert.Equal(t, time.Date(2024, 5, 1, 13, 0, 0, 0, time.UTC), *settings.NextDigestAt)
+	})
+
+	t.Run("EditorsCannotManageSlack", func(t *testing.T) {
+		svc, _, _, members, _ := newService(t)
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "editor-uid", db).Return(&models.TeamMember{Role: models.Editor}, nil)
+
+		_, err := svc.GetSettings(ctx, workspaceID, "editor-uid")
+		assert.ErrorIs(t, err, apperrors.ErrWorkspaceAccessDenied)
+	})
+
+	t.Run("PostsNewTestimonialsWithModerationButtons", func(t *testing.T) {
+		svc, repo, testimonials, _, transport := newService(t)
+		tm := testimonial(5)
+		repo.On("GetSettings", mock.Anything, workspaceID, db).Return(enabled(), nil)
+		testimonials.On("FetchByID", mock.Anything, tm.ID, db).Return(tm, nil)
+		repo.On("ClaimNotification", mock.Anything, workspaceID, tm.ID, models.SlackNotificationNew, db).Return(true, nil)
+
+		require.NoError(t, svc.Publish(ctx, created(tm)))
+		require.Len(t, transport.posts, 1)
+		post := transport.posts[0]
+		assert.Equal(t, channel, post.URL)
+		assert.Equal(t, "New testimonial", post.Message.Blocks[0].Text.Text)
+		assert.Contains(t, post.Message.Blocks[1].Text.Text, "Great &lt;b&gt;service&lt;/b&gt;")
+		assert.Equal(t, []string{slackActionApprove, slackActionReject, "open"}, buttonActions(post.Message))
+	})
+
+	t.Run("AlertsWhenAnAnalysisTurnsOutNegative", func(t *testing.T) {
+		svc, repo, testimonials, _, transport := newService(t)
+		tm := testimonial(4)
+		tm.Analyses = []models.TestimonialAnalysis{{AnalysisType: models.AnalysisTypeSentiment, SentimentScore: rating(-0.9)}}
+		repo.On("GetSettings", mock.Anything, workspaceID, db).Return(enabled(), nil)
+		testimonials.On("FetchByID", mock.Anything, tm.ID, db).Return(tm, nil)
+		repo.On("ClaimNotification", mock.Anything, workspaceID, tm.ID, models.SlackNotificationAlert, db).Return(true, nil)
+
+		event := events.Event{Type: events.AnalysisCompleted, WorkspaceID: workspaceID, SubjectID: tm.ID}
+		require.NoError(t, svc.Publish(ctx, event))
+		require.Len(t, transport.posts, 1)
+		assert.Contains(t, transport.posts[0].Message.Blocks[0].Text.Text, "Negative testimonial")
+	})
+
+	t.Run("SkipsWhatWasAlreadyPostedOrNotWanted", func(t *testing.T) {
+		svc, repo, testimonials, _, transport := newService(t)
+		posted, quiet := testimonial(5), testimonial(4)
+		settings := enabled()
+		repo.On("GetSettings", mock.Anything, workspaceID, db).Return(settings, nil)
+		testimonials.On("FetchByID", mock.Anything, posted.ID, db).Return(posted, nil)
+		testimonials.On("FetchByID", mock.Anything, quiet.ID, db).Return(quiet, nil)
+		repo.On("ClaimNotification", mock.Anything, workspaceID, posted.ID, models.SlackNotificationNew, db).Return(false, nil)
+
+		require.NoError(t, svc.Publish(ctx, created(posted)))
+		// Analyses of testimonials that are not negative are not posted
+		require.NoError(t, svc.Publish(ctx, events.Event{Type: events.AnalysisCompleted, WorkspaceID: workspaceID, SubjectID: quiet.ID}))
+		// Neither are other events
+		require.NoError(t, svc.Publish(ctx, events.Event{Type: events.SyncFailed, WorkspaceID: workspaceID}))
+		assert.Empty(t, transport.posts)
+	})
+
+	t.Run("RoutesByRule", func(t *testing.T) {
+		svc, repo, testimonials, _, transport := newService(t)
+		settings := enabled()
+		settings.Rules = []models.SlackRoutingRule{
+			{Name: "Low ratings", MaxRating: rating(2), WebhookURL: alerts},
+			{Name: "Google", Providers: []string{"Google"}},
+		}
+		low, high := testimonial(1), testimonial(5)
+		high.SourceData = models.JSONMap{"platform": "yelp"}
+		repo.On("GetSettings", mock.Anything, workspaceID, db).Return(settings, nil)
+		testimonials.On("FetchByID", mock.Anything, low.ID, db).Return(low, nil)
+		testimonials.On("FetchByID", mock.Anything, high.ID, db).Return(high, nil)
+		repo.On("ClaimNotification", mock.Anything, workspaceID, low.ID, models.SlackNotificationAlert, db).Return(true, nil)
+
+		require.NoError(t, svc.Publish(ctx, created(low)))
+		require.NoError(t, svc.Publish(ctx, created(high)))
+		require.Len(t, transport.posts, 2)
+		assert.Equal(t, alerts, transport.posts[0].URL)
+		assert.Equal(t, channel, transport.posts[1].URL)
+	})
+
+	t.Run("ReleasesTheClaimWhenSlackRejectsTheMessage", func(t *testing.T) {
+		svc, repo, testimonials, _, transport := newService(t)
+		transport.status = http.StatusNotFound
+		tm := testimonial(5)
+		repo.On("GetSettings", mock.Anything, workspaceID, db).Return(enabled(), nil)
+		testimonials.On("FetchByID", mock.Anything, tm.ID, db).Return(tm, nil)
+		repo.On("ClaimNotification", mock.Anything, workspaceID, tm.ID, models.SlackNotificationNew, db).Return(true, nil)
+		repo.On("ReleaseNotification", mock.Anything, tm.ID, models.SlackNotificationNew, db).Return(nil)
+
+		err := svc.notify(ctx, created(tm))
+		assert.ErrorContains(t, err, "404")
+	})
+}
@@ -6,18 +6,30 @@ package services
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
 	"github.com/ifeanyidike/cenphi/internal/models"
 	"github.com/ifeanyidike/cenphi/internal/repositories"
 )
 
+// TeamMemberService reads and removes team members. Members are only ever added by
+// accepting an invitation, so that plan seats and role rules are enforced; see
+// InvitationService.
 type TeamMemberService interface {
-	AddTeamMember(ctx context.Context, teamMember *models.TeamMember) error
-	RemoveTeamMember(ctx context.Context, id uuid.UUID) error
+	// RemoveTeamMember removes a member of the workspace on behalf of the user.
+	// Members of other workspaces are reported as apperrors.ErrNotFound.
+	RemoveTeamMember(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, id uuid.UUID) error
 	GetTeamMembers(ctx context.Context, workspaceID uuid.UUID, page, pageSize int) ([]*models.TeamMember, error)
-	GetTeamMemberData(ctx context.Context, id uuid.UUID) (*models.TeamMemberGetParams, error)
+	// GetTeamMemberData returns a member of the workspace. Members of other
+	// workspaces are reported as apperrors.ErrNotFound.
+	GetTeamMemberData(ctx context.Context, workspaceID uuid.UUID, id uuid.UUID) (*models.TeamMemberGetParams, error)
 	GetTeamMemberDataByUserID(ctx context.Context, id uuid.UUID) (*models.TeamMemberGetParams, error)
+	// WorkspaceMember returns the user's membership of the workspace with their
+	// permission overrides. Non-members get apperrors.ErrWorkspaceAccessDenied.
+	WorkspaceMember(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) (*models.TeamMember, error)
 }
 
 type teamMemberService struct {
@@ -29,11 +41,30 @@ func NewTeamMemberService(repo repositories.TeamMemberRepository, db *sql.DB) Te
 	return &teamMemberService{repo: repo, db: db}
 }
 
-func (s *teamMemberService) AddTeamMember(ctx context.Context, teamMember *models.TeamMember) error {
-	return s.repo.Create(ctx, teamMember, s.db)
-}
+func (s *teamMemberService) RemoveTeamMember(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, id uuid.UUID) error {
+	remover, err := workspaceMember(ctx, s.repo, s.db, workspaceID, firebaseUID)
+	if err != nil {
+		return err
+	}
+	if !remover.Can(models.PermMembersManage) {
+		return apperrors.ErrWorkspaceAccessDenied
+	}
 
-func (s *teamMemberService) RemoveTeamMember(ctx context.Context, id uuid.UUID) error {
+	member, err := s.repo.GetByID(ctx, id, s.db)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && member.WorkspaceID != workspaceID) {
+		return apperrors.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	// Removing the owner would leave the workspace without one
+	if member.Role == models.Owner {
+		return fmt.Errorf("%w: the workspace owner cannot be removed", apperrors.ErrValidationFailed)
+	}
+	// Admins can manage the team too, so only owners may remove them
+	if member.Role == models.Admin && remover.Role != models.Owner {
+		return apperrors.ErrWorkspaceAccessDenied
+	}
 	return s.repo.Delete(ctx, id, s.db)
 }
 
@@ -41,10 +72,22 @@ func (s *teamMemberService) GetTeamMembers(ctx context.Context, workspaceID uuid
 	return s.repo.GetByWorkspaceID(ctx, workspaceID, page, pageSize, s.db)
 }
 
-func (s *teamMemberService) GetTeamMemberData(ctx context.Context, id uuid.UUID) (*models.TeamMemberGetParams, error) {
-	return s.repo.GetDataByID(ctx, id, s.db)
+func (s *teamMemberService) GetTeamMemberData(ctx context.Context, workspaceID uuid.UUID, id uuid.UUID) (*models.TeamMemberGetParams, error) {
+	member, err := s.repo.GetDataByID(ctx, id, s.db)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && member.WorkspaceID != workspaceID) {
+		return nil, apperrors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	member.ID = id
+	return member, nil
 }
 
 func (s *teamMemberService) GetTeamMemberDataByUserID(ctx context.Context, id uuid.UUID) (*models.TeamMemberGetParams, error) {
 	return s.repo.GetDataByUserID(ctx, id, s.db)
 }
+
+func (s *teamMemberService) WorkspaceMember(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) (*models.TeamMember, error) {
+	return workspaceMember(ctx, s.repo, s.db, workspaceID, firebaseUID)
+}
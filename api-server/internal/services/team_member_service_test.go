@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamMemberService(t *testing.T) {
+	ctx := context.Background()
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	workspaceID := uuid.New()
+	memberID := uuid.New()
+
+	setup := func(t *testing.T, remover models.MemberRole) (*teamMemberService, *mocks.TeamMemberRepository) {
+		repo := mocks.NewTeamMemberRepository(t)
+		repo.On("GetByFirebaseUID", mock.Anything, workspaceID, "uid", db).
+			Return(&models.TeamMember{WorkspaceID: workspaceID, Role: remover}, nil).Maybe()
+		return NewTeamMemberService(repo, db).(*teamMemberService), repo
+	}
+
+	t.Run("MembersOfOtherWorkspacesAreNotFound", func(t *testing.T) {
+		svc, repo := setup(t, models.Owner)
+		other := models.TeamMember{WorkspaceID: uuid.New(), Role: models.Editor}
+		repo.On("GetByID", mock.Anything, memberID, db).Return(&other, nil)
+		repo.On("GetDataByID", mock.Anything, memberID, db).Return(&models.TeamMemberGetParams{TeamMember: other}, nil)
+
+		assert.ErrorIs(t, svc.RemoveTeamMember(ctx, workspaceID, "uid", memberID), apperrors.ErrNotFound)
+		_, err := svc.GetTeamMemberData(ctx, workspaceID, memberID)
+		assert.ErrorIs(t, err, apperrors.ErrNotFound)
+		repo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("RemovesMembers", func(t *testing.T) {
+		svc, repo := setup(t, models.Admin)
+		repo.On("GetByID", mock.Anything, memberID, db).Return(&models.TeamMember{WorkspaceID: workspaceID, Role: models.Editor}, nil)
+		repo.On("Delete", mock.Anything, memberID, db).Return(nil)
+
+		assert.NoError(t, svc.RemoveTeamMember(ctx, workspaceID, "uid", memberID))
+	})
+
+	t.Run("OnlyOwnersRemoveAdmins", func(t *testing.T) {
+		svc, repo := setup(t, models.Admin)
+		repo.On("GetByID", mock.Anything, memberID, db).Return(&models.TeamMember{WorkspaceID: workspaceID, Role: models.Admin}, nil)
+
+		assert.ErrorIs(t, svc.RemoveTeamMember(ctx, workspaceID, "uid", memberID), apperrors.ErrWorkspaceAccessDenied)
+	})
+
+	t.Run("TheOwnerCannotBeRemoved", func(t *testing.T) {
+		svc, repo := setup(t, models.Owner)
+		repo.On("GetByID", mock.Anything, memberID, db).Return(&models.TeamMember{WorkspaceID: workspaceID, Role: models.Owner}, nil)
+
+		assert.ErrorIs(t, svc.RemoveTeamMember(ctx, workspaceID, "uid", memberID), apperrors.ErrValidationFailed)
+	})
+
+	t.Run("RemovingRequiresMembersManage", func(t *testing.T) {
+		svc, _ := setup(t, models.Viewer)
+
+		assert.ErrorIs(t, svc.RemoveTeamMember(ctx, workspaceID, "uid", memberID), apperrors.ErrWorkspaceAccessDenied)
+	})
+
+	t.Run("UnknownMembersAreNotFound", func(t *testing.T) {
+		svc, repo := setup(t, models.Owner)
+		repo.On("GetDataByID", mock.Anything, memberID, db).Return(nil, sql.ErrNoRows)
+
+		_, err := svc.GetTeamMemberData(ctx, workspaceID, memberID)
+		assert.ErrorIs(t, err, apperrors.ErrNotFound)
+	})
+}
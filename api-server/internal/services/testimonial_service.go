@@ -3,45 +3,301 @@ package services
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
+	"html"
+	"log/slog"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/contracts"
 	"github.com/ifeanyidike/cenphi/internal/models"
 	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pkg/events"
 )
 
 type TestimonialService interface {
 	ProcessTestimonials(ctx context.Context, testimonials []models.Testimonial) error
-	ValidateTestimonial(t models.Testimonial) error
+	ValidateTestimonial(t *models.Testimonial) error
+	Submit(ctx context.Context, workspaceID uuid.UUID, method models.CollectionMethod, submission models.TestimonialSubmission) (*models.Testimonial, error)
 	FetchByWorkspaceID(ctx context.Context, workspaceID uuid.UUID, filter models.TestimonialFilter) ([]models.Testimonial, error)
+	// ListPage returns one page of a workspace's testimonials with the total number that
+	// match the filter.
+	ListPage(ctx context.Context, workspaceID uuid.UUID, page models.TestimonialPageRequest) (*models.TestimonialPage, error)
+	// Search ranks a workspace's testimonials against a query and returns highlighted
+	// snippets of where they matched.
+	Search(ctx context.Context, workspaceID uuid.UUID, req models.TestimonialSearchRequest) ([]models.TestimonialSearchHit, error)
 	FetchByID(ctx context.Context, id uuid.UUID) (*models.Testimonial, error)
 }
 
 type testimonialService struct {
-	repo repositories.TestimonialRepository
-	db   *sql.DB
+	repo         repositories.TestimonialRepository
+	profileRepo  repositories.CustomerProfileRepository
+	authenticity AuthenticityService
+	entitlements EntitlementService
+	events       events.Publisher
+	db           *sql.DB
 }
 
-func NewTestimonialService(repo repositories.TestimonialRepository, db *sql.DB) TestimonialService {
-	return &testimonialService{repo: repo, db: db}
+// NewTestimonialService returns a testimonial service. Plan quotas are only enforced
+// when entitlements is set, and new testimonials are only broadcast when publisher is.
+func NewTestimonialService(
+	repo repositories.TestimonialRepository,
+	profileRepo repositories.CustomerProfileRepository,
+	authenticity AuthenticityService,
+	entitlements EntitlementService,
+	publisher events.Publisher,
+	db *sql.DB,
+) TestimonialService {
+	return &testimonialService{
+		repo:         repo,
+		profileRepo:  profileRepo,
+		authenticity: authenticity,
+		entitlements: entitlements,
+		events:       publisher,
+		db:           db,
+	}
 }
 
 func (s *testimonialService) ProcessTestimonials(ctx context.Context, testimonials []models.Testimonial) error {
-	for _, t := range testimonials {
-		if err := s.ValidateTestimonial(t); err != nil {
+	checked := make(map[uuid.UUID]bool)
+	for i := range testimonials {
+		if err := s.ValidateTestimonial(&testimonials[i]); err != nil {
 			return err
 		}
+		// Imports may update testimonials that are already stored, so a batch only needs
+		// room for one more and may take the workspace past its limit
+		if workspaceID := testimonials[i].WorkspaceID; !checked[workspaceID] {
+			if err := s.checkQuota(ctx, workspaceID, s.db); err != nil {
+				return err
+			}
+			checked[workspaceID] = true
+		}
+	}
+	inserted, err := s.repo.BatchUpsert(ctx, testimonials, s.db)
+	if err != nil {
+		return err
 	}
-	return s.repo.BatchUpsert(ctx, testimonials, s.db)
+	s.scheduleAuthenticity(ctx, inserted...)
+	broadcastCreated(ctx, s.events, testimonials, inserted)
+	return nil
 }
 
-func (s *testimonialService) ValidateTestimonial(t models.Testimonial) error {
+// ValidateTestimonial applies the model's own rules and the checks that depend on the
+// testimonial's format. Errors wrap apperrors.ErrValidationFailed.
+func (s *testimonialService) ValidateTestimonial(t *models.Testimonial) error {
+	if err := t.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", apperrors.ErrValidationFailed, err)
+	}
+
+	switch t.Format {
+	case models.ContentFormatText, models.ContentFormatSocialPost:
+		// Star-only reviews imported from providers carry a rating but no text
+		if strings.TrimSpace(t.Content) == "" && t.Rating == nil {
+			return fmt.Errorf("%w: content or rating is required for %s testimonials", apperrors.ErrValidationFailed, t.Format)
+		}
+	case models.ContentFormatVideo, models.ContentFormatAudio, models.ContentFormatImage:
+		if t.MediaURL == nil && len(t.MediaURLs) == 0 {
+			return fmt.Errorf("%w: media_url is required for %s testimonials", apperrors.ErrValidationFailed, t.Format)
+		}
+	case models.ContentFormatSurvey, models.ContentFormatInterview:
+	default:
+		return fmt.Errorf("%w: unknown format %q", apperrors.ErrValidationFailed, t.Format)
+	}
+
 	return nil
 }
 
+// Submit validates and stores a testimonial received from outside the app, through the
+// public API or a collection portal. It is always stored pending review.
+func (s *testimonialService) Submit(ctx context.Context, workspaceID uuid.UUID, method models.CollectionMethod, submission models.TestimonialSubmission) (*models.Testimonial, error) {
+	testimonial := submission.ToTestimonial(workspaceID, method)
+	if err := s.ValidateTestimonial(&testimonial); err != nil {
+		return nil, err
+	}
+	// The quota check locks the workspace until the testimonial is stored, so concurrent
+	// submissions cannot all pass it
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+	defer tx.Rollback()
+
+	if err := s.checkSubmissionQuota(ctx, &testimonial, tx); err != nil {
+		return nil, err
+	}
+
+	if c := submission.Customer; c != nil && (c.Email != "" || c.ExternalID != "") {
+		profile, err := s.profileRepo.GetOrCreate(ctx, contracts.ReviewerData{
+			Name:       c.Name,
+			Email:      c.Email,
+			ExternalID: c.ExternalID,
+		}, workspaceID, string(method), tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve customer profile: %w", err)
+		}
+		testimonial.CustomerProfileID = &profile.ID
+	}
+
+	if err := s.repo.Create(ctx, &testimonial, tx); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+	s.scheduleAuthenticity(ctx, testimonial.ID)
+	broadcast(ctx, s.events, createdEvent(&testimonial))
+	return &testimonial, nil
+}
+
+// checkQuota fails when the workspace has stored every testimonial its plan allows
+// this month.
+func (s *testimonialService) checkQuota(ctx context.Context, workspaceID uuid.UUID, db repositories.DB) error {
+	if s.entitlements == nil {
+		return nil
+	}
+	return s.entitlements.Check(ctx, workspaceID, models.QuotaTestimonials, 1, db)
+}
+
+// checkSubmissionQuota is checkQuota for a single submission, which for videos also
+// fails when their length would take the workspace over its video minutes. Videos
+// submitted through the API or a portal count just like uploaded ones.
+func (s *testimonialService) checkSubmissionQuota(ctx context.Context, t *models.Testimonial, db repositories.DB) error {
+	if err := s.checkQuota(ctx, t.WorkspaceID, db); err != nil {
+		return err
+	}
+	if s.entitlements == nil || t.Format != models.ContentFormatVideo {
+		return nil
+	}
+	var seconds int
+	if t.MediaDuration != nil {
+		seconds = *t.MediaDuration
+	}
+	return s.entitlements.Check(ctx, t.WorkspaceID, models.QuotaVideoMinutes, videoMinutes(seconds), db)
+}
+
+// scheduleAuthenticity queues authenticity checks for new testimonials. The testimonial
+// is already stored, so a failure is only logged.
+func (s *testimonialService) scheduleAuthenticity(ctx context.Context, testimonialIDs ...uuid.UUID) {
+	if s.authenticity == nil || len(testimonialIDs) == 0 {
+		return
+	}
+	if err := s.authenticity.Schedule(ctx, testimonialIDs...); err != nil {
+		slog.Error("failed to schedule authenticity checks", "testimonials", len(testimonialIDs), "error", err)
+	}
+}
+
+// createdEvent describes a newly stored testimonial.
+func createdEvent(t *models.Testimonial) events.Event {
+	data := map[string]any{
+		"collection_method": t.CollectionMethod,
+		"format":            t.Format,
+		"status":            t.Status,
+	}
+	if t.Rating != nil {
+		data["rating"] = *t.Rating
+	}
+	return events.Event{
+		Type:        events.TestimonialCreated,
+		WorkspaceID: t.WorkspaceID,
+		SubjectID:   t.ID,
+		OccurredAt:  time.Now(),
+		Data:        data,
+	}
+}
+
+// broadcastCreated broadcasts the testimonials of an import batch that were inserted
+// rather than updated.
+func broadcastCreated(ctx context.Context, publisher events.Publisher, testimonials []models.Testimonial, inserted []uuid.UUID) {
+	if publisher == nil || len(inserted) == 0 {
+		return
+	}
+	isNew := make(map[uuid.UUID]bool, len(inserted))
+	for _, id := range inserted {
+		isNew[id] = true
+	}
+	for i := range testimonials {
+		if isNew[testimonials[i].ID] {
+			broadcast(ctx, publisher, createdEvent(&testimonials[i]))
+		}
+	}
+}
+
 func (s *testimonialService) FetchByWorkspaceID(ctx context.Context, workspaceID uuid.UUID, filter models.TestimonialFilter) ([]models.Testimonial, error) {
 	return s.repo.FetchByWorkspaceID(ctx, workspaceID, filter, s.db)
 }
 
+func (s *testimonialService) ListPage(ctx context.Context, workspaceID uuid.UUID, page models.TestimonialPageRequest) (*models.TestimonialPage, error) {
+	testimonials, next, err := s.repo.FetchPage(ctx, workspaceID, page, s.db)
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.repo.CountByWorkspaceID(ctx, workspaceID, page.Filter, s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.TestimonialPage{Data: testimonials, Total: total}
+	if next != nil {
+		result.NextCursor = next.Encode()
+	}
+	if len(page.Fields) > 0 {
+		if result.Data, err = projectTestimonials(testimonials, page.Fields); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// projectTestimonials keeps only the requested fields of each testimonial, so that
+// unselected fields are left out of the response rather than sent as zero values.
+func projectTestimonials(testimonials []models.Testimonial, fields []string) ([]map[string]any, error) {
+	projected := make([]map[string]any, 0, len(testimonials))
+	for i := range testimonials {
+		data, err := json.Marshal(&testimonials[i])
+		if err != nil {
+			return nil, err
+		}
+		var full map[string]any
+		if err := json.Unmarshal(data, &full); err != nil {
+			return nil, err
+		}
+
+		item := make(map[string]any, len(fields))
+		for _, field := range fields {
+			if value, ok := full[field]; ok {
+				item[field] = value
+			}
+		}
+		projected = append(projected, item)
+	}
+	return projected, nil
+}
+
+func (s *testimonialService) Search(ctx context.Context, workspaceID uuid.UUID, req models.TestimonialSearchRequest) ([]models.TestimonialSearchHit, error) {
+	hits, err := s.repo.Search(ctx, workspaceID, req, s.db)
+	if err != nil {
+		return nil, err
+	}
+	for i := range hits {
+		for field, snippet := range hits[i].Highlights {
+			hits[i].Highlights[field] = highlightHTML(snippet)
+		}
+	}
+	return hits, nil
+}
+
+// highlightHTML escapes a search snippet and marks the matched words, so snippets can be
+// shown as HTML without trusting the testimonial text.
+func highlightHTML(snippet string) string {
+	return strings.NewReplacer(
+		models.HighlightStart, "<mark>",
+		models.HighlightStop, "</mark>",
+	).Replace(html.EscapeString(snippet))
+}
+
 func (s *testimonialService) FetchByID(ctx context.Context, testimonialID uuid.UUID) (*models.Testimonial, error) {
 	return s.repo.FetchByID(ctx, testimonialID, s.db)
 }
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHighlightHTML(t *testing.T) {
+	snippet := "Ships <b>really</b> \x02fast\x03 & well"
+	assert.Equal(t, "Ships &lt;b&gt;really&lt;/b&gt; <mark>fast</mark> &amp; well", highlightHTML(snippet))
+}
+
+func TestTestimonialService_SubmitVideoQuota(t *testing.T) {
+	db, sqlMock, _ := sqlmock.New()
+	workspaceID := uuid.New()
+	mediaURL := "https://cdn.example.com/story.mp4"
+
+	newService := func(t *testing.T, minutesUsed int) (TestimonialService, *mocks.TestimonialRepository) {
+		plans := mocks.NewEntitlementRepository(t)
+		// Checked in the transaction that stores the testimonial
+		plans.On("LockPlan", mock.Anything, workspaceID, mock.AnythingOfType("*sql.Tx")).Return(models.PlanEssential, nil)
+		plans.On("CountUsage", mock.Anything, workspaceID, models.QuotaTestimonials, mock.Anything, mock.AnythingOfType("*sql.Tx")).Return(0, nil)
+		plans.On("CountUsage", mock.Anything, workspaceID, models.QuotaVideoMinutes, mock.Anything, mock.AnythingOfType("*sql.Tx")).Return(minutesUsed, nil)
+		testimonialRepo := &mocks.TestimonialRepository{}
+		entitlements := NewEntitlementService(plans, &mocks.TeamMemberRepository{}, db)
+		return NewTestimonialService(testimonialRepo, nil, nil, entitlements, nil, db), testimonialRepo
+	}
+	video := func(seconds *int) models.TestimonialSubmission {
+		return models.TestimonialSubmission{Format: models.ContentFormatVideo, MediaURL: &mediaURL, MediaDuration: seconds}
+	}
+
+	t.Run("RejectsVideosOverTheMonthlyMinutes", func(t *testing.T) {
+		svc, testimonialRepo := newService(t, 8)
+
+		// 121 seconds is three minutes, one more than the essentials plan has left
+		seconds := 121
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectRollback()
+		_, err := svc.Submit(context.Background(), workspaceID, models.CollectionMethodAPI, video(&seconds))
+		assert.ErrorIs(t, err, apperrors.ErrQuotaExceeded)
+		testimonialRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("CountsVideosWithoutALengthAsOneMinute", func(t *testing.T) {
+		svc, _ := newService(t, 10)
+
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectRollback()
+		_, err := svc.Submit(context.Background(), workspaceID, models.CollectionMethodDirectLink, video(nil))
+		assert.ErrorIs(t, err, apperrors.ErrQuotaExceeded)
+
+		svc, testimonialRepo := newService(t, 9)
+		testimonialRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Testimonial"), mock.AnythingOfType("*sql.Tx")).Return(nil)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+		_, err = svc.Submit(context.Background(), workspaceID, models.CollectionMethodDirectLink, video(nil))
+		require.NoError(t, err)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pkg/lease"
+	"github.com/ifeanyidike/cenphi/pkg/tracking"
+)
+
+const (
+	trackingFlushInterval = 30 * time.Second
+	trackingFlushLeaseKey = "lease:tracking_flush"
+)
+
+// TrackingFlusher writes the beacon hits buffered in Redis to the database. Drains must
+// not overlap, so each flush is taken under a lease that outlives it: the lease lasts an
+// interval and a flush is cancelled after half of one.
+type TrackingFlusher struct {
+	buffer     TrackingBuffer
+	repo       repositories.AnalyticsRepository
+	lease      *lease.RedisLease
+	db         *sql.DB
+	instanceID string
+	interval   time.Duration
+}
+
+func NewTrackingFlusher(buffer TrackingBuffer, repo repositories.AnalyticsRepository, flushLease *lease.RedisLease, db *sql.DB) *TrackingFlusher {
+	return &TrackingFlusher{
+		buffer:     buffer,
+		repo:       repo,
+		lease:      flushLease,
+		db:         db,
+		instanceID: newInstanceID(),
+		interval:   trackingFlushInterval,
+	}
+}
+
+// Run flushes buffered hits every interval until ctx is cancelled.
+func (f *TrackingFlusher) Run(ctx context.Context) {
+	for {
+		acquired, err := f.lease.Acquire(ctx, trackingFlushLeaseKey, f.instanceID, f.interval)
+		if err != nil {
+			slog.Error("failed to acquire tracking flush lease", "error", err)
+		}
+		if acquired {
+			if n, err := f.Flush(ctx); err != nil {
+				slog.Error("tracking flush failed", "error", err)
+			} else if n > 0 {
+				slog.Debug("flushed tracking hits", "entries", n)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(f.interval):
+		}
+	}
+}
+
+// Flush drains the buffer into the database in one transaction and returns how many
+// entries it wrote. The transaction records the batch's ID, so a batch handed out again
+// after it committed is not counted twice. The caller must hold the flush lease.
+func (f *TrackingFlusher) Flush(ctx context.Context) (int, error) {
+	if ctx.Err() != nil {
+		return 0, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, f.interval/2)
+	defer cancel()
+
+	return f.buffer.Drain(ctx, func(ctx context.Context, batch tracking.Batch) error {
+		tx, err := f.db.BeginTx(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to begin tracking flush: %w", err)
+		}
+		defer tx.Rollback()
+
+		if err := f.repo.ApplyTrackingBatch(ctx, batch, tx); err != nil {
+			return err
+		}
+		return tx.Commit()
+	})
+}
@@ -0,0 +1,223 @@
+package services
+
+//go:generate mockery --name=TrackingService --output=./mocks --case=underscore
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pkg/tracking"
+)
+
+const (
+	defaultPerformanceDays  = 30
+	maxPerformanceDays      = 366
+	defaultPerformanceLimit = 20
+	maxPerformanceLimit     = 100
+	maxConversionTypeLength = 50
+	maxVisitorIDLength      = 128
+)
+
+// TrackingBuffer holds beacon hits until they are flushed to the database.
+// *tracking.Buffer implements it.
+type TrackingBuffer interface {
+	Record(ctx context.Context, hits []tracking.Hit) (int, error)
+	Drain(ctx context.Context, flush func(context.Context, tracking.Batch) error) (int, error)
+}
+
+type TrackingService interface {
+	// RecordBeacon buffers what a visitor did with a widget's testimonials. Each visitor
+	// is counted once per testimonial, widget and kind of event within the buffer's
+	// window; beacons from crawlers are ignored.
+	RecordBeacon(ctx context.Context, req models.BeaconRequest, client models.BeaconClient) error
+	// Performance reports how the workspace's testimonials did in its widgets.
+	Performance(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, query models.TestimonialPerformanceQuery) (*models.TestimonialPerformanceReport, error)
+}
+
+type trackingService struct {
+	buffer         TrackingBuffer
+	repo           repositories.AnalyticsRepository
+	teamMemberRepo repositories.TeamMemberRepository
+	db             *sql.DB
+	now            func() time.Time
+}
+
+func NewTrackingService(
+	buffer TrackingBuffer,
+	repo repositories.AnalyticsRepository,
+	teamMemberRepo repositories.TeamMemberRepository,
+	db *sql.DB,
+) TrackingService {
+	return &trackingService{
+		buffer:         buffer,
+		repo:           repo,
+		teamMemberRepo: teamMemberRepo,
+		db:             db,
+		now:            time.Now,
+	}
+}
+
+var trackingKinds = map[models.TrackingEventType]tracking.Kind{
+	models.TrackingImpression: tracking.Impression,
+	models.TrackingClick:      tracking.Click,
+	models.TrackingConversion: tracking.Conversion,
+}
+
+func (s *trackingService) RecordBeacon(ctx context.Context, req models.BeaconRequest, client models.BeaconClient) error {
+	if err := validateBeacon(req); err != nil {
+		return fmt.Errorf("%w: %v", apperrors.ErrValidationFailed, err)
+	}
+	if isCrawler(client.UserAgent) {
+		return nil
+	}
+
+	visitor := visitorKey(req, client)
+	now := s.now()
+	hits := make([]tracking.Hit, 0, len(req.Events))
+	for _, event := range req.Events {
+		hit := tracking.Hit{
+			Kind:          trackingKinds[event.Type],
+			DisplayID:     req.WidgetID,
+			TestimonialID: event.TestimonialID,
+			Visitor:       visitor,
+			At:            now,
+		}
+		if event.Type == models.TrackingConversion {
+			hit.ConversionType = strings.TrimSpace(event.ConversionType)
+			hit.ValueCents = int64(math.Round(event.Value * 100))
+		}
+		hits = append(hits, hit)
+	}
+
+	_, err := s.buffer.Record(ctx, hits)
+	return err
+}
+
+func validateBeacon(req models.BeaconRequest) error {
+	if req.WidgetID == uuid.Nil {
+		return fmt.Errorf("widget_id is required")
+	}
+	if len(req.VisitorID) > maxVisitorIDLength {
+		return fmt.Errorf("visitor_id must be at most %d characters", maxVisitorIDLength)
+	}
+	if len(req.Events) == 0 || len(req.Events) > models.MaxBeaconEvents {
+		return fmt.Errorf("a beacon must report between 1 and %d events", models.MaxBeaconEvents)
+	}
+	for _, event := range req.Events {
+		if !event.Type.IsValid() {
+			return fmt.Errorf("unknown event type %q", event.Type)
+		}
+		if event.TestimonialID == uuid.Nil {
+			return fmt.Errorf("testimonial_id is required")
+		}
+		if len(strings.TrimSpace(event.ConversionType)) > maxConversionTypeLength {
+			return fmt.Errorf("conversion_type must be at most %d characters", maxConversionTypeLength)
+		}
+		if math.IsNaN(event.Value) || event.Value < 0 || event.Value > models.MaxConversionValue {
+			return fmt.Errorf("value must be between 0 and %d", models.MaxConversionValue)
+		}
+	}
+	return nil
+}
+
+var crawlerMarkers = []string{"bot", "crawler", "spider", "slurp", "headless", "lighthouse", "preview"}
+
+// isCrawler reports whether a beacon was sent by something other than a visitor's
+// browser. Browsers always send a user agent.
+func isCrawler(userAgent string) bool {
+	ua := strings.ToLower(userAgent)
+	if ua == "" {
+		return true
+	}
+	for _, marker := range crawlerMarkers {
+		if strings.Contains(ua, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// visitorKey identifies the visitor without keeping their IP address. It is scoped to
+// the widget, so it cannot be used to follow a visitor across customers' sites.
+func visitorKey(req models.BeaconRequest, client models.BeaconClient) string {
+	raw := req.VisitorID
+	if raw == "" {
+		raw = client.IP + "|" + client.UserAgent
+	}
+	sum := sha256.Sum256([]byte(req.WidgetID.String() + "|" + raw))
+	return hex.EncodeToString(sum[:16])
+}
+
+func (s *trackingService) Performance(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, query models.TestimonialPerformanceQuery) (*models.TestimonialPerformanceReport, error) {
+	if err := requireWorkspacePermission(ctx, s.teamMemberRepo, s.db, workspaceID, firebaseUID, models.PermTestimonialsRead); err != nil {
+		return nil, err
+	}
+
+	query, err := s.normalizePerformanceQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	testimonials, err := s.repo.TestimonialPerformance(ctx, workspaceID, query, s.db)
+	if err != nil {
+		return nil, err
+	}
+	totals, err := s.repo.PerformanceTotals(ctx, workspaceID, query, s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range testimonials {
+		testimonials[i].ComputeRates()
+	}
+	totals.ComputeRates()
+	return &models.TestimonialPerformanceReport{
+		From:         query.From,
+		To:           query.To,
+		WidgetID:     query.WidgetID,
+		Totals:       totals,
+		Testimonials: testimonials,
+	}, nil
+}
+
+// normalizePerformanceQuery fills in the defaults: the last 30 days up to today (UTC)
+// and the top 20 testimonials.
+func (s *trackingService) normalizePerformanceQuery(query models.TestimonialPerformanceQuery) (models.TestimonialPerformanceQuery, error) {
+	day := func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+
+	if query.To.IsZero() {
+		query.To = s.now().UTC()
+	}
+	query.To = day(query.To)
+	if query.From.IsZero() {
+		query.From = query.To.AddDate(0, 0, -(defaultPerformanceDays - 1))
+	}
+	query.From = day(query.From)
+
+	if query.From.After(query.To) {
+		return query, fmt.Errorf("%w: from must not be after to", apperrors.ErrValidationFailed)
+	}
+	if query.To.Sub(query.From) >= maxPerformanceDays*24*time.Hour {
+		return query, fmt.Errorf("%w: a report can cover at most %d days", apperrors.ErrValidationFailed, maxPerformanceDays)
+	}
+
+	if query.Limit == 0 {
+		query.Limit = defaultPerformanceLimit
+	}
+	if query.Limit < 0 || query.Limit > maxPerformanceLimit {
+		return query, fmt.Errorf("%w: limit must be between 1 and %d", apperrors.ErrValidationFailed, maxPerformanceLimit)
+	}
+	return query, nil
+}
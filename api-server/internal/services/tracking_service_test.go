@@ -0,0 +1,216 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/ifeanyidike/cenphi/pkg/tracking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTrackingBuffer keeps recorded hits in memory and drains a fixed batch.
+type fakeTrackingBuffer struct {
+	hits    []tracking.Hit
+	pending tracking.Batch
+}
+
+func (b *fakeTrackingBuffer) Record(_ context.Context, hits []tracking.Hit) (int, error) {
+	b.hits = append(b.hits, hits...)
+	return len(hits), nil
+}
+
+func (b *fakeTrackingBuffer) Drain(ctx context.Context, flush func(context.Context, tracking.Batch) error) (int, error) {
+	if b.pending.Empty() {
+		return 0, nil
+	}
+	if err := flush(ctx, b.pending); err != nil {
+		return 0, err
+	}
+	n := len(b.pending.Counts) + len(b.pending.Conversions)
+	b.pending = tracking.Batch{}
+	return n, nil
+}
+
+func TestTrackingService(t *testing.T) {
+	ctx := context.Background()
+	db, _, _ := sqlmock.New()
+	now := time.Date(2024, 5, 31, 18, 0, 0, 0, time.UTC)
+	workspaceID := uuid.New()
+	widgetID := uuid.New()
+	testimonialID := uuid.New()
+	browser := models.BeaconClient{IP: "203.0.113.7", UserAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 14_4) Safari/605.1.15"}
+
+	newService := func(t *testing.T) (*trackingService, *fakeTrackingBuffer, *mocks.AnalyticsRepository, *mocks.TeamMemberRepository) {
+		buffer := &fakeTrackingBuffer{}
+		repo := mocks.NewAnalyticsRepository(t)
+		members := mocks.NewTeamMemberRepository(t)
+		svc := NewTrackingService(buffer, repo, members, db).(*trackingService)
+		svc.now = func() time.Time { return now }
+		return svc, buffer, repo, members
+	}
+
+	t.Run("RecordBeaconValidates", func(t *testing.T) {
+		svc, buffer, _, _ := newService(t)
+		tooMany := make([]models.BeaconEvent, models.MaxBeaconEvents+1)
+		for i := range tooMany {
+			tooMany[i] = models.BeaconEvent{Type: models.TrackingImpression, TestimonialID: testimonialID}
+		}
+
+		for _, req := range []models.BeaconRequest{
+			{Events: []models.BeaconEvent{{Type: models.TrackingImpression, TestimonialID: testimonialID}}},
+			{WidgetID: widgetID},
+			{WidgetID: widgetID, Events: tooMany},
+			{WidgetID: widgetID, Events: []models.BeaconEvent{{Type: "hover", TestimonialID: testimonialID}}},
+			{WidgetID: widgetID, Events: []models.BeaconEvent{{Type: models.TrackingClick}}},
+			{WidgetID: widgetID, Events: []models.BeaconEvent{{Type: models.TrackingConversion, TestimonialID: testimonialID, Value: -5}}},
+		} {
+			err := svc.RecordBeacon(ctx, req, browser)
+			assert.ErrorIs(t, err, apperrors.ErrValidationFailed, req)
+		}
+		assert.Empty(t, buffer.hits)
+	})
+
+	t.Run("RecordBeaconBuffersHits", func(t *testing.T) {
+		svc, buffer, _, _ := newService(t)
+
+		err := svc.RecordBeacon(ctx, models.BeaconRequest{
+			WidgetID: widgetID,
+			Events: []models.BeaconEvent{
+				{Type: models.TrackingImpression, TestimonialID: testimonialID},
+				{Type: models.TrackingConversion, TestimonialID: testimonialID, ConversionType: " signup ", Value: 19.99},
+			},
+		}, browser)
+		require.NoError(t, err)
+
+		require.Len(t, buffer.hits, 2)
+		assert.Equal(t, tracking.Impression, buffer.hits[0].Kind)
+		assert.Equal(t, widgetID, buffer.hits[0].DisplayID)
+		assert.Equal(t, now, buffer.hits[0].At)
+		assert.Equal(t, tracking.Conversion, buffer.hits[1].Kind)
+		assert.Equal(t, "signup", buffer.hits[1].ConversionType)
+		assert.Equal(t, int64(1999), buffer.hits[1].ValueCents)
+		// The visitor is identified without keeping their address
+		assert.Len(t, buffer.hits[0].Visitor, 32)
+		assert.NotContains(t, buffer.hits[0].Visitor, browser.IP)
+	})
+
+	t.Run("VisitorsAreScopedToTheWidget", func(t *testing.T) {
+		event := []models.BeaconEvent{{Type: models.TrackingImpression, TestimonialID: testimonialID}}
+		same := visitorKey(models.BeaconRequest{WidgetID: widgetID, Events: event}, browser)
+
+		assert.Equal(t, same, visitorKey(models.BeaconRequest{WidgetID: widgetID, Events: event}, browser))
+		assert.NotEqual(t, same, visitorKey(models.BeaconRequest{WidgetID: uuid.New(), Events: event}, browser))
+		assert.NotEqual(t, same, visitorKey(models.BeaconRequest{WidgetID: widgetID, Events: event}, models.BeaconClient{IP: "198.51.100.1", UserAgent: browser.UserAgent}))
+		// An explicit visitor ID outlives changes of network
+		withID := models.BeaconRequest{WidgetID: widgetID, VisitorID: "abc123", Events: event}
+		assert.Equal(t, visitorKey(withID, browser), visitorKey(withID, models.BeaconClient{IP: "198.51.100.1"}))
+	})
+
+	t.Run("CrawlersAreIgnored", func(t *testing.T) {
+		svc, buffer, _, _ := newService(t)
+		req := models.BeaconRequest{WidgetID: widgetID, Events: []models.BeaconEvent{{Type: models.TrackingImpression, TestimonialID: testimonialID}}}
+
+		for _, ua := range []string{"", "Mozilla/5.0 (compatible; Googlebot/2.1)", "Mozilla/5.0 HeadlessChrome/120.0"} {
+			require.NoError(t, svc.RecordBeacon(ctx, req, models.BeaconClient{IP: browser.IP, UserAgent: ua}))
+		}
+		assert.Empty(t, buffer.hits)
+	})
+
+	t.Run("PerformanceDefaultsToTheLast30Days", func(t *testing.T) {
+		svc, _, repo, members := newService(t)
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "viewer-uid", db).Return(&models.TeamMember{Role: models.Viewer}, nil)
+		query := models.TestimonialPerformanceQuery{
+			From:  time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC),
+			To:    time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC),
+			Limit: defaultPerformanceLimit,
+		}
+		repo.On("TestimonialPerformance", mock.Anything, workspaceID, query, db).Return([]models.TestimonialPerformance{{
+			TestimonialID:     testimonialID,
+			PerformanceCounts: models.PerformanceCounts{Impressions: 200, Clicks: 20, Conversions: 5, ConversionValue: 99.95},
+		}}, nil)
+		repo.On("PerformanceTotals", mock.Anything, workspaceID, query, db).Return(models.PerformanceCounts{Impressions: 400, Clicks: 30, Conversions: 5}, nil)
+
+		report, err := svc.Performance(ctx, workspaceID, "viewer-uid", models.TestimonialPerformanceQuery{})
+		require.NoError(t, err)
+		assert.Equal(t, query.From, report.From)
+		require.Len(t, report.Testimonials, 1)
+		assert.InDelta(t, 0.1, report.Testimonials[0].ClickThroughRate, 1e-9)
+		assert.InDelta(t, 0.025, report.Testimonials[0].ConversionRate, 1e-9)
+		assert.InDelta(t, 0.0125, report.Totals.ConversionRate, 1e-9)
+	})
+
+	t.Run("PerformanceValidatesTheRange", func(t *testing.T) {
+		svc, _, _, members := newService(t)
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "viewer-uid", db).Return(&models.TeamMember{Role: models.Viewer}, nil)
+
+		for _, query := range []models.TestimonialPerformanceQuery{
+			{From: now, To: now.AddDate(0, 0, -1)},
+			{From: now.AddDate(-2, 0, 0), To: now},
+			{Limit: maxPerformanceLimit + 1},
+		} {
+			_, err := svc.Performance(ctx, workspaceID, "viewer-uid", query)
+			assert.ErrorIs(t, err, apperrors.ErrValidationFailed, query)
+		}
+	})
+
+	t.Run("PerformanceRequiresMembership", func(t *testing.T) {
+		svc, _, _, members := newService(t)
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "stranger-uid", db).Return(nil, sql.ErrNoRows)
+
+		_, err := svc.Performance(ctx, workspaceID, "stranger-uid", models.TestimonialPerformanceQuery{})
+		assert.ErrorIs(t, err, apperrors.ErrWorkspaceAccessDenied)
+	})
+}
+
+func TestTrackingFlusher(t *testing.T) {
+	ctx := context.Background()
+	batch := tracking.Batch{Counts: []tracking.Count{{
+		DisplayID:     uuid.New(),
+		TestimonialID: uuid.New(),
+		Day:           time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		Impressions:   3,
+	}}}
+
+	newFlusher := func(t *testing.T) (*TrackingFlusher, *fakeTrackingBuffer, *mocks.AnalyticsRepository, sqlmock.Sqlmock) {
+		db, sqlMock, err := sqlmock.New()
+		require.NoError(t, err)
+		buffer := &fakeTrackingBuffer{pending: batch}
+		repo := mocks.NewAnalyticsRepository(t)
+		return NewTrackingFlusher(buffer, repo, nil, db), buffer, repo, sqlMock
+	}
+
+	t.Run("AppliesTheBatchInOneTransaction", func(t *testing.T) {
+		f, buffer, repo, sqlMock := newFlusher(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+		repo.On("ApplyTrackingBatch", mock.Anything, batch, mock.Anything).Return(nil)
+
+		n, err := f.Flush(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.True(t, buffer.pending.Empty())
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("KeepsTheBatchWhenItFails", func(t *testing.T) {
+		f, buffer, repo, sqlMock := newFlusher(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectRollback()
+		repo.On("ApplyTrackingBatch", mock.Anything, batch, mock.Anything).Return(errors.New("deadlock detected"))
+
+		_, err := f.Flush(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, batch, buffer.pending)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,238 @@
+package services
+
+//go:generate mockery --name=VideoProcessingService --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pb"
+	"github.com/ifeanyidike/cenphi/pkg/mediastore"
+)
+
+const (
+	// videoStreamChunkSize keeps each message well under gRPC's default 4MB limit.
+	videoStreamChunkSize = 1 << 20
+	videoAnalysisVersion = "process_video_by_chunks/v1"
+)
+
+// Keys of a video transcription job's input parameters.
+const (
+	jobParamTask        = "task"
+	jobParamStorageKey  = "storage_key"
+	jobParamContentType = "content_type"
+	jobParamSubmittedBy = "submitted_by"
+)
+
+// sentimentLabelScores maps the sentiment labels the intelligence service may return to
+// scores on the -1 to 1 scale used elsewhere.
+var sentimentLabelScores = map[string]float32{
+	"very_negative": -1,
+	"negative":      -0.5,
+	"neutral":       0,
+	"positive":      0.5,
+	"very_positive": 1,
+}
+
+// VideoSource identifies a stored video to transcribe.
+type VideoSource struct {
+	StorageKey  string
+	ContentType string
+	SubmittedBy string
+}
+
+type VideoProcessingService interface {
+	// Schedule queues a transcription job for a video testimonial.
+	Schedule(ctx context.Context, testimonialID uuid.UUID, source VideoSource) (*models.AIJob, error)
+	// Handle streams the job's video to the intelligence service and stores the
+	// transcript, summary and analysis it returns. It runs transcription jobs for the
+	// AI job worker.
+	Handle(ctx context.Context, job *models.AIJob) (models.JSONMap, *uuid.UUID, error)
+}
+
+type videoProcessingService struct {
+	jobs            AIJobService
+	analysisRepo    repositories.AnalysisRepository
+	testimonialRepo repositories.TestimonialRepository
+	store           mediastore.Store
+	client          *pb.IntelligenceClient
+	db              *sql.DB
+}
+
+func NewVideoProcessingService(
+	jobs AIJobService,
+	analysisRepo repositories.AnalysisRepository,
+	testimonialRepo repositories.TestimonialRepository,
+	store mediastore.Store,
+	client *pb.IntelligenceClient,
+	db *sql.DB,
+) VideoProcessingService {
+	return &videoProcessingService{
+		jobs:            jobs,
+		analysisRepo:    analysisRepo,
+		testimonialRepo: testimonialRepo,
+		store:           store,
+		client:          client,
+		db:              db,
+	}
+}
+
+func (s *videoProcessingService) Schedule(ctx context.Context, testimonialID uuid.UUID, source VideoSource) (*models.AIJob, error) {
+	job := &models.AIJob{
+		TestimonialID: testimonialID,
+		JobType:       models.AIServiceCategoryAnalysis,
+		InputParameters: models.JSONMap{
+			jobParamTask:        models.AIJobTaskVideoTranscription,
+			jobParamStorageKey:  source.StorageKey,
+			jobParamContentType: source.ContentType,
+			jobParamSubmittedBy: source.SubmittedBy,
+		},
+	}
+	if err := s.jobs.Enqueue(ctx, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (s *videoProcessingService) Handle(ctx context.Context, job *models.AIJob) (models.JSONMap, *uuid.UUID, error) {
+	summary, err := s.transcribe(ctx, job)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.saveResult(ctx, job, summary)
+}
+
+// transcribe streams the stored video to the intelligence service in chunks and waits
+// for its summary.
+func (s *videoProcessingService) transcribe(ctx context.Context, job *models.AIJob) (*pb.VideoSummary, error) {
+	if s.client == nil || *s.client == nil {
+		return nil, permanentJobFailure(errors.New("intelligence service is not configured"))
+	}
+	storageKey, _ := job.InputParameters[jobParamStorageKey].(string)
+	if storageKey == "" {
+		return nil, permanentJobFailure(errors.New("job has no video to process"))
+	}
+	submittedBy, _ := job.InputParameters[jobParamSubmittedBy].(string)
+
+	video, err := s.store.Open(ctx, storageKey)
+	if errors.Is(err, mediastore.ErrObjectNotFound) {
+		return nil, permanentJobFailure(fmt.Errorf("video %s no longer exists", storageKey))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open video: %w", err)
+	}
+	defer video.Close()
+
+	stream, err := (*s.client).ProcessVideoByChunks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start video stream: %w", err)
+	}
+
+	metadata := &pb.VideoMetadata{UserId: submittedBy, SessionId: job.ID.String()}
+	buf := make([]byte, videoStreamChunkSize)
+	for {
+		n, readErr := io.ReadFull(video, buf)
+		if n > 0 {
+			// io.EOF from Send means the server ended the stream; its status is
+			// returned by CloseAndRecv
+			sendErr := stream.Send(&pb.VideoChunk{Content: buf[:n], Metadata: metadata})
+			if errors.Is(sendErr, io.EOF) {
+				break
+			}
+			if sendErr != nil {
+				return nil, fmt.Errorf("failed to send video chunk: %w", sendErr)
+			}
+		}
+		if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+			break
+		}
+		if readErr != nil {
+			return nil, fmt.Errorf("failed to read video: %w", readErr)
+		}
+	}
+
+	summary, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, fmt.Errorf("video processing failed: %w", err)
+	}
+	return summary, nil
+}
+
+// saveResult stores the transcript, the summary and the analysis in a single transaction,
+// and returns the job output.
+func (s *videoProcessingService) saveResult(ctx context.Context, job *models.AIJob, summary *pb.VideoSummary) (models.JSONMap, *uuid.UUID, error) {
+	transcript := strings.TrimSpace(summary.GetTranscript())
+	highlights := strings.TrimSpace(summary.GetHighlights())
+
+	analysis := &models.TestimonialAnalysis{
+		TestimonialID:  job.TestimonialID,
+		AnalysisType:   models.AnalysisTypeNarrative,
+		SentimentScore: parseSentimentScore(summary.GetSentiment()),
+		AnalysisData: models.JSONMap{
+			"source":            "video",
+			"highlights":        highlights,
+			"sentiment":         summary.GetSentiment(),
+			"transcript_length": len(transcript),
+		},
+		ExtractedInsights: splitHighlights(highlights),
+		AnalysisVersion:   videoAnalysisVersion,
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+	defer tx.Rollback()
+
+	if err := s.testimonialRepo.UpdateTranscript(ctx, job.TestimonialID, transcript, highlights, tx); err != nil {
+		return nil, nil, err
+	}
+	if err := s.analysisRepo.Upsert(ctx, analysis, tx); err != nil {
+		return nil, nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", apperrors.ErrTransactionFailed, err)
+	}
+
+	output := models.JSONMap{
+		"analysis_id":       analysis.ID.String(),
+		"transcript_length": len(transcript),
+		"sentiment":         summary.GetSentiment(),
+	}
+	return output, &analysis.ID, nil
+}
+
+// parseSentimentScore accepts either a numeric score or a sentiment label.
+func parseSentimentScore(sentiment string) *float32 {
+	sentiment = strings.ToLower(strings.TrimSpace(sentiment))
+	if f, err := strconv.ParseFloat(sentiment, 32); err == nil {
+		score := float32(max(-1, min(1, f)))
+		return &score
+	}
+	if score, ok := sentimentLabelScores[strings.ReplaceAll(sentiment, " ", "_")]; ok {
+		return &score
+	}
+	return nil
+}
+
+// splitHighlights turns the highlights text into one insight per line, without list
+// markers.
+func splitHighlights(highlights string) models.JSONArray {
+	insights := models.JSONArray{}
+	for _, line := range strings.Split(highlights, "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "-*•"))
+		if line != "" {
+			insights = append(insights, line)
+		}
+	}
+	return insights
+}
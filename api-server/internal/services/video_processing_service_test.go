@@ -0,0 +1,176 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/ifeanyidike/cenphi/pb"
+	"github.com/ifeanyidike/cenphi/pkg/mediastore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestVideoProcessingService(t *testing.T) {
+	ctx := context.Background()
+	testimonialID := uuid.New()
+	storageKey := "workspaces/ws/media/clip.mp4"
+	video := bytes.Repeat([]byte("0123456789"), videoStreamChunkSize/4)
+
+	store, err := mediastore.NewLocalStore(t.TempDir(), "http://localhost/api/v1/media")
+	require.NoError(t, err)
+	uploadID, err := store.InitUpload(ctx, storageKey, "video/mp4")
+	require.NoError(t, err)
+	_, err = store.UploadPart(ctx, storageKey, uploadID, 1, bytes.NewReader(video), int64(len(video)))
+	require.NoError(t, err)
+	require.NoError(t, store.CompleteUpload(ctx, storageKey, uploadID, []mediastore.Part{{Number: 1}}))
+
+	runningJob := func() *models.AIJob {
+		return &models.AIJob{
+			ID:            uuid.New(),
+			TestimonialID: testimonialID,
+			Status:        models.AIJobStatusRunning,
+			InputParameters: models.JSONMap{
+				jobParamTask:        models.AIJobTaskVideoTranscription,
+				jobParamStorageKey:  storageKey,
+				jobParamSubmittedBy: "editor-uid",
+			},
+		}
+	}
+
+	t.Run("StreamsVideoAndStoresResults", func(t *testing.T) {
+		db, sqlMock, err := sqlmock.New()
+		require.NoError(t, err)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		job := runningJob()
+		analysisRepo := mocks.NewAnalysisRepository(t)
+		testimonialRepo := mocks.NewTestimonialRepository(t)
+		stream := &fakeVideoStream{summary: &pb.VideoSummary{
+			Highlights: "- Set up in a day\n- Support answered within minutes\n",
+			Transcript: " We set it up in a day. ",
+			Sentiment:  "Positive",
+		}}
+		var client pb.IntelligenceClient = &fakeIntelligenceClient{stream: stream}
+
+		testimonialRepo.On("UpdateTranscript", mock.Anything, testimonialID, "We set it up in a day.", "- Set up in a day\n- Support answered within minutes", mock.Anything).Return(nil)
+		var saved *models.TestimonialAnalysis
+		analysisRepo.On("Upsert", mock.Anything, mock.AnythingOfType("*models.TestimonialAnalysis"), mock.Anything).
+			Run(func(args mock.Arguments) {
+				saved = args.Get(1).(*models.TestimonialAnalysis)
+				saved.ID = uuid.New()
+			}).
+			Return(nil)
+
+		svc := NewVideoProcessingService(nil, analysisRepo, testimonialRepo, store, &client, db)
+		output, ref, err := svc.Handle(ctx, job)
+		require.NoError(t, err)
+
+		require.Len(t, stream.chunks, 3)
+		var received []byte
+		for _, chunk := range stream.chunks {
+			assert.LessOrEqual(t, len(chunk.Content), videoStreamChunkSize)
+			assert.Equal(t, job.ID.String(), chunk.Metadata.GetSessionId())
+			assert.Equal(t, "editor-uid", chunk.Metadata.GetUserId())
+			received = append(received, chunk.Content...)
+		}
+		assert.Equal(t, video, received)
+
+		require.NotNil(t, saved)
+		assert.Equal(t, models.AnalysisTypeNarrative, saved.AnalysisType)
+		require.NotNil(t, saved.SentimentScore)
+		assert.Equal(t, float32(0.5), *saved.SentimentScore)
+		assert.Equal(t, models.JSONArray{"Set up in a day", "Support answered within minutes"}, saved.ExtractedInsights)
+		assert.Equal(t, &saved.ID, ref)
+		assert.Equal(t, saved.ID.String(), output["analysis_id"])
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("ReturnsFailureFromIntelligenceService", func(t *testing.T) {
+		db, sqlMock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		stream := &fakeVideoStream{err: errors.New("transcriber unavailable")}
+		var client pb.IntelligenceClient = &fakeIntelligenceClient{stream: stream}
+
+		svc := NewVideoProcessingService(nil, mocks.NewAnalysisRepository(t), mocks.NewTestimonialRepository(t), store, &client, db)
+		_, _, err = svc.Handle(ctx, runningJob())
+		assert.ErrorContains(t, err, "transcriber unavailable")
+		assert.False(t, isPermanentJobFailure(err))
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("FailsPermanentlyWhenVideoIsGone", func(t *testing.T) {
+		var client pb.IntelligenceClient = &fakeIntelligenceClient{stream: &fakeVideoStream{}}
+		job := runningJob()
+		job.InputParameters[jobParamStorageKey] = "workspaces/ws/media/missing.mp4"
+
+		svc := NewVideoProcessingService(nil, mocks.NewAnalysisRepository(t), mocks.NewTestimonialRepository(t), store, &client, nil)
+		_, _, err := svc.Handle(ctx, job)
+		assert.True(t, isPermanentJobFailure(err))
+	})
+
+	t.Run("ScheduleQueuesTranscriptionJob", func(t *testing.T) {
+		db, sqlMock, err := sqlmock.New()
+		require.NoError(t, err)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		jobRepo := mocks.NewAIJobRepository(t)
+		jobRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.AIJob"), mock.Anything).Return(nil)
+		jobs := NewAIJobService(jobRepo, mocks.NewTestimonialRepository(t), mocks.NewTeamMemberRepository(t), nil, db)
+
+		svc := NewVideoProcessingService(jobs, mocks.NewAnalysisRepository(t), mocks.NewTestimonialRepository(t), store, nil, db)
+		job, err := svc.Schedule(ctx, testimonialID, VideoSource{StorageKey: storageKey, ContentType: "video/mp4", SubmittedBy: "editor-uid"})
+		require.NoError(t, err)
+		assert.Equal(t, models.AIJobStatusPending, job.Status)
+		assert.Equal(t, models.AIServiceCategoryAnalysis, job.JobType)
+		assert.Equal(t, models.AIJobTaskVideoTranscription, job.InputParameters[jobParamTask])
+		assert.Equal(t, storageKey, job.InputParameters[jobParamStorageKey])
+		assert.NotEqual(t, uuid.Nil, job.ID)
+	})
+}
+
+func TestParseSentimentScore(t *testing.T) {
+	for input, want := range map[string]float32{"0.8": 0.8, "3": 1, "Very Positive": 1, "negative": -0.5, " neutral ": 0} {
+		got := parseSentimentScore(input)
+		require.NotNil(t, got, input)
+		assert.InDelta(t, want, *got, 0.0001, input)
+	}
+	assert.Nil(t, parseSentimentScore("mixed"))
+}
+
+type fakeIntelligenceClient struct {
+	pb.IntelligenceClient
+	stream *fakeVideoStream
+}
+
+func (c *fakeIntelligenceClient) ProcessVideoByChunks(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[pb.VideoChunk, pb.VideoSummary], error) {
+	return c.stream, nil
+}
+
+// fakeVideoStream collects the chunks it is sent. Content is copied because the sender
+// reuses its buffer.
+type fakeVideoStream struct {
+	grpc.ClientStream
+	chunks  []*pb.VideoChunk
+	summary *pb.VideoSummary
+	err     error
+}
+
+func (s *fakeVideoStream) Send(chunk *pb.VideoChunk) error {
+	s.chunks = append(s.chunks, &pb.VideoChunk{Content: bytes.Clone(chunk.Content), Metadata: chunk.Metadata})
+	return nil
+}
+
+func (s *fakeVideoStream) CloseAndRecv() (*pb.VideoSummary, error) {
+	return s.summary, s.err
+}
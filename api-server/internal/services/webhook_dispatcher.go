@@ -0,0 +1,295 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pkg/webhooksig"
+)
+
+const (
+	defaultWebhookPollInterval = 5 * time.Second
+	defaultWebhookBatchSize    = 20
+	defaultWebhookTimeout      = 10 * time.Second
+	defaultWebhookBaseBackoff  = 30 * time.Second
+	defaultWebhookMaxBackoff   = 4 * time.Hour
+	// defaultWebhookMaxAttempts spreads retries over roughly a day before a delivery
+	// is given up on.
+	defaultWebhookMaxAttempts = 10
+	// webhookLeaseMargin is how long a delivery stays leased after its timeout, so a
+	// slow dispatcher can still record the outcome before another one resends it.
+	webhookLeaseMargin = time.Minute
+	// maxWebhookResponseBody is how much of an endpoint's response is kept in the log.
+	maxWebhookResponseBody = 1024
+
+	webhookEventHeader    = "X-Cenphi-Event"
+	webhookDeliveryHeader = "X-Cenphi-Delivery"
+	webhookUserAgent      = "Cenphi-Webhooks/1.0"
+)
+
+var errWebhookDestinationForbidden = errors.New("webhook destination is a private address")
+
+type WebhookDispatcherConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	Timeout      time.Duration
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	MaxAttempts  int
+	// AllowPrivateNetworks lets endpoints resolve to loopback and private addresses.
+	// It is meant for development; otherwise such endpoints could be used to reach
+	// services inside our network.
+	AllowPrivateNetworks bool
+}
+
+// WebhookDispatcher sends queued webhook deliveries. Due deliveries are claimed from
+// Postgres with SKIP LOCKED, so every API server can run a dispatcher. Any 2xx response
+// counts as delivered; anything else is retried with exponential backoff until the
+// delivery runs out of attempts and is marked dead.
+type WebhookDispatcher struct {
+	repo   repositories.WebhookRepository
+	db     *sql.DB
+	cfg    WebhookDispatcherConfig
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewWebhookDispatcher(repo repositories.WebhookRepository, cfg WebhookDispatcherConfig, db *sql.DB) *WebhookDispatcher {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaultWebhookPollInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultWebhookBatchSize
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultWebhookTimeout
+	}
+	if cfg.BaseBackoff <= 0 {
+		cfg.BaseBackoff = defaultWebhookBaseBackoff
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = defaultWebhookMaxBackoff
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultWebhookMaxAttempts
+	}
+
+	return &WebhookDispatcher{
+		repo:   repo,
+		db:     db,
+		cfg:    cfg,
+		client: newWebhookClient(cfg),
+		now:    time.Now,
+	}
+}
+
+// newWebhookClient returns a client that does not follow redirects and, unless private
+// networks are allowed, refuses to connect to non-public addresses. The check runs on
+// the address actually dialled, so DNS answers cannot sneak past it.
+func newWebhookClient(cfg WebhookDispatcherConfig) *http.Client {
+	dialer := &net.Dialer{Timeout: cfg.Timeout}
+	if !cfg.AllowPrivateNetworks {
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip, err := netip.ParseAddr(host); err != nil || !isPublicIP(ip) {
+				return errWebhookDestinationForbidden
+			}
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   cfg.Timeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// nonPublicPrefixes are the IANA special-purpose ranges webhooks may not reach. Besides
+// private and loopback networks they cover shared address space, which some clouds use
+// for metadata endpoints, and the IPv6 prefixes that embed an IPv4 address.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("127.0.0.0/8"),
+	netip.MustParsePrefix("169.254.0.0/16"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("192.88.99.0/24"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("224.0.0.0/4"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("::/128"),
+	netip.MustParsePrefix("::1/128"),
+	netip.MustParsePrefix("::ffff:0:0/96"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("100::/64"),
+	netip.MustParsePrefix("2001::/23"),
+	netip.MustParsePrefix("2001:db8::/32"),
+	netip.MustParsePrefix("2002::/16"),
+	netip.MustParsePrefix("fc00::/7"),
+	netip.MustParsePrefix("fe80::/10"),
+	netip.MustParsePrefix("fec0::/10"),
+	netip.MustParsePrefix("ff00::/8"),
+}
+
+func isPublicIP(ip netip.Addr) bool {
+	// IPv4-mapped addresses are checked as IPv4, and zoned addresses would match no prefix
+	ip = ip.Unmap().WithZone("")
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// Run sends due deliveries until ctx is cancelled.
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	for {
+		// Keep going while batches come back full, so a backlog is not spread over polls
+		n, err := d.DispatchDue(ctx)
+		if err != nil {
+			slog.Error("webhook dispatcher failed", "error", err)
+		}
+		if err == nil && n == d.cfg.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.cfg.PollInterval):
+		}
+	}
+}
+
+// DispatchDue sends one batch of due deliveries concurrently and returns how many it
+// attempted.
+func (d *WebhookDispatcher) DispatchDue(ctx context.Context) (int, error) {
+	if ctx.Err() != nil {
+		return 0, nil
+	}
+
+	dispatches, err := d.repo.ClaimDue(ctx, d.now(), d.cfg.Timeout+webhookLeaseMargin, d.cfg.BatchSize, d.db)
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	for _, dispatch := range dispatches {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			attempt := d.send(ctx, dispatch)
+			// The outcome is recorded even when ctx was cancelled mid-flight
+			if err := d.repo.RecordAttempt(context.WithoutCancel(ctx), dispatch.Delivery.ID, attempt, d.db); err != nil {
+				slog.Error("failed to record webhook attempt", "delivery", dispatch.Delivery.ID, "error", err)
+			}
+		}()
+	}
+	wg.Wait()
+	return len(dispatches), nil
+}
+
+// send makes one attempt at a delivery and decides what happens to it next.
+func (d *WebhookDispatcher) send(ctx context.Context, dispatch models.WebhookDispatch) models.WebhookAttempt {
+	delivery := dispatch.Delivery
+	attempt := models.WebhookAttempt{AttemptedAt: d.now()}
+
+	statusCode, body, err := d.post(ctx, dispatch, attempt.AttemptedAt)
+	if statusCode != 0 {
+		attempt.ResponseStatus = &statusCode
+		attempt.ResponseBody = body
+	}
+
+	switch {
+	case err == nil && statusCode >= 200 && statusCode < 300:
+		attempt.Status = models.WebhookDeliverySucceeded
+		attempt.NextAttemptAt = attempt.AttemptedAt
+		return attempt
+	case err != nil:
+		attempt.Error = err.Error()
+	default:
+		attempt.Error = fmt.Sprintf("endpoint responded with status %d", statusCode)
+	}
+
+	if delivery.Attempts+1 >= d.cfg.MaxAttempts {
+		attempt.Status = models.WebhookDeliveryDead
+		attempt.NextAttemptAt = attempt.AttemptedAt
+		slog.Warn("webhook delivery ran out of attempts", "delivery", delivery.ID, "endpoint", delivery.EndpointID, "error", attempt.Error)
+		return attempt
+	}
+	attempt.Status = models.WebhookDeliveryPending
+	attempt.NextAttemptAt = attempt.AttemptedAt.Add(d.backoff(delivery.Attempts + 1))
+	return attempt
+}
+
+// post sends the delivery and returns the response status and the start of its body.
+func (d *WebhookDispatcher) post(ctx context.Context, dispatch models.WebhookDispatch, at time.Time) (int, string, error) {
+	delivery := dispatch.Delivery
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, dispatch.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", webhookUserAgent)
+	req.Header.Set(webhookEventHeader, delivery.EventType)
+	req.Header.Set(webhookDeliveryHeader, delivery.ID.String())
+	req.Header.Set(webhooksig.Header, webhooksig.Sign(dispatch.Secret, at, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxWebhookResponseBody))
+	if err != nil {
+		return resp.StatusCode, "", nil
+	}
+	// Postgres text columns reject invalid UTF-8 and NUL bytes
+	logged := strings.ReplaceAll(strings.ToValidUTF8(string(body), "\uFFFD"), "\x00", "")
+	return resp.StatusCode, logged, nil
+}
+
+// backoff doubles the delay with every attempt, with jitter so that deliveries to an
+// endpoint that went down together do not all retry at once.
+func (d *WebhookDispatcher) backoff(attempts int) time.Duration {
+	delay := d.cfg.BaseBackoff
+	for i := 1; i < attempts && delay < d.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	delay = min(delay, d.cfg.MaxBackoff)
+	return delay/2 + rand.N(delay/2+1)
+}
@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/ifeanyidike/cenphi/pkg/webhooksig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookDispatcher(t *testing.T) {
+	ctx := context.Background()
+	db, _, _ := sqlmock.New()
+	now := time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC)
+	secret := "whsec_test"
+
+	newDispatcher := func(t *testing.T, cfg WebhookDispatcherConfig) (*WebhookDispatcher, *mocks.WebhookRepository) {
+		repo := mocks.NewWebhookRepository(t)
+		cfg.BaseBackoff = time.Minute
+		cfg.MaxBackoff = time.Hour
+		dispatcher := NewWebhookDispatcher(repo, cfg, db)
+		dispatcher.now = func() time.Time { return now }
+		return dispatcher, repo
+	}
+	dispatch := func(url string, attempts int) models.WebhookDispatch {
+		return models.WebhookDispatch{
+			Delivery: models.WebhookDelivery{
+				ID:         uuid.New(),
+				EndpointID: uuid.New(),
+				EventType:  "testimonial.created",
+				Payload:    []byte(`{"type":"testimonial.created"}`),
+				Status:     models.WebhookDeliveryPending,
+				Attempts:   attempts,
+			},
+			URL:    url,
+			Secret: secret,
+		}
+	}
+	recordedAttempt := func(repo *mocks.WebhookRepository, id uuid.UUID) *models.WebhookAttempt {
+		var attempt models.WebhookAttempt
+		repo.On("RecordAttempt", mock.Anything, id, mock.AnythingOfType("models.WebhookAttempt"), db).
+			Run(func(args mock.Arguments) { attempt = args.Get(2).(models.WebhookAttempt) }).
+			Return(nil)
+		return &attempt
+	}
+
+	t.Run("SendsSignedRequestAndRecordsSuccess", func(t *testing.T) {
+		var received *http.Request
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			w.Write([]byte("ok"))
+		}))
+		defer server.Close()
+
+		dispatcher, repo := newDispatcher(t, WebhookDispatcherConfig{AllowPrivateNetworks: true})
+		d := dispatch(server.URL, 0)
+		repo.On("ClaimDue", mock.Anything, now, mock.Anything, defaultWebhookBatchSize, db).Return([]models.WebhookDispatch{d}, nil)
+		attempt := recordedAttempt(repo, d.Delivery.ID)
+
+		n, err := dispatcher.DispatchDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		assert.Equal(t, "testimonial.created", received.Header.Get("X-Cenphi-Event"))
+		assert.Equal(t, d.Delivery.ID.String(), received.Header.Get("X-Cenphi-Delivery"))
+		assert.NoError(t, webhooksig.Verify(secret, received.Header.Get(webhooksig.Header), body, time.Minute, now))
+
+		assert.Equal(t, models.WebhookDeliverySucceeded, attempt.Status)
+		require.NotNil(t, attempt.ResponseStatus)
+		assert.Equal(t, http.StatusOK, *attempt.ResponseStatus)
+		assert.Equal(t, "ok", attempt.ResponseBody)
+	})
+
+	t.Run("RetriesFailuresWithBackoff", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		dispatcher, repo := newDispatcher(t, WebhookDispatcherConfig{AllowPrivateNetworks: true, MaxAttempts: 5})
+		d := dispatch(server.URL, 2)
+		repo.On("ClaimDue", mock.Anything, now, mock.Anything, mock.Anything, db).Return([]models.WebhookDispatch{d}, nil)
+		attempt := recordedAttempt(repo, d.Delivery.ID)
+
+		_, err := dispatcher.DispatchDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, models.WebhookDeliveryPending, attempt.Status)
+		assert.Contains(t, attempt.Error, "503")
+		// The third attempt waits between half and all of four minutes
+		delay := attempt.NextAttemptAt.Sub(now)
+		assert.GreaterOrEqual(t, delay, 2*time.Minute)
+		assert.LessOrEqual(t, delay, 4*time.Minute)
+	})
+
+	t.Run("MarksDeliveriesDeadAfterTheLastAttempt", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		dispatcher, repo := newDispatcher(t, WebhookDispatcherConfig{AllowPrivateNetworks: true, MaxAttempts: 3})
+		d := dispatch(server.URL, 2)
+		repo.On("ClaimDue", mock.Anything, now, mock.Anything, mock.Anything, db).Return([]models.WebhookDispatch{d}, nil)
+		attempt := recordedAttempt(repo, d.Delivery.ID)
+
+		_, err := dispatcher.DispatchDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, models.WebhookDeliveryDead, attempt.Status)
+	})
+
+	t.Run("RefusesPrivateAddressesAndRedirects", func(t *testing.T) {
+		redirecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "https://example.com", http.StatusFound)
+		}))
+		defer redirecting.Close()
+
+		dispatcher, repo := newDispatcher(t, WebhookDispatcherConfig{})
+		d := dispatch(redirecting.URL, 0)
+		repo.On("ClaimDue", mock.Anything, now, mock.Anything, mock.Anything, db).Return([]models.WebhookDispatch{d}, nil)
+		attempt := recordedAttempt(repo, d.Delivery.ID)
+
+		_, err := dispatcher.DispatchDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, models.WebhookDeliveryPending, attempt.Status)
+		assert.Contains(t, attempt.Error, errWebhookDestinationForbidden.Error())
+		assert.Nil(t, attempt.ResponseStatus)
+
+		dispatcher, repo = newDispatcher(t, WebhookDispatcherConfig{AllowPrivateNetworks: true})
+		d = dispatch(redirecting.URL, 0)
+		repo.On("ClaimDue", mock.Anything, now, mock.Anything, mock.Anything, db).Return([]models.WebhookDispatch{d}, nil)
+		attempt = recordedAttempt(repo, d.Delivery.ID)
+
+		_, err = dispatcher.DispatchDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, models.WebhookDeliveryPending, attempt.Status)
+		require.NotNil(t, attempt.ResponseStatus)
+		assert.Equal(t, http.StatusFound, *attempt.ResponseStatus)
+	})
+}
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		addr   string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"8.8.8.8", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"10.1.2.3", false},
+		{"127.0.0.1", false},
+		{"169.254.169.254", false},
+		{"100.100.100.200", false},
+		{"192.0.0.192", false},
+		{"198.18.0.1", false},
+		{"0.0.0.0", false},
+		{"255.255.255.255", false},
+		{"::1", false},
+		{"::ffff:10.0.0.1", false},
+		{"64:ff9b::a9fe:a9fe", false},
+		{"2002:c0a8:0101::1", false},
+		{"fd00::1", false},
+		{"fe80::1%eth0", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			assert.Equal(t, tt.public, isPublicIP(netip.MustParseAddr(tt.addr)))
+		})
+	}
+}
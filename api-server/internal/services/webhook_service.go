@@ -0,0 +1,333 @@
+package services
+
+//go:generate mockery --name=WebhookService --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+	"github.com/ifeanyidike/cenphi/pkg/events"
+	"github.com/ifeanyidike/cenphi/pkg/webhooksig"
+)
+
+const (
+	maxWebhookURLLength         = 2048
+	maxWebhookDescriptionLength = 255
+	maxWebhookDeliveryLimit     = 200
+)
+
+// WebhookService manages a workspace's webhook endpoints and queues deliveries to them.
+// It is an events.Publisher: every event published through it is queued once for each
+// active endpoint subscribed to its type, and sent by the WebhookDispatcher.
+type WebhookService interface {
+	CreateEndpoint(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, req models.WebhookEndpointRequest) (*models.WebhookEndpoint, error)
+	ListEndpoints(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) ([]models.WebhookEndpoint, error)
+	GetEndpoint(ctx context.Context, workspaceID, endpointID uuid.UUID, firebaseUID string) (*models.WebhookEndpoint, error)
+	UpdateEndpoint(ctx context.Context, workspaceID, endpointID uuid.UUID, firebaseUID string, req models.WebhookEndpointRequest) (*models.WebhookEndpoint, error)
+	DeleteEndpoint(ctx context.Context, workspaceID, endpointID uuid.UUID, firebaseUID string) error
+	// RotateSecret replaces an endpoint's signing secret and returns the endpoint with
+	// the new secret. Requests already queued are signed with the new secret.
+	RotateSecret(ctx context.Context, workspaceID, endpointID uuid.UUID, firebaseUID string) (*models.WebhookEndpoint, error)
+	// ListDeliveries returns the delivery log, newest first. Filtering by the dead
+	// status gives the deliveries that ran out of attempts.
+	ListDeliveries(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, filter models.WebhookDeliveryFilter) ([]models.WebhookDelivery, error)
+	// Redeliver queues a fresh copy of a delivery, with the same event ID and payload,
+	// to be sent straight away.
+	Redeliver(ctx context.Context, workspaceID, deliveryID uuid.UUID, firebaseUID string) (*models.WebhookDelivery, error)
+	Publish(ctx context.Context, event events.Event) error
+}
+
+// WebhookPayload is the body POSTed to an endpoint. ID identifies the event, so
+// receivers can discard redeliveries they have already handled.
+type WebhookPayload struct {
+	ID          uuid.UUID      `json:"id"`
+	Type        string         `json:"type"`
+	WorkspaceID uuid.UUID      `json:"workspace_id"`
+	SubjectID   uuid.UUID      `json:"subject_id"`
+	OccurredAt  time.Time      `json:"occurred_at"`
+	Data        map[string]any `json:"data,omitempty"`
+}
+
+type webhookService struct {
+	repo           repositories.WebhookRepository
+	teamMemberRepo repositories.TeamMemberRepository
+	db             *sql.DB
+	now            func() time.Time
+}
+
+func NewWebhookService(repo repositories.WebhookRepository, teamMemberRepo repositories.TeamMemberRepository, db *sql.DB) WebhookService {
+	return &webhookService{repo: repo, teamMemberRepo: teamMemberRepo, db: db, now: time.Now}
+}
+
+// requireManager returns the caller's membership if they may manage the workspace's
+// webhooks.
+func (s *webhookService) requireManager(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) (*models.TeamMember, error) {
+	member, err := workspaceMember(ctx, s.teamMemberRepo, s.db, workspaceID, firebaseUID)
+	if err != nil {
+		return nil, err
+	}
+	if !member.Can(models.PermWebhooksManage) {
+		return nil, apperrors.ErrWorkspaceAccessDenied
+	}
+	return member, nil
+}
+
+func (s *webhookService) CreateEndpoint(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, req models.WebhookEndpointRequest) (*models.WebhookEndpoint, error) {
+	member, err := s.requireManager(ctx, workspaceID, firebaseUID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateWebhookEndpoint(&req); err != nil {
+		return nil, err
+	}
+
+	secret, err := webhooksig.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := &models.WebhookEndpoint{
+		ID:          uuid.New(),
+		WorkspaceID: workspaceID,
+		URL:         req.URL,
+		Description: req.Description,
+		Events:      req.Events,
+		IsActive:    req.IsActive == nil || *req.IsActive,
+		Secret:      secret,
+		CreatedBy:   &member.ID,
+	}
+	if err := s.repo.CreateEndpoint(ctx, endpoint, s.db); err != nil {
+		return nil, err
+	}
+	return endpoint, nil
+}
+
+func (s *webhookService) ListEndpoints(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) ([]models.WebhookEndpoint, error) {
+	if _, err := s.requireManager(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListEndpoints(ctx, workspaceID, s.db)
+}
+
+func (s *webhookService) GetEndpoint(ctx context.Context, workspaceID, endpointID uuid.UUID, firebaseUID string) (*models.WebhookEndpoint, error) {
+	if _, err := s.requireManager(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+	return s.getEndpoint(ctx, workspaceID, endpointID)
+}
+
+func (s *webhookService) getEndpoint(ctx context.Context, workspaceID, endpointID uuid.UUID) (*models.WebhookEndpoint, error) {
+	endpoint, err := s.repo.GetEndpoint(ctx, workspaceID, endpointID, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return endpoint, nil
+}
+
+func (s *webhookService) UpdateEndpoint(ctx context.Context, workspaceID, endpointID uuid.UUID, firebaseUID string, req models.WebhookEndpointRequest) (*models.WebhookEndpoint, error) {
+	if _, err := s.requireManager(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+	if err := validateWebhookEndpoint(&req); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := s.getEndpoint(ctx, workspaceID, endpointID)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint.URL = req.URL
+	endpoint.Description = req.Description
+	endpoint.Events = req.Events
+	if req.IsActive != nil {
+		endpoint.IsActive = *req.IsActive
+	}
+
+	err = s.repo.UpdateEndpoint(ctx, endpoint, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return endpoint, nil
+}
+
+func (s *webhookService) DeleteEndpoint(ctx context.Context, workspaceID, endpointID uuid.UUID, firebaseUID string) error {
+	if _, err := s.requireManager(ctx, workspaceID, firebaseUID); err != nil {
+		return err
+	}
+
+	err := s.repo.DeleteEndpoint(ctx, workspaceID, endpointID, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperrors.ErrNotFound
+	}
+	return err
+}
+
+func (s *webhookService) RotateSecret(ctx context.Context, workspaceID, endpointID uuid.UUID, firebaseUID string) (*models.WebhookEndpoint, error) {
+	if _, err := s.requireManager(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := s.getEndpoint(ctx, workspaceID, endpointID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := webhooksig.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+	err = s.repo.UpdateSecret(ctx, workspaceID, endpointID, secret, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint.Secret = secret
+	return endpoint, nil
+}
+
+func (s *webhookService) ListDeliveries(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, filter models.WebhookDeliveryFilter) ([]models.WebhookDelivery, error) {
+	if _, err := s.requireManager(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, fmt.Errorf("%w: unknown delivery status %q", apperrors.ErrValidationFailed, filter.Status)
+	}
+	if filter.Limit < 0 || filter.Limit > maxWebhookDeliveryLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", apperrors.ErrValidationFailed, maxWebhookDeliveryLimit)
+	}
+	return s.repo.ListDeliveries(ctx, workspaceID, filter, s.db)
+}
+
+func (s *webhookService) Redeliver(ctx context.Context, workspaceID, deliveryID uuid.UUID, firebaseUID string) (*models.WebhookDelivery, error) {
+	if _, err := s.requireManager(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+
+	original, err := s.repo.GetDelivery(ctx, workspaceID, deliveryID, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	delivery := &models.WebhookDelivery{
+		ID:            uuid.New(),
+		EndpointID:    original.EndpointID,
+		WorkspaceID:   workspaceID,
+		EventID:       original.EventID,
+		EventType:     original.EventType,
+		Payload:       original.Payload,
+		Status:        models.WebhookDeliveryPending,
+		NextAttemptAt: s.now(),
+		RedeliveryOf:  &original.ID,
+	}
+	if err := s.repo.CreateDelivery(ctx, delivery, s.db); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (s *webhookService) Publish(ctx context.Context, event events.Event) error {
+	endpoints, err := s.repo.ListSubscribed(ctx, event.WorkspaceID, event.Type, s.db)
+	if err != nil {
+		return err
+	}
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	payload := WebhookPayload{
+		ID:          uuid.New(),
+		Type:        event.Type,
+		WorkspaceID: event.WorkspaceID,
+		SubjectID:   event.SubjectID,
+		OccurredAt:  event.OccurredAt,
+		Data:        event.Data,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("error encoding webhook payload: %w", err)
+	}
+
+	// Queue what can be queued; one endpoint failing should not starve the others
+	var errs []error
+	for _, endpoint := range endpoints {
+		delivery := &models.WebhookDelivery{
+			ID:            uuid.New(),
+			EndpointID:    endpoint.ID,
+			WorkspaceID:   event.WorkspaceID,
+			EventID:       payload.ID,
+			EventType:     event.Type,
+			Payload:       body,
+			Status:        models.WebhookDeliveryPending,
+			NextAttemptAt: s.now(),
+		}
+		if err := s.repo.CreateDelivery(ctx, delivery, s.db); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validateWebhookEndpoint trims the request and checks the URL and event types.
+// Endpoints must use HTTPS since payloads carry customer content.
+func validateWebhookEndpoint(req *models.WebhookEndpointRequest) error {
+	req.URL = strings.TrimSpace(req.URL)
+	req.Description = strings.TrimSpace(req.Description)
+
+	if req.URL == "" {
+		return fmt.Errorf("%w: url is required", apperrors.ErrValidationFailed)
+	}
+	if len(req.URL) > maxWebhookURLLength {
+		return fmt.Errorf("%w: url must be at most %d characters", apperrors.ErrValidationFailed, maxWebhookURLLength)
+	}
+	u, err := url.Parse(req.URL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute https URL", apperrors.ErrValidationFailed)
+	}
+	if u.User != nil {
+		return fmt.Errorf("%w: url must not contain credentials", apperrors.ErrValidationFailed)
+	}
+	if len(req.Description) > maxWebhookDescriptionLength {
+		return fmt.Errorf("%w: description must be at most %d characters", apperrors.ErrValidationFailed, maxWebhookDescriptionLength)
+	}
+
+	if len(req.Events) == 0 {
+		return fmt.Errorf("%w: at least one event is required", apperrors.ErrValidationFailed)
+	}
+	seen := make(map[string]bool, len(req.Events))
+	unique := req.Events[:0]
+	for _, eventType := range req.Events {
+		if !slices.Contains(events.Types, eventType) {
+			return fmt.Errorf("%w: unknown event %q", apperrors.ErrValidationFailed, eventType)
+		}
+		if !seen[eventType] {
+			seen[eventType] = true
+			unique = append(unique, eventType)
+		}
+	}
+	req.Events = unique
+	return nil
+}
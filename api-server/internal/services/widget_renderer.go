@@ -0,0 +1,180 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"regexp"
+	"strings"
+
+	"github.com/ifeanyidike/cenphi/internal/models"
+)
+
+var (
+	// Brand colors and fonts come from workspace settings and end up in a style sheet, so
+	// only plain values are let through.
+	cssColorPattern = regexp.MustCompile(`^(#[0-9a-fA-F]{3,8}|[a-zA-Z]{3,20}|(rgb|rgba|hsl|hsla)\([0-9.,%\s]+\))$`)
+	cssFontPattern  = regexp.MustCompile(`^[a-zA-Z0-9 ,-]{1,100}$`)
+	cssClassPattern = regexp.MustCompile(`[^a-z0-9-]+`)
+)
+
+var widgetShapeRadius = map[string]string{
+	"square":  "0",
+	"rounded": "12px",
+	"pill":    "28px",
+	"circle":  "28px",
+}
+
+var widgetShadows = map[string]string{
+	"none": "none",
+	"sm":   "0 1px 3px rgba(0,0,0,.12)",
+	"md":   "0 4px 12px rgba(0,0,0,.12)",
+	"lg":   "0 10px 30px rgba(0,0,0,.16)",
+	"xl":   "0 20px 50px rgba(0,0,0,.2)",
+}
+
+// widgetTemplate renders a self-contained fragment: its styles are scoped to the
+// widget, and it uses no scripts, so it can be inserted into a page or framed as is.
+var widgetTemplate = template.Must(template.New("widget").Funcs(template.FuncMap{
+	"stars": widgetStars,
+	"deref": func(f *float32) float32 { return *f },
+}).Parse(`<div class="cenphi-widget cenphi-{{.Layout}} cenphi-style-{{.Style}}" data-cenphi-widget="{{.ID}}">
+<style>
+[data-cenphi-widget="{{.ID}}"]{--cenphi-primary:{{.Primary}};--cenphi-background:{{.Background}};--cenphi-text:{{.Text}};--cenphi-radius:{{.Radius}};--cenphi-shadow:{{.Shadow}};font-family:{{.Font}};color:var(--cenphi-text);box-sizing:border-box}
+[data-cenphi-widget="{{.ID}}"] *{box-sizing:inherit}
+[data-cenphi-widget="{{.ID}}"] .cenphi-title{margin:0 0 16px;font-size:1.25em}
+[data-cenphi-widget="{{.ID}}"] .cenphi-list{list-style:none;margin:0;padding:0;gap:16px}
+[data-cenphi-widget="{{.ID}}"].cenphi-grid .cenphi-list{display:grid;grid-template-columns:repeat(auto-fill,minmax(260px,1fr))}
+[data-cenphi-widget="{{.ID}}"].cenphi-wall .cenphi-list{column-width:260px;column-gap:16px}
+[data-cenphi-widget="{{.ID}}"].cenphi-wall .cenphi-item{break-inside:avoid;margin-bottom:16px}
+[data-cenphi-widget="{{.ID}}"].cenphi-carousel .cenphi-list{display:flex;overflow-x:auto;scroll-snap-type:x mandatory}
+[data-cenphi-widget="{{.ID}}"].cenphi-carousel .cenphi-item{flex:0 0 min(320px,85%);scroll-snap-align:start}
+[data-cenphi-widget="{{.ID}}"].cenphi-single .cenphi-item{max-width:640px;margin:0 auto}
+[data-cenphi-widget="{{.ID}}"] .cenphi-item{background:var(--cenphi-background);border-radius:var(--cenphi-radius);box-shadow:var(--cenphi-shadow);padding:20px{{if .Border}};border:1px solid rgba(0,0,0,.1){{end}}}
+[data-cenphi-widget="{{.ID}}"] .cenphi-rating{color:var(--cenphi-primary);letter-spacing:2px}
+[data-cenphi-widget="{{.ID}}"] .cenphi-content{margin:8px 0 16px;line-height:1.5}
+[data-cenphi-widget="{{.ID}}"].cenphi-style-quote .cenphi-content{font-style:italic}
+[data-cenphi-widget="{{.ID}}"] .cenphi-author{display:flex;align-items:center;gap:12px}
+[data-cenphi-widget="{{.ID}}"] .cenphi-avatar{width:40px;height:40px;border-radius:50%;object-fit:cover}
+[data-cenphi-widget="{{.ID}}"] .cenphi-name{font-weight:600}
+[data-cenphi-widget="{{.ID}}"] .cenphi-meta{opacity:.7;font-size:.875em}
+[data-cenphi-widget="{{.ID}}"] video,[data-cenphi-widget="{{.ID}}"] img.cenphi-media{width:100%;border-radius:var(--cenphi-radius)}
+{{- if .Animation}}
+@keyframes cenphi-fade{from{opacity:0;transform:translateY(8px)}to{opacity:1;transform:none}}
+@media (prefers-reduced-motion:no-preference){[data-cenphi-widget="{{.ID}}"] .cenphi-item{animation:cenphi-fade .4s ease-out both}}
+{{- end}}
+</style>
+{{- with .Title}}
+<h2 class="cenphi-title">{{.}}</h2>
+{{- end}}
+<ul class="cenphi-list">
+{{- range .Testimonials}}
+<li class="cenphi-item">
+<figure>
+{{- if and $.ShowRating .Rating}}
+<div class="cenphi-rating" aria-label="Rated {{printf "%.1f" (deref .Rating)}} out of 5">{{stars $.RatingStyle .Rating}}</div>
+{{- end}}
+{{- if and (eq .Format "video") .MediaURL}}
+<video controls preload="none" src="{{.MediaURL}}"{{with .ThumbnailURL}} poster="{{.}}"{{end}}></video>
+{{- else if and (eq .Format "image") .MediaURL}}
+<img class="cenphi-media" src="{{.MediaURL}}" alt="" loading="lazy">
+{{- end}}
+<blockquote class="cenphi-content">
+{{- with .Title}}<strong>{{.}}</strong> {{end}}{{.Content -}}
+</blockquote>
+<figcaption class="cenphi-author">
+{{- if .AvatarURL}}<img class="cenphi-avatar" src="{{.AvatarURL}}" alt="" loading="lazy">{{end}}
+<div>
+{{- with .AuthorName}}<div class="cenphi-name">{{.}}</div>{{end}}
+{{- if or .AuthorTitle .AuthorCompany}}<div class="cenphi-meta">{{.AuthorTitle}}{{if and .AuthorTitle .AuthorCompany}}, {{end}}{{.AuthorCompany}}</div>{{end}}
+{{- if .PublishedAt}}<div class="cenphi-meta"><time datetime="{{.PublishedAt.Format "2006-01-02"}}">{{.PublishedAt.Format "Jan 2, 2006"}}</time></div>{{end}}
+</div>
+</figcaption>
+</figure>
+</li>
+{{- end}}
+</ul>
+</div>
+`))
+
+// widgetView is what the template renders. Style values are checked before they are
+// marked safe for the style sheet.
+type widgetView struct {
+	*models.WidgetEmbed
+	Style       string
+	Primary     template.CSS
+	Background  template.CSS
+	Text        template.CSS
+	Font        template.CSS
+	Radius      template.CSS
+	Shadow      template.CSS
+	Border      bool
+	Animation   bool
+	ShowRating  bool
+	RatingStyle string
+}
+
+// RenderWidgetHTML renders an embed as an HTML fragment styled with its brand guide.
+func RenderWidgetHTML(embed *models.WidgetEmbed) ([]byte, error) {
+	style := embed.Style
+	view := widgetView{
+		WidgetEmbed: embed,
+		Style:       cssClassPattern.ReplaceAllString(strings.ToLower(style.Style), ""),
+		Primary:     widgetColor(style.Colors, "primary", "#f59e0b"),
+		Background:  widgetColor(style.Colors, "background", "#ffffff"),
+		Text:        widgetColor(style.Colors, "text", "#1f2937"),
+		Font:        widgetFont(style.Typography),
+		Radius:      template.CSS(lookupOr(widgetShapeRadius, style.Shape, "rounded")),
+		Shadow:      template.CSS(lookupOr(widgetShadows, style.Shadow, "md")),
+		Border:      style.Border,
+		Animation:   style.Animation,
+		ShowRating:  style.ShowRating,
+		RatingStyle: style.RatingStyle,
+	}
+
+	var buf bytes.Buffer
+	if err := widgetTemplate.Execute(&buf, view); err != nil {
+		return nil, fmt.Errorf("error rendering widget: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func widgetColor(colors models.JSONMap, key, fallback string) template.CSS {
+	if value, ok := colors[key].(string); ok && cssColorPattern.MatchString(strings.TrimSpace(value)) {
+		return template.CSS(strings.TrimSpace(value))
+	}
+	return template.CSS(fallback)
+}
+
+func widgetFont(typography models.JSONMap) template.CSS {
+	const fallback = `system-ui, -apple-system, "Segoe UI", Roboto, sans-serif`
+	for _, key := range []string{"font_family", "fontFamily"} {
+		if value, ok := typography[key].(string); ok && cssFontPattern.MatchString(value) {
+			return template.CSS(value)
+		}
+	}
+	return template.CSS(fallback)
+}
+
+func lookupOr(values map[string]string, key, fallback string) string {
+	if value, ok := values[strings.ToLower(key)]; ok {
+		return value
+	}
+	return values[fallback]
+}
+
+// widgetStars shows a rating as filled and empty stars, or as a number when the brand
+// guide asks for one.
+func widgetStars(ratingStyle string, rating *float32) string {
+	if rating == nil {
+		return ""
+	}
+	if ratingStyle == "numeric" || ratingStyle == "number" {
+		return fmt.Sprintf("%.1f/5", *rating)
+	}
+	filled := int(*rating + 0.5)
+	if filled > 5 {
+		filled = 5
+	}
+	return strings.Repeat("★", filled) + strings.Repeat("☆", 5-filled)
+}
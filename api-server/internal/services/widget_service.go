@@ -0,0 +1,216 @@
+package services
+
+//go:generate mockery --name=WidgetService --output=./mocks --case=underscore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+)
+
+type WidgetService interface {
+	Create(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, req models.DisplayWidgetRequest) (*models.DisplayWidget, error)
+	List(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) ([]models.DisplayWidget, error)
+	Get(ctx context.Context, workspaceID, widgetID uuid.UUID, firebaseUID string) (*models.DisplayWidget, error)
+	Update(ctx context.Context, workspaceID, widgetID uuid.UUID, firebaseUID string, req models.DisplayWidgetRequest) (*models.DisplayWidget, error)
+	Delete(ctx context.Context, workspaceID, widgetID uuid.UUID, firebaseUID string) error
+	// Embed returns what an active widget shows, styled with the workspace's default
+	// brand guide.
+	Embed(ctx context.Context, widgetID uuid.UUID) (*models.WidgetEmbed, error)
+}
+
+type widgetService struct {
+	repo           repositories.WidgetRepository
+	brandGuideRepo repositories.BrandGuideRepository
+	workspaceRepo  repositories.WorkspaceRepository
+	teamMemberRepo repositories.TeamMemberRepository
+	db             *sql.DB
+}
+
+func NewWidgetService(
+	repo repositories.WidgetRepository,
+	brandGuideRepo repositories.BrandGuideRepository,
+	workspaceRepo repositories.WorkspaceRepository,
+	teamMemberRepo repositories.TeamMemberRepository,
+	db *sql.DB,
+) WidgetService {
+	return &widgetService{
+		repo:           repo,
+		brandGuideRepo: brandGuideRepo,
+		workspaceRepo:  workspaceRepo,
+		teamMemberRepo: teamMemberRepo,
+		db:             db,
+	}
+}
+
+// requireEditor ensures the caller may manage the workspace's widgets. Widgets are
+// managed by the same roles as collection portals.
+func (s *widgetService) requireEditor(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) error {
+	return requireWorkspacePermission(ctx, s.teamMemberRepo, s.db, workspaceID, firebaseUID, models.PermPortalsManage)
+}
+
+func (s *widgetService) Create(ctx context.Context, workspaceID uuid.UUID, firebaseUID string, req models.DisplayWidgetRequest) (*models.DisplayWidget, error) {
+	if err := s.requireEditor(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+
+	widget := &models.DisplayWidget{
+		ID:          uuid.New(),
+		WorkspaceID: workspaceID,
+		Layout:      models.WidgetLayoutGrid,
+		Active:      true,
+	}
+	if err := applyWidgetRequest(widget, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, widget, s.db); err != nil {
+		return nil, err
+	}
+	return widget, nil
+}
+
+func (s *widgetService) List(ctx context.Context, workspaceID uuid.UUID, firebaseUID string) ([]models.DisplayWidget, error) {
+	if err := s.requireEditor(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetByWorkspace(ctx, workspaceID, s.db)
+}
+
+func (s *widgetService) Get(ctx context.Context, workspaceID, widgetID uuid.UUID, firebaseUID string) (*models.DisplayWidget, error) {
+	if err := s.requireEditor(ctx, workspaceID, firebaseUID); err != nil {
+		return nil, err
+	}
+
+	widget, err := s.repo.Get(ctx, workspaceID, widgetID, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrNotFound
+	}
+	return widget, err
+}
+
+func (s *widgetService) Update(ctx context.Context, workspaceID, widgetID uuid.UUID, firebaseUID string, req models.DisplayWidgetRequest) (*models.DisplayWidget, error) {
+	widget, err := s.Get(ctx, workspaceID, widgetID, firebaseUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// An empty layout keeps the current one
+	if err := applyWidgetRequest(widget, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, widget, s.db); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, err
+	}
+	return widget, nil
+}
+
+func (s *widgetService) Delete(ctx context.Context, workspaceID, widgetID uuid.UUID, firebaseUID string) error {
+	if err := s.requireEditor(ctx, workspaceID, firebaseUID); err != nil {
+		return err
+	}
+
+	err := s.repo.Delete(ctx, workspaceID, widgetID, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperrors.ErrNotFound
+	}
+	return err
+}
+
+func (s *widgetService) Embed(ctx context.Context, widgetID uuid.UUID) (*models.WidgetEmbed, error) {
+	widget, err := s.repo.GetByID(ctx, widgetID, s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !widget.Active {
+		return nil, apperrors.ErrNotFound
+	}
+
+	workspace, err := s.workspaceRepo.GetByID(ctx, widget.WorkspaceID, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching widget workspace: %w", err)
+	}
+
+	style := models.DefaultWidgetStyle()
+	guide, err := s.brandGuideRepo.FetchDefault(ctx, widget.WorkspaceID, s.db)
+	switch {
+	case err == nil:
+		style = models.WidgetStyleFromBrandGuide(guide)
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, err
+	}
+
+	limit := widget.Settings.EffectiveLimit(widget.Layout)
+	testimonials, err := s.repo.ListTestimonials(ctx, widget.WorkspaceID, widget.Settings, limit, s.db)
+	if err != nil {
+		return nil, err
+	}
+	for i := range testimonials {
+		hideWidgetFields(&testimonials[i], style)
+	}
+
+	return &models.WidgetEmbed{
+		ID:           widget.ID,
+		Layout:       widget.Layout,
+		Title:        widget.Settings.Title,
+		Style:        style,
+		Testimonials: testimonials,
+		Hosts:        models.EmbedHosts(workspace),
+	}, nil
+}
+
+// hideWidgetFields clears what the brand guide does not show, so it is not published in
+// the JSON either.
+func hideWidgetFields(t *models.EmbedTestimonial, style models.WidgetStyle) {
+	if !style.ShowRating {
+		t.Rating = nil
+	}
+	if !style.ShowAvatar {
+		t.AvatarURL = ""
+	}
+	if !style.ShowDate {
+		t.PublishedAt = nil
+	}
+	if !style.ShowCompany {
+		t.AuthorCompany = ""
+	}
+}
+
+// applyWidgetRequest validates req and copies it onto widget.
+func applyWidgetRequest(widget *models.DisplayWidget, req models.DisplayWidgetRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return fmt.Errorf("%w: name is required", apperrors.ErrValidationFailed)
+	}
+	if req.Layout != "" {
+		if !req.Layout.IsValid() {
+			return fmt.Errorf("%w: layout must be carousel, grid, wall or single", apperrors.ErrValidationFailed)
+		}
+		widget.Layout = req.Layout
+	}
+	if err := req.Settings.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", apperrors.ErrValidationFailed, err)
+	}
+
+	widget.Name = name
+	widget.Settings = req.Settings
+	widget.Settings.Title = strings.TrimSpace(widget.Settings.Title)
+	if req.Active != nil {
+		widget.Active = *req.Active
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWidgetService(t *testing.T) {
+	ctx := context.Background()
+	db, _, _ := sqlmock.New()
+	workspaceID := uuid.New()
+	editor := &models.TeamMember{ID: uuid.New(), WorkspaceID: workspaceID, Role: models.Editor}
+	workspace := &models.Workspace{ID: workspaceID, WebsiteURL: "https://www.acme.com/about", CustomDomain: "reviews.acme.io"}
+
+	newService := func(t *testing.T) (WidgetService, *mocks.WidgetRepository, *mocks.BrandGuideRepository, *mocks.TeamMemberRepository) {
+		repo := mocks.NewWidgetRepository(t)
+		guides := mocks.NewBrandGuideRepository(t)
+		workspaces := mocks.NewWorkspaceRepository(t)
+		workspaces.On("GetByID", mock.Anything, workspaceID, db).Return(workspace, nil).Maybe()
+		members := mocks.NewTeamMemberRepository(t)
+		return NewWidgetService(repo, guides, workspaces, members, db), repo, guides, members
+	}
+	rating := func(r float32) *float32 { return &r }
+	published := time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC)
+	testimonials := func() []models.EmbedTestimonial {
+		return []models.EmbedTestimonial{{
+			ID:            uuid.New(),
+			Content:       "Loved it <3",
+			Rating:        rating(5),
+			Format:        models.ContentFormatText,
+			AuthorName:    "Ada",
+			AuthorCompany: "Acme",
+			AvatarURL:     "https://cdn.acme.com/ada.png",
+			PublishedAt:   &published,
+		}}
+	}
+
+	t.Run("CreateValidates", func(t *testing.T) {
+		svc, _, _, members := newService(t)
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "editor-uid", db).Return(editor, nil)
+
+		for _, req := range []models.DisplayWidgetRequest{
+			{Layout: models.WidgetLayoutGrid},
+			{Name: "Home", Layout: "slideshow"},
+			{Name: "Home", Settings: models.WidgetSettings{MinRating: rating(6)}},
+			{Name: "Home", Settings: models.WidgetSettings{Limit: models.MaxWidgetTestimonials + 1}},
+		} {
+			_, err := svc.Create(ctx, workspaceID, "editor-uid", req)
+			assert.ErrorIs(t, err, apperrors.ErrValidationFailed, req)
+		}
+	})
+
+	t.Run("ViewersCannotManageWidgets", func(t *testing.T) {
+		svc, _, _, members := newService(t)
+		members.On("GetByFirebaseUID", mock.Anything, workspaceID, "viewer-uid", db).Return(&models.TeamMember{Role: models.Viewer}, nil)
+
+		_, err := svc.Create(ctx, workspaceID, "viewer-uid", models.DisplayWidgetRequest{Name: "Home"})
+		assert.ErrorIs(t, err, apperrors.ErrWorkspaceAccessDenied)
+	})
+
+	t.Run("EmbedIsStyledWithTheDefaultBrandGuide", func(t *testing.T) {
+		svc, repo, guides, _ := newService(t)
+		widget := &models.DisplayWidget{
+			ID:          uuid.New(),
+			WorkspaceID: workspaceID,
+			Layout:      models.WidgetLayoutCarousel,
+			Settings:    models.WidgetSettings{Title: "Customers say", Limit: 5},
+			Active:      true,
+		}
+		repo.On("GetByID", mock.Anything, widget.ID, db).Return(widget, nil)
+		guides.On("FetchDefault", mock.Anything, workspaceID, db).Return(&models.BrandGuide{
+			TestimonialStyle: "quote",
+			TestimonialShape: "square",
+			ShowRating:       true,
+			ShowAvatar:       false,
+			ShowDate:         true,
+			ShowCompany:      false,
+			Colors:           models.JSONMap{"primary": "#ff0066"},
+		}, nil)
+		repo.On("ListTestimonials", mock.Anything, workspaceID, widget.Settings, 5, db).Return(testimonials(), nil)
+
+		embed, err := svc.Embed(ctx, widget.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Customers say", embed.Title)
+		assert.Equal(t, "quote", embed.Style.Style)
+		require.Len(t, embed.Testimonials, 1)
+		// What the brand guide hides is not published at all
+		assert.Empty(t, embed.Testimonials[0].AvatarURL)
+		assert.Empty(t, embed.Testimonials[0].AuthorCompany)
+		assert.NotNil(t, embed.Testimonials[0].Rating)
+		assert.Equal(t, []string{"acme.com", "reviews.acme.io"}, embed.Hosts)
+
+		html, err := RenderWidgetHTML(embed)
+		require.NoError(t, err)
+		assert.Contains(t, string(html), "cenphi-carousel cenphi-style-quote")
+		assert.Contains(t, string(html), "--cenphi-primary:#ff0066")
+		assert.Contains(t, string(html), "Loved it &lt;3")
+		assert.Contains(t, string(html), "★★★★★")
+		assert.NotContains(t, string(html), "Acme")
+	})
+
+	t.Run("EmbedFallsBackToTheDefaultStyle", func(t *testing.T) {
+		svc, repo, guides, _ := newService(t)
+		widget := &models.DisplayWidget{ID: uuid.New(), WorkspaceID: workspaceID, Layout: models.WidgetLayoutSingle, Active: true}
+		repo.On("GetByID", mock.Anything, widget.ID, db).Return(widget, nil)
+		guides.On("FetchDefault", mock.Anything, workspaceID, db).Return(nil, sql.ErrNoRows)
+		// A single testimonial widget only ever needs one
+		repo.On("ListTestimonials", mock.Anything, workspaceID, widget.Settings, 1, db).Return(testimonials(), nil)
+
+		embed, err := svc.Embed(ctx, widget.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.DefaultWidgetStyle(), embed.Style)
+		assert.Equal(t, "https://cdn.acme.com/ada.png", embed.Testimonials[0].AvatarURL)
+	})
+
+	t.Run("InactiveWidgetsAreNotEmbedded", func(t *testing.T) {
+		svc, repo, _, _ := newService(t)
+		widget := &models.DisplayWidget{ID: uuid.New(), WorkspaceID: workspaceID, Layout: models.WidgetLayoutGrid}
+		repo.On("GetByID", mock.Anything, widget.ID, db).Return(widget, nil)
+		missing := uuid.New()
+		repo.On("GetByID", mock.Anything, missing, db).Return(nil, sql.ErrNoRows)
+
+		_, err := svc.Embed(ctx, widget.ID)
+		assert.ErrorIs(t, err, apperrors.ErrNotFound)
+		_, err = svc.Embed(ctx, missing)
+		assert.ErrorIs(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("EmbedsAreLimitedToTheWorkspaceDomains", func(t *testing.T) {
+		embed := &models.WidgetEmbed{Hosts: models.EmbedHosts(workspace)}
+
+		for origin, allowed := range map[string]bool{
+			"https://acme.com":           true,
+			"https://www.acme.com":       true,
+			"https://shop.acme.com:8443": true,
+			"https://reviews.acme.io":    true,
+			"https://acme.io":            false,
+			"https://evilacme.com":       false,
+			"https://acme.com.evil.io":   false,
+			"null":                       false,
+		} {
+			assert.Equal(t, allowed, embed.AllowsOrigin(origin), origin)
+		}
+	})
+}
+
+func TestRenderWidgetHTMLRejectsUnsafeStyles(t *testing.T) {
+	style := models.DefaultWidgetStyle()
+	style.Colors = models.JSONMap{"primary": "red}</style><script>alert(1)</script>"}
+	style.Typography = models.JSONMap{"font_family": "x;background:url(https://evil.io)"}
+	style.ShowRating = false
+	rating := float32(4)
+
+	html, err := RenderWidgetHTML(&models.WidgetEmbed{
+		ID:           uuid.New(),
+		Layout:       models.WidgetLayoutGrid,
+		Style:        style,
+		Testimonials: []models.EmbedTestimonial{{ID: uuid.New(), Content: "Fine", Rating: &rating, AvatarURL: "javascript:alert(1)"}},
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, string(html), "<script>")
+	assert.NotContains(t, string(html), "evil.io")
+	assert.NotContains(t, string(html), "javascript:")
+	assert.NotContains(t, string(html), "cenphi-rating\"")
+}
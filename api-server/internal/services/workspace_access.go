@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/ifeanyidike/cenphi/internal/apperrors"
+	"github.com/ifeanyidike/cenphi/internal/models"
+	"github.com/ifeanyidike/cenphi/internal/repositories"
+)
+
+// requireWorkspacePermission ensures the user is a member of the workspace with the
+// permission, from their role or their own permission overrides. Non-members get the
+// same error as members with too little access.
+func requireWorkspacePermission(ctx context.Context, repo repositories.TeamMemberRepository, db repositories.DB, workspaceID uuid.UUID, firebaseUID string, permission models.Permission) error {
+	member, err := workspaceMember(ctx, repo, db, workspaceID, firebaseUID)
+	if err != nil {
+		return err
+	}
+	if !member.Can(permission) {
+		return apperrors.ErrWorkspaceAccessDenied
+	}
+	return nil
+}
+
+// workspaceMember returns the user's membership of the workspace. Non-members get
+// apperrors.ErrWorkspaceAccessDenied.
+func workspaceMember(ctx context.Context, repo repositories.TeamMemberRepository, db repositories.DB, workspaceID uuid.UUID, firebaseUID string) (*models.TeamMember, error) {
+	member, err := repo.GetByFirebaseUID(ctx, workspaceID, firebaseUID, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrWorkspaceAccessDenied
+	}
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// hasRole reports whether role is one of roles.
+func hasRole(role models.MemberRole, roles []models.MemberRole) bool {
+	for _, allowed := range roles {
+		if role == allowed {
+			return true
+		}
+	}
+	return false
+}
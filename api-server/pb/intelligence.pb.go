@@ -5017,6 +5017,153 @@ func (x *VideoSummary) GetSentiment() string {
 	return ""
 }
 
+// Texts to embed for semantic similarity search.
+type EmbedTextsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The texts to embed, at most 64 per request.
+	Texts         []string `protobuf:"bytes,1,rep,name=texts,proto3" json:"texts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmbedTextsRequest) Reset() {
+	*x = EmbedTextsRequest{}
+	mi := &file_protobuf_intelligence_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmbedTextsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbedTextsRequest) ProtoMessage() {}
+
+func (x *EmbedTextsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_intelligence_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbedTextsRequest.ProtoReflect.Descriptor instead.
+func (*EmbedTextsRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_intelligence_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *EmbedTextsRequest) GetTexts() []string {
+	if x != nil {
+		return x.Texts
+	}
+	return nil
+}
+
+// A dense vector representing the meaning of a text.
+type Embedding struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1536 values, the dimensions of the vectors the api-server indexes.
+	Values        []float32 `protobuf:"fixed32,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Embedding) Reset() {
+	*x = Embedding{}
+	mi := &file_protobuf_intelligence_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Embedding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Embedding) ProtoMessage() {}
+
+func (x *Embedding) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_intelligence_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Embedding.ProtoReflect.Descriptor instead.
+func (*Embedding) Descriptor() ([]byte, []int) {
+	return file_protobuf_intelligence_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Embedding) GetValues() []float32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// The embeddings of the requested texts, in request order.
+type EmbedTextsResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Embeddings []*Embedding           `protobuf:"bytes,1,rep,name=embeddings,proto3" json:"embeddings,omitempty"`
+	// The model that produced the embeddings. Vectors from different models
+	// are not comparable.
+	Model         string `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmbedTextsResponse) Reset() {
+	*x = EmbedTextsResponse{}
+	mi := &file_protobuf_intelligence_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmbedTextsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbedTextsResponse) ProtoMessage() {}
+
+func (x *EmbedTextsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_intelligence_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbedTextsResponse.ProtoReflect.Descriptor instead.
+func (*EmbedTextsResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_intelligence_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *EmbedTextsResponse) GetEmbeddings() []*Embedding {
+	if x != nil {
+		return x.Embeddings
+	}
+	return nil
+}
+
+func (x *EmbedTextsResponse) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
 var File_protobuf_intelligence_proto protoreflect.FileDescriptor
 
 var file_protobuf_intelligence_proto_rawDesc = []byte{
@@ -5891,124 +6038,140 @@ var file_protobuf_intelligence_proto_rawDesc = []byte{
 	0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e,
 	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x74, 0x69, 0x6d,
 	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x6e, 0x74, 0x32, 0xbc, 0x0e, 0x0a, 0x0c, 0x49, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69,
-	0x67, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x67, 0x0a, 0x12, 0x45, 0x6e, 0x68, 0x61, 0x6e, 0x63, 0x65,
-	0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x12, 0x27, 0x2e, 0x69, 0x6e,
-	0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x68, 0x61, 0x6e,
-	0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65,
-	0x6e, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x69,
-	0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61,
-	0x0a, 0x10, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63,
-	0x65, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x74, 0x65,
-	0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
-	0x53, 0x65, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x70, 0x0a, 0x15, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x46, 0x61, 0x6b, 0x65, 0x54,
-	0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x12, 0x2a, 0x2e, 0x69, 0x6e, 0x74,
-	0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
-	0x46, 0x61, 0x6b, 0x65, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69,
-	0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x46, 0x61, 0x6b, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x11, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x54, 0x65, 0x78,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x78,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x65, 0x78, 0x74, 0x73, 0x22,
+	0x23, 0x0a, 0x09, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x02, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x22, 0x63, 0x0a, 0x12, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x54, 0x65, 0x78,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x65, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x45, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x32, 0x8d, 0x0f, 0x0a, 0x0c, 0x49, 0x6e,
+	0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x67, 0x0a, 0x12, 0x45, 0x6e,
+	0x68, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c,
+	0x12, 0x27, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x45, 0x6e, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6e, 0x74, 0x65,
+	0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x68, 0x61, 0x6e, 0x63, 0x65,
 	0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x1d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53,
-	0x74, 0x6f, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e,
-	0x69, 0x61, 0x6c, 0x73, 0x12, 0x22, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65,
-	0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c,
-	0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a,
-	0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d,
-	0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67,
+	0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x53, 0x65,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c,
+	0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x53, 0x65,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x15, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x46, 0x61, 0x6b, 0x65, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x12,
+	0x2a, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x44,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x46, 0x61, 0x6b, 0x65, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f,
+	0x6e, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x46, 0x61, 0x6b, 0x65, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x1d, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x73,
+	0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x22, 0x2e, 0x69, 0x6e, 0x74, 0x65,
+	0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67,
 	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x65,
-	0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f,
+	0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x79, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x12, 0x2d, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d,
+	0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f,
 	0x6e, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x18,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x65, 0x73,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x54, 0x65, 0x73,
 	0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c,
 	0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c,
+	0x56, 0x6f, 0x69, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c,
 	0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x56,
-	0x69, 0x64, 0x65, 0x6f, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e,
-	0x69, 0x61, 0x6c, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e,
-	0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x69, 0x63, 0x65,
-	0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63,
-	0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x54,
-	0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5e, 0x0a, 0x1b, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x41,
-	0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x6f, 0x72,
-	0x73, 0x12, 0x1e, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65,
-	0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65,
-	0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x49,
-	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x4d, 0x61, 0x70, 0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x74,
-	0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
-	0x79, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69, 0x6e,
-	0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
-	0x65, 0x79, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a,
-	0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x49, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x44, 0x65, 0x6d, 0x6f, 0x12, 0x20, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69,
-	0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x6d,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c,
-	0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44,
-	0x65, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x16, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x49, 0x52, 0x4f, 0x49, 0x50, 0x72, 0x65, 0x64,
-	0x69, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67,
-	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x52, 0x4f, 0x49, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x6f,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c,
-	0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x52, 0x4f, 0x49, 0x50, 0x72, 0x65, 0x64, 0x69,
-	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x13,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x49, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73,
-	0x69, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e,
-	0x63, 0x65, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e,
-	0x63, 0x65, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x53, 0x61, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x26, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65,
-	0x2e, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6e, 0x74, 0x65,
-	0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x43, 0x6f,
-	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x6e, 0x0a, 0x19, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x45, 0x6d, 0x6f,
-	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x27, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x45,
-	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c,
-	0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x6c, 0x52, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x70, 0x0a, 0x21, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x43, 0x6f, 0x6d,
-	0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c,
-	0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69,
-	0x76, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
-	0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d,
-	0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x1f, 0x53, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x73, 0x69,
-	0x7a, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x6f, 0x64, 0x61, 0x6c, 0x54, 0x65, 0x73, 0x74,
-	0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c,
-	0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x6f, 0x64, 0x61,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c,
-	0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x6f, 0x64,
-	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x68,
-	0x61, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c,
-	0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x1a, 0x1a, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e,
-	0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
-	0x01, 0x30, 0x01, 0x12, 0x4e, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x56, 0x69,
-	0x64, 0x65, 0x6f, 0x42, 0x79, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x18, 0x2e, 0x69, 0x6e,
-	0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f,
-	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x1a, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67,
-	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x79, 0x28, 0x01, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6f, 0x69, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x1b, 0x42, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x65,
+	0x74, 0x69, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69,
+	0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69,
+	0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x41, 0x49, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x4d, 0x61, 0x70, 0x12,
+	0x1f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5c, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x49,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x6d, 0x6f, 0x12, 0x20, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x44, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5f, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x49, 0x52, 0x4f,
+	0x49, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x52, 0x4f, 0x49, 0x50, 0x72, 0x65,
+	0x64, 0x69, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x52, 0x4f, 0x49,
+	0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x56, 0x0a, 0x13, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x49, 0x4d,
+	0x69, 0x63, 0x72, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c,
+	0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c,
+	0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x19, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69,
+	0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x61,
+	0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x19, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x7a, 0x65, 0x45, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65,
+	0x6e, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x21, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x7a, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x69, 0x66,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x65, 0x74, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63,
+	0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x1f, 0x53, 0x79, 0x6e,
+	0x74, 0x68, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x6f, 0x64, 0x61,
+	0x6c, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x12, 0x1f, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x6d, 0x6f, 0x64, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x6d, 0x6f, 0x64, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x47, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1a, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c,
+	0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4e, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x42, 0x79, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73,
+	0x12, 0x18, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x1a, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x28, 0x01, 0x12, 0x4f, 0x0a, 0x0a, 0x45, 0x6d, 0x62, 0x65,
+	0x64, 0x54, 0x65, 0x78, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69,
+	0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x54, 0x65, 0x78, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c,
+	0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x54, 0x65, 0x78, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6023,7 +6186,7 @@ func file_protobuf_intelligence_proto_rawDescGZIP() []byte {
 	return file_protobuf_intelligence_proto_rawDescData
 }
 
-var file_protobuf_intelligence_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
+var file_protobuf_intelligence_proto_msgTypes = make([]protoimpl.MessageInfo, 93)
 var file_protobuf_intelligence_proto_goTypes = []any{
 	(*EnhanceTestimonialRequest)(nil),        // 0: intelligence.EnhanceTestimonialRequest
 	(*EnhanceTestimonialResponse)(nil),       // 1: intelligence.EnhanceTestimonialResponse
@@ -6106,103 +6269,109 @@ var file_protobuf_intelligence_proto_goTypes = []any{
 	(*VideoChunk)(nil),                       // 78: intelligence.VideoChunk
 	(*VideoMetadata)(nil),                    // 79: intelligence.VideoMetadata
 	(*VideoSummary)(nil),                     // 80: intelligence.VideoSummary
-	nil,                                      // 81: intelligence.DetectFakeTestimonialResponse.FeatureScoresEntry
-	nil,                                      // 82: intelligence.GenerateVoiceTestimonialResponse.EmotionConfidenceEntry
-	nil,                                      // 83: intelligence.CompetitorAnalysisResponse.SentimentComparisonEntry
-	nil,                                      // 84: intelligence.PlacementResponse.PredictedEngagementEntry
-	nil,                                      // 85: intelligence.FeatureComparison.CompetitorScoresEntry
-	nil,                                      // 86: intelligence.ProductDemoResponse.EngagementMetricsEntry
-	nil,                                      // 87: intelligence.SalesConversationResponse.ObjectionHandlersEntry
-	nil,                                      // 88: intelligence.CompetitiveDiffResponse.CompetitiveAdvantagesEntry
-	nil,                                      // 89: intelligence.MultimodalResponse.ContentUrlsEntry
-	(*timestamppb.Timestamp)(nil),            // 90: google.protobuf.Timestamp
+	(*EmbedTextsRequest)(nil),                // 81: intelligence.EmbedTextsRequest
+	(*Embedding)(nil),                        // 82: intelligence.Embedding
+	(*EmbedTextsResponse)(nil),               // 83: intelligence.EmbedTextsResponse
+	nil,                                      // 84: intelligence.DetectFakeTestimonialResponse.FeatureScoresEntry
+	nil,                                      // 85: intelligence.GenerateVoiceTestimonialResponse.EmotionConfidenceEntry
+	nil,                                      // 86: intelligence.CompetitorAnalysisResponse.SentimentComparisonEntry
+	nil,                                      // 87: intelligence.PlacementResponse.PredictedEngagementEntry
+	nil,                                      // 88: intelligence.FeatureComparison.CompetitorScoresEntry
+	nil,                                      // 89: intelligence.ProductDemoResponse.EngagementMetricsEntry
+	nil,                                      // 90: intelligence.SalesConversationResponse.ObjectionHandlersEntry
+	nil,                                      // 91: intelligence.CompetitiveDiffResponse.CompetitiveAdvantagesEntry
+	nil,                                      // 92: intelligence.MultimodalResponse.ContentUrlsEntry
+	(*timestamppb.Timestamp)(nil),            // 93: google.protobuf.Timestamp
 }
 var file_protobuf_intelligence_proto_depIdxs = []int32{
 	22, // 0: intelligence.AnalyzeSentimentResponse.emotions:type_name -> intelligence.EmotionAnalysis
 	23, // 1: intelligence.AnalyzeSentimentResponse.aspects:type_name -> intelligence.AspectAnalysis
 	24, // 2: intelligence.DetectFakeTestimonialRequest.user_data:type_name -> intelligence.UserMetadata
-	81, // 3: intelligence.DetectFakeTestimonialResponse.feature_scores:type_name -> intelligence.DetectFakeTestimonialResponse.FeatureScoresEntry
+	84, // 3: intelligence.DetectFakeTestimonialResponse.feature_scores:type_name -> intelligence.DetectFakeTestimonialResponse.FeatureScoresEntry
 	25, // 4: intelligence.GenerateVideoTestimonialRequest.avatar_prefs:type_name -> intelligence.AvatarPreferences
 	26, // 5: intelligence.GenerateVideoTestimonialRequest.emotion_markers:type_name -> intelligence.EmotionTimestamp
 	27, // 6: intelligence.GenerateVoiceTestimonialRequest.emotion_points:type_name -> intelligence.EmotionMarker
 	28, // 7: intelligence.GenerateVoiceTestimonialRequest.preferences:type_name -> intelligence.AudioPreferences
-	82, // 8: intelligence.GenerateVoiceTestimonialResponse.emotion_confidence:type_name -> intelligence.GenerateVoiceTestimonialResponse.EmotionConfidenceEntry
+	85, // 8: intelligence.GenerateVoiceTestimonialResponse.emotion_confidence:type_name -> intelligence.GenerateVoiceTestimonialResponse.EmotionConfidenceEntry
 	29, // 9: intelligence.GenerateStoryRequest.preferences:type_name -> intelligence.StoryPreferences
 	30, // 10: intelligence.GenerateStoryResponse.themes:type_name -> intelligence.ThemeAnalysis
 	31, // 11: intelligence.CompetitorAnalysisResponse.insights:type_name -> intelligence.CompetitorInsight
-	83, // 12: intelligence.CompetitorAnalysisResponse.sentiment_comparison:type_name -> intelligence.CompetitorAnalysisResponse.SentimentComparisonEntry
+	86, // 12: intelligence.CompetitorAnalysisResponse.sentiment_comparison:type_name -> intelligence.CompetitorAnalysisResponse.SentimentComparisonEntry
 	32, // 13: intelligence.CompetitorAnalysisResponse.feature_analysis:type_name -> intelligence.FeatureComparison
 	33, // 14: intelligence.SentimentPredictionRequest.historical_data:type_name -> intelligence.HistoricalSentiment
 	34, // 15: intelligence.SentimentPredictionResponse.predictions:type_name -> intelligence.TimestampedPrediction
 	35, // 16: intelligence.SentimentPredictionResponse.contributing_factors:type_name -> intelligence.TrendFactor
 	36, // 17: intelligence.PlacementRequest.target_audience:type_name -> intelligence.UserSegment
 	37, // 18: intelligence.PlacementResponse.recommendations:type_name -> intelligence.PlacementRecommendation
-	84, // 19: intelligence.PlacementResponse.predicted_engagement:type_name -> intelligence.PlacementResponse.PredictedEngagementEntry
-	85, // 20: intelligence.FeatureComparison.competitor_scores:type_name -> intelligence.FeatureComparison.CompetitorScoresEntry
-	90, // 21: intelligence.HistoricalSentiment.timestamp:type_name -> google.protobuf.Timestamp
-	90, // 22: intelligence.TimestampedPrediction.timestamp:type_name -> google.protobuf.Timestamp
+	87, // 19: intelligence.PlacementResponse.predicted_engagement:type_name -> intelligence.PlacementResponse.PredictedEngagementEntry
+	88, // 20: intelligence.FeatureComparison.competitor_scores:type_name -> intelligence.FeatureComparison.CompetitorScoresEntry
+	93, // 21: intelligence.HistoricalSentiment.timestamp:type_name -> google.protobuf.Timestamp
+	93, // 22: intelligence.TimestampedPrediction.timestamp:type_name -> google.protobuf.Timestamp
 	38, // 23: intelligence.JourneyMapResponse.stages:type_name -> intelligence.JourneyStage
 	39, // 24: intelligence.JourneyMapResponse.emotional_milestones:type_name -> intelligence.EmotionalMilestone
 	63, // 25: intelligence.ProductDemoRequest.target_user:type_name -> intelligence.UserProfile
 	64, // 26: intelligence.ProductDemoResponse.scenes:type_name -> intelligence.DemoScene
-	86, // 27: intelligence.ProductDemoResponse.engagement_metrics:type_name -> intelligence.ProductDemoResponse.EngagementMetricsEntry
+	89, // 27: intelligence.ProductDemoResponse.engagement_metrics:type_name -> intelligence.ProductDemoResponse.EngagementMetricsEntry
 	44, // 28: intelligence.ROIPredictorRequest.company_profile:type_name -> intelligence.CompanyProfile
 	65, // 29: intelligence.ROIPredictorRequest.historical_data:type_name -> intelligence.HistoricalData
 	66, // 30: intelligence.ROIPredictorResponse.predictions:type_name -> intelligence.ROIPrediction
 	67, // 31: intelligence.MicrositeResponse.content_blocks:type_name -> intelligence.AIGeneratedContent
 	68, // 32: intelligence.SalesConversationRequest.context:type_name -> intelligence.SalesContext
 	69, // 33: intelligence.SalesConversationResponse.talking_points:type_name -> intelligence.ConversationPoint
-	87, // 34: intelligence.SalesConversationResponse.objection_handlers:type_name -> intelligence.SalesConversationResponse.ObjectionHandlersEntry
+	90, // 34: intelligence.SalesConversationResponse.objection_handlers:type_name -> intelligence.SalesConversationResponse.ObjectionHandlersEntry
 	70, // 35: intelligence.EmotionalResonanceResponse.emotional_impacts:type_name -> intelligence.EmotionalImpact
 	71, // 36: intelligence.CompetitiveDiffResponse.differentiators:type_name -> intelligence.DifferentiatorInsight
-	88, // 37: intelligence.CompetitiveDiffResponse.competitive_advantages:type_name -> intelligence.CompetitiveDiffResponse.CompetitiveAdvantagesEntry
+	91, // 37: intelligence.CompetitiveDiffResponse.competitive_advantages:type_name -> intelligence.CompetitiveDiffResponse.CompetitiveAdvantagesEntry
 	72, // 38: intelligence.MultimodalRequest.style_prefs:type_name -> intelligence.StylePreferences
-	89, // 39: intelligence.MultimodalResponse.content_urls:type_name -> intelligence.MultimodalResponse.ContentUrlsEntry
+	92, // 39: intelligence.MultimodalResponse.content_urls:type_name -> intelligence.MultimodalResponse.ContentUrlsEntry
 	73, // 40: intelligence.MultimodalResponse.quality_metrics:type_name -> intelligence.SynthesisMetric
 	66, // 41: intelligence.HistoricalData.past_roi_predictions:type_name -> intelligence.ROIPrediction
 	71, // 42: intelligence.BenchmarkResponse.insights:type_name -> intelligence.DifferentiatorInsight
 	79, // 43: intelligence.VideoChunk.metadata:type_name -> intelligence.VideoMetadata
-	0,  // 44: intelligence.Intelligence.EnhanceTestimonial:input_type -> intelligence.EnhanceTestimonialRequest
-	2,  // 45: intelligence.Intelligence.AnalyzeSentiment:input_type -> intelligence.AnalyzeSentimentRequest
-	4,  // 46: intelligence.Intelligence.DetectFakeTestimonial:input_type -> intelligence.DetectFakeTestimonialRequest
-	12, // 47: intelligence.Intelligence.GenerateStoryFromTestimonials:input_type -> intelligence.GenerateStoryRequest
-	6,  // 48: intelligence.Intelligence.TranslateTestimonial:input_type -> intelligence.TranslateTestimonialRequest
-	8,  // 49: intelligence.Intelligence.GenerateVideoTestimonial:input_type -> intelligence.GenerateVideoTestimonialRequest
-	10, // 50: intelligence.Intelligence.GenerateVoiceTestimonial:input_type -> intelligence.GenerateVoiceTestimonialRequest
-	74, // 51: intelligence.Intelligence.BenchmarkAgainstCompetitors:input_type -> intelligence.BenchmarkRequest
-	47, // 52: intelligence.Intelligence.GenerateAIJourneyMap:input_type -> intelligence.JourneyMapRequest
-	49, // 53: intelligence.Intelligence.GenerateAIProductDemo:input_type -> intelligence.ProductDemoRequest
-	51, // 54: intelligence.Intelligence.GenerateAIROIPredictor:input_type -> intelligence.ROIPredictorRequest
-	53, // 55: intelligence.Intelligence.GenerateAIMicrosite:input_type -> intelligence.MicrositeRequest
-	55, // 56: intelligence.Intelligence.GenerateSalesConversation:input_type -> intelligence.SalesConversationRequest
-	57, // 57: intelligence.Intelligence.AnalyzeEmotionalResonance:input_type -> intelligence.EmotionalResonanceRequest
-	59, // 58: intelligence.Intelligence.AnalyzeCompetitiveDifferentiators:input_type -> intelligence.CompetitiveDiffRequest
-	61, // 59: intelligence.Intelligence.SynthesizeMultimodalTestimonial:input_type -> intelligence.MultimodalRequest
-	76, // 60: intelligence.Intelligence.ChatStream:input_type -> intelligence.ChatMessage
-	78, // 61: intelligence.Intelligence.ProcessVideoByChunks:input_type -> intelligence.VideoChunk
-	1,  // 62: intelligence.Intelligence.EnhanceTestimonial:output_type -> intelligence.EnhanceTestimonialResponse
-	3,  // 63: intelligence.Intelligence.AnalyzeSentiment:output_type -> intelligence.AnalyzeSentimentResponse
-	5,  // 64: intelligence.Intelligence.DetectFakeTestimonial:output_type -> intelligence.DetectFakeTestimonialResponse
-	13, // 65: intelligence.Intelligence.GenerateStoryFromTestimonials:output_type -> intelligence.GenerateStoryResponse
-	7,  // 66: intelligence.Intelligence.TranslateTestimonial:output_type -> intelligence.TranslateTestimonialResponse
-	9,  // 67: intelligence.Intelligence.GenerateVideoTestimonial:output_type -> intelligence.GenerateVideoTestimonialResponse
-	11, // 68: intelligence.Intelligence.GenerateVoiceTestimonial:output_type -> intelligence.GenerateVoiceTestimonialResponse
-	75, // 69: intelligence.Intelligence.BenchmarkAgainstCompetitors:output_type -> intelligence.BenchmarkResponse
-	48, // 70: intelligence.Intelligence.GenerateAIJourneyMap:output_type -> intelligence.JourneyMapResponse
-	50, // 71: intelligence.Intelligence.GenerateAIProductDemo:output_type -> intelligence.ProductDemoResponse
-	52, // 72: intelligence.Intelligence.GenerateAIROIPredictor:output_type -> intelligence.ROIPredictorResponse
-	54, // 73: intelligence.Intelligence.GenerateAIMicrosite:output_type -> intelligence.MicrositeResponse
-	56, // 74: intelligence.Intelligence.GenerateSalesConversation:output_type -> intelligence.SalesConversationResponse
-	58, // 75: intelligence.Intelligence.AnalyzeEmotionalResonance:output_type -> intelligence.EmotionalResonanceResponse
-	60, // 76: intelligence.Intelligence.AnalyzeCompetitiveDifferentiators:output_type -> intelligence.CompetitiveDiffResponse
-	62, // 77: intelligence.Intelligence.SynthesizeMultimodalTestimonial:output_type -> intelligence.MultimodalResponse
-	77, // 78: intelligence.Intelligence.ChatStream:output_type -> intelligence.ChatResponse
-	80, // 79: intelligence.Intelligence.ProcessVideoByChunks:output_type -> intelligence.VideoSummary
-	62, // [62:80] is the sub-list for method output_type
-	44, // [44:62] is the sub-list for method input_type
-	44, // [44:44] is the sub-list for extension type_name
-	44, // [44:44] is the sub-list for extension extendee
-	0,  // [0:44] is the sub-list for field type_name
+	82, // 44: intelligence.EmbedTextsResponse.embeddings:type_name -> intelligence.Embedding
+	0,  // 45: intelligence.Intelligence.EnhanceTestimonial:input_type -> intelligence.EnhanceTestimonialRequest
+	2,  // 46: intelligence.Intelligence.AnalyzeSentiment:input_type -> intelligence.AnalyzeSentimentRequest
+	4,  // 47: intelligence.Intelligence.DetectFakeTestimonial:input_type -> intelligence.DetectFakeTestimonialRequest
+	12, // 48: intelligence.Intelligence.GenerateStoryFromTestimonials:input_type -> intelligence.GenerateStoryRequest
+	6,  // 49: intelligence.Intelligence.TranslateTestimonial:input_type -> intelligence.TranslateTestimonialRequest
+	8,  // 50: intelligence.Intelligence.GenerateVideoTestimonial:input_type -> intelligence.GenerateVideoTestimonialRequest
+	10, // 51: intelligence.Intelligence.GenerateVoiceTestimonial:input_type -> intelligence.GenerateVoiceTestimonialRequest
+	74, // 52: intelligence.Intelligence.BenchmarkAgainstCompetitors:input_type -> intelligence.BenchmarkRequest
+	47, // 53: intelligence.Intelligence.GenerateAIJourneyMap:input_type -> intelligence.JourneyMapRequest
+	49, // 54: intelligence.Intelligence.GenerateAIProductDemo:input_type -> intelligence.ProductDemoRequest
+	51, // 55: intelligence.Intelligence.GenerateAIROIPredictor:input_type -> intelligence.ROIPredictorRequest
+	53, // 56: intelligence.Intelligence.GenerateAIMicrosite:input_type -> intelligence.MicrositeRequest
+	55, // 57: intelligence.Intelligence.GenerateSalesConversation:input_type -> intelligence.SalesConversationRequest
+	57, // 58: intelligence.Intelligence.AnalyzeEmotionalResonance:input_type -> intelligence.EmotionalResonanceRequest
+	59, // 59: intelligence.Intelligence.AnalyzeCompetitiveDifferentiators:input_type -> intelligence.CompetitiveDiffRequest
+	61, // 60: intelligence.Intelligence.SynthesizeMultimodalTestimonial:input_type -> intelligence.MultimodalRequest
+	76, // 61: intelligence.Intelligence.ChatStream:input_type -> intelligence.ChatMessage
+	78, // 62: intelligence.Intelligence.ProcessVideoByChunks:input_type -> intelligence.VideoChunk
+	81, // 63: intelligence.Intelligence.EmbedTexts:input_type -> intelligence.EmbedTextsRequest
+	1,  // 64: intelligence.Intelligence.EnhanceTestimonial:output_type -> intelligence.EnhanceTestimonialResponse
+	3,  // 65: intelligence.Intelligence.AnalyzeSentiment:output_type -> intelligence.AnalyzeSentimentResponse
+	5,  // 66: intelligence.Intelligence.DetectFakeTestimonial:output_type -> intelligence.DetectFakeTestimonialResponse
+	13, // 67: intelligence.Intelligence.GenerateStoryFromTestimonials:output_type -> intelligence.GenerateStoryResponse
+	7,  // 68: intelligence.Intelligence.TranslateTestimonial:output_type -> intelligence.TranslateTestimonialResponse
+	9,  // 69: intelligence.Intelligence.GenerateVideoTestimonial:output_type -> intelligence.GenerateVideoTestimonialResponse
+	11, // 70: intelligence.Intelligence.GenerateVoiceTestimonial:output_type -> intelligence.GenerateVoiceTestimonialResponse
+	75, // 71: intelligence.Intelligence.BenchmarkAgainstCompetitors:output_type -> intelligence.BenchmarkResponse
+	48, // 72: intelligence.Intelligence.GenerateAIJourneyMap:output_type -> intelligence.JourneyMapResponse
+	50, // 73: intelligence.Intelligence.GenerateAIProductDemo:output_type -> intelligence.ProductDemoResponse
+	52, // 74: intelligence.Intelligence.GenerateAIROIPredictor:output_type -> intelligence.ROIPredictorResponse
+	54, // 75: intelligence.Intelligence.GenerateAIMicrosite:output_type -> intelligence.MicrositeResponse
+	56, // 76: intelligence.Intelligence.GenerateSalesConversation:output_type -> intelligence.SalesConversationResponse
+	58, // 77: intelligence.Intelligence.AnalyzeEmotionalResonance:output_type -> intelligence.EmotionalResonanceResponse
+	60, // 78: intelligence.Intelligence.AnalyzeCompetitiveDifferentiators:output_type -> intelligence.CompetitiveDiffResponse
+	62, // 79: intelligence.Intelligence.SynthesizeMultimodalTestimonial:output_type -> intelligence.MultimodalResponse
+	77, // 80: intelligence.Intelligence.ChatStream:output_type -> intelligence.ChatResponse
+	80, // 81: intelligence.Intelligence.ProcessVideoByChunks:output_type -> intelligence.VideoSummary
+	83, // 82: intelligence.Intelligence.EmbedTexts:output_type -> intelligence.EmbedTextsResponse
+	64, // [64:83] is the sub-list for method output_type
+	45, // [45:64] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_protobuf_intelligence_proto_init() }
@@ -6216,7 +6385,7 @@ func file_protobuf_intelligence_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protobuf_intelligence_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   90,
+			NumMessages:   93,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -37,6 +37,7 @@ const (
 	Intelligence_SynthesizeMultimodalTestimonial_FullMethodName   = "/intelligence.Intelligence/SynthesizeMultimodalTestimonial"
 	Intelligence_ChatStream_FullMethodName                        = "/intelligence.Intelligence/ChatStream"
 	Intelligence_ProcessVideoByChunks_FullMethodName              = "/intelligence.Intelligence/ProcessVideoByChunks"
+	Intelligence_EmbedTexts_FullMethodName                        = "/intelligence.Intelligence/EmbedTexts"
 )
 
 // IntelligenceClient is the client API for Intelligence service.
@@ -86,6 +87,8 @@ type IntelligenceClient interface {
 	// A client-side streaming RPC: the client sends a stream of VideoChunk
 	// messages and then waits for a single VideoSummary response.
 	ProcessVideoByChunks(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[VideoChunk, VideoSummary], error)
+	// Embeds texts as dense vectors for semantic similarity search
+	EmbedTexts(ctx context.Context, in *EmbedTextsRequest, opts ...grpc.CallOption) (*EmbedTextsResponse, error)
 }
 
 type intelligenceClient struct {
@@ -282,6 +285,16 @@ func (c *intelligenceClient) ProcessVideoByChunks(ctx context.Context, opts ...g
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Intelligence_ProcessVideoByChunksClient = grpc.ClientStreamingClient[VideoChunk, VideoSummary]
 
+func (c *intelligenceClient) EmbedTexts(ctx context.Context, in *EmbedTextsRequest, opts ...grpc.CallOption) (*EmbedTextsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmbedTextsResponse)
+	err := c.cc.Invoke(ctx, Intelligence_EmbedTexts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IntelligenceServer is the server API for Intelligence service.
 // All implementations must embed UnimplementedIntelligenceServer
 // for forward compatibility.
@@ -329,6 +342,8 @@ type IntelligenceServer interface {
 	// A client-side streaming RPC: the client sends a stream of VideoChunk
 	// messages and then waits for a single VideoSummary response.
 	ProcessVideoByChunks(grpc.ClientStreamingServer[VideoChunk, VideoSummary]) error
+	// Embeds texts as dense vectors for semantic similarity search
+	EmbedTexts(context.Context, *EmbedTextsRequest) (*EmbedTextsResponse, error)
 	mustEmbedUnimplementedIntelligenceServer()
 }
 
@@ -393,6 +408,9 @@ func (UnimplementedIntelligenceServer) ChatStream(grpc.BidiStreamingServer[ChatM
 func (UnimplementedIntelligenceServer) ProcessVideoByChunks(grpc.ClientStreamingServer[VideoChunk, VideoSummary]) error {
 	return status.Errorf(codes.Unimplemented, "method ProcessVideoByChunks not implemented")
 }
+func (UnimplementedIntelligenceServer) EmbedTexts(context.Context, *EmbedTextsRequest) (*EmbedTextsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmbedTexts not implemented")
+}
 func (UnimplementedIntelligenceServer) mustEmbedUnimplementedIntelligenceServer() {}
 func (UnimplementedIntelligenceServer) testEmbeddedByValue()                      {}
 
@@ -716,6 +734,24 @@ func _Intelligence_ProcessVideoByChunks_Handler(srv interface{}, stream grpc.Ser
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Intelligence_ProcessVideoByChunksServer = grpc.ClientStreamingServer[VideoChunk, VideoSummary]
 
+func _Intelligence_EmbedTexts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmbedTextsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntelligenceServer).EmbedTexts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Intelligence_EmbedTexts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntelligenceServer).EmbedTexts(ctx, req.(*EmbedTextsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Intelligence_ServiceDesc is the grpc.ServiceDesc for Intelligence service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -787,6 +823,10 @@ var Intelligence_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SynthesizeMultimodalTestimonial",
 			Handler:    _Intelligence_SynthesizeMultimodalTestimonial_Handler,
 		},
+		{
+			MethodName: "EmbedTexts",
+			Handler:    _Intelligence_EmbedTexts_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,123 @@
+package envelope
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	keySize        = 32
+	currentVersion = 1
+)
+
+var (
+	ErrInvalidKey      = errors.New("encryption key must be 32 bytes, base64 encoded")
+	ErrInvalidEnvelope = errors.New("invalid or corrupted envelope")
+)
+
+// Envelope is the at-rest representation of an encrypted payload. The payload is
+// sealed with a random per-record data key, and the data key itself is sealed with
+// the master key, so rotating the master key only requires re-wrapping data keys.
+type Envelope struct {
+	Version    int    `json:"version"`
+	WrappedKey []byte `json:"wrapped_key"`
+	KeyNonce   []byte `json:"key_nonce"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+type Cipher struct {
+	master cipher.AEAD
+}
+
+// NewCipher builds a Cipher from a base64 encoded 256-bit master key.
+func NewCipher(encodedKey string) (*Cipher, error) {
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil || len(key) != keySize {
+		return nil, ErrInvalidKey
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{master: aead}, nil
+}
+
+func (c *Cipher) Seal(plaintext []byte) (*Envelope, error) {
+	dataKey := make([]byte, keySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, fmt.Errorf("error generating data key: %w", err)
+	}
+
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	keyNonce, err := randomNonce(c.master)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := randomNonce(dataAEAD)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Envelope{
+		Version:    currentVersion,
+		WrappedKey: c.master.Seal(nil, keyNonce, dataKey, nil),
+		KeyNonce:   keyNonce,
+		Nonce:      nonce,
+		Ciphertext: dataAEAD.Seal(nil, nonce, plaintext, nil),
+	}, nil
+}
+
+func (c *Cipher) Open(env *Envelope) ([]byte, error) {
+	if env == nil || env.Version != currentVersion {
+		return nil, ErrInvalidEnvelope
+	}
+	if len(env.KeyNonce) != c.master.NonceSize() {
+		return nil, ErrInvalidEnvelope
+	}
+
+	dataKey, err := c.master.Open(nil, env.KeyNonce, env.WrappedKey, nil)
+	if err != nil {
+		return nil, ErrInvalidEnvelope
+	}
+
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, ErrInvalidEnvelope
+	}
+	if len(env.Nonce) != dataAEAD.NonceSize() {
+		return nil, ErrInvalidEnvelope
+	}
+
+	plaintext, err := dataAEAD.Open(nil, env.Nonce, env.Ciphertext, nil)
+	if err != nil {
+		return nil, ErrInvalidEnvelope
+	}
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("error creating block cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+func randomNonce(aead cipher.AEAD) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("error generating nonce: %w", err)
+	}
+	return nonce, nil
+}
@@ -0,0 +1,57 @@
+package envelope
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKey(t *testing.T) string {
+	key := make([]byte, keySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func TestCipher(t *testing.T) {
+	c, err := NewCipher(newTestKey(t))
+	require.NoError(t, err)
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		env, err := c.Seal([]byte(`{"clientSecret":"s3cr3t"}`))
+		require.NoError(t, err)
+		assert.NotContains(t, string(env.Ciphertext), "s3cr3t")
+
+		plaintext, err := c.Open(env)
+		require.NoError(t, err)
+		assert.Equal(t, `{"clientSecret":"s3cr3t"}`, string(plaintext))
+	})
+
+	t.Run("TamperedCiphertext", func(t *testing.T) {
+		env, err := c.Seal([]byte("payload"))
+		require.NoError(t, err)
+		env.Ciphertext[0] ^= 0xff
+
+		_, err = c.Open(env)
+		assert.ErrorIs(t, err, ErrInvalidEnvelope)
+	})
+
+	t.Run("WrongMasterKey", func(t *testing.T) {
+		env, err := c.Seal([]byte("payload"))
+		require.NoError(t, err)
+
+		other, err := NewCipher(newTestKey(t))
+		require.NoError(t, err)
+
+		_, err = other.Open(env)
+		assert.ErrorIs(t, err, ErrInvalidEnvelope)
+	})
+
+	t.Run("InvalidKey", func(t *testing.T) {
+		_, err := NewCipher("not-a-key")
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	})
+}
@@ -0,0 +1,85 @@
+// Package events broadcasts domain events to the other parts of the system, such as
+// the embeddable widgets that cache published testimonials.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// TestimonialCreated is emitted when a testimonial is submitted or imported.
+	TestimonialCreated = "testimonial.created"
+	// TestimonialStatusChanged is emitted when a testimonial moves between statuses.
+	TestimonialStatusChanged = "testimonial.status_changed"
+	// TestimonialPublished is emitted when a testimonial goes live.
+	TestimonialPublished = "testimonial.published"
+	// AnalysisCompleted is emitted when an AI job on a testimonial finishes.
+	AnalysisCompleted = "analysis.completed"
+	// SyncFailed is emitted when a provider sync for a workspace fails.
+	SyncFailed = "sync.failed"
+)
+
+// Types lists every event type.
+var Types = []string{
+	TestimonialCreated,
+	TestimonialStatusChanged,
+	TestimonialPublished,
+	AnalysisCompleted,
+	SyncFailed,
+}
+
+// Event is the payload broadcast for a change. Subject is the record the event is about.
+type Event struct {
+	Type        string         `json:"type"`
+	WorkspaceID uuid.UUID      `json:"workspace_id"`
+	SubjectID   uuid.UUID      `json:"subject_id"`
+	OccurredAt  time.Time      `json:"occurred_at"`
+	Data        map[string]any `json:"data,omitempty"`
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+// Channel is the Redis channel events of a type are published on.
+func Channel(eventType string) string {
+	return "events:" + eventType
+}
+
+// RedisPublisher publishes events as JSON over Redis pub/sub. Delivery is at most once:
+// subscribers that are not connected when an event is published never see it.
+type RedisPublisher struct {
+	client *redis.Client
+}
+
+func NewRedisPublisher(client *redis.Client) *RedisPublisher {
+	return &RedisPublisher{client: client}
+}
+
+func (p *RedisPublisher) Publish(ctx context.Context, event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return p.client.Publish(ctx, Channel(event.Type), payload).Err()
+}
+
+// Fanout publishes every event to each of its publishers in turn. One publisher failing
+// does not stop the others; their errors are joined.
+type Fanout []Publisher
+
+func (f Fanout) Publish(ctx context.Context, event Event) error {
+	var errs []error
+	for _, p := range f {
+		if err := p.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
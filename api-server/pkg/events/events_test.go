@@ -0,0 +1,54 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedisPublisher(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	p := NewRedisPublisher(client)
+
+	workspaceID := uuid.MustParse("5b1f2f4e-9f55-4a43-8c55-0d4c1b0c8a01")
+	testimonialID := uuid.MustParse("0e9a3c55-2d7a-4c8b-9a4d-3f1e6b7c8d02")
+	event := Event{
+		Type:        TestimonialPublished,
+		WorkspaceID: workspaceID,
+		SubjectID:   testimonialID,
+		OccurredAt:  time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC),
+	}
+
+	payload := `{"type":"testimonial.published","workspace_id":"5b1f2f4e-9f55-4a43-8c55-0d4c1b0c8a01",` +
+		`"subject_id":"0e9a3c55-2d7a-4c8b-9a4d-3f1e6b7c8d02","occurred_at":"2024-05-01T09:30:00Z"}`
+	mock.ExpectPublish("events:testimonial.published", []byte(payload)).SetVal(1)
+
+	assert.NoError(t, p.Publish(context.Background(), event))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+type recordingPublisher struct {
+	events []Event
+	err    error
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, event Event) error {
+	p.events = append(p.events, event)
+	return p.err
+}
+
+func TestFanout(t *testing.T) {
+	failing := &recordingPublisher{err: errors.New("unavailable")}
+	working := &recordingPublisher{}
+	event := Event{Type: SyncFailed, WorkspaceID: uuid.New()}
+
+	err := Fanout{failing, working}.Publish(context.Background(), event)
+	assert.ErrorIs(t, err, failing.err)
+	assert.Equal(t, []Event{event}, failing.events)
+	assert.Equal(t, []Event{event}, working.events)
+}
@@ -0,0 +1,129 @@
+package formtoken
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	ErrInvalid  = errors.New("form token is invalid")
+	ErrTooFast  = errors.New("form was submitted too quickly")
+	ErrTooOld   = errors.New("form token has expired")
+	ErrReused   = errors.New("form token was already used")
+	errNoSecret = errors.New("form token secret must not be empty")
+)
+
+const (
+	nonceSize     = 16
+	usedKeyPrefix = "formtoken:used:"
+)
+
+// Signer issues tokens that bind a form to the time it was served. Bots that post
+// straight to a form either have no token or submit faster than a person could type.
+// Each token carries a random nonce, so that a ReplayGuard can accept it only once.
+type Signer struct {
+	secret []byte
+	now    func() time.Time
+}
+
+func NewSigner(secret []byte) (*Signer, error) {
+	if len(secret) == 0 {
+		return nil, errNoSecret
+	}
+	return &Signer{secret: secret, now: time.Now}, nil
+}
+
+// NewRandomSigner returns a signer with a per-process secret. Its tokens do not survive
+// restarts and are not accepted by other replicas.
+func NewRandomSigner() (*Signer, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate form token secret: %w", err)
+	}
+	return NewSigner(secret)
+}
+
+// Issue returns a token for the form identified by subject.
+func (s *Signer) Issue(subject string) string {
+	return s.IssueAt(subject, s.now())
+}
+
+// IssueAt returns a token for subject as if the form had been served at t.
+func (s *Signer) IssueAt(subject string, t time.Time) string {
+	issued := strconv.FormatInt(t.Unix(), 10)
+	nonce := make([]byte, nonceSize)
+	// crypto/rand only fails when the system has no randomness source at all
+	if _, err := rand.Read(nonce); err != nil {
+		panic(fmt.Sprintf("formtoken: failed to generate nonce: %v", err))
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(nonce)
+	return issued + "." + encoded + "." + s.sign(subject, issued, encoded)
+}
+
+// Verify checks that token was issued for subject at least minAge and at most maxAge ago.
+func (s *Signer) Verify(token, subject string, minAge, maxAge time.Duration) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ErrInvalid
+	}
+	issued, nonce, signature := parts[0], parts[1], parts[2]
+	if !hmac.Equal([]byte(signature), []byte(s.sign(subject, issued, nonce))) {
+		return ErrInvalid
+	}
+
+	unix, err := strconv.ParseInt(issued, 10, 64)
+	if err != nil {
+		return ErrInvalid
+	}
+	age := s.now().Sub(time.Unix(unix, 0))
+	if age < minAge {
+		return ErrTooFast
+	}
+	if age > maxAge {
+		return ErrTooOld
+	}
+	return nil
+}
+
+func (s *Signer) sign(subject, issued, nonce string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(subject + "|" + issued + "|" + nonce))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// ReplayGuard remembers the nonces of submitted tokens in Redis, so that a served form
+// can only be submitted once.
+type ReplayGuard struct {
+	client *redis.Client
+}
+
+func NewReplayGuard(client *redis.Client) *ReplayGuard {
+	return &ReplayGuard{client: client}
+}
+
+// Use marks a verified token as used for ttl, which should be at least the age up to
+// which tokens are accepted. It returns ErrReused when the token was used before.
+func (g *ReplayGuard) Use(ctx context.Context, token string, ttl time.Duration) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ErrInvalid
+	}
+	fresh, err := g.client.SetNX(ctx, usedKeyPrefix+parts[1], 1, ttl).Result()
+	if err != nil {
+		return fmt.Errorf("failed to record form token: %w", err)
+	}
+	if !fresh {
+		return ErrReused
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package formtoken
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner(t *testing.T) {
+	signer, err := NewSigner([]byte("secret"))
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+	signer.now = func() time.Time { return now }
+	token := signer.Issue("portal-a")
+
+	now = now.Add(2 * time.Second)
+	assert.ErrorIs(t, signer.Verify(token, "portal-a", 3*time.Second, time.Hour), ErrTooFast)
+
+	now = now.Add(10 * time.Second)
+	assert.NoError(t, signer.Verify(token, "portal-a", 3*time.Second, time.Hour))
+	assert.ErrorIs(t, signer.Verify(token, "portal-b", 3*time.Second, time.Hour), ErrInvalid)
+	assert.ErrorIs(t, signer.Verify("garbage", "portal-a", 0, time.Hour), ErrInvalid)
+
+	// The nonce is signed too
+	parts := strings.Split(token, ".")
+	forged := parts[0] + ".AAAAAAAAAAAAAAAAAAAAAA." + parts[2]
+	assert.ErrorIs(t, signer.Verify(forged, "portal-a", 0, time.Hour), ErrInvalid)
+
+	now = now.Add(2 * time.Hour)
+	assert.ErrorIs(t, signer.Verify(token, "portal-a", 3*time.Second, time.Hour), ErrTooOld)
+
+	// Forms served in the same second still get different tokens
+	assert.NotEqual(t, signer.Issue("portal-a"), signer.Issue("portal-a"))
+
+	_, err = NewSigner(nil)
+	assert.Error(t, err)
+}
+
+func TestReplayGuard(t *testing.T) {
+	ctx := context.Background()
+	signer, err := NewSigner([]byte("secret"))
+	require.NoError(t, err)
+	token := signer.Issue("portal-a")
+	key := usedKeyPrefix + strings.Split(token, ".")[1]
+
+	client, redisMock := redismock.NewClientMock()
+	guard := NewReplayGuard(client)
+
+	redisMock.ExpectSetNX(key, 1, time.Hour).SetVal(true)
+	assert.NoError(t, guard.Use(ctx, token, time.Hour))
+
+	redisMock.ExpectSetNX(key, 1, time.Hour).SetVal(false)
+	assert.ErrorIs(t, guard.Use(ctx, token, time.Hour), ErrReused)
+
+	assert.ErrorIs(t, guard.Use(ctx, "garbage", time.Hour), ErrInvalid)
+	assert.NoError(t, redisMock.ExpectationsWereMet())
+}
@@ -0,0 +1,102 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	// ErrInProgress is returned when another request with the same key has not finished.
+	ErrInProgress = errors.New("request with this idempotency key is in progress")
+	// ErrMismatch is returned when a key is reused for a different request.
+	ErrMismatch = errors.New("idempotency key was used with a different request")
+)
+
+// Response is the stored outcome of a request made with an idempotency key.
+type Response struct {
+	RequestHash string `json:"request_hash"`
+	Completed   bool   `json:"completed"`
+	StatusCode  int    `json:"status_code,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+// Store keeps idempotency records in Redis so a retried request is answered with the
+// original response instead of being executed twice.
+type Store struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+func NewStore(client *redis.Client, ttl time.Duration) *Store {
+	return &Store{client: client, ttl: ttl}
+}
+
+func redisKey(key string) string {
+	return "idempotency:" + key
+}
+
+// Begin claims key for a request. It returns (nil, nil) when the caller should execute
+// the request, the stored response when the request already completed, ErrInProgress
+// while the first request is still running and ErrMismatch when requestHash differs
+// from the request that first used the key.
+func (s *Store) Begin(ctx context.Context, key, requestHash string) (*Response, error) {
+	pending, err := json.Marshal(Response{RequestHash: requestHash})
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := s.client.SetNX(ctx, redisKey(key), pending, s.ttl).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim idempotency key: %w", err)
+	}
+	if claimed {
+		return nil, nil
+	}
+
+	raw, err := s.client.Get(ctx, redisKey(key)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		// The record expired between the two calls, treat it as in progress so the
+		// client retries rather than risking a double execution.
+		return nil, ErrInProgress
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read idempotency key: %w", err)
+	}
+
+	var existing Response
+	if err := json.Unmarshal(raw, &existing); err != nil {
+		return nil, fmt.Errorf("failed to decode idempotency record: %w", err)
+	}
+
+	if existing.RequestHash != requestHash {
+		return nil, ErrMismatch
+	}
+	if !existing.Completed {
+		return nil, ErrInProgress
+	}
+	return &existing, nil
+}
+
+// Complete stores the response of a request started with Begin.
+func (s *Store) Complete(ctx context.Context, key, requestHash string, statusCode int, body []byte) error {
+	record, err := json.Marshal(Response{
+		RequestHash: requestHash,
+		Completed:   true,
+		StatusCode:  statusCode,
+		Body:        body,
+	})
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, redisKey(key), record, s.ttl).Err()
+}
+
+// Release forgets key so that the request can be retried, e.g. after a server error.
+func (s *Store) Release(ctx context.Context, key string) error {
+	return s.client.Del(ctx, redisKey(key)).Err()
+}
@@ -0,0 +1,66 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func record(t *testing.T, r Response) []byte {
+	data, err := json.Marshal(r)
+	require.NoError(t, err)
+	return data
+}
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	ttl := time.Hour
+
+	t.Run("FirstRequestClaimsKey", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		store := NewStore(client, ttl)
+
+		mock.ExpectSetNX("idempotency:k1", record(t, Response{RequestHash: "h1"}), ttl).SetVal(true)
+
+		resp, err := store.Begin(ctx, "k1", "h1")
+		require.NoError(t, err)
+		assert.Nil(t, resp)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CompletedRequestIsReplayed", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		store := NewStore(client, ttl)
+
+		done := Response{RequestHash: "h1", Completed: true, StatusCode: 201, Body: []byte(`{"id":"1"}`)}
+		mock.ExpectSetNX("idempotency:k1", record(t, Response{RequestHash: "h1"}), ttl).SetVal(false)
+		mock.ExpectGet("idempotency:k1").SetVal(string(record(t, done)))
+
+		resp, err := store.Begin(ctx, "k1", "h1")
+		require.NoError(t, err)
+		assert.Equal(t, &done, resp)
+	})
+
+	t.Run("PendingAndMismatchedRequestsAreRejected", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		store := NewStore(client, ttl)
+
+		pending := string(record(t, Response{RequestHash: "h1"}))
+		mock.ExpectSetNX("idempotency:k1", record(t, Response{RequestHash: "h1"}), ttl).SetVal(false)
+		mock.ExpectGet("idempotency:k1").SetVal(pending)
+		mock.ExpectSetNX("idempotency:k1", record(t, Response{RequestHash: "h2"}), ttl).SetVal(false)
+		mock.ExpectGet("idempotency:k1").SetVal(pending)
+
+		_, err := store.Begin(ctx, "k1", "h1")
+		assert.ErrorIs(t, err, ErrInProgress)
+
+		_, err = store.Begin(ctx, "k1", "h2")
+		assert.ErrorIs(t, err, ErrMismatch)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
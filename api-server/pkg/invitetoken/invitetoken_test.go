@@ -0,0 +1,49 @@
+package invitetoken
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner(t *testing.T) {
+	signer, err := NewSigner([]byte("secret"))
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+	signer.now = func() time.Time { return now }
+	id := uuid.New()
+	expiresAt := now.Add(7 * 24 * time.Hour)
+	token := signer.Issue(id, expiresAt)
+
+	gotID, gotExpiry, err := signer.Verify(token)
+	require.NoError(t, err)
+	assert.Equal(t, id, gotID)
+	assert.True(t, expiresAt.Equal(gotExpiry))
+
+	// Changing the expiry or the invitation breaks the signature
+	parts := strings.Split(token, ".")
+	_, _, err = signer.Verify(parts[0] + "." + strconv.FormatInt(expiresAt.Add(time.Hour).Unix(), 10) + "." + parts[2])
+	assert.ErrorIs(t, err, ErrInvalid)
+	_, _, err = signer.Verify(uuid.NewString() + token[36:])
+	assert.ErrorIs(t, err, ErrInvalid)
+	_, _, err = signer.Verify("garbage")
+	assert.ErrorIs(t, err, ErrInvalid)
+
+	other, err := NewSigner([]byte("other secret"))
+	require.NoError(t, err)
+	_, _, err = other.Verify(token)
+	assert.ErrorIs(t, err, ErrInvalid)
+
+	now = expiresAt
+	_, _, err = signer.Verify(token)
+	assert.ErrorIs(t, err, ErrExpired)
+
+	_, err = NewSigner(nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,22 @@
+package lease
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type RedisLease struct {
+	client *redis.Client
+}
+
+func NewRedisLease(client *redis.Client) *RedisLease {
+	return &RedisLease{client: client}
+}
+
+// Acquire takes the lease for key on behalf of owner. It returns false when another
+// owner already holds it. Leases are never released early; they expire after ttl.
+func (l *RedisLease) Acquire(ctx context.Context, key, owner string, ttl time.Duration) (bool, error) {
+	return l.client.SetNX(ctx, key, owner, ttl).Result()
+}
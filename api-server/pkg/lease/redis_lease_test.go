@@ -0,0 +1,29 @@
+package lease
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedisLease(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	l := NewRedisLease(client)
+	ctx := context.Background()
+
+	mock.ExpectSetNX("sync:lease:job", "replica-a", time.Minute).SetVal(true)
+	mock.ExpectSetNX("sync:lease:job", "replica-b", time.Minute).SetVal(false)
+
+	ok, err := l.Acquire(ctx, "sync:lease:job", "replica-a", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = l.Acquire(ctx, "sync:lease:job", "replica-b", time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,180 @@
+package mediastore
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalStore keeps objects on the local filesystem. It is meant for development and
+// tests; parts are kept in a staging directory until the upload is completed.
+type LocalStore struct {
+	objectsDir string
+	partsDir   string
+	baseURL    string
+}
+
+// NewLocalStore stores objects under root. Completed objects are served from baseURL,
+// typically by mounting Handler on the API server.
+func NewLocalStore(root, baseURL string) (*LocalStore, error) {
+	s := &LocalStore{
+		objectsDir: filepath.Join(root, "objects"),
+		partsDir:   filepath.Join(root, "parts"),
+		baseURL:    strings.TrimRight(baseURL, "/"),
+	}
+	for _, dir := range []string{s.objectsDir, s.partsDir} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create media directory: %w", err)
+		}
+	}
+	return s, nil
+}
+
+func (s *LocalStore) InitUpload(ctx context.Context, key, contentType string) (string, error) {
+	if _, err := cleanKey(key); err != nil {
+		return "", err
+	}
+
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate upload id: %w", err)
+	}
+	uploadID := hex.EncodeToString(buf)
+
+	if err := os.MkdirAll(s.uploadDir(uploadID), 0o755); err != nil {
+		return "", fmt.Errorf("failed to start upload: %w", err)
+	}
+	return uploadID, nil
+}
+
+func (s *LocalStore) UploadPart(ctx context.Context, key, uploadID string, number int, body io.Reader, size int64) (string, error) {
+	dir, err := s.existingUploadDir(uploadID)
+	if err != nil {
+		return "", err
+	}
+
+	tmp, err := os.CreateTemp(dir, "part-*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("failed to store part: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := md5.New()
+	written, err := io.Copy(io.MultiWriter(tmp, hash), body)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to store part: %w", err)
+	}
+	if written != size {
+		return "", fmt.Errorf("part %d is %d bytes, expected %d", number, written, size)
+	}
+
+	// Rename last so a part is never visible half written
+	if err := os.Rename(tmp.Name(), s.partPath(uploadID, number)); err != nil {
+		return "", fmt.Errorf("failed to store part: %w", err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func (s *LocalStore) CompleteUpload(ctx context.Context, key, uploadID string, parts []Part) error {
+	key, err := cleanKey(key)
+	if err != nil {
+		return err
+	}
+	if _, err := s.existingUploadDir(uploadID); err != nil {
+		return err
+	}
+
+	target := filepath.Join(s.objectsDir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return fmt.Errorf("failed to complete upload: %w", err)
+	}
+	out, err := os.CreateTemp(filepath.Dir(target), ".assemble-*")
+	if err != nil {
+		return fmt.Errorf("failed to complete upload: %w", err)
+	}
+	defer os.Remove(out.Name())
+
+	for _, part := range parts {
+		if err := appendFile(out, s.partPath(uploadID, part.Number)); err != nil {
+			out.Close()
+			return fmt.Errorf("failed to complete upload: part %d: %w", part.Number, err)
+		}
+	}
+	if err := out.Close(); err != nil {
+		return fmt.Errorf("failed to complete upload: %w", err)
+	}
+	if err := os.Rename(out.Name(), target); err != nil {
+		return fmt.Errorf("failed to complete upload: %w", err)
+	}
+
+	return os.RemoveAll(s.uploadDir(uploadID))
+}
+
+func (s *LocalStore) AbortUpload(ctx context.Context, key, uploadID string) error {
+	if _, err := s.existingUploadDir(uploadID); err != nil {
+		return err
+	}
+	return os.RemoveAll(s.uploadDir(uploadID))
+}
+
+func (s *LocalStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	cleaned, err := cleanKey(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filepath.Join(s.objectsDir, filepath.FromSlash(cleaned)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrObjectNotFound
+	}
+	return f, err
+}
+
+func (s *LocalStore) URL(key string) string {
+	return s.baseURL + "/" + key
+}
+
+// Handler serves completed objects. In-progress parts are never exposed.
+func (s *LocalStore) Handler() http.Handler {
+	return http.FileServer(http.Dir(s.objectsDir))
+}
+
+func (s *LocalStore) uploadDir(uploadID string) string {
+	return filepath.Join(s.partsDir, uploadID)
+}
+
+func (s *LocalStore) existingUploadDir(uploadID string) (string, error) {
+	if uploadID == "" || strings.ContainsAny(uploadID, `/\.`) {
+		return "", ErrUploadNotFound
+	}
+	dir := s.uploadDir(uploadID)
+	if _, err := os.Stat(dir); err != nil {
+		return "", ErrUploadNotFound
+	}
+	return dir, nil
+}
+
+func (s *LocalStore) partPath(uploadID string, number int) string {
+	return filepath.Join(s.uploadDir(uploadID), fmt.Sprintf("part-%05d", number))
+}
+
+func appendFile(dst io.Writer, name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(dst, f)
+	return err
+}
@@ -0,0 +1,79 @@
+package mediastore
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore(t *testing.T) {
+	root := t.TempDir()
+	s, err := NewLocalStore(root, "http://localhost:8081/media/")
+	require.NoError(t, err)
+	ctx := context.Background()
+	key := "workspaces/ws/clip.mp4"
+
+	t.Run("AssemblesPartsInOrder", func(t *testing.T) {
+		uploadID, err := s.InitUpload(ctx, key, "video/mp4")
+		require.NoError(t, err)
+
+		// Parts may arrive out of order and be retried
+		_, err = s.UploadPart(ctx, key, uploadID, 2, strings.NewReader("world"), 5)
+		require.NoError(t, err)
+		_, err = s.UploadPart(ctx, key, uploadID, 1, strings.NewReader("HELLO "), 6)
+		require.NoError(t, err)
+		_, err = s.UploadPart(ctx, key, uploadID, 1, strings.NewReader("hello "), 6)
+		require.NoError(t, err)
+
+		require.NoError(t, s.CompleteUpload(ctx, key, uploadID, []Part{{Number: 1}, {Number: 2}}))
+
+		data, err := os.ReadFile(filepath.Join(root, "objects", "workspaces", "ws", "clip.mp4"))
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", string(data))
+		assert.NoDirExists(t, filepath.Join(root, "parts", uploadID))
+		assert.Equal(t, "http://localhost:8081/media/"+key, s.URL(key))
+
+		rec := httptest.NewRecorder()
+		s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/"+key, nil))
+		assert.Equal(t, "hello world", rec.Body.String())
+
+		rc, err := s.Open(ctx, key)
+		require.NoError(t, err)
+		defer rc.Close()
+		data, err = io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", string(data))
+
+		_, err = s.Open(ctx, "workspaces/ws/missing.mp4")
+		assert.ErrorIs(t, err, ErrObjectNotFound)
+	})
+
+	t.Run("RejectsShortPartsAndUnknownUploads", func(t *testing.T) {
+		uploadID, err := s.InitUpload(ctx, key, "video/mp4")
+		require.NoError(t, err)
+
+		_, err = s.UploadPart(ctx, key, uploadID, 1, strings.NewReader("abc"), 10)
+		assert.Error(t, err)
+
+		require.NoError(t, s.AbortUpload(ctx, key, uploadID))
+		_, err = s.UploadPart(ctx, key, uploadID, 1, strings.NewReader("abc"), 3)
+		assert.ErrorIs(t, err, ErrUploadNotFound)
+		_, err = s.UploadPart(ctx, key, "../objects", 1, strings.NewReader("abc"), 3)
+		assert.ErrorIs(t, err, ErrUploadNotFound)
+	})
+
+	t.Run("RejectsKeysOutsideTheStore", func(t *testing.T) {
+		for _, key := range []string{"", "/etc/passwd", "../secret", "a/../../b", "a//b"} {
+			_, err := s.InitUpload(ctx, key, "video/mp4")
+			assert.ErrorIs(t, err, ErrInvalidKey, key)
+		}
+	})
+}
@@ -0,0 +1,52 @@
+// Package mediastore stores uploaded media with multipart uploads, so large files can be
+// sent in chunks and resumed after a failure.
+package mediastore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+)
+
+var (
+	ErrUploadNotFound = errors.New("multipart upload not found")
+	ErrInvalidKey     = errors.New("invalid object key")
+	ErrObjectNotFound = errors.New("object not found")
+)
+
+// Part identifies an uploaded part when an upload is completed.
+type Part struct {
+	Number int
+	ETag   string
+}
+
+// Store is a backend that assembles objects from separately uploaded parts.
+type Store interface {
+	// InitUpload starts a multipart upload for key and returns its upload ID.
+	InitUpload(ctx context.Context, key, contentType string) (string, error)
+	// UploadPart stores one part of an upload. Uploading a part number again
+	// replaces it.
+	UploadPart(ctx context.Context, key, uploadID string, number int, body io.Reader, size int64) (string, error)
+	// CompleteUpload assembles the parts, in order, into the object at key.
+	CompleteUpload(ctx context.Context, key, uploadID string, parts []Part) error
+	// AbortUpload discards an upload and its parts.
+	AbortUpload(ctx context.Context, key, uploadID string) error
+	// Open streams a completed object. The caller must close the reader.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// URL returns the address the completed object is served from.
+	URL(key string) string
+}
+
+// cleanKey rejects keys that could escape the store's namespace.
+func cleanKey(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") {
+		return "", ErrInvalidKey
+	}
+	cleaned := path.Clean(key)
+	if cleaned != key || cleaned == "." || strings.HasPrefix(cleaned, "../") || cleaned == ".." {
+		return "", ErrInvalidKey
+	}
+	return cleaned, nil
+}
@@ -0,0 +1,73 @@
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidLinkToken = errors.New("slack link token is invalid")
+	ErrExpiredLinkToken = errors.New("slack link token has expired")
+)
+
+// LinkClaim names a Slack user who asked to be linked to their account in a workspace.
+type LinkClaim struct {
+	WorkspaceID uuid.UUID
+	TeamID      string
+	UserID      string
+}
+
+// IssueLinkToken returns a token for the claim that is valid until expiresAt. It is sent
+// to the Slack user only, so whoever redeems it while signed in to the workspace proves
+// that both accounts are theirs.
+func IssueLinkToken(secret string, claim LinkClaim, expiresAt time.Time) string {
+	payload := strings.Join([]string{
+		claim.WorkspaceID.String(),
+		claim.TeamID,
+		claim.UserID,
+		strconv.FormatInt(expiresAt.Unix(), 10),
+	}, ".")
+	return payload + "." + signLink(secret, payload)
+}
+
+// VerifyLinkToken checks the token's signature and expiry and returns its claim.
+func VerifyLinkToken(secret, token string, now time.Time) (LinkClaim, error) {
+	i := strings.LastIndex(token, ".")
+	if i < 0 {
+		return LinkClaim{}, ErrInvalidLinkToken
+	}
+	payload, signature := token[:i], token[i+1:]
+	if !hmac.Equal([]byte(signature), []byte(signLink(secret, payload))) {
+		return LinkClaim{}, ErrInvalidLinkToken
+	}
+
+	parts := strings.Split(payload, ".")
+	if len(parts) != 4 {
+		return LinkClaim{}, ErrInvalidLinkToken
+	}
+	workspaceID, err := uuid.Parse(parts[0])
+	if err != nil {
+		return LinkClaim{}, ErrInvalidLinkToken
+	}
+	unix, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return LinkClaim{}, ErrInvalidLinkToken
+	}
+	if !now.Before(time.Unix(unix, 0)) {
+		return LinkClaim{}, ErrExpiredLinkToken
+	}
+	return LinkClaim{WorkspaceID: workspaceID, TeamID: parts[1], UserID: parts[2]}, nil
+}
+
+func signLink(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("slack-link|" + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,238 @@
+// Package slack posts Block Kit messages to Slack incoming webhooks and checks the
+// requests Slack sends back when someone clicks a button in one of them.
+package slack
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// TimestampHeader and SignatureHeader carry the signature of requests from Slack.
+	TimestampHeader = "X-Slack-Request-Timestamp"
+	SignatureHeader = "X-Slack-Signature"
+
+	// webhookHost is the only host incoming webhooks are posted to, so a stored URL
+	// cannot be used to make us call anywhere else.
+	webhookHost = "hooks.slack.com"
+)
+
+var (
+	ErrInvalidSignature = errors.New("slack request signature is invalid")
+	ErrStaleRequest     = errors.New("slack request timestamp is outside the tolerance")
+	ErrInvalidURL       = errors.New("slack URL must be an https://hooks.slack.com URL")
+)
+
+// Text is a text object. Type is "mrkdwn" or "plain_text".
+type Text struct {
+	Type  string `json:"type"`
+	Text  string `json:"text"`
+	Emoji bool   `json:"emoji,omitempty"`
+}
+
+// Markdown returns a mrkdwn text object.
+func Markdown(text string) *Text {
+	return &Text{Type: "mrkdwn", Text: text}
+}
+
+// Plain returns a plain_text text object.
+func Plain(text string) *Text {
+	return &Text{Type: "plain_text", Text: text, Emoji: true}
+}
+
+// Element is a button or other interactive element of an actions block.
+type Element struct {
+	Type     string `json:"type"`
+	Text     *Text  `json:"text,omitempty"`
+	ActionID string `json:"action_id,omitempty"`
+	Value    string `json:"value,omitempty"`
+	Style    string `json:"style,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+// Block is a Block Kit layout block. Only the fields of its Type are set. Elements are
+// Elements in actions blocks and *Text in context blocks.
+type Block struct {
+	Type     string  `json:"type"`
+	Text     *Text   `json:"text,omitempty"`
+	Fields   []*Text `json:"fields,omitempty"`
+	Elements []any   `json:"elements,omitempty"`
+	BlockID  string  `json:"block_id,omitempty"`
+}
+
+func Header(text string) Block {
+	return Block{Type: "header", Text: Plain(text)}
+}
+
+func Section(text string, fields ...string) Block {
+	block := Block{Type: "section", Text: Markdown(text)}
+	for _, f := range fields {
+		block.Fields = append(block.Fields, Markdown(f))
+	}
+	return block
+}
+
+func Context(texts ...string) Block {
+	block := Block{Type: "context"}
+	for _, t := range texts {
+		block.Elements = append(block.Elements, Markdown(t))
+	}
+	return block
+}
+
+func Divider() Block {
+	return Block{Type: "divider"}
+}
+
+func Actions(blockID string, elements ...Element) Block {
+	block := Block{Type: "actions", BlockID: blockID}
+	for _, e := range elements {
+		block.Elements = append(block.Elements, e)
+	}
+	return block
+}
+
+// Button returns a button that sends value back with action ID when clicked. Style is
+// "primary", "danger" or empty.
+func Button(text, actionID, value, style string) Element {
+	return Element{Type: "button", Text: Plain(text), ActionID: actionID, Value: value, Style: style}
+}
+
+// Message is the body posted to an incoming webhook or a response URL.
+type Message struct {
+	// Text is shown in notifications and by clients that cannot render blocks.
+	Text   string  `json:"text"`
+	Blocks []Block `json:"blocks,omitempty"`
+	// ResponseType and ReplaceOriginal only apply to replies sent to a response URL.
+	ResponseType    string `json:"response_type,omitempty"`
+	ReplaceOriginal bool   `json:"replace_original,omitempty"`
+}
+
+// ValidateWebhookURL checks that u is a Slack incoming webhook URL.
+func ValidateWebhookURL(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil || parsed.Scheme != "https" || parsed.Host != webhookHost || parsed.User != nil {
+		return ErrInvalidURL
+	}
+	return nil
+}
+
+// Client posts messages to Slack.
+type Client struct {
+	http *http.Client
+}
+
+// NewClient returns a client. A nil httpClient uses one with a ten second timeout.
+func NewClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Client{http: httpClient}
+}
+
+// Post sends a message to an incoming webhook or to the response URL of an interaction.
+func (c *Client) Post(ctx context.Context, u string, msg Message) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 256))
+		return fmt.Errorf("slack responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
+
+// VerifyRequest checks the signature Slack puts on the requests it sends. Requests
+// signed more than tolerance away from now are rejected, so captured requests cannot
+// be replayed.
+func VerifyRequest(signingSecret string, header http.Header, body []byte, tolerance time.Duration, now time.Time) error {
+	timestamp := header.Get(TimestampHeader)
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	signature, ok := strings.CutPrefix(header.Get(SignatureHeader), "v0=")
+	if !ok {
+		return ErrInvalidSignature
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(got, sign(signingSecret, timestamp, body)) {
+		return ErrInvalidSignature
+	}
+
+	if age := now.Sub(time.Unix(unix, 0)); age > tolerance || age < -tolerance {
+		return ErrStaleRequest
+	}
+	return nil
+}
+
+// SignRequest returns the signature header value Slack would send for body at the
+// given time. It is meant for tests.
+func SignRequest(signingSecret string, timestamp time.Time, body []byte) (string, string) {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	return t, "v0=" + hex.EncodeToString(sign(signingSecret, t, body))
+}
+
+func sign(secret, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// Interaction is the part of a block_actions payload we use.
+type Interaction struct {
+	Type string `json:"type"`
+	User struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	} `json:"user"`
+	Team struct {
+		ID string `json:"id"`
+	} `json:"team"`
+	ResponseURL string `json:"response_url"`
+	Actions     []struct {
+		ActionID string `json:"action_id"`
+		BlockID  string `json:"block_id"`
+		Value    string `json:"value"`
+	} `json:"actions"`
+}
+
+// ParseInteraction decodes the form-encoded body Slack posts when a button is clicked.
+func ParseInteraction(body []byte) (*Interaction, error) {
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	var interaction Interaction
+	if err := json.Unmarshal([]byte(form.Get("payload")), &interaction); err != nil {
+		return nil, fmt.Errorf("invalid interaction payload: %w", err)
+	}
+	return &interaction, nil
+}
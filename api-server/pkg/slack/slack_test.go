@@ -0,0 +1,102 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyRequest(t *testing.T) {
+	secret := "8f742231b10e8888abcd99yyyzzz85a5"
+	sentAt := time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC)
+	body := []byte("payload=%7B%22type%22%3A%22block_actions%22%7D")
+
+	timestamp, signature := SignRequest(secret, sentAt, body)
+	header := http.Header{}
+	header.Set(TimestampHeader, timestamp)
+	header.Set(SignatureHeader, signature)
+
+	assert.NoError(t, VerifyRequest(secret, header, body, 5*time.Minute, sentAt.Add(time.Minute)))
+	assert.ErrorIs(t, VerifyRequest(secret, header, []byte("payload=%7B%7D"), 5*time.Minute, sentAt), ErrInvalidSignature)
+	assert.ErrorIs(t, VerifyRequest("other", header, body, 5*time.Minute, sentAt), ErrInvalidSignature)
+	assert.ErrorIs(t, VerifyRequest(secret, header, body, 5*time.Minute, sentAt.Add(10*time.Minute)), ErrStaleRequest)
+
+	header.Set(SignatureHeader, "v1="+signature[3:])
+	assert.ErrorIs(t, VerifyRequest(secret, header, body, 5*time.Minute, sentAt), ErrInvalidSignature)
+}
+
+func TestParseInteraction(t *testing.T) {
+	payload := `{"type":"block_actions","user":{"id":"U123","username":"ada"},"team":{"id":"T9"},` +
+		`"response_url":"https://hooks.slack.com/actions/T9/1/abc","actions":[{"action_id":"approve","value":"x"}]}`
+	body := []byte(url.Values{"payload": {payload}}.Encode())
+
+	interaction, err := ParseInteraction(body)
+	require.NoError(t, err)
+	assert.Equal(t, "U123", interaction.User.ID)
+	assert.Equal(t, "T9", interaction.Team.ID)
+	require.Len(t, interaction.Actions, 1)
+	assert.Equal(t, "approve", interaction.Actions[0].ActionID)
+
+	_, err = ParseInteraction([]byte("payload=not-json"))
+	assert.Error(t, err)
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	assert.NoError(t, ValidateWebhookURL("https://hooks.slack.com/services/T0/B0/XXXX"))
+	assert.ErrorIs(t, ValidateWebhookURL("http://hooks.slack.com/services/T0/B0/XXXX"), ErrInvalidURL)
+	assert.ErrorIs(t, ValidateWebhookURL("https://hooks.slack.com.example.com/services"), ErrInvalidURL)
+	assert.ErrorIs(t, ValidateWebhookURL("https://169.254.169.254/latest"), ErrInvalidURL)
+}
+
+func TestClientPost(t *testing.T) {
+	var received Message
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &received)
+		if received.Text == "fail" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("no_service"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(nil)
+	msg := Message{Text: "New testimonial", Blocks: []Block{
+		Header("New testimonial"),
+		Context("Imported from *Google*"),
+		Actions("t1", Button("Approve", "approve", "t1", "primary")),
+	}}
+	require.NoError(t, client.Post(context.Background(), server.URL, msg))
+	assert.Equal(t, "New testimonial", received.Text)
+	require.Len(t, received.Blocks, 3)
+	assert.Equal(t, "actions", received.Blocks[2].Type)
+
+	err := client.Post(context.Background(), server.URL, Message{Text: "fail"})
+	assert.ErrorContains(t, err, "no_service")
+}
+
+func TestLinkToken(t *testing.T) {
+	now := time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC)
+	claim := LinkClaim{WorkspaceID: uuid.New(), TeamID: "T9", UserID: "U123"}
+	token := IssueLinkToken("secret", claim, now.Add(time.Hour))
+
+	got, err := VerifyLinkToken("secret", token, now)
+	require.NoError(t, err)
+	assert.Equal(t, claim, got)
+
+	_, err = VerifyLinkToken("other", token, now)
+	assert.ErrorIs(t, err, ErrInvalidLinkToken)
+	_, err = VerifyLinkToken("secret", token, now.Add(2*time.Hour))
+	assert.ErrorIs(t, err, ErrExpiredLinkToken)
+	_, err = VerifyLinkToken("secret", "U999"+token[len(claim.WorkspaceID.String()):], now)
+	assert.ErrorIs(t, err, ErrInvalidLinkToken)
+}
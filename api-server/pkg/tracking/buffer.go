@@ -0,0 +1,283 @@
+// Package tracking buffers display analytics in Redis, so that recording what a visitor
+// saw costs a few Redis commands, and hands them out in aggregated batches to be
+// written to the database.
+package tracking
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+type Kind string
+
+const (
+	Impression Kind = "impression"
+	Click      Kind = "click"
+	Conversion Kind = "conversion"
+)
+
+const (
+	countsKey              = "tracking:counts"
+	conversionsKey         = "tracking:conversions"
+	drainingCountsKey      = "tracking:counts:draining"
+	drainingConversionsKey = "tracking:conversions:draining"
+	drainIDKey             = "tracking:draining:id"
+	dayLayout              = "2006-01-02"
+)
+
+// Hit is one event reported by a visitor's browser.
+type Hit struct {
+	Kind          Kind
+	DisplayID     uuid.UUID
+	TestimonialID uuid.UUID
+	// Visitor identifies the browser. Hits are counted once per visitor within the
+	// buffer's window.
+	Visitor        string
+	ConversionType string
+	ValueCents     int64
+	At             time.Time
+}
+
+// Count is what happened to a testimonial in one display on one day (UTC).
+type Count struct {
+	DisplayID     uuid.UUID
+	TestimonialID uuid.UUID
+	Day           time.Time
+	Impressions   int64
+	Clicks        int64
+	Conversions   int64
+	ValueCents    int64
+}
+
+// ConversionRecord is a single conversion, kept so its type and value can be stored.
+type ConversionRecord struct {
+	DisplayID     uuid.UUID `json:"display_id"`
+	TestimonialID uuid.UUID `json:"testimonial_id"`
+	Type          string    `json:"type,omitempty"`
+	ValueCents    int64     `json:"value_cents,omitempty"`
+	At            time.Time `json:"at"`
+}
+
+// Batch is everything buffered since the last drain. A batch handed out again keeps its
+// ID, so a flush can tell that it already applied it.
+type Batch struct {
+	ID          uuid.UUID
+	Counts      []Count
+	Conversions []ConversionRecord
+}
+
+func (b Batch) Empty() bool {
+	return len(b.Counts) == 0 && len(b.Conversions) == 0
+}
+
+// Buffer accumulates hits in Redis until they are drained.
+type Buffer struct {
+	client *redis.Client
+	window time.Duration
+}
+
+// NewBuffer returns a buffer that counts each visitor once per window for the same
+// testimonial, display and kind of hit.
+func NewBuffer(client *redis.Client, window time.Duration) *Buffer {
+	return &Buffer{client: client, window: window}
+}
+
+func seenKey(h Hit) string {
+	key := "tracking:seen:" + string(h.Kind) + ":" + h.DisplayID.String() + ":" + h.TestimonialID.String() + ":" + h.Visitor
+	if h.Kind == Conversion {
+		key += ":" + h.ConversionType
+	}
+	return key
+}
+
+func countField(h Hit, metric string) string {
+	return h.DisplayID.String() + "|" + h.TestimonialID.String() + "|" + h.At.UTC().Format(dayLayout) + "|" + metric
+}
+
+var kindMetrics = map[Kind]string{
+	Impression: "impressions",
+	Click:      "clicks",
+	Conversion: "conversions",
+}
+
+// Record buffers the hits their visitors have not already made within the window and
+// returns how many it counted.
+func (b *Buffer) Record(ctx context.Context, hits []Hit) (int, error) {
+	if len(hits) == 0 {
+		return 0, nil
+	}
+
+	pipe := b.client.Pipeline()
+	claims := make([]*redis.BoolCmd, len(hits))
+	for i, h := range hits {
+		claims[i] = pipe.SetNX(ctx, seenKey(h), 1, b.window)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to deduplicate hits: %w", err)
+	}
+
+	pipe = b.client.Pipeline()
+	counted := 0
+	for i, h := range hits {
+		if !claims[i].Val() {
+			continue
+		}
+		counted++
+		pipe.HIncrBy(ctx, countsKey, countField(h, kindMetrics[h.Kind]), 1)
+		if h.Kind != Conversion {
+			continue
+		}
+		if h.ValueCents > 0 {
+			pipe.HIncrBy(ctx, countsKey, countField(h, "value"), h.ValueCents)
+		}
+		record, err := json.Marshal(ConversionRecord{
+			DisplayID:     h.DisplayID,
+			TestimonialID: h.TestimonialID,
+			Type:          h.ConversionType,
+			ValueCents:    h.ValueCents,
+			At:            h.At.UTC(),
+		})
+		if err != nil {
+			return 0, err
+		}
+		pipe.RPush(ctx, conversionsKey, record)
+	}
+	if counted == 0 {
+		return 0, nil
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to buffer hits: %w", err)
+	}
+	return counted, nil
+}
+
+// Drain hands everything buffered to flush, and forgets it once flush succeeds. A batch
+// whose flush failed, or that could not be forgotten afterwards, is handed out again with
+// the same ID by the next Drain before anything newer, so buffers sharing a Redis must
+// not be drained concurrently. It returns how many counts and conversions were flushed.
+func (b *Buffer) Drain(ctx context.Context, flush func(context.Context, Batch) error) (int, error) {
+	pending, err := b.client.Exists(ctx, drainingCountsKey, drainingConversionsKey).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to check drained hits: %w", err)
+	}
+	if pending == 0 {
+		// New hits keep going to the live keys while these are flushed
+		for _, keys := range [][2]string{{countsKey, drainingCountsKey}, {conversionsKey, drainingConversionsKey}} {
+			if err := b.client.Rename(ctx, keys[0], keys[1]).Err(); err != nil && !isNoSuchKey(err) {
+				return 0, fmt.Errorf("failed to drain hits: %w", err)
+			}
+		}
+	}
+
+	fields, err := b.client.HGetAll(ctx, drainingCountsKey).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read drained counts: %w", err)
+	}
+	records, err := b.client.LRange(ctx, drainingConversionsKey, 0, -1).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read drained conversions: %w", err)
+	}
+
+	batch := decodeBatch(fields, records)
+	if !batch.Empty() {
+		if batch.ID, err = b.drainID(ctx); err != nil {
+			return 0, err
+		}
+		if err := flush(ctx, batch); err != nil {
+			return 0, err
+		}
+	}
+
+	// The ID goes with the batch, so a batch that was not cleared is handed out under it again
+	if err := b.client.Del(ctx, drainingCountsKey, drainingConversionsKey, drainIDKey).Err(); err != nil {
+		return 0, fmt.Errorf("failed to clear drained hits: %w", err)
+	}
+	return len(batch.Counts) + len(batch.Conversions), nil
+}
+
+// drainID returns the ID of the batch being drained, assigning one the first time the
+// batch is handed out.
+func (b *Buffer) drainID(ctx context.Context) (uuid.UUID, error) {
+	raw, err := b.client.Get(ctx, drainIDKey).Result()
+	if err == nil {
+		if id, err := uuid.Parse(raw); err == nil {
+			return id, nil
+		}
+	} else if !errors.Is(err, redis.Nil) {
+		return uuid.Nil, fmt.Errorf("failed to read drain id: %w", err)
+	}
+
+	id := uuid.New()
+	if err := b.client.Set(ctx, drainIDKey, id.String(), 0).Err(); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to assign drain id: %w", err)
+	}
+	return id, nil
+}
+
+func isNoSuchKey(err error) bool {
+	return strings.Contains(err.Error(), "no such key")
+}
+
+// decodeBatch folds the counter fields back into one Count per testimonial, display and
+// day. Malformed entries are skipped rather than blocking every later drain.
+func decodeBatch(fields map[string]string, records []string) Batch {
+	counts := map[string]*Count{}
+	for field, raw := range fields {
+		parts := strings.Split(field, "|")
+		if len(parts) != 4 {
+			continue
+		}
+		displayID, err1 := uuid.Parse(parts[0])
+		testimonialID, err2 := uuid.Parse(parts[1])
+		day, err3 := time.Parse(dayLayout, parts[2])
+		n, err4 := strconv.ParseInt(raw, 10, 64)
+		if err1 != nil || err2 != nil || err3 != nil || err4 != nil {
+			continue
+		}
+
+		key := parts[0] + "|" + parts[1] + "|" + parts[2]
+		c, ok := counts[key]
+		if !ok {
+			c = &Count{DisplayID: displayID, TestimonialID: testimonialID, Day: day}
+			counts[key] = c
+		}
+		switch parts[3] {
+		case "impressions":
+			c.Impressions += n
+		case "clicks":
+			c.Clicks += n
+		case "conversions":
+			c.Conversions += n
+		case "value":
+			c.ValueCents += n
+		}
+	}
+
+	var batch Batch
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		batch.Counts = append(batch.Counts, *counts[key])
+	}
+
+	for _, raw := range records {
+		var record ConversionRecord
+		if err := json.Unmarshal([]byte(raw), &record); err != nil {
+			continue
+		}
+		batch.Conversions = append(batch.Conversions, record)
+	}
+	return batch
+}
@@ -0,0 +1,119 @@
+package tracking
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuffer(t *testing.T) {
+	ctx := context.Background()
+	window := 24 * time.Hour
+	displayID := uuid.MustParse("5b1f2f4e-9f55-4a43-8c55-0d4c1b0c8a01")
+	testimonialID := uuid.MustParse("0e9a3c55-2d7a-4c8b-9a4d-3f1e6b7c8d02")
+	at := time.Date(2024, 5, 1, 23, 30, 0, 0, time.UTC)
+	prefix := displayID.String() + "|" + testimonialID.String() + "|2024-05-01|"
+
+	t.Run("RecordCountsEachVisitorOnce", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		buffer := NewBuffer(client, window)
+		hit := Hit{Kind: Impression, DisplayID: displayID, TestimonialID: testimonialID, Visitor: "v1", At: at}
+		repeat := hit
+		repeat.Kind = Click
+
+		mock.ExpectSetNX(seenKey(hit), 1, window).SetVal(true)
+		mock.ExpectSetNX(seenKey(repeat), 1, window).SetVal(false)
+		mock.ExpectHIncrBy(countsKey, prefix+"impressions", 1).SetVal(1)
+
+		n, err := buffer.Record(ctx, []Hit{hit, repeat})
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RecordKeepsConversionValues", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		buffer := NewBuffer(client, window)
+		hit := Hit{Kind: Conversion, DisplayID: displayID, TestimonialID: testimonialID, Visitor: "v1", ConversionType: "signup", ValueCents: 4999, At: at}
+		record, err := json.Marshal(ConversionRecord{DisplayID: displayID, TestimonialID: testimonialID, Type: "signup", ValueCents: 4999, At: at})
+		require.NoError(t, err)
+
+		mock.ExpectSetNX(seenKey(hit), 1, window).SetVal(true)
+		mock.ExpectHIncrBy(countsKey, prefix+"conversions", 1).SetVal(1)
+		mock.ExpectHIncrBy(countsKey, prefix+"value", 4999).SetVal(4999)
+		mock.ExpectRPush(conversionsKey, record).SetVal(1)
+
+		n, err := buffer.Record(ctx, []Hit{hit})
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DrainAggregatesAndClears", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		buffer := NewBuffer(client, window)
+
+		mock.ExpectExists(drainingCountsKey, drainingConversionsKey).SetVal(0)
+		mock.ExpectRename(countsKey, drainingCountsKey).SetVal("OK")
+		mock.ExpectRename(conversionsKey, drainingConversionsKey).SetErr(errors.New("ERR no such key"))
+		mock.ExpectHGetAll(drainingCountsKey).SetVal(map[string]string{
+			prefix + "impressions": "10",
+			prefix + "clicks":      "3",
+			prefix + "conversions": "1",
+			prefix + "value":       "4999",
+			"garbage":              "1",
+		})
+		mock.ExpectLRange(drainingConversionsKey, 0, -1).SetVal(nil)
+		mock.ExpectGet(drainIDKey).RedisNil()
+		mock.Regexp().ExpectSet(drainIDKey, `^[0-9a-f-]{36}$`, 0).SetVal("OK")
+		mock.ExpectDel(drainingCountsKey, drainingConversionsKey, drainIDKey).SetVal(1)
+
+		var flushed Batch
+		n, err := buffer.Drain(ctx, func(_ context.Context, b Batch) error {
+			flushed = b
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.NotEqual(t, uuid.Nil, flushed.ID)
+		assert.Equal(t, []Count{{
+			DisplayID:     displayID,
+			TestimonialID: testimonialID,
+			Day:           time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			Impressions:   10,
+			Clicks:        3,
+			Conversions:   1,
+			ValueCents:    4999,
+		}}, flushed.Counts)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FailedFlushesAreRetriedFirst", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		buffer := NewBuffer(client, window)
+		unavailable := errors.New("database unavailable")
+
+		// The batch left over is read again, under its ID, instead of taking newer hits
+		drainID := uuid.New()
+		mock.ExpectExists(drainingCountsKey, drainingConversionsKey).SetVal(1)
+		mock.ExpectHGetAll(drainingCountsKey).SetVal(map[string]string{prefix + "impressions": "2"})
+		mock.ExpectLRange(drainingConversionsKey, 0, -1).SetVal(nil)
+		mock.ExpectGet(drainIDKey).SetVal(drainID.String())
+
+		var flushed Batch
+		_, err := buffer.Drain(ctx, func(_ context.Context, b Batch) error {
+			flushed = b
+			return unavailable
+		})
+		assert.ErrorIs(t, err, unavailable)
+		assert.Equal(t, drainID, flushed.ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
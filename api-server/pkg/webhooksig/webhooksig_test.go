@@ -0,0 +1,39 @@
+package webhooksig
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignature(t *testing.T) {
+	secret, err := NewSecret()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(secret, "whsec_"))
+
+	sentAt := time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC)
+	body := []byte(`{"type":"testimonial.created"}`)
+	header := Sign(secret, sentAt, body)
+	assert.True(t, strings.HasPrefix(header, "t=1714555800,v1="))
+
+	t.Run("AcceptsTheSignedBody", func(t *testing.T) {
+		assert.NoError(t, Verify(secret, header, body, 5*time.Minute, sentAt.Add(time.Minute)))
+	})
+
+	t.Run("RejectsTamperingAndOtherSecrets", func(t *testing.T) {
+		assert.ErrorIs(t, Verify(secret, header, []byte(`{"type":"sync.failed"}`), 5*time.Minute, sentAt), ErrInvalid)
+		assert.ErrorIs(t, Verify("whsec_other", header, body, 5*time.Minute, sentAt), ErrInvalid)
+		assert.ErrorIs(t, Verify(secret, "t=1714555800", body, 5*time.Minute, sentAt), ErrInvalid)
+
+		// Moving the timestamp breaks the signature, so replays cannot be refreshed
+		replayed := strings.Replace(header, "t=1714555800", "t=1714559400", 1)
+		assert.ErrorIs(t, Verify(secret, replayed, body, 5*time.Minute, sentAt.Add(time.Hour)), ErrInvalid)
+	})
+
+	t.Run("RejectsOldSignatures", func(t *testing.T) {
+		assert.ErrorIs(t, Verify(secret, header, body, 5*time.Minute, sentAt.Add(10*time.Minute)), ErrExpired)
+	})
+}
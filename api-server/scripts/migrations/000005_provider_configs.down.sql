@@ -0,0 +1,6 @@
+-- +migrate Down
+
+DROP TRIGGER IF EXISTS update_platform_integrations_updated_at ON platform_integrations;
+DROP INDEX IF EXISTS idx_platform_integrations_status;
+DROP INDEX IF EXISTS idx_platform_integrations_workspace_platform;
+ALTER TABLE platform_integrations DROP COLUMN IF EXISTS user_id;
@@ -0,0 +1,23 @@
+-- +migrate Up
+-- Persist workspace scoped provider configurations in platform_integrations
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'platform_integrations' AND column_name = 'user_id') THEN
+        ALTER TABLE platform_integrations ADD COLUMN user_id VARCHAR(255);
+    END IF;
+END$$;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_platform_integrations_workspace_platform
+    ON platform_integrations(workspace_id, platform_name);
+
+CREATE INDEX IF NOT EXISTS idx_platform_integrations_status ON platform_integrations(status);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'update_platform_integrations_updated_at') THEN
+        CREATE TRIGGER update_platform_integrations_updated_at
+            BEFORE UPDATE ON platform_integrations
+            FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END$$;
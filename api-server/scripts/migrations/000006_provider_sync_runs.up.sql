@@ -0,0 +1,23 @@
+-- +migrate Up
+-- History of provider sync runs, one row per scheduled or manual execution
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_tables WHERE schemaname = 'public' AND tablename = 'provider_sync_runs') THEN
+        CREATE TABLE provider_sync_runs (
+            id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+            workspace_id UUID REFERENCES workspaces(id) ON DELETE CASCADE,
+            provider_name VARCHAR(100) NOT NULL,
+            trigger_type VARCHAR(50) NOT NULL,
+            status VARCHAR(50) NOT NULL,
+            instance_id VARCHAR(255),
+            testimonials_fetched INTEGER DEFAULT 0,
+            error TEXT,
+            started_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+            finished_at TIMESTAMPTZ
+        );
+
+        CREATE INDEX IF NOT EXISTS idx_provider_sync_runs_workspace ON provider_sync_runs(workspace_id, started_at DESC);
+        CREATE INDEX IF NOT EXISTS idx_provider_sync_runs_provider ON provider_sync_runs(provider_name);
+    END IF;
+END$$;
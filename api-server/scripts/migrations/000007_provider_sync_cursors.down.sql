@@ -0,0 +1,8 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_testimonials_workspace_review_id;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_testimonials_source_data
+    ON testimonials((source_data->>'review_id'))
+    WHERE source_data->>'review_id' IS NOT NULL;
+
+DROP TABLE IF EXISTS provider_sync_cursors CASCADE;
@@ -0,0 +1,24 @@
+-- +migrate Up
+-- Per workspace+provider high-water marks for incremental syncs
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_tables WHERE schemaname = 'public' AND tablename = 'provider_sync_cursors') THEN
+        CREATE TABLE provider_sync_cursors (
+            workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+            provider_name VARCHAR(100) NOT NULL,
+            since_at TIMESTAMPTZ,
+            resume_token TEXT,
+            last_full_scan_at TIMESTAMPTZ,
+            updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+            PRIMARY KEY (workspace_id, provider_name)
+        );
+    END IF;
+END$$;
+
+-- Upstream review ids are only unique within a workspace; two workspaces may import
+-- the same page.
+DROP INDEX IF EXISTS idx_testimonials_source_data;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_testimonials_workspace_review_id
+    ON testimonials(workspace_id, (source_data->>'review_id'))
+    WHERE source_data->>'review_id' IS NOT NULL;
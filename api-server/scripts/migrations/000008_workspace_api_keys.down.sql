@@ -0,0 +1,3 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS workspace_api_keys CASCADE;
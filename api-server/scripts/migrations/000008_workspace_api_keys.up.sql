@@ -0,0 +1,23 @@
+-- +migrate Up
+-- Per-workspace API keys for the public submission API. Only a SHA-256 hash of each
+-- key is stored; the plaintext is shown once when the key is issued.
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_tables WHERE schemaname = 'public' AND tablename = 'workspace_api_keys') THEN
+        CREATE TABLE workspace_api_keys (
+            id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+            workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+            name VARCHAR(255) NOT NULL,
+            key_prefix VARCHAR(32) NOT NULL,
+            key_hash CHAR(64) NOT NULL UNIQUE,
+            rate_limit_per_minute INTEGER NOT NULL DEFAULT 60,
+            created_by VARCHAR(128),
+            last_used_at TIMESTAMPTZ,
+            revoked_at TIMESTAMPTZ,
+            created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+        );
+
+        CREATE INDEX IF NOT EXISTS idx_workspace_api_keys_workspace ON workspace_api_keys(workspace_id);
+    END IF;
+END$$;
@@ -0,0 +1,4 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS media_upload_parts CASCADE;
+DROP TABLE IF EXISTS media_uploads CASCADE;
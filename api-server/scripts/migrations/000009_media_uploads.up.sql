@@ -0,0 +1,50 @@
+-- +migrate Up
+-- Chunked media uploads. Each row tracks one multipart upload in the media store; a
+-- pending testimonial is created once every part has arrived.
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_tables WHERE schemaname = 'public' AND tablename = 'media_uploads') THEN
+        CREATE TABLE media_uploads (
+            id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+            workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+            created_by VARCHAR(128),
+            filename VARCHAR(255) NOT NULL,
+            content_type VARCHAR(100) NOT NULL,
+            format content_format NOT NULL,
+            size_bytes BIGINT NOT NULL,
+            chunk_size BIGINT NOT NULL,
+            storage_key TEXT NOT NULL,
+            storage_upload_id TEXT NOT NULL,
+            status VARCHAR(20) NOT NULL DEFAULT 'in_progress',
+            metadata JSONB DEFAULT '{}',
+            testimonial_id UUID REFERENCES testimonials(id) ON DELETE SET NULL,
+            expires_at TIMESTAMPTZ NOT NULL,
+            created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+            updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+        );
+
+        CREATE INDEX IF NOT EXISTS idx_media_uploads_workspace ON media_uploads(workspace_id);
+        CREATE INDEX IF NOT EXISTS idx_media_uploads_status ON media_uploads(status, expires_at);
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM pg_tables WHERE schemaname = 'public' AND tablename = 'media_upload_parts') THEN
+        CREATE TABLE media_upload_parts (
+            upload_id UUID NOT NULL REFERENCES media_uploads(id) ON DELETE CASCADE,
+            part_number INTEGER NOT NULL,
+            etag TEXT NOT NULL,
+            size_bytes BIGINT NOT NULL,
+            created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+            PRIMARY KEY (upload_id, part_number)
+        );
+    END IF;
+END$$;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'update_media_uploads_updated_at') THEN
+        CREATE TRIGGER update_media_uploads_updated_at
+            BEFORE UPDATE ON media_uploads
+            FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END$$;
@@ -0,0 +1,9 @@
+-- +migrate Down
+
+DROP TRIGGER IF EXISTS update_ai_jobs_updated_at ON ai_jobs;
+DROP INDEX IF EXISTS idx_ai_jobs_lease;
+DROP INDEX IF EXISTS idx_ai_jobs_queue;
+ALTER TABLE ai_jobs DROP COLUMN IF EXISTS locked_until;
+ALTER TABLE ai_jobs DROP COLUMN IF EXISTS run_at;
+ALTER TABLE ai_jobs DROP COLUMN IF EXISTS max_attempts;
+ALTER TABLE ai_jobs DROP COLUMN IF EXISTS attempts;
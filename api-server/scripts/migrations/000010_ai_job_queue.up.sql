@@ -0,0 +1,36 @@
+-- +migrate Up
+-- Turn ai_jobs into a durable work queue: retries are rescheduled through run_at and
+-- running jobs hold a lease so jobs of a crashed worker are picked up again
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'ai_jobs' AND column_name = 'attempts') THEN
+        ALTER TABLE ai_jobs ADD COLUMN attempts INTEGER NOT NULL DEFAULT 0;
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'ai_jobs' AND column_name = 'max_attempts') THEN
+        ALTER TABLE ai_jobs ADD COLUMN max_attempts INTEGER NOT NULL DEFAULT 5;
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'ai_jobs' AND column_name = 'run_at') THEN
+        ALTER TABLE ai_jobs ADD COLUMN run_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'ai_jobs' AND column_name = 'locked_until') THEN
+        ALTER TABLE ai_jobs ADD COLUMN locked_until TIMESTAMPTZ;
+    END IF;
+END$$;
+
+CREATE INDEX IF NOT EXISTS idx_ai_jobs_queue
+    ON ai_jobs(priority DESC, run_at)
+    WHERE status = 'pending';
+
+CREATE INDEX IF NOT EXISTS idx_ai_jobs_lease
+    ON ai_jobs(locked_until)
+    WHERE status = 'running';
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'update_ai_jobs_updated_at') THEN
+        CREATE TRIGGER update_ai_jobs_updated_at
+            BEFORE UPDATE ON ai_jobs
+            FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END$$;
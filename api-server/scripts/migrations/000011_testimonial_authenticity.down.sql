@@ -0,0 +1,6 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_testimonials_submitter_ip;
+DROP INDEX IF EXISTS idx_testimonials_content_hash;
+
+ALTER TABLE testimonials DROP COLUMN IF EXISTS hold_reason;
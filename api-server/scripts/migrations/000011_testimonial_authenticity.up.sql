@@ -0,0 +1,19 @@
+-- +migrate Up
+-- Authenticity checks hold suspicious testimonials for review and record why
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'testimonials' AND column_name = 'hold_reason') THEN
+        ALTER TABLE testimonials ADD COLUMN hold_reason TEXT;
+    END IF;
+END$$;
+
+-- Finds the same text submitted by other customers of a workspace
+CREATE INDEX IF NOT EXISTS idx_testimonials_content_hash
+    ON testimonials(workspace_id, md5(lower(btrim(content))))
+    WHERE content IS NOT NULL AND btrim(content) <> '';
+
+-- Finds bursts of submissions from one address
+CREATE INDEX IF NOT EXISTS idx_testimonials_submitter_ip
+    ON testimonials(workspace_id, (source_data->>'submitter_ip'), created_at)
+    WHERE source_data ? 'submitter_ip';
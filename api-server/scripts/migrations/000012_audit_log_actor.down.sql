@@ -0,0 +1,7 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_audit_log_workspace;
+DROP INDEX IF EXISTS idx_audit_log_entity;
+
+ALTER TABLE audit_log DROP COLUMN IF EXISTS actor_id;
+ALTER TABLE audit_log DROP COLUMN IF EXISTS workspace_id;
@@ -0,0 +1,16 @@
+-- +migrate Up
+-- Record which workspace an audit event belongs to and the team member who caused it.
+-- The actor is kept as NULL when the member is removed so the history survives.
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'audit_log' AND column_name = 'workspace_id') THEN
+        ALTER TABLE audit_log ADD COLUMN workspace_id UUID;
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'audit_log' AND column_name = 'actor_id') THEN
+        ALTER TABLE audit_log ADD COLUMN actor_id UUID REFERENCES team_members(id) ON DELETE SET NULL;
+    END IF;
+END$$;
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_entity ON audit_log(entity_type, entity_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_log_workspace ON audit_log(workspace_id, created_at);
@@ -0,0 +1,3 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_testimonials_scheduled_publish;
@@ -0,0 +1,6 @@
+-- +migrate Up
+
+-- The scheduled publisher polls for scheduled testimonials that are due
+CREATE INDEX IF NOT EXISTS idx_testimonials_scheduled_publish
+    ON testimonials(scheduled_publish_at)
+    WHERE status = 'scheduled';
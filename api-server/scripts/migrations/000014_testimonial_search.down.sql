@@ -0,0 +1,10 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_customer_profiles_company_trgm;
+DROP INDEX IF EXISTS idx_customer_profiles_name_trgm;
+DROP INDEX IF EXISTS idx_testimonials_title_trgm;
+DROP INDEX IF EXISTS idx_testimonials_search_vector;
+
+ALTER TABLE testimonials DROP COLUMN IF EXISTS search_vector;
+
+DROP FUNCTION IF EXISTS testimonial_search_config(TEXT);
@@ -0,0 +1,49 @@
+-- +migrate Up
+-- Full-text search over testimonials, stemmed in each testimonial's language
+
+-- Maps a language tag such as en or pt-BR to the text search configuration used to stem
+-- it. Languages without a built-in configuration are indexed without stemming.
+CREATE OR REPLACE FUNCTION testimonial_search_config(lang TEXT)
+RETURNS regconfig
+LANGUAGE sql
+IMMUTABLE PARALLEL SAFE
+AS $$
+    SELECT (CASE split_part(lower(coalesce(lang, '')), '-', 1)
+        WHEN 'da' THEN 'pg_catalog.danish'
+        WHEN 'de' THEN 'pg_catalog.german'
+        WHEN 'en' THEN 'pg_catalog.english'
+        WHEN 'es' THEN 'pg_catalog.spanish'
+        WHEN 'fi' THEN 'pg_catalog.finnish'
+        WHEN 'fr' THEN 'pg_catalog.french'
+        WHEN 'hu' THEN 'pg_catalog.hungarian'
+        WHEN 'it' THEN 'pg_catalog.italian'
+        WHEN 'nl' THEN 'pg_catalog.dutch'
+        WHEN 'no' THEN 'pg_catalog.norwegian'
+        WHEN 'nb' THEN 'pg_catalog.norwegian'
+        WHEN 'pt' THEN 'pg_catalog.portuguese'
+        WHEN 'ro' THEN 'pg_catalog.romanian'
+        WHEN 'ru' THEN 'pg_catalog.russian'
+        WHEN 'sv' THEN 'pg_catalog.swedish'
+        WHEN 'tr' THEN 'pg_catalog.turkish'
+        ELSE 'pg_catalog.simple'
+    END)::regconfig
+$$;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'testimonials' AND column_name = 'search_vector') THEN
+        ALTER TABLE testimonials ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+            setweight(to_tsvector(testimonial_search_config(language), coalesce(title, '')), 'A') ||
+            setweight(to_tsvector(testimonial_search_config(language), coalesce(summary, '')), 'B') ||
+            setweight(to_tsvector(testimonial_search_config(language), coalesce(content, '')), 'B') ||
+            setweight(to_tsvector(testimonial_search_config(language), coalesce(transcript, '')), 'C')
+        ) STORED;
+    END IF;
+END$$;
+
+CREATE INDEX IF NOT EXISTS idx_testimonials_search_vector ON testimonials USING gin(search_vector);
+
+-- Fuzzy matching for misspelled titles and customer names
+CREATE INDEX IF NOT EXISTS idx_testimonials_title_trgm ON testimonials USING gin(title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_customer_profiles_name_trgm ON customer_profiles USING gin(name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_customer_profiles_company_trgm ON customer_profiles USING gin(company gin_trgm_ops);
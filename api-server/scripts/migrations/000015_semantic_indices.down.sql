@@ -0,0 +1,4 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS semantic_indices CASCADE;
+DROP EXTENSION IF EXISTS vector;
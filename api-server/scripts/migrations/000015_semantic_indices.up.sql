@@ -0,0 +1,35 @@
+-- +migrate Up
+-- Testimonial embeddings for semantic similarity search, stored with pgvector. Each
+-- testimonial has one embedding of its title, summary and content or transcript.
+-- content_hash identifies the text that was embedded, so edits that leave it unchanged
+-- are not embedded again. Vectors are only comparable with others from the same model.
+
+CREATE EXTENSION IF NOT EXISTS vector;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_tables WHERE schemaname = 'public' AND tablename = 'semantic_indices') THEN
+        CREATE TABLE semantic_indices (
+            testimonial_id UUID PRIMARY KEY REFERENCES testimonials(id) ON DELETE CASCADE,
+            workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+            embedding_model VARCHAR(100) NOT NULL,
+            embedding vector(1536) NOT NULL,
+            content_hash CHAR(64) NOT NULL,
+            source_updated_at TIMESTAMPTZ NOT NULL,
+            created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+            updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+        );
+    END IF;
+END$$;
+
+CREATE INDEX IF NOT EXISTS idx_semantic_indices_workspace ON semantic_indices(workspace_id, embedding_model);
+CREATE INDEX IF NOT EXISTS idx_semantic_indices_embedding ON semantic_indices USING hnsw (embedding vector_cosine_ops);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'update_semantic_indices_updated_at') THEN
+        CREATE TRIGGER update_semantic_indices_updated_at
+            BEFORE UPDATE ON semantic_indices
+            FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END$$;
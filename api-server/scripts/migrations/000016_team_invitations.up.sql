@@ -0,0 +1,39 @@
+-- +migrate Up
+-- Invitations to join a workspace. Tokens are signed rather than stored; a token carries
+-- its expiry, and resending an invitation moves expires_at so older tokens stop working.
+-- Pending invitations hold a seat until they are accepted, revoked or expire.
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_tables WHERE schemaname = 'public' AND tablename = 'team_invitations') THEN
+        CREATE TABLE team_invitations (
+            id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+            workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+            email VARCHAR(255) NOT NULL,
+            role member_role NOT NULL,
+            status VARCHAR(20) NOT NULL DEFAULT 'pending'
+                CHECK (status IN ('pending', 'accepted', 'revoked')),
+            invited_by UUID REFERENCES team_members(id) ON DELETE SET NULL,
+            accepted_by UUID REFERENCES users(id) ON DELETE SET NULL,
+            expires_at TIMESTAMPTZ NOT NULL,
+            sent_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+            accepted_at TIMESTAMPTZ,
+            revoked_at TIMESTAMPTZ,
+            created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+            updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+        );
+    END IF;
+END$$;
+
+-- One open invitation per address and workspace
+CREATE UNIQUE INDEX IF NOT EXISTS idx_team_invitations_pending_email
+    ON team_invitations(workspace_id, LOWER(email)) WHERE status = 'pending';
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'update_team_invitations_updated_at') THEN
+        CREATE TRIGGER update_team_invitations_updated_at
+            BEFORE UPDATE ON team_invitations
+            FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END$$;
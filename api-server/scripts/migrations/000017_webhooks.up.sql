@@ -0,0 +1,66 @@
+-- +migrate Up
+-- Outbound webhooks. Each endpoint subscribes to event types; every event a workspace
+-- emits is queued as one delivery per subscribed endpoint. Deliveries are retried with
+-- backoff until they succeed or run out of attempts, which leaves them dead until
+-- someone redelivers them. Signing secrets are stored encrypted with the credentials key.
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_tables WHERE schemaname = 'public' AND tablename = 'webhook_endpoints') THEN
+        CREATE TABLE webhook_endpoints (
+            id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+            workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+            url VARCHAR(2048) NOT NULL,
+            description VARCHAR(255),
+            events TEXT[] NOT NULL,
+            secret JSONB NOT NULL,
+            is_active BOOLEAN NOT NULL DEFAULT TRUE,
+            created_by UUID REFERENCES team_members(id) ON DELETE SET NULL,
+            created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+            updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+        );
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM pg_tables WHERE schemaname = 'public' AND tablename = 'webhook_deliveries') THEN
+        CREATE TABLE webhook_deliveries (
+            id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+            endpoint_id UUID NOT NULL REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+            workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+            event_id UUID NOT NULL,
+            event_type VARCHAR(100) NOT NULL,
+            payload JSONB NOT NULL,
+            status VARCHAR(20) NOT NULL DEFAULT 'pending'
+                CHECK (status IN ('pending', 'succeeded', 'dead')),
+            attempts INTEGER NOT NULL DEFAULT 0,
+            next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+            last_attempt_at TIMESTAMPTZ,
+            response_status INTEGER,
+            response_body TEXT,
+            error TEXT,
+            redelivery_of UUID REFERENCES webhook_deliveries(id) ON DELETE SET NULL,
+            created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+            updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+        );
+    END IF;
+END$$;
+
+CREATE INDEX IF NOT EXISTS idx_webhook_endpoints_workspace ON webhook_endpoints(workspace_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due
+    ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_workspace
+    ON webhook_deliveries(workspace_id, created_at DESC);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'update_webhook_endpoints_updated_at') THEN
+        CREATE TRIGGER update_webhook_endpoints_updated_at
+            BEFORE UPDATE ON webhook_endpoints
+            FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'update_webhook_deliveries_updated_at') THEN
+        CREATE TRIGGER update_webhook_deliveries_updated_at
+            BEFORE UPDATE ON webhook_deliveries
+            FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END$$;
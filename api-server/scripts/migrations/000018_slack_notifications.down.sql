@@ -0,0 +1,5 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS slack_user_links CASCADE;
+DROP TABLE IF EXISTS slack_notifications CASCADE;
+DROP TABLE IF EXISTS slack_settings CASCADE;
@@ -0,0 +1,60 @@
+-- +migrate Up
+-- Slack notifications. Messages go to the incoming webhook in the workspace's
+-- integration_settings unless a routing rule names another one. slack_notifications
+-- records what each testimonial was posted as, so retries and repeated analyses do not
+-- post it twice. slack_user_links tie Slack users to team members so that the approve
+-- and reject buttons act as, and are limited to the role of, the member who clicked.
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_tables WHERE schemaname = 'public' AND tablename = 'slack_settings') THEN
+        CREATE TABLE slack_settings (
+            workspace_id UUID PRIMARY KEY REFERENCES workspaces(id) ON DELETE CASCADE,
+            enabled BOOLEAN NOT NULL DEFAULT FALSE,
+            notify_new BOOLEAN NOT NULL DEFAULT TRUE,
+            alert_rating REAL NOT NULL DEFAULT 2,
+            alert_sentiments TEXT[] NOT NULL DEFAULT ARRAY['very_negative'],
+            rules JSONB NOT NULL DEFAULT '[]',
+            digest_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+            digest_hour SMALLINT NOT NULL DEFAULT 9 CHECK (digest_hour BETWEEN 0 AND 23),
+            timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+            next_digest_at TIMESTAMPTZ,
+            created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+            updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+        );
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM pg_tables WHERE schemaname = 'public' AND tablename = 'slack_notifications') THEN
+        CREATE TABLE slack_notifications (
+            id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+            workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+            testimonial_id UUID NOT NULL REFERENCES testimonials(id) ON DELETE CASCADE,
+            kind VARCHAR(20) NOT NULL CHECK (kind IN ('new', 'alert')),
+            created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+            UNIQUE (testimonial_id, kind)
+        );
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM pg_tables WHERE schemaname = 'public' AND tablename = 'slack_user_links') THEN
+        CREATE TABLE slack_user_links (
+            workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+            slack_team_id VARCHAR(32) NOT NULL,
+            slack_user_id VARCHAR(32) NOT NULL,
+            team_member_id UUID NOT NULL REFERENCES team_members(id) ON DELETE CASCADE,
+            created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+            PRIMARY KEY (workspace_id, slack_team_id, slack_user_id)
+        );
+    END IF;
+END$$;
+
+CREATE INDEX IF NOT EXISTS idx_slack_settings_digest_due
+    ON slack_settings(next_digest_at) WHERE enabled AND digest_enabled;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'update_slack_settings_updated_at') THEN
+        CREATE TRIGGER update_slack_settings_updated_at
+            BEFORE UPDATE ON slack_settings
+            FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END$$;
@@ -0,0 +1,6 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_testimonials_published;
+DROP INDEX IF EXISTS idx_display_widgets_workspace;
+ALTER TABLE display_widgets DROP COLUMN IF EXISTS active;
+ALTER TABLE display_widgets DROP COLUMN IF EXISTS settings;
@@ -0,0 +1,21 @@
+-- +migrate Up
+-- Embeddable widgets. A display widget is served publicly at /embed/{id}; its type is
+-- the layout it renders with (carousel, grid, wall or single) and its settings choose
+-- which of the workspace's published testimonials it shows.
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'display_widgets' AND column_name = 'settings') THEN
+        ALTER TABLE display_widgets ADD COLUMN settings JSONB NOT NULL DEFAULT '{}';
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'display_widgets' AND column_name = 'active') THEN
+        ALTER TABLE display_widgets ADD COLUMN active BOOLEAN NOT NULL DEFAULT TRUE;
+    END IF;
+END$$;
+
+CREATE INDEX IF NOT EXISTS idx_display_widgets_workspace ON display_widgets(workspace_id);
+
+-- Widgets list a workspace's most recently published testimonials
+CREATE INDEX IF NOT EXISTS idx_testimonials_published
+    ON testimonials(workspace_id, published_at DESC)
+    WHERE published = TRUE;
@@ -0,0 +1,23 @@
+-- +migrate Up
+-- Display analytics. Beacons from embedded widgets are buffered in Redis and flushed
+-- here as daily totals per testimonial and display; individual conversions keep going
+-- to conversion_tracking.
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_tables WHERE schemaname = 'public' AND tablename = 'testimonial_display_stats') THEN
+        CREATE TABLE testimonial_display_stats (
+            testimonial_id UUID NOT NULL REFERENCES testimonials(id) ON DELETE CASCADE,
+            display_id UUID NOT NULL REFERENCES display_widgets(id) ON DELETE CASCADE,
+            workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+            day DATE NOT NULL,
+            impressions BIGINT NOT NULL DEFAULT 0,
+            clicks BIGINT NOT NULL DEFAULT 0,
+            conversions BIGINT NOT NULL DEFAULT 0,
+            conversion_value NUMERIC(14,2) NOT NULL DEFAULT 0,
+            PRIMARY KEY (testimonial_id, display_id, day)
+        );
+    END IF;
+END$$;
+
+CREATE INDEX IF NOT EXISTS idx_testimonial_display_stats_workspace ON testimonial_display_stats(workspace_id, day);
@@ -0,0 +1,5 @@
+-- +migrate Up
+-- The analytics dashboard aggregates the testimonials a workspace collected over a
+-- period by when they were created.
+
+CREATE INDEX IF NOT EXISTS idx_testimonials_workspace_created ON testimonials(workspace_id, created_at);
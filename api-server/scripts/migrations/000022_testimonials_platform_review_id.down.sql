@@ -0,0 +1,8 @@
+-- +migrate Down
+-- Fails if two platforms imported the same review id into a workspace since the up
+-- migration ran; those rows have to be resolved by hand first.
+
+DROP INDEX IF EXISTS idx_testimonials_workspace_platform_review_id;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_testimonials_workspace_review_id
+    ON testimonials(workspace_id, (source_data->>'review_id'))
+    WHERE source_data->>'review_id' IS NOT NULL;
@@ -0,0 +1,8 @@
+-- +migrate Up
+-- Review ids are only unique on the platform that issued them, so a Google review and
+-- a Yelp review with the same id must not overwrite each other.
+
+DROP INDEX IF EXISTS idx_testimonials_workspace_review_id;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_testimonials_workspace_platform_review_id
+    ON testimonials(workspace_id, (source_data->>'platform'), (source_data->>'review_id'))
+    WHERE source_data->>'review_id' IS NOT NULL;
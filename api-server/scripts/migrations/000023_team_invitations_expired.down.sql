@@ -0,0 +1,8 @@
+-- +migrate Down
+-- Expired invitations are kept as revoked, the closest status the old constraint allows.
+
+UPDATE team_invitations SET status = 'revoked', revoked_at = COALESCE(revoked_at, updated_at)
+    WHERE status = 'expired';
+ALTER TABLE team_invitations DROP CONSTRAINT IF EXISTS team_invitations_status_check;
+ALTER TABLE team_invitations ADD CONSTRAINT team_invitations_status_check
+    CHECK (status IN ('pending', 'accepted', 'revoked'));
@@ -0,0 +1,7 @@
+-- +migrate Up
+-- Invitations that lapse stop holding a seat. A lapsed invitation is marked expired when
+-- the address is invited again, which frees the one-open-invitation index for the new one.
+
+ALTER TABLE team_invitations DROP CONSTRAINT IF EXISTS team_invitations_status_check;
+ALTER TABLE team_invitations ADD CONSTRAINT team_invitations_status_check
+    CHECK (status IN ('pending', 'accepted', 'revoked', 'expired'));
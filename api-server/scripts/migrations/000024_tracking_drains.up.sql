@@ -0,0 +1,11 @@
+-- +migrate Up
+-- Batches of display analytics already applied. A batch is recorded in the transaction
+-- that applies it, so a batch handed out again after that transaction committed is not
+-- counted twice. Old entries are pruned as new batches arrive.
+
+CREATE TABLE IF NOT EXISTS tracking_drains (
+    id UUID PRIMARY KEY,
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_tracking_drains_applied_at ON tracking_drains(applied_at);
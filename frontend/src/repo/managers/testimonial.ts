@@ -50,23 +50,33 @@ export class TestimonialManager {
       const url = `${this.workspaceOrchestrator.server}/${workspaceID}/testimonials`;
       const { token } = await this.workspaceOrchestrator.getToken();
 
-      const response = await fetch(url, {
-        method: "GET",
-        headers: {
-          "Content-Type": "application/json",
-          Authorization: `Bearer ${token}`,
-        },
-      });
-
-      if (response.ok) {
-        const data = await response.json();
-        runInAction(() => {
-          this.testimonials = data;
+      // The listing is paginated; follow the cursors to load every page
+      const testimonials: Testimonial[] = [];
+      let cursor = "";
+      do {
+        const params = new URLSearchParams({ limit: "100" });
+        if (cursor) params.set("cursor", cursor);
+
+        const response = await fetch(`${url}?${params}`, {
+          method: "GET",
+          headers: {
+            "Content-Type": "application/json",
+            Authorization: `Bearer ${token}`,
+          },
         });
-        return data;
-      }
+        if (!response.ok) {
+          throw new Error("Could not get testimonials");
+        }
 
-      throw new Error("Could not get testimonials");
+        const page = await response.json();
+        testimonials.push(...page.data);
+        cursor = page.next_cursor ?? "";
+      } while (cursor);
+
+      runInAction(() => {
+        this.testimonials = testimonials;
+      });
+      return testimonials;
     } catch (error) {
       console.error("Error fetching testimonials:", error);
       throw error;